@@ -0,0 +1,174 @@
+# SPDX-License-Identifier: GPL-3.0-or-later
+"""Split files into content-defined chunks for uploading to Shaman.
+
+This must split files exactly as the `pkg/shaman/chunker` Go package does,
+otherwise the chunks will not be deduplicated against chunks uploaded by others.
+"""
+
+import dataclasses
+import hashlib
+import mmap
+from pathlib import Path
+from typing import Iterator, Union
+
+from . import time_tracker
+
+try:
+    import numpy
+except ImportError:
+    # Blender comes with NumPy, but without it chunking still works, just slower.
+    _have_numpy = False
+else:
+    _have_numpy = True
+
+_MASK64 = (1 << 64) - 1
+
+# The hash only depends on the last 64 bytes, as earlier bytes are shifted out.
+_WINDOW_SIZE = 64
+
+# Files are hashed in blocks of this size, to limit memory usage.
+_BLOCK_SIZE = 1024 * 1024
+
+
+class TimeInfo:
+    computing_chunks = 0.0
+
+
+@dataclasses.dataclass(frozen=True)
+class ChunkParams:
+    min_size: int
+    avg_size: int
+    max_size: int
+
+
+@dataclasses.dataclass(frozen=True)
+class Chunk:
+    offset: int
+    size: int
+    sha: str
+
+
+def _gear_table() -> list[int]:
+    """Return the first 256 outputs of SplitMix64, seeded with 0."""
+    table = []
+    state = 0
+    for _ in range(256):
+        state = (state + 0x9E3779B97F4A7C15) & _MASK64
+        z = state
+        z = ((z ^ (z >> 30)) * 0xBF58476D1CE4E5B9) & _MASK64
+        z = ((z ^ (z >> 27)) * 0x94D049BB133111EB) & _MASK64
+        table.append(z ^ (z >> 31))
+    return table
+
+
+_GEAR = _gear_table()
+
+
+
+
+def split(filepath: Path, params: ChunkParams) -> list[Chunk]:
+    """Split the file into chunks, and return them in order."""
+    num_bits = params.avg_size.bit_length() - 1
+    mask = ((1 << num_bits) - 1) << (64 - num_bits)
+
+    chunks: list[Chunk] = []
+    with time_tracker.track_time(TimeInfo, "computing_chunks"):
+        with filepath.open("rb") as infile:
+            if filepath.stat().st_size == 0:
+                return chunks
+            with mmap.mmap(infile.fileno(), 0, access=mmap.ACCESS_READ) as data:
+                offset = 0
+                for end in _chunk_ends(data, params, mask):
+                    checksum = hashlib.sha256(data[offset:end]).hexdigest()
+                    chunks.append(Chunk(offset=offset, size=end - offset, sha=checksum))
+                    offset = end
+    return chunks
+
+
+def _chunk_ends(
+    data: Union[bytes, mmap.mmap], params: ChunkParams, mask: int
+) -> Iterator[int]:
+    """Yield the end offset of each chunk."""
+    if not _have_numpy or params.min_size < _WINDOW_SIZE:
+        # Without NumPy, or when the hash at the possible chunk ends depends on
+        # where the chunk started, the hash has to be computed byte by byte.
+        offset = 0
+        while offset < len(data):
+            offset = _chunk_end(data, offset, params, mask)
+            yield offset
+        return
+
+    # Every possible chunk end is at least 64 bytes after the start of the
+    # chunk. The hash there only depends on the 64 bytes before it, and not on
+    # where the chunk started. This means the hashes can be computed with NumPy
+    # for a block of the file at once, which is much faster than byte by byte.
+    offset = 0
+    for block_start in range(0, len(data), _BLOCK_SIZE):
+        block_end = min(block_start + _BLOCK_SIZE, len(data))
+        flags = _window_hash_flags(data, block_start, block_end, mask)
+
+        while offset < len(data):
+            min_end = offset + params.min_size
+            max_end = min(offset + params.max_size, len(data))
+            if min_end >= max_end:
+                offset = max_end
+                yield offset
+                continue
+            if min_end - 1 >= block_end:
+                break  # The first possible end is in a later block.
+
+            # Find the first byte that ends the chunk, within this block.
+            first = max(min_end - 1, block_start)
+            last = min(max_end, block_end)
+            found = flags.find(0, first - block_start, last - block_start)
+            if found >= 0:
+                offset = block_start + found + 1
+            elif max_end <= block_end:
+                offset = max_end
+            else:
+                break  # The chunk continues in the next block.
+            yield offset
+
+
+def _window_hash_flags(
+    data: Union[bytes, mmap.mmap], block_start: int, block_end: int, mask: int
+) -> bytes:
+    """Return a byte per position in the block, which is zero when the hash of
+    the 64 bytes up to and including that position has no bits in the mask."""
+    window_start = max(0, block_start - (_WINDOW_SIZE - 1))
+    window = numpy.frombuffer(data[window_start:block_end], dtype=numpy.uint8)
+    hashes = numpy.array(_GEAR, dtype=numpy.uint64)[window]
+
+    # Add the shifted hashes of earlier positions, doubling the number of bytes
+    # that are included in each hash every step, until there are 64. Bytes
+    # before the start of the file are treated as zero, just like the initial
+    # hash of a chunk. Overflowing bits are discarded, like in the Go code.
+    width = 1
+    while width < _WINDOW_SIZE:
+        hashes[width:] += hashes[:-width] << numpy.uint64(width)
+        width *= 2
+
+    flags = (hashes & numpy.uint64(mask)) != 0
+    return flags[block_start - window_start :].tobytes()
+
+
+def _chunk_end(data: mmap.mmap, start: int, params: ChunkParams, mask: int) -> int:
+    """Return the end offset of the chunk that starts at the given offset."""
+    max_end = min(start + params.max_size, len(data))
+    min_end = start + params.min_size
+    if min_end >= max_end:
+        return max_end
+
+    gear = _GEAR
+    rolling_hash = 0
+
+    # Each byte is shifted out of the 64-bit hash after 64 more bytes, so the
+    # bytes before that don't influence the hash at the first possible chunk end.
+    for byte in data[max(start, min_end - 64) : min_end - 1]:
+        rolling_hash = ((rolling_hash << 1) + gear[byte]) & _MASK64
+
+    for index in range(min_end - 1, max_end):
+        rolling_hash = ((rolling_hash << 1) + gear[data[index]]) & _MASK64
+        if not rolling_hash & mask:
+            return index + 1
+    return max_end
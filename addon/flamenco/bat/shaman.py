@@ -1,6 +1,7 @@
 # SPDX-License-Identifier: GPL-3.0-or-later
 """BAT interface for sending files to the Manager via the Shaman API."""
 
+import io
 import logging
 import random
 import platform
@@ -8,27 +9,34 @@ from collections import deque
 from pathlib import Path, PurePath, PurePosixPath, PureWindowsPath
 from typing import TYPE_CHECKING, Optional, Any, Iterable, Iterator
 
-from . import cache, submodules
+from . import cache, chunker, submodules
 
 if TYPE_CHECKING:
     from ..manager import ApiClient as _ApiClient
+    from ..manager.exceptions import ApiException as _ApiException
 
     from ..manager.models import (
         ShamanCheckoutResult as _ShamanCheckoutResult,
+        ShamanChunking as _ShamanChunking,
         ShamanRequirementsRequest as _ShamanRequirementsRequest,
         ShamanFileSpec as _ShamanFileSpec,
     )
 else:
     _ApiClient = object
     _ShamanCheckoutResult = object
+    _ShamanChunking = object
     _ShamanRequirementsRequest = object
     _ShamanFileSpec = object
+    _ApiException = object
 
 log = logging.getLogger(__name__)
 
 MAX_DEFERRED_PATHS = 8
 MAX_FAILED_PATHS = 8
 
+# Seconds between checkout requests, while Shaman assembles files from their chunks.
+CHECKOUT_RETRY_DELAY_SECS = 2.0
+
 HashableShamanFileSpec = tuple[str, int, str]
 """Tuple of the 'sha', 'size', and 'path' fields of a ShamanFileSpec."""
 
@@ -126,6 +134,10 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
         # Temporary files that should be deleted before stopping.
         self._delete_when_done: list[Path] = []
 
+        # Mapping from the (checksum, size) of a chunk to the local file and the
+        # offset in that file where the chunk can be found.
+        self._chunk_sources: dict[tuple[str, int], tuple[Path, int]] = {}
+
     # noinspection PyBroadException
     def run(self) -> None:
         try:
@@ -146,7 +158,8 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
         self.uploaded_bytes = 0
 
         # Construct the Shaman Checkout Definition file.
-        shaman_file_specs = self._create_checkout_definition()
+        chunking = self._get_chunking()
+        shaman_file_specs = self._create_checkout_definition(chunking)
         if not shaman_file_specs:
             # An error has already been logged.
             return
@@ -197,9 +210,52 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
             # file to the Shaman and obtain a new list of files to upload.
         return make_file_specs_regular_list(failed_files)
 
-    def _create_checkout_definition(self) -> Optional[_ShamanRequirementsRequest]:
+    def _get_chunking(self) -> Optional[_ShamanChunking]:
+        """Return the chunking parameters of the Shaman.
+
+        :returns: the parameters, or None if files cannot be uploaded in chunks.
+        """
+        from ..manager.exceptions import ApiException
+
+        try:
+            status = self.shaman_api.get_shaman_status()
+        except ApiException as ex:
+            # Uploading in chunks is optional, so just upload whole files.
+            self.log.warning(
+                "Unable to get Shaman status, code %d: %s", ex.status, ex.body
+            )
+            return None
+
+        chunking: Optional[_ShamanChunking] = status.get("chunking")
+        if chunking:
+            self.log.info(
+                "Shaman accepts files of %d bytes or larger in chunks",
+                chunking.min_file_size,
+            )
+        return chunking
+
+    def _split_into_chunks(
+        self, filepath: Path, chunking: _ShamanChunking
+    ) -> list[chunker.Chunk]:
+        """Split the file into chunks, and remember where they can be found."""
+        params = chunker.ChunkParams(
+            min_size=chunking.min_chunk_size,
+            avg_size=chunking.avg_chunk_size,
+            max_size=chunking.max_chunk_size,
+        )
+        self.log.debug("Splitting %s into chunks", filepath)
+        chunks = chunker.split(filepath, params)
+        for chunk in chunks:
+            self._chunk_sources[chunk.sha, chunk.size] = (filepath, chunk.offset)
+        return chunks
+
+    def _create_checkout_definition(
+        self, chunking: Optional[_ShamanChunking]
+    ) -> Optional[_ShamanRequirementsRequest]:
         """Create the checkout definition file for this BAT pack.
 
+        :param chunking: when given, large files are described by their chunks
+            as well, so that they can be uploaded in chunks.
         :returns: the checkout definition.
 
         If there was an error and file transfer was aborted, the checkout
@@ -207,6 +263,7 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
         """
 
         from ..manager.models import (
+            ShamanChunkSpec,
             ShamanRequirementsRequest,
             ShamanFileSpec,
         )
@@ -224,6 +281,11 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
                     size=filesize,
                     path=relpath,
                 )
+                if chunking and filesize >= chunking.min_file_size:
+                    filespec.chunks = [
+                        ShamanChunkSpec(sha=chunk.sha, size=chunk.size)
+                        for chunk in self._split_into_chunks(src, chunking)
+                    ]
                 if filespec in filespecs:
                     # FIXME: there is an issue in BAT that some UDIM files are
                     # reported twice. There is no use asking Shaman to check
@@ -275,6 +337,8 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
             return None
         assert isinstance(resp, ShamanRequirementsResponse)
 
+        from ..manager.models import ShamanFileSpec
+
         to_upload: deque[_ShamanFileSpec] = deque()
         for file_spec in resp.files:
             if file_spec.path not in self._rel_to_local_path:
@@ -285,6 +349,24 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
                 self.error_set(msg)
                 return None
 
+            chunks = file_spec.get("chunks")
+            if chunks is not None:
+                # Only upload the chunks that the Shaman doesn't have yet. They
+                # are uploaded just like files, but from a part of the local file.
+                self.log.debug("   %s: %d chunks", file_spec.path, len(chunks))
+                for chunk in chunks:
+                    if (chunk.sha, chunk.size) not in self._chunk_sources:
+                        msg = "Shaman requested chunk we did not offer: %r" % chunk
+                        self.log.error(msg)
+                        self.error_set(msg)
+                        return None
+                    to_upload.appendleft(
+                        ShamanFileSpec(
+                            sha=chunk.sha, size=chunk.size, path=file_spec.path
+                        )
+                    )
+                continue
+
             self.log.debug("   %s: %s", file_spec.status, file_spec.path)
             match file_spec.status.value:
                 case "unknown":
@@ -298,6 +380,55 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
                     return None
         return to_upload
 
+    def _local_source(self, file_spec: _ShamanFileSpec) -> tuple[Path, int, bool]:
+        """Return where the file or chunk can be found locally.
+
+        :returns: the local file, the offset of the data in that file, and
+            whether this is a chunk of that file.
+        """
+        chunk_source = self._chunk_sources.get((file_spec.sha, file_spec.size))
+        if chunk_source:
+            local_filepath, chunk_offset = chunk_source
+            return local_filepath, chunk_offset, True
+        return self._rel_to_local_path[file_spec.path], 0, False
+
+    def _store_file(
+        self,
+        file_spec: _ShamanFileSpec,
+        offset: int,
+        can_defer: bool,
+    ) -> Optional[_ApiException]:
+        """Send the file or chunk to the Shaman, starting at the given byte offset.
+
+        Returns the API exception when the Shaman refused the file, or None when
+        it was stored.
+        """
+        from ..manager.exceptions import ApiException
+
+        kwargs = {}
+        if offset:
+            kwargs["x_shaman_upload_offset"] = offset
+
+        local_filepath, data_offset, is_chunk = self._local_source(file_spec)
+        try:
+            with local_filepath.open("rb") as file_reader:
+                file_reader.seek(data_offset + offset)
+                body: io.IOBase = file_reader
+                if is_chunk:
+                    # Only send this chunk, and not the remainder of the file.
+                    body = io.BytesIO(file_reader.read(file_spec.size - offset))
+                self.shaman_api.shaman_file_store(
+                    checksum=file_spec.sha,
+                    filesize=file_spec.size,
+                    body=body,
+                    x_shaman_can_defer_upload=can_defer,
+                    x_shaman_original_filename=file_spec.path,
+                    **kwargs,
+                )
+        except ApiException as ex:
+            return ex
+        return None
+
     def _upload_files(
         self, to_upload: deque[_ShamanFileSpec]
     ) -> set[HashableShamanFileSpec]:
@@ -310,8 +441,6 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
             self.report_transferred(0)
             return set()
 
-        from ..manager.exceptions import ApiException
-
         failed_specs: set[HashableShamanFileSpec] = set()
         deferred_specs: set[HashableShamanFileSpec] = set()
 
@@ -364,19 +493,31 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
                 and len(to_upload)
             )
 
-            local_filepath = self._rel_to_local_path[file_spec.path]
-            try:
-                with local_filepath.open("rb") as file_reader:
-                    self.shaman_api.shaman_file_store(
-                        checksum=file_spec.sha,
-                        filesize=file_spec.size,
-                        body=file_reader,
-                        x_shaman_can_defer_upload=can_defer,
-                        x_shaman_original_filename=file_spec.path,
-                    )
-            except ApiException as ex:
+            # Resume an earlier, unfinished upload of this file if the Shaman has
+            # part of it already.
+            offset = check_resp.get("offset", 0) or 0
+            if offset:
+                self.log.info(
+                    "  %s: resuming upload at %d of %d bytes",
+                    file_spec.path,
+                    offset,
+                    file_spec.size,
+                )
+
+            ex = self._store_file(file_spec, offset, can_defer)
+            if ex is not None and ex.status == 416 and offset:
+                # Requested Range Not Satisfiable; the partial upload is gone or
+                # doesn't match, so just upload the entire file again.
+                self.log.info(
+                    "  %s: unable to resume upload, uploading entire file",
+                    file_spec.path,
+                )
+                offset = 0
+                ex = self._store_file(file_spec, offset, can_defer)
+
+            if ex is not None:
                 match ex.status:
-                    case 425:  # Too Early, i.e. defer uploading this file.
+                    case 425 | 409:  # Too Early / Conflict: someone else is uploading.
                         self.log.info(
                             "  %s: someone else is uploading this file, deferring",
                             file_spec.path,
@@ -402,7 +543,7 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
 
             failed_specs.discard(make_file_spec_hashable(file_spec))
             self.uploaded_files += 1
-            file_size = local_filepath.stat().st_size
+            file_size = file_spec.size - offset
             self.uploaded_bytes += file_size
             self.report_transferred(file_size)
 
@@ -448,27 +589,35 @@ class Transferrer(submodules.transfer.FileTransferer):  # type: ignore
             checkout_path=str(self.checkout_path),
         )
 
-        try:
-            result: ShamanCheckoutResult = self.shaman_api.shaman_checkout(
-                checkoutRequest
-            )
-        except ApiException as ex:
-            match ex.status:
-                case 424:  # Files were missing
-                    msg = "We did not upload some files, checkout aborted"
-                case 409:  # Checkout already exists
-                    msg = (
-                        "There is already an existing checkout at %s"
-                        % self.checkout_path
-                    )
-                case _:  # Unknown error
-                    msg = "API exception\nHeaders: %s\nBody: %s\n" % (
-                        ex.headers,
-                        ex.body,
-                    )
-            self.log.error(msg)
-            self.error_set(msg)
-            return None
+        while True:
+            try:
+                result: ShamanCheckoutResult = self.shaman_api.shaman_checkout(
+                    checkoutRequest
+                )
+            except ApiException as ex:
+                match ex.status:
+                    case 425:  # Too Early; files are being assembled from chunks.
+                        self.log.info("Shaman is assembling files, waiting")
+                        if self._abort.wait(CHECKOUT_RETRY_DELAY_SECS):
+                            self.log.warning("Interrupting checkout request")
+                            raise self.AbortUpload("interrupting checkout request")
+                        continue
+                    case 424:  # Files were missing
+                        msg = "We did not upload some files, checkout aborted"
+                    case 409:  # Checkout already exists
+                        msg = (
+                            "There is already an existing checkout at %s"
+                            % self.checkout_path
+                        )
+                    case _:  # Unknown error
+                        msg = "API exception\nHeaders: %s\nBody: %s\n" % (
+                            ex.headers,
+                            ex.body,
+                        )
+                self.log.error(msg)
+                self.error_set(msg)
+                return None
+            break
 
         self.log.info("Shaman created checkout at %s", result.checkout_path)
         return result
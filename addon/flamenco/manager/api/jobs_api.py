@@ -27,15 +27,22 @@ from flamenco.manager.model.error import Error
 from flamenco.manager.model.job import Job
 from flamenco.manager.model.job_blocklist import JobBlocklist
 from flamenco.manager.model.job_deletion_info import JobDeletionInfo
+from flamenco.manager.model.job_last_rendered_history import JobLastRenderedHistory
 from flamenco.manager.model.job_last_rendered_image_info import JobLastRenderedImageInfo
 from flamenco.manager.model.job_priority_change import JobPriorityChange
 from flamenco.manager.model.job_status_change import JobStatusChange
 from flamenco.manager.model.job_tasks_summary import JobTasksSummary
+from flamenco.manager.model.job_template import JobTemplate
+from flamenco.manager.model.job_template_list import JobTemplateList
 from flamenco.manager.model.jobs_query import JobsQuery
 from flamenco.manager.model.jobs_query_result import JobsQueryResult
+from flamenco.manager.model.last_rendered_queue_info import LastRenderedQueueInfo
 from flamenco.manager.model.submitted_job import SubmittedJob
+from flamenco.manager.model.submitted_job_template import SubmittedJobTemplate
 from flamenco.manager.model.task import Task
 from flamenco.manager.model.task_log_info import TaskLogInfo
+from flamenco.manager.model.task_log_search_match import TaskLogSearchMatch
+from flamenco.manager.model.task_log_search_query import TaskLogSearchQuery
 from flamenco.manager.model.task_status_change import TaskStatusChange
 
 
@@ -50,21 +57,21 @@ class JobsApi(object):
         if api_client is None:
             api_client = ApiClient()
         self.api_client = api_client
-        self.delete_job_endpoint = _Endpoint(
+        self.create_job_template_endpoint = _Endpoint(
             settings={
-                'response_type': None,
+                'response_type': (JobTemplate,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}',
-                'operation_id': 'delete_job',
-                'http_method': 'DELETE',
+                'endpoint_path': '/api/v3/job-templates',
+                'operation_id': 'create_job_template',
+                'http_method': 'POST',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'job_id',
+                    'submitted_job_template',
                 ],
                 'required': [
-                    'job_id',
+                    'submitted_job_template',
                 ],
                 'nullable': [
                 ],
@@ -79,14 +86,13 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'job_id':
-                        (str,),
+                    'submitted_job_template':
+                        (SubmittedJobTemplate,),
                 },
                 'attribute_map': {
-                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'job_id': 'path',
+                    'submitted_job_template': 'body',
                 },
                 'collection_format_map': {
                 }
@@ -95,17 +101,19 @@ class JobsApi(object):
                 'accept': [
                     'application/json'
                 ],
-                'content_type': [],
+                'content_type': [
+                    'application/json'
+                ]
             },
             api_client=api_client
         )
-        self.delete_job_what_would_it_do_endpoint = _Endpoint(
+        self.delete_job_endpoint = _Endpoint(
             settings={
-                'response_type': (JobDeletionInfo,),
+                'response_type': None,
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}/what-would-delete-do',
-                'operation_id': 'delete_job_what_would_it_do',
-                'http_method': 'GET',
+                'endpoint_path': '/api/v3/jobs/{job_id}',
+                'operation_id': 'delete_job',
+                'http_method': 'DELETE',
                 'servers': None,
             },
             params_map={
@@ -148,19 +156,22 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.fetch_global_last_rendered_info_endpoint = _Endpoint(
+        self.delete_job_template_endpoint = _Endpoint(
             settings={
-                'response_type': (JobLastRenderedImageInfo,),
+                'response_type': None,
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/last-rendered',
-                'operation_id': 'fetch_global_last_rendered_info',
-                'http_method': 'GET',
+                'endpoint_path': '/api/v3/job-templates/{template_id}',
+                'operation_id': 'delete_job_template',
+                'http_method': 'DELETE',
                 'servers': None,
             },
             params_map={
                 'all': [
+                    'template_id',
+                ],
+                'required': [
+                    'template_id',
                 ],
-                'required': [],
                 'nullable': [
                 ],
                 'enum': [
@@ -174,10 +185,14 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
+                    'template_id':
+                        (str,),
                 },
                 'attribute_map': {
+                    'template_id': 'template_id',
                 },
                 'location_map': {
+                    'template_id': 'path',
                 },
                 'collection_format_map': {
                 }
@@ -190,12 +205,12 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.fetch_job_endpoint = _Endpoint(
+        self.delete_job_what_would_it_do_endpoint = _Endpoint(
             settings={
-                'response_type': (Job,),
+                'response_type': (JobDeletionInfo,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}',
-                'operation_id': 'fetch_job',
+                'endpoint_path': '/api/v3/jobs/{job_id}/what-would-delete-do',
+                'operation_id': 'delete_job_what_would_it_do',
                 'http_method': 'GET',
                 'servers': None,
             },
@@ -239,12 +254,12 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.fetch_job_blocklist_endpoint = _Endpoint(
+        self.export_job_archive_endpoint = _Endpoint(
             settings={
-                'response_type': (JobBlocklist,),
+                'response_type': (file_type,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}/blocklist',
-                'operation_id': 'fetch_job_blocklist',
+                'endpoint_path': '/api/v3/jobs/{job_id}/archive',
+                'operation_id': 'export_job_archive',
                 'http_method': 'GET',
                 'servers': None,
             },
@@ -282,28 +297,26 @@ class JobsApi(object):
             },
             headers_map={
                 'accept': [
+                    'application/zip',
                     'application/json'
                 ],
                 'content_type': [],
             },
             api_client=api_client
         )
-        self.fetch_job_last_rendered_info_endpoint = _Endpoint(
+        self.fetch_global_last_rendered_info_endpoint = _Endpoint(
             settings={
                 'response_type': (JobLastRenderedImageInfo,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}/last-rendered',
-                'operation_id': 'fetch_job_last_rendered_info',
+                'endpoint_path': '/api/v3/jobs/last-rendered',
+                'operation_id': 'fetch_global_last_rendered_info',
                 'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'job_id',
-                ],
-                'required': [
-                    'job_id',
                 ],
+                'required': [],
                 'nullable': [
                 ],
                 'enum': [
@@ -317,14 +330,10 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'job_id':
-                        (str,),
                 },
                 'attribute_map': {
-                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'job_id': 'path',
                 },
                 'collection_format_map': {
                 }
@@ -337,12 +346,12 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.fetch_job_tasks_endpoint = _Endpoint(
+        self.fetch_job_endpoint = _Endpoint(
             settings={
-                'response_type': (JobTasksSummary,),
+                'response_type': (Job,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}/tasks',
-                'operation_id': 'fetch_job_tasks',
+                'endpoint_path': '/api/v3/jobs/{job_id}',
+                'operation_id': 'fetch_job',
                 'http_method': 'GET',
                 'servers': None,
             },
@@ -386,21 +395,21 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.fetch_task_endpoint = _Endpoint(
+        self.fetch_job_blocklist_endpoint = _Endpoint(
             settings={
-                'response_type': (Task,),
+                'response_type': (JobBlocklist,),
                 'auth': [],
-                'endpoint_path': '/api/v3/tasks/{task_id}',
-                'operation_id': 'fetch_task',
+                'endpoint_path': '/api/v3/jobs/{job_id}/blocklist',
+                'operation_id': 'fetch_job_blocklist',
                 'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'task_id',
+                    'job_id',
                 ],
                 'required': [
-                    'task_id',
+                    'job_id',
                 ],
                 'nullable': [
                 ],
@@ -415,14 +424,14 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'task_id':
+                    'job_id':
                         (str,),
                 },
                 'attribute_map': {
-                    'task_id': 'task_id',
+                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'task_id': 'path',
+                    'job_id': 'path',
                 },
                 'collection_format_map': {
                 }
@@ -435,70 +444,82 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.fetch_task_log_info_endpoint = _Endpoint(
+        self.fetch_job_last_rendered_contact_sheet_endpoint = _Endpoint(
             settings={
-                'response_type': (TaskLogInfo,),
+                'response_type': (file_type,),
                 'auth': [],
-                'endpoint_path': '/api/v3/tasks/{task_id}/log',
-                'operation_id': 'fetch_task_log_info',
+                'endpoint_path': '/api/v3/jobs/{job_id}/last-rendered/contact-sheet',
+                'operation_id': 'fetch_job_last_rendered_contact_sheet',
                 'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'task_id',
+                    'job_id',
+                    'columns',
                 ],
                 'required': [
-                    'task_id',
+                    'job_id',
                 ],
                 'nullable': [
                 ],
                 'enum': [
                 ],
                 'validation': [
+                    'columns',
                 ]
             },
             root_map={
                 'validations': {
+                    ('columns',): {
+
+                        'inclusive_maximum': 32,
+                        'inclusive_minimum': 1,
+                    },
                 },
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'task_id':
+                    'job_id':
                         (str,),
+                    'columns':
+                        (int,),
                 },
                 'attribute_map': {
-                    'task_id': 'task_id',
+                    'job_id': 'job_id',
+                    'columns': 'columns',
                 },
                 'location_map': {
-                    'task_id': 'path',
+                    'job_id': 'path',
+                    'columns': 'query',
                 },
                 'collection_format_map': {
                 }
             },
             headers_map={
                 'accept': [
+                    'image/jpeg',
                     'application/json'
                 ],
                 'content_type': [],
             },
             api_client=api_client
         )
-        self.fetch_task_log_tail_endpoint = _Endpoint(
+        self.fetch_job_last_rendered_history_endpoint = _Endpoint(
             settings={
-                'response_type': (str,),
+                'response_type': (JobLastRenderedHistory,),
                 'auth': [],
-                'endpoint_path': '/api/v3/tasks/{task_id}/logtail',
-                'operation_id': 'fetch_task_log_tail',
+                'endpoint_path': '/api/v3/jobs/{job_id}/last-rendered/history',
+                'operation_id': 'fetch_job_last_rendered_history',
                 'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'task_id',
+                    'job_id',
                 ],
                 'required': [
-                    'task_id',
+                    'job_id',
                 ],
                 'nullable': [
                 ],
@@ -513,42 +534,41 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'task_id':
+                    'job_id':
                         (str,),
                 },
                 'attribute_map': {
-                    'task_id': 'task_id',
+                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'task_id': 'path',
+                    'job_id': 'path',
                 },
                 'collection_format_map': {
                 }
             },
             headers_map={
                 'accept': [
-                    'text/plain',
                     'application/json'
                 ],
                 'content_type': [],
             },
             api_client=api_client
         )
-        self.get_job_type_endpoint = _Endpoint(
+        self.fetch_job_last_rendered_info_endpoint = _Endpoint(
             settings={
-                'response_type': (AvailableJobType,),
+                'response_type': (JobLastRenderedImageInfo,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/type/{typeName}',
-                'operation_id': 'get_job_type',
+                'endpoint_path': '/api/v3/jobs/{job_id}/last-rendered',
+                'operation_id': 'fetch_job_last_rendered_info',
                 'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'type_name',
+                    'job_id',
                 ],
                 'required': [
-                    'type_name',
+                    'job_id',
                 ],
                 'nullable': [
                 ],
@@ -563,14 +583,14 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'type_name':
+                    'job_id':
                         (str,),
                 },
                 'attribute_map': {
-                    'type_name': 'typeName',
+                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'type_name': 'path',
+                    'job_id': 'path',
                 },
                 'collection_format_map': {
                 }
@@ -583,19 +603,22 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.get_job_types_endpoint = _Endpoint(
+        self.fetch_job_tasks_endpoint = _Endpoint(
             settings={
-                'response_type': (AvailableJobTypes,),
+                'response_type': (JobTasksSummary,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/types',
-                'operation_id': 'get_job_types',
+                'endpoint_path': '/api/v3/jobs/{job_id}/tasks',
+                'operation_id': 'fetch_job_tasks',
                 'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
+                    'job_id',
+                ],
+                'required': [
+                    'job_id',
                 ],
-                'required': [],
                 'nullable': [
                 ],
                 'enum': [
@@ -609,10 +632,14 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
+                    'job_id':
+                        (str,),
                 },
                 'attribute_map': {
+                    'job_id': 'job_id',
                 },
                 'location_map': {
+                    'job_id': 'path',
                 },
                 'collection_format_map': {
                 }
@@ -625,21 +652,21 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.query_jobs_endpoint = _Endpoint(
+        self.fetch_job_template_endpoint = _Endpoint(
             settings={
-                'response_type': (JobsQueryResult,),
+                'response_type': (JobTemplate,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/query',
-                'operation_id': 'query_jobs',
-                'http_method': 'POST',
+                'endpoint_path': '/api/v3/job-templates/{template_id}',
+                'operation_id': 'fetch_job_template',
+                'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'jobs_query',
+                    'template_id',
                 ],
                 'required': [
-                    'jobs_query',
+                    'template_id',
                 ],
                 'nullable': [
                 ],
@@ -654,13 +681,14 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'jobs_query':
-                        (JobsQuery,),
+                    'template_id':
+                        (str,),
                 },
                 'attribute_map': {
+                    'template_id': 'template_id',
                 },
                 'location_map': {
-                    'jobs_query': 'body',
+                    'template_id': 'path',
                 },
                 'collection_format_map': {
                 }
@@ -669,29 +697,23 @@ class JobsApi(object):
                 'accept': [
                     'application/json'
                 ],
-                'content_type': [
-                    'application/json'
-                ]
+                'content_type': [],
             },
             api_client=api_client
         )
-        self.remove_job_blocklist_endpoint = _Endpoint(
+        self.fetch_job_templates_endpoint = _Endpoint(
             settings={
-                'response_type': None,
+                'response_type': (JobTemplateList,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}/blocklist',
-                'operation_id': 'remove_job_blocklist',
-                'http_method': 'DELETE',
+                'endpoint_path': '/api/v3/job-templates',
+                'operation_id': 'fetch_job_templates',
+                'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'job_id',
-                    'job_blocklist',
-                ],
-                'required': [
-                    'job_id',
                 ],
+                'required': [],
                 'nullable': [
                 ],
                 'enum': [
@@ -705,17 +727,10 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'job_id':
-                        (str,),
-                    'job_blocklist':
-                        (JobBlocklist,),
                 },
                 'attribute_map': {
-                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'job_id': 'path',
-                    'job_blocklist': 'body',
                 },
                 'collection_format_map': {
                 }
@@ -724,30 +739,23 @@ class JobsApi(object):
                 'accept': [
                     'application/json'
                 ],
-                'content_type': [
-                    'application/json'
-                ]
+                'content_type': [],
             },
             api_client=api_client
         )
-        self.set_job_priority_endpoint = _Endpoint(
+        self.fetch_last_rendered_queue_info_endpoint = _Endpoint(
             settings={
-                'response_type': None,
+                'response_type': (LastRenderedQueueInfo,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}/setpriority',
-                'operation_id': 'set_job_priority',
-                'http_method': 'POST',
+                'endpoint_path': '/api/v3/jobs/last-rendered-queue',
+                'operation_id': 'fetch_last_rendered_queue_info',
+                'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'job_id',
-                    'job_priority_change',
-                ],
-                'required': [
-                    'job_id',
-                    'job_priority_change',
                 ],
+                'required': [],
                 'nullable': [
                 ],
                 'enum': [
@@ -761,17 +769,10 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'job_id':
-                        (str,),
-                    'job_priority_change':
-                        (JobPriorityChange,),
                 },
                 'attribute_map': {
-                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'job_id': 'path',
-                    'job_priority_change': 'body',
                 },
                 'collection_format_map': {
                 }
@@ -780,29 +781,25 @@ class JobsApi(object):
                 'accept': [
                     'application/json'
                 ],
-                'content_type': [
-                    'application/json'
-                ]
+                'content_type': [],
             },
             api_client=api_client
         )
-        self.set_job_status_endpoint = _Endpoint(
+        self.fetch_task_endpoint = _Endpoint(
             settings={
-                'response_type': None,
+                'response_type': (Task,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/{job_id}/setstatus',
-                'operation_id': 'set_job_status',
-                'http_method': 'POST',
+                'endpoint_path': '/api/v3/tasks/{task_id}',
+                'operation_id': 'fetch_task',
+                'http_method': 'GET',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'job_id',
-                    'job_status_change',
+                    'task_id',
                 ],
                 'required': [
-                    'job_id',
-                    'job_status_change',
+                    'task_id',
                 ],
                 'nullable': [
                 ],
@@ -817,17 +814,14 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'job_id':
+                    'task_id':
                         (str,),
-                    'job_status_change':
-                        (JobStatusChange,),
                 },
                 'attribute_map': {
-                    'job_id': 'job_id',
+                    'task_id': 'task_id',
                 },
                 'location_map': {
-                    'job_id': 'path',
-                    'job_status_change': 'body',
+                    'task_id': 'path',
                 },
                 'collection_format_map': {
                 }
@@ -836,29 +830,25 @@ class JobsApi(object):
                 'accept': [
                     'application/json'
                 ],
-                'content_type': [
-                    'application/json'
-                ]
+                'content_type': [],
             },
             api_client=api_client
         )
-        self.set_task_status_endpoint = _Endpoint(
+        self.fetch_task_log_info_endpoint = _Endpoint(
             settings={
-                'response_type': None,
+                'response_type': (TaskLogInfo,),
                 'auth': [],
-                'endpoint_path': '/api/v3/tasks/{task_id}/setstatus',
-                'operation_id': 'set_task_status',
-                'http_method': 'POST',
-                'servers': None,
-            },
+                'endpoint_path': '/api/v3/tasks/{task_id}/log',
+                'operation_id': 'fetch_task_log_info',
+                'http_method': 'GET',
+                'servers': None,
+            },
             params_map={
                 'all': [
                     'task_id',
-                    'task_status_change',
                 ],
                 'required': [
                     'task_id',
-                    'task_status_change',
                 ],
                 'nullable': [
                 ],
@@ -875,15 +865,12 @@ class JobsApi(object):
                 'openapi_types': {
                     'task_id':
                         (str,),
-                    'task_status_change':
-                        (TaskStatusChange,),
                 },
                 'attribute_map': {
                     'task_id': 'task_id',
                 },
                 'location_map': {
                     'task_id': 'path',
-                    'task_status_change': 'body',
                 },
                 'collection_format_map': {
                 }
@@ -892,27 +879,166 @@ class JobsApi(object):
                 'accept': [
                     'application/json'
                 ],
-                'content_type': [
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.fetch_task_log_tail_endpoint = _Endpoint(
+            settings={
+                'response_type': (str,),
+                'auth': [],
+                'endpoint_path': '/api/v3/tasks/{task_id}/logtail',
+                'operation_id': 'fetch_task_log_tail',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'task_id',
+                ],
+                'required': [
+                    'task_id',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'task_id':
+                        (str,),
+                },
+                'attribute_map': {
+                    'task_id': 'task_id',
+                },
+                'location_map': {
+                    'task_id': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'text/plain',
                     'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.get_job_type_endpoint = _Endpoint(
+            settings={
+                'response_type': (AvailableJobType,),
+                'auth': [],
+                'endpoint_path': '/api/v3/jobs/type/{typeName}',
+                'operation_id': 'get_job_type',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'type_name',
+                ],
+                'required': [
+                    'type_name',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
                 ]
             },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'type_name':
+                        (str,),
+                },
+                'attribute_map': {
+                    'type_name': 'typeName',
+                },
+                'location_map': {
+                    'type_name': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
             api_client=api_client
         )
-        self.submit_job_endpoint = _Endpoint(
+        self.get_job_types_endpoint = _Endpoint(
+            settings={
+                'response_type': (AvailableJobTypes,),
+                'auth': [],
+                'endpoint_path': '/api/v3/jobs/types',
+                'operation_id': 'get_job_types',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                ],
+                'required': [],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                },
+                'attribute_map': {
+                },
+                'location_map': {
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
+        self.import_job_archive_endpoint = _Endpoint(
             settings={
                 'response_type': (Job,),
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs',
-                'operation_id': 'submit_job',
+                'endpoint_path': '/api/v3/jobs/import',
+                'operation_id': 'import_job_archive',
                 'http_method': 'POST',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'submitted_job',
+                    'body',
                 ],
                 'required': [
-                    'submitted_job',
+                    'body',
                 ],
                 'nullable': [
                 ],
@@ -927,13 +1053,63 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'submitted_job':
-                        (SubmittedJob,),
+                    'body':
+                        (file_type,),
                 },
                 'attribute_map': {
                 },
                 'location_map': {
-                    'submitted_job': 'body',
+                    'body': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/zip'
+                ]
+            },
+            api_client=api_client
+        )
+        self.query_jobs_endpoint = _Endpoint(
+            settings={
+                'response_type': (JobsQueryResult,),
+                'auth': [],
+                'endpoint_path': '/api/v3/jobs/query',
+                'operation_id': 'query_jobs',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'jobs_query',
+                ],
+                'required': [
+                    'jobs_query',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'jobs_query':
+                        (JobsQuery,),
+                },
+                'attribute_map': {
+                },
+                'location_map': {
+                    'jobs_query': 'body',
                 },
                 'collection_format_map': {
                 }
@@ -948,21 +1124,22 @@ class JobsApi(object):
             },
             api_client=api_client
         )
-        self.submit_job_check_endpoint = _Endpoint(
+        self.remove_job_blocklist_endpoint = _Endpoint(
             settings={
                 'response_type': None,
                 'auth': [],
-                'endpoint_path': '/api/v3/jobs/check',
-                'operation_id': 'submit_job_check',
-                'http_method': 'POST',
+                'endpoint_path': '/api/v3/jobs/{job_id}/blocklist',
+                'operation_id': 'remove_job_blocklist',
+                'http_method': 'DELETE',
                 'servers': None,
             },
             params_map={
                 'all': [
-                    'submitted_job',
+                    'job_id',
+                    'job_blocklist',
                 ],
                 'required': [
-                    'submitted_job',
+                    'job_id',
                 ],
                 'nullable': [
                 ],
@@ -977,13 +1154,17 @@ class JobsApi(object):
                 'allowed_values': {
                 },
                 'openapi_types': {
-                    'submitted_job':
-                        (SubmittedJob,),
+                    'job_id':
+                        (str,),
+                    'job_blocklist':
+                        (JobBlocklist,),
                 },
                 'attribute_map': {
+                    'job_id': 'job_id',
                 },
                 'location_map': {
-                    'submitted_job': 'body',
+                    'job_id': 'path',
+                    'job_blocklist': 'body',
                 },
                 'collection_format_map': {
                 }
@@ -998,18 +1179,1159 @@ class JobsApi(object):
             },
             api_client=api_client
         )
+        self.search_task_logs_endpoint = _Endpoint(
+            settings={
+                'response_type': (TaskLogSearchMatch,),
+                'auth': [],
+                'endpoint_path': '/api/v3/tasks/logs/search',
+                'operation_id': 'search_task_logs',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'task_log_search_query',
+                ],
+                'required': [
+                    'task_log_search_query',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'task_log_search_query':
+                        (TaskLogSearchQuery,),
+                },
+                'attribute_map': {
+                },
+                'location_map': {
+                    'task_log_search_query': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/x-ndjson',
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.set_job_priority_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [],
+                'endpoint_path': '/api/v3/jobs/{job_id}/setpriority',
+                'operation_id': 'set_job_priority',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'job_id',
+                    'job_priority_change',
+                ],
+                'required': [
+                    'job_id',
+                    'job_priority_change',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'job_id':
+                        (str,),
+                    'job_priority_change':
+                        (JobPriorityChange,),
+                },
+                'attribute_map': {
+                    'job_id': 'job_id',
+                },
+                'location_map': {
+                    'job_id': 'path',
+                    'job_priority_change': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.set_job_status_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [],
+                'endpoint_path': '/api/v3/jobs/{job_id}/setstatus',
+                'operation_id': 'set_job_status',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'job_id',
+                    'job_status_change',
+                ],
+                'required': [
+                    'job_id',
+                    'job_status_change',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'job_id':
+                        (str,),
+                    'job_status_change':
+                        (JobStatusChange,),
+                },
+                'attribute_map': {
+                    'job_id': 'job_id',
+                },
+                'location_map': {
+                    'job_id': 'path',
+                    'job_status_change': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.set_task_status_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [],
+                'endpoint_path': '/api/v3/tasks/{task_id}/setstatus',
+                'operation_id': 'set_task_status',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'task_id',
+                    'task_status_change',
+                ],
+                'required': [
+                    'task_id',
+                    'task_status_change',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'task_id':
+                        (str,),
+                    'task_status_change':
+                        (TaskStatusChange,),
+                },
+                'attribute_map': {
+                    'task_id': 'task_id',
+                },
+                'location_map': {
+                    'task_id': 'path',
+                    'task_status_change': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.submit_job_endpoint = _Endpoint(
+            settings={
+                'response_type': (Job,),
+                'auth': [],
+                'endpoint_path': '/api/v3/jobs',
+                'operation_id': 'submit_job',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'submitted_job',
+                ],
+                'required': [
+                    'submitted_job',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'submitted_job':
+                        (SubmittedJob,),
+                },
+                'attribute_map': {
+                },
+                'location_map': {
+                    'submitted_job': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.submit_job_check_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [],
+                'endpoint_path': '/api/v3/jobs/check',
+                'operation_id': 'submit_job_check',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'submitted_job',
+                ],
+                'required': [
+                    'submitted_job',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'submitted_job':
+                        (SubmittedJob,),
+                },
+                'attribute_map': {
+                },
+                'location_map': {
+                    'submitted_job': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+        self.update_job_template_endpoint = _Endpoint(
+            settings={
+                'response_type': (JobTemplate,),
+                'auth': [],
+                'endpoint_path': '/api/v3/job-templates/{template_id}',
+                'operation_id': 'update_job_template',
+                'http_method': 'PUT',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'template_id',
+                    'submitted_job_template',
+                ],
+                'required': [
+                    'template_id',
+                    'submitted_job_template',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'template_id':
+                        (str,),
+                    'submitted_job_template':
+                        (SubmittedJobTemplate,),
+                },
+                'attribute_map': {
+                    'template_id': 'template_id',
+                },
+                'location_map': {
+                    'template_id': 'path',
+                    'submitted_job_template': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
+
+    def create_job_template(
+        self,
+        submitted_job_template,
+        **kwargs
+    ):
+        """Create a new job template.  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.create_job_template(submitted_job_template, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            submitted_job_template (SubmittedJobTemplate): The job template.
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            JobTemplate
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['submitted_job_template'] = \
+            submitted_job_template
+        return self.create_job_template_endpoint.call_with_http_info(**kwargs)
+
+    def delete_job(
+        self,
+        job_id,
+        **kwargs
+    ):
+        """Request deletion this job, including its tasks and any log files. The actual deletion may happen in the background. No job files will be deleted (yet).   # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.delete_job(job_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            job_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['job_id'] = \
+            job_id
+        return self.delete_job_endpoint.call_with_http_info(**kwargs)
+
+    def delete_job_template(
+        self,
+        template_id,
+        **kwargs
+    ):
+        """Remove this job template. Jobs that were submitted from it are kept.  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.delete_job_template(template_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            template_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['template_id'] = \
+            template_id
+        return self.delete_job_template_endpoint.call_with_http_info(**kwargs)
+
+    def delete_job_what_would_it_do(
+        self,
+        job_id,
+        **kwargs
+    ):
+        """Get info about what would be deleted when deleting this job. The job itself, its logs, and the last-rendered images will always be deleted. The job files are only deleted conditionally, and this operation can be used to figure that out.   # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.delete_job_what_would_it_do(job_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            job_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            JobDeletionInfo
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['job_id'] = \
+            job_id
+        return self.delete_job_what_would_it_do_endpoint.call_with_http_info(**kwargs)
+
+    def export_job_archive(
+        self,
+        job_id,
+        **kwargs
+    ):
+        """Export the job, its tasks, blocklist, task logs, and last-rendered images as a ZIP archive. The archive can be imported into another Flamenco Manager with the `importJobArchive` operation.   # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.export_job_archive(job_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            job_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            file_type
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['job_id'] = \
+            job_id
+        return self.export_job_archive_endpoint.call_with_http_info(**kwargs)
+
+    def fetch_global_last_rendered_info(
+        self,
+        **kwargs
+    ):
+        """Get the URL that serves the last-rendered images.  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.fetch_global_last_rendered_info(async_req=True)
+        >>> result = thread.get()
+
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            JobLastRenderedImageInfo
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        return self.fetch_global_last_rendered_info_endpoint.call_with_http_info(**kwargs)
+
+    def fetch_job(
+        self,
+        job_id,
+        **kwargs
+    ):
+        """Fetch info about the job.  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.fetch_job(job_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            job_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            Job
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['job_id'] = \
+            job_id
+        return self.fetch_job_endpoint.call_with_http_info(**kwargs)
+
+    def fetch_job_blocklist(
+        self,
+        job_id,
+        **kwargs
+    ):
+        """Fetch the list of workers that are blocked from doing certain task types on this job.  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.fetch_job_blocklist(job_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            job_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            JobBlocklist
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['job_id'] = \
+            job_id
+        return self.fetch_job_blocklist_endpoint.call_with_http_info(**kwargs)
+
+    def fetch_job_last_rendered_contact_sheet(
+        self,
+        job_id,
+        **kwargs
+    ):
+        """Get a single JPEG image that shows the thumbnails of the previously rendered frames of this job, ordered by frame number.   # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.fetch_job_last_rendered_contact_sheet(job_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            job_id (str):
+
+        Keyword Args:
+            columns (int): Number of thumbnails per row of the contact sheet.. [optional] if omitted the server will use the default value of 8
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            file_type
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['job_id'] = \
+            job_id
+        return self.fetch_job_last_rendered_contact_sheet_endpoint.call_with_http_info(**kwargs)
+
+    def fetch_job_last_rendered_history(
+        self,
+        job_id,
+        **kwargs
+    ):
+        """Get the URLs of the thumbnails of previously rendered frames of this job. Only a limited number of frames is kept per job.   # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.fetch_job_last_rendered_history(job_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            job_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            JobLastRenderedHistory
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['job_id'] = \
+            job_id
+        return self.fetch_job_last_rendered_history_endpoint.call_with_http_info(**kwargs)
 
-    def delete_job(
+    def fetch_job_last_rendered_info(
         self,
         job_id,
         **kwargs
     ):
-        """Request deletion this job, including its tasks and any log files. The actual deletion may happen in the background. No job files will be deleted (yet).   # noqa: E501
+        """Get the URL that serves the last-rendered images of this job.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.delete_job(job_id, async_req=True)
+        >>> thread = api.fetch_job_last_rendered_info(job_id, async_req=True)
         >>> result = thread.get()
 
         Args:
@@ -1044,7 +2366,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            None
+            JobLastRenderedImageInfo
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1074,19 +2396,19 @@ class JobsApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['job_id'] = \
             job_id
-        return self.delete_job_endpoint.call_with_http_info(**kwargs)
+        return self.fetch_job_last_rendered_info_endpoint.call_with_http_info(**kwargs)
 
-    def delete_job_what_would_it_do(
+    def fetch_job_tasks(
         self,
         job_id,
         **kwargs
     ):
-        """Get info about what would be deleted when deleting this job. The job itself, its logs, and the last-rendered images will always be deleted. The job files are only deleted conditionally, and this operation can be used to figure that out.   # noqa: E501
+        """Fetch a summary of all tasks of the given job.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.delete_job_what_would_it_do(job_id, async_req=True)
+        >>> thread = api.fetch_job_tasks(job_id, async_req=True)
         >>> result = thread.get()
 
         Args:
@@ -1121,7 +2443,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            JobDeletionInfo
+            JobTasksSummary
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1151,20 +2473,23 @@ class JobsApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['job_id'] = \
             job_id
-        return self.delete_job_what_would_it_do_endpoint.call_with_http_info(**kwargs)
+        return self.fetch_job_tasks_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_global_last_rendered_info(
+    def fetch_job_template(
         self,
+        template_id,
         **kwargs
     ):
-        """Get the URL that serves the last-rendered images.  # noqa: E501
+        """Get a single job template.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_global_last_rendered_info(async_req=True)
+        >>> thread = api.fetch_job_template(template_id, async_req=True)
         >>> result = thread.get()
 
+        Args:
+            template_id (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1195,7 +2520,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            JobLastRenderedImageInfo
+            JobTemplate
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1223,23 +2548,22 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        return self.fetch_global_last_rendered_info_endpoint.call_with_http_info(**kwargs)
+        kwargs['template_id'] = \
+            template_id
+        return self.fetch_job_template_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_job(
+    def fetch_job_templates(
         self,
-        job_id,
         **kwargs
     ):
-        """Fetch info about the job.  # noqa: E501
+        """Get list of job templates.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_job(job_id, async_req=True)
+        >>> thread = api.fetch_job_templates(async_req=True)
         >>> result = thread.get()
 
-        Args:
-            job_id (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1270,7 +2594,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            Job
+            JobTemplateList
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1298,25 +2622,20 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['job_id'] = \
-            job_id
-        return self.fetch_job_endpoint.call_with_http_info(**kwargs)
+        return self.fetch_job_templates_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_job_blocklist(
+    def fetch_last_rendered_queue_info(
         self,
-        job_id,
         **kwargs
     ):
-        """Fetch the list of workers that are blocked from doing certain task types on this job.  # noqa: E501
+        """Get metrics of the queue of to-be-processed last-rendered images.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_job_blocklist(job_id, async_req=True)
+        >>> thread = api.fetch_last_rendered_queue_info(async_req=True)
         >>> result = thread.get()
 
-        Args:
-            job_id (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1347,7 +2666,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            JobBlocklist
+            LastRenderedQueueInfo
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1375,25 +2694,23 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['job_id'] = \
-            job_id
-        return self.fetch_job_blocklist_endpoint.call_with_http_info(**kwargs)
+        return self.fetch_last_rendered_queue_info_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_job_last_rendered_info(
+    def fetch_task(
         self,
-        job_id,
+        task_id,
         **kwargs
     ):
-        """Get the URL that serves the last-rendered images of this job.  # noqa: E501
+        """Fetch a single task.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_job_last_rendered_info(job_id, async_req=True)
+        >>> thread = api.fetch_task(task_id, async_req=True)
         >>> result = thread.get()
 
         Args:
-            job_id (str):
+            task_id (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1424,7 +2741,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            JobLastRenderedImageInfo
+            Task
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1452,25 +2769,25 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['job_id'] = \
-            job_id
-        return self.fetch_job_last_rendered_info_endpoint.call_with_http_info(**kwargs)
+        kwargs['task_id'] = \
+            task_id
+        return self.fetch_task_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_job_tasks(
+    def fetch_task_log_info(
         self,
-        job_id,
+        task_id,
         **kwargs
     ):
-        """Fetch a summary of all tasks of the given job.  # noqa: E501
+        """Get the URL of the task log, and some more info.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_job_tasks(job_id, async_req=True)
+        >>> thread = api.fetch_task_log_info(task_id, async_req=True)
         >>> result = thread.get()
 
         Args:
-            job_id (str):
+            task_id (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1501,7 +2818,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            JobTasksSummary
+            TaskLogInfo
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1529,21 +2846,21 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['job_id'] = \
-            job_id
-        return self.fetch_job_tasks_endpoint.call_with_http_info(**kwargs)
+        kwargs['task_id'] = \
+            task_id
+        return self.fetch_task_log_info_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_task(
+    def fetch_task_log_tail(
         self,
         task_id,
         **kwargs
     ):
-        """Fetch a single task.  # noqa: E501
+        """Fetch the last few lines of the task's log.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_task(task_id, async_req=True)
+        >>> thread = api.fetch_task_log_tail(task_id, async_req=True)
         >>> result = thread.get()
 
         Args:
@@ -1578,7 +2895,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            Task
+            str
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1608,23 +2925,23 @@ class JobsApi(object):
         kwargs['_host_index'] = kwargs.get('_host_index')
         kwargs['task_id'] = \
             task_id
-        return self.fetch_task_endpoint.call_with_http_info(**kwargs)
+        return self.fetch_task_log_tail_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_task_log_info(
+    def get_job_type(
         self,
-        task_id,
+        type_name,
         **kwargs
     ):
-        """Get the URL of the task log, and some more info.  # noqa: E501
+        """Get single job type and its parameters.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_task_log_info(task_id, async_req=True)
+        >>> thread = api.get_job_type(type_name, async_req=True)
         >>> result = thread.get()
 
         Args:
-            task_id (str):
+            type_name (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1655,7 +2972,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            TaskLogInfo
+            AvailableJobType
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1683,25 +3000,22 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['task_id'] = \
-            task_id
-        return self.fetch_task_log_info_endpoint.call_with_http_info(**kwargs)
+        kwargs['type_name'] = \
+            type_name
+        return self.get_job_type_endpoint.call_with_http_info(**kwargs)
 
-    def fetch_task_log_tail(
+    def get_job_types(
         self,
-        task_id,
         **kwargs
     ):
-        """Fetch the last few lines of the task's log.  # noqa: E501
+        """Get list of job types and their parameters.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.fetch_task_log_tail(task_id, async_req=True)
+        >>> thread = api.get_job_types(async_req=True)
         >>> result = thread.get()
 
-        Args:
-            task_id (str):
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1732,7 +3046,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            str
+            AvailableJobTypes
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1760,25 +3074,23 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['task_id'] = \
-            task_id
-        return self.fetch_task_log_tail_endpoint.call_with_http_info(**kwargs)
+        return self.get_job_types_endpoint.call_with_http_info(**kwargs)
 
-    def get_job_type(
+    def import_job_archive(
         self,
-        type_name,
+        body,
         **kwargs
     ):
-        """Get single job type and its parameters.  # noqa: E501
+        """Import a job from a ZIP archive, as produced by the `exportJobArchive` operation. The job keeps its UUID, so it cannot be imported into a Manager that already has that job. References to Workers, worker tags, and jobs that do not exist on this Manager are dropped.   # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.get_job_type(type_name, async_req=True)
+        >>> thread = api.import_job_archive(body, async_req=True)
         >>> result = thread.get()
 
         Args:
-            type_name (str):
+            body (file_type): The job archive.
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1809,7 +3121,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            AvailableJobType
+            Job
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1837,22 +3149,25 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['type_name'] = \
-            type_name
-        return self.get_job_type_endpoint.call_with_http_info(**kwargs)
+        kwargs['body'] = \
+            body
+        return self.import_job_archive_endpoint.call_with_http_info(**kwargs)
 
-    def get_job_types(
+    def query_jobs(
         self,
+        jobs_query,
         **kwargs
     ):
-        """Get list of job types and their parameters.  # noqa: E501
+        """Fetch list of jobs.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.get_job_types(async_req=True)
+        >>> thread = api.query_jobs(jobs_query, async_req=True)
         >>> result = thread.get()
 
+        Args:
+            jobs_query (JobsQuery): Specification of which jobs to get.
 
         Keyword Args:
             _return_http_data_only (bool): response data without head status
@@ -1883,7 +3198,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            AvailableJobTypes
+            JobsQueryResult
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1911,25 +3226,28 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        return self.get_job_types_endpoint.call_with_http_info(**kwargs)
+        kwargs['jobs_query'] = \
+            jobs_query
+        return self.query_jobs_endpoint.call_with_http_info(**kwargs)
 
-    def query_jobs(
+    def remove_job_blocklist(
         self,
-        jobs_query,
+        job_id,
         **kwargs
     ):
-        """Fetch list of jobs.  # noqa: E501
+        """Remove entries from a job blocklist.  # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.query_jobs(jobs_query, async_req=True)
+        >>> thread = api.remove_job_blocklist(job_id, async_req=True)
         >>> result = thread.get()
 
         Args:
-            jobs_query (JobsQuery): Specification of which jobs to get.
+            job_id (str):
 
         Keyword Args:
+            job_blocklist (JobBlocklist): Tuples (worker, task type) to be removed from the blocklist.. [optional]
             _return_http_data_only (bool): response data without head status
                 code and headers. Default is True.
             _preload_content (bool): if False, the urllib3.HTTPResponse object
@@ -1958,7 +3276,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            JobsQueryResult
+            None
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -1986,28 +3304,27 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['jobs_query'] = \
-            jobs_query
-        return self.query_jobs_endpoint.call_with_http_info(**kwargs)
+        kwargs['job_id'] = \
+            job_id
+        return self.remove_job_blocklist_endpoint.call_with_http_info(**kwargs)
 
-    def remove_job_blocklist(
+    def search_task_logs(
         self,
-        job_id,
+        task_log_search_query,
         **kwargs
     ):
-        """Remove entries from a job blocklist.  # noqa: E501
+        """Search the task logs of a single job, or of all jobs that were active within a time range. The response is streamed as newline-delimited JSON, with one `TaskLogSearchMatch` object per line.   # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
 
-        >>> thread = api.remove_job_blocklist(job_id, async_req=True)
+        >>> thread = api.search_task_logs(task_log_search_query, async_req=True)
         >>> result = thread.get()
 
         Args:
-            job_id (str):
+            task_log_search_query (TaskLogSearchQuery): What to search for, and where.
 
         Keyword Args:
-            job_blocklist (JobBlocklist): Tuples (worker, task type) to be removed from the blocklist.. [optional]
             _return_http_data_only (bool): response data without head status
                 code and headers. Default is True.
             _preload_content (bool): if False, the urllib3.HTTPResponse object
@@ -2036,7 +3353,7 @@ class JobsApi(object):
             async_req (bool): execute request asynchronously
 
         Returns:
-            None
+            TaskLogSearchMatch
                 If the method is called asynchronously, returns the request
                 thread.
         """
@@ -2064,9 +3381,9 @@ class JobsApi(object):
         kwargs['_content_type'] = kwargs.get(
             '_content_type')
         kwargs['_host_index'] = kwargs.get('_host_index')
-        kwargs['job_id'] = \
-            job_id
-        return self.remove_job_blocklist_endpoint.call_with_http_info(**kwargs)
+        kwargs['task_log_search_query'] = \
+            task_log_search_query
+        return self.search_task_logs_endpoint.call_with_http_info(**kwargs)
 
     def set_job_priority(
         self,
@@ -2465,3 +3782,84 @@ class JobsApi(object):
             submitted_job
         return self.submit_job_check_endpoint.call_with_http_info(**kwargs)
 
+    def update_job_template(
+        self,
+        template_id,
+        submitted_job_template,
+        **kwargs
+    ):
+        """Update an existing job template.  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.update_job_template(template_id, submitted_job_template, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            template_id (str):
+            submitted_job_template (SubmittedJobTemplate): The updated job template.
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            JobTemplate
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['template_id'] = \
+            template_id
+        kwargs['submitted_job_template'] = \
+            submitted_job_template
+        return self.update_job_template_endpoint.call_with_http_info(**kwargs)
+
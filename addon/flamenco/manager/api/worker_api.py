@@ -28,6 +28,7 @@ from flamenco.manager.model.registered_worker import RegisteredWorker
 from flamenco.manager.model.security_error import SecurityError
 from flamenco.manager.model.task_update import TaskUpdate
 from flamenco.manager.model.worker_registration import WorkerRegistration
+from flamenco.manager.model.worker_resource_usage import WorkerResourceUsage
 from flamenco.manager.model.worker_sign_on import WorkerSignOn
 from flamenco.manager.model.worker_state_change import WorkerStateChange
 from flamenco.manager.model.worker_state_changed import WorkerStateChanged
@@ -300,6 +301,7 @@ class WorkerApi(object):
                 'all': [
                     'task_id',
                     'body',
+                    'x_flamenco_output_filename',
                 ],
                 'required': [
                     'task_id',
@@ -322,13 +324,17 @@ class WorkerApi(object):
                         (str,),
                     'body':
                         (file_type,),
+                    'x_flamenco_output_filename':
+                        (str,),
                 },
                 'attribute_map': {
                     'task_id': 'task_id',
+                    'x_flamenco_output_filename': 'X-Flamenco-Output-Filename',
                 },
                 'location_map': {
                     'task_id': 'path',
                     'body': 'body',
+                    'x_flamenco_output_filename': 'header',
                 },
                 'collection_format_map': {
                 }
@@ -339,7 +345,8 @@ class WorkerApi(object):
                 ],
                 'content_type': [
                     'image/jpeg',
-                    'image/png'
+                    'image/png',
+                    'image/x-exr'
                 ]
             },
             api_client=api_client
@@ -402,6 +409,58 @@ class WorkerApi(object):
             },
             api_client=api_client
         )
+        self.worker_heartbeat_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [
+                    'worker_auth'
+                ],
+                'endpoint_path': '/api/v3/worker/heartbeat',
+                'operation_id': 'worker_heartbeat',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'worker_resource_usage',
+                ],
+                'required': [
+                    'worker_resource_usage',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'worker_resource_usage':
+                        (WorkerResourceUsage,),
+                },
+                'attribute_map': {
+                },
+                'location_map': {
+                    'worker_resource_usage': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
         self.worker_state_endpoint = _Endpoint(
             settings={
                 'response_type': (WorkerStateChange,),
@@ -880,7 +939,7 @@ class WorkerApi(object):
         body,
         **kwargs
     ):
-        """Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. Currently only PNG and JPEG support is planned.   # noqa: E501
+        """Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. PNG, JPEG, and OpenEXR images are supported.   # noqa: E501
 
         This method makes a synchronous HTTP request by default. To make an
         asynchronous HTTP request, please pass async_req=True
@@ -893,6 +952,7 @@ class WorkerApi(object):
             body (file_type): Contents of the file
 
         Keyword Args:
+            x_flamenco_output_filename (str): The filename of the rendered image, without directory. The frame number is taken from this filename, to keep a history of last-rendered images per job. . [optional]
             _return_http_data_only (bool): response data without head status
                 code and headers. Default is True.
             _preload_content (bool): if False, the urllib3.HTTPResponse object
@@ -1036,6 +1096,83 @@ class WorkerApi(object):
             task_update
         return self.task_update_endpoint.call_with_http_info(**kwargs)
 
+    def worker_heartbeat(
+        self,
+        worker_resource_usage,
+        **kwargs
+    ):
+        """Report the Worker's current load and memory usage.  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.worker_heartbeat(worker_resource_usage, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            worker_resource_usage (WorkerResourceUsage): Resource usage of the Worker
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['worker_resource_usage'] = \
+            worker_resource_usage
+        return self.worker_heartbeat_endpoint.call_with_http_info(**kwargs)
+
     def worker_state(
         self,
         **kwargs
@@ -337,6 +337,55 @@ class WorkerMgtApi(object):
             },
             api_client=api_client
         )
+        self.fetch_worker_tag_sleep_schedule_endpoint = _Endpoint(
+            settings={
+                'response_type': (WorkerSleepSchedule,),
+                'auth': [],
+                'endpoint_path': '/api/v3/worker-mgt/tag/{tag_id}/sleep-schedule',
+                'operation_id': 'fetch_worker_tag_sleep_schedule',
+                'http_method': 'GET',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'tag_id',
+                ],
+                'required': [
+                    'tag_id',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'tag_id':
+                        (str,),
+                },
+                'attribute_map': {
+                    'tag_id': 'tag_id',
+                },
+                'location_map': {
+                    'tag_id': 'path',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [],
+            },
+            api_client=api_client
+        )
         self.fetch_worker_tags_endpoint = _Endpoint(
             settings={
                 'response_type': (WorkerTagList,),
@@ -533,6 +582,62 @@ class WorkerMgtApi(object):
             },
             api_client=api_client
         )
+        self.set_worker_tag_sleep_schedule_endpoint = _Endpoint(
+            settings={
+                'response_type': None,
+                'auth': [],
+                'endpoint_path': '/api/v3/worker-mgt/tag/{tag_id}/sleep-schedule',
+                'operation_id': 'set_worker_tag_sleep_schedule',
+                'http_method': 'POST',
+                'servers': None,
+            },
+            params_map={
+                'all': [
+                    'tag_id',
+                    'worker_sleep_schedule',
+                ],
+                'required': [
+                    'tag_id',
+                    'worker_sleep_schedule',
+                ],
+                'nullable': [
+                ],
+                'enum': [
+                ],
+                'validation': [
+                ]
+            },
+            root_map={
+                'validations': {
+                },
+                'allowed_values': {
+                },
+                'openapi_types': {
+                    'tag_id':
+                        (str,),
+                    'worker_sleep_schedule':
+                        (WorkerSleepSchedule,),
+                },
+                'attribute_map': {
+                    'tag_id': 'tag_id',
+                },
+                'location_map': {
+                    'tag_id': 'path',
+                    'worker_sleep_schedule': 'body',
+                },
+                'collection_format_map': {
+                }
+            },
+            headers_map={
+                'accept': [
+                    'application/json'
+                ],
+                'content_type': [
+                    'application/json'
+                ]
+            },
+            api_client=api_client
+        )
         self.set_worker_tags_endpoint = _Endpoint(
             settings={
                 'response_type': None,
@@ -1108,6 +1213,83 @@ class WorkerMgtApi(object):
             tag_id
         return self.fetch_worker_tag_endpoint.call_with_http_info(**kwargs)
 
+    def fetch_worker_tag_sleep_schedule(
+        self,
+        tag_id,
+        **kwargs
+    ):
+        """fetch_worker_tag_sleep_schedule  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.fetch_worker_tag_sleep_schedule(tag_id, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            tag_id (str):
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            WorkerSleepSchedule
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['tag_id'] = \
+            tag_id
+        return self.fetch_worker_tag_sleep_schedule_endpoint.call_with_http_info(**kwargs)
+
     def fetch_worker_tags(
         self,
         **kwargs
@@ -1414,6 +1596,87 @@ class WorkerMgtApi(object):
             worker_sleep_schedule
         return self.set_worker_sleep_schedule_endpoint.call_with_http_info(**kwargs)
 
+    def set_worker_tag_sleep_schedule(
+        self,
+        tag_id,
+        worker_sleep_schedule,
+        **kwargs
+    ):
+        """set_worker_tag_sleep_schedule  # noqa: E501
+
+        This method makes a synchronous HTTP request by default. To make an
+        asynchronous HTTP request, please pass async_req=True
+
+        >>> thread = api.set_worker_tag_sleep_schedule(tag_id, worker_sleep_schedule, async_req=True)
+        >>> result = thread.get()
+
+        Args:
+            tag_id (str):
+            worker_sleep_schedule (WorkerSleepSchedule): The new sleep schedule.
+
+        Keyword Args:
+            _return_http_data_only (bool): response data without head status
+                code and headers. Default is True.
+            _preload_content (bool): if False, the urllib3.HTTPResponse object
+                will be returned without reading/decoding response data.
+                Default is True.
+            _request_timeout (int/float/tuple): timeout setting for this request. If
+                one number provided, it will be total request timeout. It can also
+                be a pair (tuple) of (connection, read) timeouts.
+                Default is None.
+            _check_input_type (bool): specifies if type checking
+                should be done one the data sent to the server.
+                Default is True.
+            _check_return_type (bool): specifies if type checking
+                should be done one the data received from the server.
+                Default is True.
+            _spec_property_naming (bool): True if the variable names in the input data
+                are serialized names, as specified in the OpenAPI document.
+                False if the variable names in the input data
+                are pythonic names, e.g. snake case (default)
+            _content_type (str/None): force body content-type.
+                Default is None and content-type will be predicted by allowed
+                content-types and body.
+            _host_index (int/None): specifies the index of the server
+                that we want to use.
+                Default is read from the configuration.
+            async_req (bool): execute request asynchronously
+
+        Returns:
+            None
+                If the method is called asynchronously, returns the request
+                thread.
+        """
+        kwargs['async_req'] = kwargs.get(
+            'async_req', False
+        )
+        kwargs['_return_http_data_only'] = kwargs.get(
+            '_return_http_data_only', True
+        )
+        kwargs['_preload_content'] = kwargs.get(
+            '_preload_content', True
+        )
+        kwargs['_request_timeout'] = kwargs.get(
+            '_request_timeout', None
+        )
+        kwargs['_check_input_type'] = kwargs.get(
+            '_check_input_type', True
+        )
+        kwargs['_check_return_type'] = kwargs.get(
+            '_check_return_type', True
+        )
+        kwargs['_spec_property_naming'] = kwargs.get(
+            '_spec_property_naming', False
+        )
+        kwargs['_content_type'] = kwargs.get(
+            '_content_type')
+        kwargs['_host_index'] = kwargs.get('_host_index')
+        kwargs['tag_id'] = \
+            tag_id
+        kwargs['worker_sleep_schedule'] = \
+            worker_sleep_schedule
+        return self.set_worker_tag_sleep_schedule_endpoint.call_with_http_info(**kwargs)
+
     def set_worker_tags(
         self,
         worker_id,
@@ -32,12 +32,14 @@ from flamenco.manager.exceptions import ApiAttributeError
 def lazy_import():
     from flamenco.manager.model.job_all_of import JobAllOf
     from flamenco.manager.model.job_metadata import JobMetadata
+    from flamenco.manager.model.job_retry_policy import JobRetryPolicy
     from flamenco.manager.model.job_settings import JobSettings
     from flamenco.manager.model.job_status import JobStatus
     from flamenco.manager.model.job_storage_info import JobStorageInfo
     from flamenco.manager.model.submitted_job import SubmittedJob
     globals()['JobAllOf'] = JobAllOf
     globals()['JobMetadata'] = JobMetadata
+    globals()['JobRetryPolicy'] = JobRetryPolicy
     globals()['JobSettings'] = JobSettings
     globals()['JobStatus'] = JobStatus
     globals()['JobStorageInfo'] = JobStorageInfo
@@ -72,6 +74,9 @@ class Job(ModelComposed):
     }
 
     validations = {
+        ('max_workers',): {
+            'inclusive_minimum': 0,
+        },
     }
 
     @cached_property
@@ -111,6 +116,10 @@ class Job(ModelComposed):
             'metadata': (JobMetadata,),  # noqa: E501
             'storage': (JobStorageInfo,),  # noqa: E501
             'worker_tag': (str,),  # noqa: E501
+            'depends_on': ([str],),  # noqa: E501
+            'not_before': (datetime,),  # noqa: E501
+            'max_workers': (int,),  # noqa: E501
+            'retry_policy': (JobRetryPolicy,),  # noqa: E501
             'delete_requested_at': (datetime,),  # noqa: E501
         }
 
@@ -134,6 +143,10 @@ class Job(ModelComposed):
         'metadata': 'metadata',  # noqa: E501
         'storage': 'storage',  # noqa: E501
         'worker_tag': 'worker_tag',  # noqa: E501
+        'depends_on': 'depends_on',  # noqa: E501
+        'not_before': 'not_before',  # noqa: E501
+        'max_workers': 'max_workers',  # noqa: E501
+        'retry_policy': 'retry_policy',  # noqa: E501
         'delete_requested_at': 'delete_requested_at',  # noqa: E501
     }
 
@@ -190,6 +203,10 @@ class Job(ModelComposed):
             metadata (JobMetadata): [optional]  # noqa: E501
             storage (JobStorageInfo): [optional]  # noqa: E501
             worker_tag (str): Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job. . [optional]  # noqa: E501
+            depends_on ([str]): UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well. . [optional]  # noqa: E501
+            not_before (datetime): Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately. . [optional]  # noqa: E501
+            max_workers (int): Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit. . [optional]  # noqa: E501
+            retry_policy (JobRetryPolicy): [optional]  # noqa: E501
             delete_requested_at (datetime): If job deletion was requested, this is the timestamp at which that request was stored on Flamenco Manager. . [optional]  # noqa: E501
         """
 
@@ -305,6 +322,10 @@ class Job(ModelComposed):
             metadata (JobMetadata): [optional]  # noqa: E501
             storage (JobStorageInfo): [optional]  # noqa: E501
             worker_tag (str): Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job. . [optional]  # noqa: E501
+            depends_on ([str]): UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well. . [optional]  # noqa: E501
+            not_before (datetime): Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately. . [optional]  # noqa: E501
+            max_workers (int): Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit. . [optional]  # noqa: E501
+            retry_policy (JobRetryPolicy): [optional]  # noqa: E501
             delete_requested_at (datetime): If job deletion was requested, this is the timestamp at which that request was stored on Flamenco Manager. . [optional]  # noqa: E501
         """
 
@@ -29,6 +29,10 @@ from flamenco.manager.model_utils import (  # noqa: F401
 from flamenco.manager.exceptions import ApiAttributeError
 
 
+def lazy_import():
+    from flamenco.manager.model.shaman_chunk_spec import ShamanChunkSpec
+    globals()['ShamanChunkSpec'] = ShamanChunkSpec
+
 
 class ShamanFileSpec(ModelNormal):
     """NOTE: This class is auto generated by OpenAPI Generator.
@@ -66,6 +70,7 @@ class ShamanFileSpec(ModelNormal):
         This must be a method because a model may have properties that are
         of type self, this must run after the class is loaded
         """
+        lazy_import()
         return (bool, date, datetime, dict, float, int, list, str, none_type,)  # noqa: E501
 
     _nullable = False
@@ -80,10 +85,12 @@ class ShamanFileSpec(ModelNormal):
             openapi_types (dict): The key is attribute name
                 and the value is attribute type.
         """
+        lazy_import()
         return {
             'sha': (str,),  # noqa: E501
             'size': (int,),  # noqa: E501
             'path': (str,),  # noqa: E501
+            'chunks': ([ShamanChunkSpec],),  # noqa: E501
         }
 
     @cached_property
@@ -95,6 +102,7 @@ class ShamanFileSpec(ModelNormal):
         'sha': 'sha',  # noqa: E501
         'size': 'size',  # noqa: E501
         'path': 'path',  # noqa: E501
+        'chunks': 'chunks',  # noqa: E501
     }
 
     read_only_vars = {
@@ -143,6 +151,7 @@ class ShamanFileSpec(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            chunks ([ShamanChunkSpec]): The file's content-defined chunks, in order. When the Shaman server has chunking enabled, large files can be uploaded as these chunks instead of as a whole. See `ShamanChunking` for how to split files. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -232,6 +241,7 @@ class ShamanFileSpec(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            chunks ([ShamanChunkSpec]): The file's content-defined chunks, in order. When the Shaman server has chunking enabled, large files can be uploaded as these chunks instead of as a whole. See `ShamanChunking` for how to split files. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -30,7 +30,9 @@ from flamenco.manager.exceptions import ApiAttributeError
 
 
 def lazy_import():
+    from flamenco.manager.model.shaman_chunk_spec import ShamanChunkSpec
     from flamenco.manager.model.shaman_file_status import ShamanFileStatus
+    globals()['ShamanChunkSpec'] = ShamanChunkSpec
     globals()['ShamanFileStatus'] = ShamanFileStatus
 
 
@@ -91,6 +93,7 @@ class ShamanFileSpecWithStatus(ModelNormal):
             'size': (int,),  # noqa: E501
             'path': (str,),  # noqa: E501
             'status': (ShamanFileStatus,),  # noqa: E501
+            'chunks': ([ShamanChunkSpec],),  # noqa: E501
         }
 
     @cached_property
@@ -103,6 +106,7 @@ class ShamanFileSpecWithStatus(ModelNormal):
         'size': 'size',  # noqa: E501
         'path': 'path',  # noqa: E501
         'status': 'status',  # noqa: E501
+        'chunks': 'chunks',  # noqa: E501
     }
 
     read_only_vars = {
@@ -152,6 +156,7 @@ class ShamanFileSpecWithStatus(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            chunks ([ShamanChunkSpec]): Present when the file should be uploaded as chunks, instead of as a whole. Only the chunks that are unknown to the Shaman server are listed, so this can be empty. The file is assembled from its chunks in the background, once the checkout is requested. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -243,6 +248,7 @@ class ShamanFileSpecWithStatus(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            chunks ([ShamanChunkSpec]): Present when the file should be uploaded as chunks, instead of as a whole. Only the chunks that are unknown to the Shaman server are listed, so this can be empty. The file is assembled from its chunks in the background, once the checkout is requested. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
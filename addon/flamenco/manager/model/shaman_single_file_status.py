@@ -88,6 +88,7 @@ class ShamanSingleFileStatus(ModelNormal):
         lazy_import()
         return {
             'status': (ShamanFileStatus,),  # noqa: E501
+            'offset': (int,),  # noqa: E501
         }
 
     @cached_property
@@ -97,6 +98,7 @@ class ShamanSingleFileStatus(ModelNormal):
 
     attribute_map = {
         'status': 'status',  # noqa: E501
+        'offset': 'offset',  # noqa: E501
     }
 
     read_only_vars = {
@@ -143,6 +145,7 @@ class ShamanSingleFileStatus(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            offset (int): Number of bytes received by an earlier, unfinished upload of this file. The upload can be resumed from this offset by passing it in the `X-Shaman-Upload-Offset` header. Only non-zero when the status is `unknown`. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -228,6 +231,7 @@ class ShamanSingleFileStatus(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            offset (int): Number of bytes received by an earlier, unfinished upload of this file. The upload can be resumed from this offset by passing it in the `X-Shaman-Upload-Offset` header. Only non-zero when the status is `unknown`. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
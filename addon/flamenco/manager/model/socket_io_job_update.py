@@ -95,6 +95,7 @@ class SocketIOJobUpdate(ModelNormal):
             'refresh_tasks': (bool,),  # noqa: E501
             'name': (str,),  # noqa: E501
             'previous_status': (JobStatus,),  # noqa: E501
+            'progress': (int,),  # noqa: E501
             'delete_requested_at': (datetime,),  # noqa: E501
             'was_deleted': (bool,),  # noqa: E501
         }
@@ -113,6 +114,7 @@ class SocketIOJobUpdate(ModelNormal):
         'refresh_tasks': 'refresh_tasks',  # noqa: E501
         'name': 'name',  # noqa: E501
         'previous_status': 'previous_status',  # noqa: E501
+        'progress': 'progress',  # noqa: E501
         'delete_requested_at': 'delete_requested_at',  # noqa: E501
         'was_deleted': 'was_deleted',  # noqa: E501
     }
@@ -168,6 +170,7 @@ class SocketIOJobUpdate(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             name (str): Name of the job. [optional]  # noqa: E501
             previous_status (JobStatus): [optional]  # noqa: E501
+            progress (int): Percentage of the job that has been completed, 0-100. This is the average progress of its tasks, where completed tasks count as 100%. Only sent when the progress of one of the job's tasks changed. . [optional]  # noqa: E501
             delete_requested_at (datetime): If job deletion was requested, this is the timestamp at which that request was stored on Flamenco Manager. . [optional]  # noqa: E501
             was_deleted (bool): When a job was just deleted, this is set to `true`. If this is specified, only the 'id' field is set, the rest will be empty. . [optional]  # noqa: E501
         """
@@ -268,6 +271,7 @@ class SocketIOJobUpdate(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             name (str): Name of the job. [optional]  # noqa: E501
             previous_status (JobStatus): [optional]  # noqa: E501
+            progress (int): Percentage of the job that has been completed, 0-100. This is the average progress of its tasks, where completed tasks count as 100%. Only sent when the progress of one of the job's tasks changed. . [optional]  # noqa: E501
             delete_requested_at (datetime): If job deletion was requested, this is the timestamp at which that request was stored on Flamenco Manager. . [optional]  # noqa: E501
             was_deleted (bool): When a job was just deleted, this is set to `true`. If this is specified, only the 'id' field is set, the rest will be empty. . [optional]  # noqa: E501
         """
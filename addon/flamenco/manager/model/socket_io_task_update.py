@@ -30,7 +30,9 @@ from flamenco.manager.exceptions import ApiAttributeError
 
 
 def lazy_import():
+    from flamenco.manager.model.task_render_progress import TaskRenderProgress
     from flamenco.manager.model.task_status import TaskStatus
+    globals()['TaskRenderProgress'] = TaskRenderProgress
     globals()['TaskStatus'] = TaskStatus
 
 
@@ -93,7 +95,9 @@ class SocketIOTaskUpdate(ModelNormal):
             'updated': (datetime,),  # noqa: E501
             'status': (TaskStatus,),  # noqa: E501
             'activity': (str,),  # noqa: E501
+            'progress': (int,),  # noqa: E501
             'previous_status': (TaskStatus,),  # noqa: E501
+            'render_progress': (TaskRenderProgress,),  # noqa: E501
         }
 
     @cached_property
@@ -108,7 +112,9 @@ class SocketIOTaskUpdate(ModelNormal):
         'updated': 'updated',  # noqa: E501
         'status': 'status',  # noqa: E501
         'activity': 'activity',  # noqa: E501
+        'progress': 'progress',  # noqa: E501
         'previous_status': 'previous_status',  # noqa: E501
+        'render_progress': 'render_progress',  # noqa: E501
     }
 
     read_only_vars = {
@@ -118,7 +124,7 @@ class SocketIOTaskUpdate(ModelNormal):
 
     @classmethod
     @convert_js_args_to_python_args
-    def _from_openapi_data(cls, id, job_id, name, updated, status, activity, *args, **kwargs):  # noqa: E501
+    def _from_openapi_data(cls, id, job_id, name, updated, status, activity, progress, *args, **kwargs):  # noqa: E501
         """SocketIOTaskUpdate - a model defined in OpenAPI
 
         Args:
@@ -128,6 +134,7 @@ class SocketIOTaskUpdate(ModelNormal):
             updated (datetime): Timestamp of last update
             status (TaskStatus):
             activity (str):
+            progress (int): Percentage of the task that has been completed, 0-100.
 
         Keyword Args:
             _check_type (bool): if True, values for parameters in openapi_types
@@ -161,6 +168,7 @@ class SocketIOTaskUpdate(ModelNormal):
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
             previous_status (TaskStatus): [optional]  # noqa: E501
+            render_progress (TaskRenderProgress): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -194,6 +202,7 @@ class SocketIOTaskUpdate(ModelNormal):
         self.updated = updated
         self.status = status
         self.activity = activity
+        self.progress = progress
         for var_name, var_value in kwargs.items():
             if var_name not in self.attribute_map and \
                         self._configuration is not None and \
@@ -214,7 +223,7 @@ class SocketIOTaskUpdate(ModelNormal):
     ])
 
     @convert_js_args_to_python_args
-    def __init__(self, id, job_id, name, updated, status, activity, *args, **kwargs):  # noqa: E501
+    def __init__(self, id, job_id, name, updated, status, activity, progress, *args, **kwargs):  # noqa: E501
         """SocketIOTaskUpdate - a model defined in OpenAPI
 
         Args:
@@ -224,6 +233,7 @@ class SocketIOTaskUpdate(ModelNormal):
             updated (datetime): Timestamp of last update
             status (TaskStatus):
             activity (str):
+            progress (int): Percentage of the task that has been completed, 0-100.
 
         Keyword Args:
             _check_type (bool): if True, values for parameters in openapi_types
@@ -257,6 +267,7 @@ class SocketIOTaskUpdate(ModelNormal):
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
             previous_status (TaskStatus): [optional]  # noqa: E501
+            render_progress (TaskRenderProgress): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -288,6 +299,7 @@ class SocketIOTaskUpdate(ModelNormal):
         self.updated = updated
         self.status = status
         self.activity = activity
+        self.progress = progress
         for var_name, var_value in kwargs.items():
             if var_name not in self.attribute_map and \
                         self._configuration is not None and \
@@ -31,9 +31,11 @@ from flamenco.manager.exceptions import ApiAttributeError
 
 def lazy_import():
     from flamenco.manager.model.job_metadata import JobMetadata
+    from flamenco.manager.model.job_retry_policy import JobRetryPolicy
     from flamenco.manager.model.job_settings import JobSettings
     from flamenco.manager.model.job_storage_info import JobStorageInfo
     globals()['JobMetadata'] = JobMetadata
+    globals()['JobRetryPolicy'] = JobRetryPolicy
     globals()['JobSettings'] = JobSettings
     globals()['JobStorageInfo'] = JobStorageInfo
 
@@ -66,6 +68,9 @@ class SubmittedJob(ModelNormal):
     }
 
     validations = {
+        ('max_workers',): {
+            'inclusive_minimum': 0,
+        },
     }
 
     @cached_property
@@ -100,6 +105,10 @@ class SubmittedJob(ModelNormal):
             'metadata': (JobMetadata,),  # noqa: E501
             'storage': (JobStorageInfo,),  # noqa: E501
             'worker_tag': (str,),  # noqa: E501
+            'depends_on': ([str],),  # noqa: E501
+            'not_before': (datetime,),  # noqa: E501
+            'max_workers': (int,),  # noqa: E501
+            'retry_policy': (JobRetryPolicy,),  # noqa: E501
         }
 
     @cached_property
@@ -117,6 +126,10 @@ class SubmittedJob(ModelNormal):
         'metadata': 'metadata',  # noqa: E501
         'storage': 'storage',  # noqa: E501
         'worker_tag': 'worker_tag',  # noqa: E501
+        'depends_on': 'depends_on',  # noqa: E501
+        'not_before': 'not_before',  # noqa: E501
+        'max_workers': 'max_workers',  # noqa: E501
+        'retry_policy': 'retry_policy',  # noqa: E501
     }
 
     read_only_vars = {
@@ -171,6 +184,10 @@ class SubmittedJob(ModelNormal):
             metadata (JobMetadata): [optional]  # noqa: E501
             storage (JobStorageInfo): [optional]  # noqa: E501
             worker_tag (str): Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job. . [optional]  # noqa: E501
+            depends_on ([str]): UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well. . [optional]  # noqa: E501
+            not_before (datetime): Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately. . [optional]  # noqa: E501
+            max_workers (int): Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit. . [optional]  # noqa: E501
+            retry_policy (JobRetryPolicy): [optional]  # noqa: E501
         """
 
         priority = kwargs.get('priority', 50)
@@ -268,6 +285,10 @@ class SubmittedJob(ModelNormal):
             metadata (JobMetadata): [optional]  # noqa: E501
             storage (JobStorageInfo): [optional]  # noqa: E501
             worker_tag (str): Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job. . [optional]  # noqa: E501
+            depends_on ([str]): UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well. . [optional]  # noqa: E501
+            not_before (datetime): Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately. . [optional]  # noqa: E501
+            max_workers (int): Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit. . [optional]  # noqa: E501
+            retry_policy (JobRetryPolicy): [optional]  # noqa: E501
         """
 
         priority = kwargs.get('priority', 50)
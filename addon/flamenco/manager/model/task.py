@@ -100,10 +100,13 @@ class Task(ModelNormal):
             'priority': (int,),  # noqa: E501
             'task_type': (str,),  # noqa: E501
             'activity': (str,),  # noqa: E501
+            'progress': (int,),  # noqa: E501
             'commands': ([Command],),  # noqa: E501
             'worker': (TaskWorker,),  # noqa: E501
             'last_touched': (datetime,),  # noqa: E501
             'failed_by_workers': ([TaskWorker],),  # noqa: E501
+            'min_cpu_count': (int,),  # noqa: E501
+            'min_memory_gb': (int,),  # noqa: E501
         }
 
     @cached_property
@@ -121,10 +124,13 @@ class Task(ModelNormal):
         'priority': 'priority',  # noqa: E501
         'task_type': 'task_type',  # noqa: E501
         'activity': 'activity',  # noqa: E501
+        'progress': 'progress',  # noqa: E501
         'commands': 'commands',  # noqa: E501
         'worker': 'worker',  # noqa: E501
         'last_touched': 'last_touched',  # noqa: E501
         'failed_by_workers': 'failed_by_workers',  # noqa: E501
+        'min_cpu_count': 'min_cpu_count',  # noqa: E501
+        'min_memory_gb': 'min_memory_gb',  # noqa: E501
     }
 
     read_only_vars = {
@@ -134,7 +140,7 @@ class Task(ModelNormal):
 
     @classmethod
     @convert_js_args_to_python_args
-    def _from_openapi_data(cls, id, created, updated, job_id, name, status, priority, task_type, activity, commands, *args, **kwargs):  # noqa: E501
+    def _from_openapi_data(cls, id, created, updated, job_id, name, status, priority, task_type, activity, progress, commands, *args, **kwargs):  # noqa: E501
         """Task - a model defined in OpenAPI
 
         Args:
@@ -147,6 +153,7 @@ class Task(ModelNormal):
             priority (int):
             task_type (str):
             activity (str):
+            progress (int): Percentage of the task that has been completed, 0-100.
             commands ([Command]):
 
         Keyword Args:
@@ -183,6 +190,8 @@ class Task(ModelNormal):
             worker (TaskWorker): [optional]  # noqa: E501
             last_touched (datetime): Timestamp of when any worker worked on this task.. [optional]  # noqa: E501
             failed_by_workers ([TaskWorker]): [optional]  # noqa: E501
+            min_cpu_count (int): Minimum number of CPUs a Worker needs to get this task.. [optional]  # noqa: E501
+            min_memory_gb (int): Minimum amount of RAM, in GB, a Worker needs to get this task.. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -219,6 +228,7 @@ class Task(ModelNormal):
         self.priority = priority
         self.task_type = task_type
         self.activity = activity
+        self.progress = progress
         self.commands = commands
         for var_name, var_value in kwargs.items():
             if var_name not in self.attribute_map and \
@@ -240,7 +250,7 @@ class Task(ModelNormal):
     ])
 
     @convert_js_args_to_python_args
-    def __init__(self, id, created, updated, job_id, name, status, priority, task_type, activity, commands, *args, **kwargs):  # noqa: E501
+    def __init__(self, id, created, updated, job_id, name, status, priority, task_type, activity, progress, commands, *args, **kwargs):  # noqa: E501
         """Task - a model defined in OpenAPI
 
         Args:
@@ -253,6 +263,7 @@ class Task(ModelNormal):
             priority (int):
             task_type (str):
             activity (str):
+            progress (int): Percentage of the task that has been completed, 0-100.
             commands ([Command]):
 
         Keyword Args:
@@ -289,6 +300,8 @@ class Task(ModelNormal):
             worker (TaskWorker): [optional]  # noqa: E501
             last_touched (datetime): Timestamp of when any worker worked on this task.. [optional]  # noqa: E501
             failed_by_workers ([TaskWorker]): [optional]  # noqa: E501
+            min_cpu_count (int): Minimum number of CPUs a Worker needs to get this task.. [optional]  # noqa: E501
+            min_memory_gb (int): Minimum amount of RAM, in GB, a Worker needs to get this task.. [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -323,6 +336,7 @@ class Task(ModelNormal):
         self.priority = priority
         self.task_type = task_type
         self.activity = activity
+        self.progress = progress
         self.commands = commands
         for var_name, var_value in kwargs.items():
             if var_name not in self.attribute_map and \
@@ -30,7 +30,9 @@ from flamenco.manager.exceptions import ApiAttributeError
 
 
 def lazy_import():
+    from flamenco.manager.model.task_render_progress import TaskRenderProgress
     from flamenco.manager.model.task_status import TaskStatus
+    globals()['TaskRenderProgress'] = TaskRenderProgress
     globals()['TaskStatus'] = TaskStatus
 
 
@@ -62,6 +64,10 @@ class TaskUpdate(ModelNormal):
     }
 
     validations = {
+        ('progress',): {
+            'inclusive_maximum': 100,
+            'inclusive_minimum': 0,
+        },
     }
 
     @cached_property
@@ -90,6 +96,8 @@ class TaskUpdate(ModelNormal):
             'task_status': (TaskStatus,),  # noqa: E501
             'activity': (str,),  # noqa: E501
             'log': (str,),  # noqa: E501
+            'progress': (int,),  # noqa: E501
+            'render_progress': (TaskRenderProgress,),  # noqa: E501
         }
 
     @cached_property
@@ -101,6 +109,8 @@ class TaskUpdate(ModelNormal):
         'task_status': 'taskStatus',  # noqa: E501
         'activity': 'activity',  # noqa: E501
         'log': 'log',  # noqa: E501
+        'progress': 'progress',  # noqa: E501
+        'render_progress': 'render_progress',  # noqa: E501
     }
 
     read_only_vars = {
@@ -147,6 +157,8 @@ class TaskUpdate(ModelNormal):
             task_status (TaskStatus): [optional]  # noqa: E501
             activity (str): One-liner to indicate what's currently happening with the task. Overwrites previously sent activity strings.. [optional]  # noqa: E501
             log (str): Log lines for this task, will be appended to logs sent earlier.. [optional]  # noqa: E501
+            progress (int): Percentage of the task that has been completed. Overwrites previously sent progress. . [optional]  # noqa: E501
+            render_progress (TaskRenderProgress): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -231,6 +243,8 @@ class TaskUpdate(ModelNormal):
             task_status (TaskStatus): [optional]  # noqa: E501
             activity (str): One-liner to indicate what's currently happening with the task. Overwrites previously sent activity strings.. [optional]  # noqa: E501
             log (str): Log lines for this task, will be appended to logs sent earlier.. [optional]  # noqa: E501
+            progress (int): Percentage of the task that has been completed. Overwrites previously sent progress. . [optional]  # noqa: E501
+            render_progress (TaskRenderProgress): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -31,12 +31,16 @@ from flamenco.manager.exceptions import ApiAttributeError
 
 def lazy_import():
     from flamenco.manager.model.worker_all_of import WorkerAllOf
+    from flamenco.manager.model.worker_resource_sample import WorkerResourceSample
+    from flamenco.manager.model.worker_resources import WorkerResources
     from flamenco.manager.model.worker_status import WorkerStatus
     from flamenco.manager.model.worker_status_change_request import WorkerStatusChangeRequest
     from flamenco.manager.model.worker_summary import WorkerSummary
     from flamenco.manager.model.worker_tag import WorkerTag
     from flamenco.manager.model.worker_task import WorkerTask
     globals()['WorkerAllOf'] = WorkerAllOf
+    globals()['WorkerResourceSample'] = WorkerResourceSample
+    globals()['WorkerResources'] = WorkerResources
     globals()['WorkerStatus'] = WorkerStatus
     globals()['WorkerStatusChangeRequest'] = WorkerStatusChangeRequest
     globals()['WorkerSummary'] = WorkerSummary
@@ -109,6 +113,8 @@ class Worker(ModelComposed):
             'last_seen': (datetime,),  # noqa: E501
             'task': (WorkerTask,),  # noqa: E501
             'tags': ([WorkerTag],),  # noqa: E501
+            'resources': (WorkerResources,),  # noqa: E501
+            'resource_usage': (WorkerResourceSample,),  # noqa: E501
         }
 
     @cached_property
@@ -129,6 +135,8 @@ class Worker(ModelComposed):
         'last_seen': 'last_seen',  # noqa: E501
         'task': 'task',  # noqa: E501
         'tags': 'tags',  # noqa: E501
+        'resources': 'resources',  # noqa: E501
+        'resource_usage': 'resource_usage',  # noqa: E501
     }
 
     read_only_vars = {
@@ -182,6 +190,8 @@ class Worker(ModelComposed):
             last_seen (datetime): Last time this worker was seen by the Manager.. [optional]  # noqa: E501
             task (WorkerTask): [optional]  # noqa: E501
             tags ([WorkerTag]): Tags of which this Worker is a member.. [optional]  # noqa: E501
+            resources (WorkerResources): [optional]  # noqa: E501
+            resource_usage (WorkerResourceSample): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -293,6 +303,8 @@ class Worker(ModelComposed):
             last_seen (datetime): Last time this worker was seen by the Manager.. [optional]  # noqa: E501
             task (WorkerTask): [optional]  # noqa: E501
             tags ([WorkerTag]): Tags of which this Worker is a member.. [optional]  # noqa: E501
+            resources (WorkerResources): [optional]  # noqa: E501
+            resource_usage (WorkerResourceSample): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
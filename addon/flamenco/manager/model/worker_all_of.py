@@ -30,8 +30,12 @@ from flamenco.manager.exceptions import ApiAttributeError
 
 
 def lazy_import():
+    from flamenco.manager.model.worker_resource_sample import WorkerResourceSample
+    from flamenco.manager.model.worker_resources import WorkerResources
     from flamenco.manager.model.worker_tag import WorkerTag
     from flamenco.manager.model.worker_task import WorkerTask
+    globals()['WorkerResourceSample'] = WorkerResourceSample
+    globals()['WorkerResources'] = WorkerResources
     globals()['WorkerTag'] = WorkerTag
     globals()['WorkerTask'] = WorkerTask
 
@@ -94,6 +98,8 @@ class WorkerAllOf(ModelNormal):
             'supported_task_types': ([str],),  # noqa: E501
             'task': (WorkerTask,),  # noqa: E501
             'tags': ([WorkerTag],),  # noqa: E501
+            'resources': (WorkerResources,),  # noqa: E501
+            'resource_usage': (WorkerResourceSample,),  # noqa: E501
         }
 
     @cached_property
@@ -107,6 +113,8 @@ class WorkerAllOf(ModelNormal):
         'supported_task_types': 'supported_task_types',  # noqa: E501
         'task': 'task',  # noqa: E501
         'tags': 'tags',  # noqa: E501
+        'resources': 'resources',  # noqa: E501
+        'resource_usage': 'resource_usage',  # noqa: E501
     }
 
     read_only_vars = {
@@ -157,6 +165,8 @@ class WorkerAllOf(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             task (WorkerTask): [optional]  # noqa: E501
             tags ([WorkerTag]): Tags of which this Worker is a member.. [optional]  # noqa: E501
+            resources (WorkerResources): [optional]  # noqa: E501
+            resource_usage (WorkerResourceSample): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -248,6 +258,8 @@ class WorkerAllOf(ModelNormal):
                                 _visited_composed_classes = (Animal,)
             task (WorkerTask): [optional]  # noqa: E501
             tags ([WorkerTag]): Tags of which this Worker is a member.. [optional]  # noqa: E501
+            resources (WorkerResources): [optional]  # noqa: E501
+            resource_usage (WorkerResourceSample): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
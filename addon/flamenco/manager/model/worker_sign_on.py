@@ -29,6 +29,10 @@ from flamenco.manager.model_utils import (  # noqa: F401
 from flamenco.manager.exceptions import ApiAttributeError
 
 
+def lazy_import():
+    from flamenco.manager.model.worker_resources import WorkerResources
+    globals()['WorkerResources'] = WorkerResources
+
 
 class WorkerSignOn(ModelNormal):
     """NOTE: This class is auto generated by OpenAPI Generator.
@@ -66,6 +70,7 @@ class WorkerSignOn(ModelNormal):
         This must be a method because a model may have properties that are
         of type self, this must run after the class is loaded
         """
+        lazy_import()
         return (bool, date, datetime, dict, float, int, list, str, none_type,)  # noqa: E501
 
     _nullable = False
@@ -80,11 +85,13 @@ class WorkerSignOn(ModelNormal):
             openapi_types (dict): The key is attribute name
                 and the value is attribute type.
         """
+        lazy_import()
         return {
             'name': (str,),  # noqa: E501
             'supported_task_types': ([str],),  # noqa: E501
             'software_version': (str,),  # noqa: E501
             'can_restart': (bool,),  # noqa: E501
+            'resources': (WorkerResources,),  # noqa: E501
         }
 
     @cached_property
@@ -97,6 +104,7 @@ class WorkerSignOn(ModelNormal):
         'supported_task_types': 'supported_task_types',  # noqa: E501
         'software_version': 'software_version',  # noqa: E501
         'can_restart': 'can_restart',  # noqa: E501
+        'resources': 'resources',  # noqa: E501
     }
 
     read_only_vars = {
@@ -146,6 +154,7 @@ class WorkerSignOn(ModelNormal):
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
             can_restart (bool): [optional]  # noqa: E501
+            resources (WorkerResources): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -236,6 +245,7 @@ class WorkerSignOn(ModelNormal):
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
             can_restart (bool): [optional]  # noqa: E501
+            resources (WorkerResources): [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
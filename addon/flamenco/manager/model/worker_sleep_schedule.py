@@ -29,6 +29,10 @@ from flamenco.manager.model_utils import (  # noqa: F401
 from flamenco.manager.exceptions import ApiAttributeError
 
 
+def lazy_import():
+    from flamenco.manager.model.worker_sleep_window import WorkerSleepWindow
+    globals()['WorkerSleepWindow'] = WorkerSleepWindow
+
 
 class WorkerSleepSchedule(ModelNormal):
     """NOTE: This class is auto generated by OpenAPI Generator.
@@ -66,6 +70,7 @@ class WorkerSleepSchedule(ModelNormal):
         This must be a method because a model may have properties that are
         of type self, this must run after the class is loaded
         """
+        lazy_import()
         return (bool, date, datetime, dict, float, int, list, str, none_type,)  # noqa: E501
 
     _nullable = False
@@ -80,11 +85,14 @@ class WorkerSleepSchedule(ModelNormal):
             openapi_types (dict): The key is attribute name
                 and the value is attribute type.
         """
+        lazy_import()
         return {
             'is_active': (bool,),  # noqa: E501
             'days_of_week': (str,),  # noqa: E501
             'start_time': (str,),  # noqa: E501
             'end_time': (str,),  # noqa: E501
+            'extra_windows': ([WorkerSleepWindow],),  # noqa: E501
+            'exception_dates': ([str],),  # noqa: E501
         }
 
     @cached_property
@@ -97,6 +105,8 @@ class WorkerSleepSchedule(ModelNormal):
         'days_of_week': 'days_of_week',  # noqa: E501
         'start_time': 'start_time',  # noqa: E501
         'end_time': 'end_time',  # noqa: E501
+        'extra_windows': 'extra_windows',  # noqa: E501
+        'exception_dates': 'exception_dates',  # noqa: E501
     }
 
     read_only_vars = {
@@ -146,6 +156,8 @@ class WorkerSleepSchedule(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            extra_windows ([WorkerSleepWindow]): Time windows in which the Worker sleeps, in addition to the window from `start_time` to `end_time`. . [optional]  # noqa: E501
+            exception_dates ([str]): Dates, in YYYY-MM-DD notation, on which the schedule does not apply and the Worker stays awake all day. This can be used to keep Workers rendering during holidays. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -237,6 +249,8 @@ class WorkerSleepSchedule(ModelNormal):
                                 Animal class but this time we won't travel
                                 through its discriminator because we passed in
                                 _visited_composed_classes = (Animal,)
+            extra_windows ([WorkerSleepWindow]): Time windows in which the Worker sleeps, in addition to the window from `start_time` to `end_time`. . [optional]  # noqa: E501
+            exception_dates ([str]): Dates, in YYYY-MM-DD notation, on which the schedule does not apply and the Worker stays awake all day. This can be used to keep Workers rendering during holidays. . [optional]  # noqa: E501
         """
 
         _check_type = kwargs.pop('_check_type', True)
@@ -28,16 +28,23 @@ from flamenco.manager.model.job_all_of import JobAllOf
 from flamenco.manager.model.job_blocklist import JobBlocklist
 from flamenco.manager.model.job_blocklist_entry import JobBlocklistEntry
 from flamenco.manager.model.job_deletion_info import JobDeletionInfo
+from flamenco.manager.model.job_last_rendered_frame import JobLastRenderedFrame
+from flamenco.manager.model.job_last_rendered_history import JobLastRenderedHistory
 from flamenco.manager.model.job_last_rendered_image_info import JobLastRenderedImageInfo
 from flamenco.manager.model.job_metadata import JobMetadata
 from flamenco.manager.model.job_priority_change import JobPriorityChange
+from flamenco.manager.model.job_retry_policy import JobRetryPolicy
 from flamenco.manager.model.job_settings import JobSettings
 from flamenco.manager.model.job_status import JobStatus
 from flamenco.manager.model.job_status_change import JobStatusChange
 from flamenco.manager.model.job_storage_info import JobStorageInfo
 from flamenco.manager.model.job_tasks_summary import JobTasksSummary
+from flamenco.manager.model.job_template import JobTemplate
+from flamenco.manager.model.job_template_all_of import JobTemplateAllOf
+from flamenco.manager.model.job_template_list import JobTemplateList
 from flamenco.manager.model.jobs_query import JobsQuery
 from flamenco.manager.model.jobs_query_result import JobsQueryResult
+from flamenco.manager.model.last_rendered_queue_info import LastRenderedQueueInfo
 from flamenco.manager.model.manager_configuration import ManagerConfiguration
 from flamenco.manager.model.manager_variable import ManagerVariable
 from flamenco.manager.model.manager_variable_audience import ManagerVariableAudience
@@ -50,12 +57,19 @@ from flamenco.manager.model.security_error import SecurityError
 from flamenco.manager.model.setup_assistant_config import SetupAssistantConfig
 from flamenco.manager.model.shaman_checkout import ShamanCheckout
 from flamenco.manager.model.shaman_checkout_result import ShamanCheckoutResult
+from flamenco.manager.model.shaman_chunk_spec import ShamanChunkSpec
+from flamenco.manager.model.shaman_chunking import ShamanChunking
+from flamenco.manager.model.shaman_corrupt_file import ShamanCorruptFile
+from flamenco.manager.model.shaman_disk_space import ShamanDiskSpace
 from flamenco.manager.model.shaman_file_spec import ShamanFileSpec
 from flamenco.manager.model.shaman_file_spec_with_status import ShamanFileSpecWithStatus
 from flamenco.manager.model.shaman_file_status import ShamanFileStatus
 from flamenco.manager.model.shaman_requirements_request import ShamanRequirementsRequest
 from flamenco.manager.model.shaman_requirements_response import ShamanRequirementsResponse
+from flamenco.manager.model.shaman_scrub_report import ShamanScrubReport
+from flamenco.manager.model.shaman_scrub_status import ShamanScrubStatus
 from flamenco.manager.model.shaman_single_file_status import ShamanSingleFileStatus
+from flamenco.manager.model.shaman_status import ShamanStatus
 from flamenco.manager.model.shared_storage_location import SharedStorageLocation
 from flamenco.manager.model.socket_io_job_update import SocketIOJobUpdate
 from flamenco.manager.model.socket_io_last_rendered_update import SocketIOLastRenderedUpdate
@@ -67,8 +81,12 @@ from flamenco.manager.model.socket_io_task_update import SocketIOTaskUpdate
 from flamenco.manager.model.socket_io_worker_tag_update import SocketIOWorkerTagUpdate
 from flamenco.manager.model.socket_io_worker_update import SocketIOWorkerUpdate
 from flamenco.manager.model.submitted_job import SubmittedJob
+from flamenco.manager.model.submitted_job_template import SubmittedJobTemplate
 from flamenco.manager.model.task import Task
 from flamenco.manager.model.task_log_info import TaskLogInfo
+from flamenco.manager.model.task_log_search_match import TaskLogSearchMatch
+from flamenco.manager.model.task_log_search_query import TaskLogSearchQuery
+from flamenco.manager.model.task_render_progress import TaskRenderProgress
 from flamenco.manager.model.task_status import TaskStatus
 from flamenco.manager.model.task_status_change import TaskStatusChange
 from flamenco.manager.model.task_summary import TaskSummary
@@ -78,8 +96,13 @@ from flamenco.manager.model.worker import Worker
 from flamenco.manager.model.worker_all_of import WorkerAllOf
 from flamenco.manager.model.worker_list import WorkerList
 from flamenco.manager.model.worker_registration import WorkerRegistration
+from flamenco.manager.model.worker_resource_sample import WorkerResourceSample
+from flamenco.manager.model.worker_resource_sample_all_of import WorkerResourceSampleAllOf
+from flamenco.manager.model.worker_resource_usage import WorkerResourceUsage
+from flamenco.manager.model.worker_resources import WorkerResources
 from flamenco.manager.model.worker_sign_on import WorkerSignOn
 from flamenco.manager.model.worker_sleep_schedule import WorkerSleepSchedule
+from flamenco.manager.model.worker_sleep_window import WorkerSleepWindow
 from flamenco.manager.model.worker_state_change import WorkerStateChange
 from flamenco.manager.model.worker_state_changed import WorkerStateChanged
 from flamenco.manager.model.worker_status import WorkerStatus
@@ -26,6 +26,8 @@ import (
 	"projects.blender.org/studio/flamenco/internal/manager/api_impl"
 	"projects.blender.org/studio/flamenco/internal/manager/api_impl/dummy"
 	"projects.blender.org/studio/flamenco/internal/manager/config"
+	"projects.blender.org/studio/flamenco/internal/manager/job_activator"
+	"projects.blender.org/studio/flamenco/internal/manager/job_archive"
 	"projects.blender.org/studio/flamenco/internal/manager/job_compilers"
 	"projects.blender.org/studio/flamenco/internal/manager/job_deleter"
 	"projects.blender.org/studio/flamenco/internal/manager/last_rendered"
@@ -34,10 +36,12 @@ import (
 	"projects.blender.org/studio/flamenco/internal/manager/sleep_scheduler"
 	"projects.blender.org/studio/flamenco/internal/manager/task_logs"
 	"projects.blender.org/studio/flamenco/internal/manager/task_state_machine"
+	"projects.blender.org/studio/flamenco/internal/manager/template_scheduler"
 	"projects.blender.org/studio/flamenco/internal/manager/timeout_checker"
 	"projects.blender.org/studio/flamenco/internal/manager/webupdates"
 	"projects.blender.org/studio/flamenco/internal/own_url"
 	"projects.blender.org/studio/flamenco/internal/upnp_ssdp"
+	"projects.blender.org/studio/flamenco/pkg/exr"
 	"projects.blender.org/studio/flamenco/pkg/shaman"
 	"projects.blender.org/studio/flamenco/pkg/sysinfo"
 )
@@ -48,6 +52,7 @@ var cliArgs struct {
 	delayResponses bool
 	setupAssistant bool
 	pprof          bool
+	shamanScrub    bool
 }
 
 const (
@@ -129,6 +134,13 @@ func runFlamencoManager() bool {
 		return false
 	}
 
+	if cliArgs.shamanScrub {
+		if !scrubShamanStorage(configService) {
+			os.Exit(1)
+		}
+		return false
+	}
+
 	// TODO: enable TLS via Let's Encrypt.
 	listen := configService.Get().Listen
 	_, port, _ := net.SplitHostPort(listen)
@@ -159,15 +171,18 @@ func runFlamencoManager() bool {
 
 	taskStateMachine := task_state_machine.NewStateMachine(persist, webUpdater, logStorage)
 	sleepScheduler := sleep_scheduler.New(timeService, persist, webUpdater)
-	lastRender := last_rendered.New(localStorage)
+	lastRender := last_rendered.New(localStorage, exr.Options{
+		Layer: configService.Get().LastRenderedEXRLayer,
+	})
 
 	shamanServer := buildShamanServer(configService, isFirstRun)
 	jobDeleter := job_deleter.NewService(persist, localStorage, webUpdater, shamanServer)
+	jobArchiver := job_archive.NewService(persist, localStorage)
 
 	flamenco := api_impl.NewFlamenco(
 		compiler, persist, webUpdater, logStorage, configService,
 		taskStateMachine, shamanServer, timeService, lastRender,
-		localStorage, sleepScheduler, jobDeleter)
+		localStorage, sleepScheduler, jobDeleter, jobArchiver)
 
 	e := buildWebService(flamenco, persist, ssdp, webUpdater, urls, localStorage)
 
@@ -175,6 +190,8 @@ func runFlamencoManager() bool {
 		configService.Get().TaskTimeout,
 		configService.Get().WorkerTimeout,
 		timeService, persist, taskStateMachine, logStorage, webUpdater)
+	jobActivator := job_activator.New(timeService, persist, taskStateMachine)
+	templateScheduler := template_scheduler.New(timeService, persist, flamenco)
 
 	// The main context determines the lifetime of the application. All
 	// long-running goroutines need to keep an eye on this, and stop their work
@@ -245,6 +262,20 @@ func runFlamencoManager() bool {
 		sleepScheduler.Run(mainCtx)
 	}()
 
+	// Run the Job Activator, to queue jobs that were waiting for their start time.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		jobActivator.Run(mainCtx)
+	}()
+
+	// Run the Job Template Scheduler, to periodically submit jobs.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		templateScheduler.Run(mainCtx)
+	}()
+
 	// Run the Job Deleter.
 	wg.Add(1)
 	go func() {
@@ -283,6 +314,36 @@ func buildShamanServer(configService *config.Service, isFirstRun bool) api_impl.
 	return shaman.NewServer(configService.Get().Shaman, nil)
 }
 
+// scrubShamanStorage checks the integrity of the Shaman file store, and returns
+// whether that was successful.
+func scrubShamanStorage(configService *config.Service) bool {
+	shamanServer := shaman.NewServer(configService.Get().Shaman, nil)
+	if shamanServer == nil {
+		log.Error().Msg("Shaman is not enabled, unable to check the integrity of its file store")
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	installSignalHandler(cancel)
+
+	report, err := shamanServer.Scrub(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("could not check the integrity of the Shaman file store")
+		return false
+	}
+
+	for _, corrupt := range report.CorruptFiles {
+		log.Warn().
+			Str("checksum", corrupt.Checksum).
+			Int64("size", corrupt.Size).
+			Str("quarantinePath", corrupt.QuarantinePath).
+			Strs("referencedBy", corrupt.ReferencedBy).
+			Msg("corrupt file in Shaman file store")
+	}
+	return true
+}
+
 // openWebbrowser starts a web browser after waiting for 1 second.
 // Closing the context aborts the opening of the browser, but doesn't close the
 // browser itself if has already started.
@@ -313,6 +374,8 @@ func parseCliArgs() {
 		"Add a random delay to any HTTP responses. This aids in development of Flamenco Manager's web frontend.")
 	flag.BoolVar(&cliArgs.setupAssistant, "setup-assistant", false, "Open a webbrowser with the setup assistant.")
 	flag.BoolVar(&cliArgs.pprof, "pprof", false, "Expose profiler endpoints on /debug/pprof/.")
+	flag.BoolVar(&cliArgs.shamanScrub, "shaman-scrub", false,
+		"Checks the integrity of the Shaman file store, moving corrupt files to quarantine, then exits.")
 
 	flag.Parse()
 
@@ -343,10 +406,16 @@ func openDB(configService config.Service) *persistence.DB {
 	if err != nil {
 		log.Fatal().
 			Err(err).
-			Str("dsn", dsn).
+			Str("dsn", persistence.RedactDSN(dsn)).
 			Msg("error opening database")
 	}
 
+	fairShare := configService.Get().FairShare
+	persist.SetFairShare(persistence.FairShare{
+		Enabled:              fairShare.Enabled,
+		SubmitterMetadataKey: fairShare.SubmitterMetadataKey,
+	})
+
 	return persist
 }
 
@@ -23,6 +23,7 @@ import (
 	"projects.blender.org/studio/flamenco/internal/appinfo"
 	"projects.blender.org/studio/flamenco/internal/worker"
 	"projects.blender.org/studio/flamenco/internal/worker/cli_runner"
+	"projects.blender.org/studio/flamenco/pkg/exr"
 	"projects.blender.org/studio/flamenco/pkg/sysinfo"
 )
 
@@ -149,7 +150,8 @@ func main() {
 	}
 
 	cliRunner := cli_runner.NewCLIRunner()
-	listener = worker.NewListener(client, buffer)
+	workerConfig, _ := configWrangler.WorkerConfig()
+	listener = worker.NewListener(client, buffer, exr.Options{Layer: workerConfig.EXRLayer})
 	cmdRunner := worker.NewCommandExecutor(cliRunner, listener, timeService)
 	taskRunner := worker.NewTaskExecutor(cmdRunner, listener)
 	w = worker.NewWorker(client, taskRunner)
@@ -0,0 +1,218 @@
+package main
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/mattn/go-colorable"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/internal/appinfo"
+	"projects.blender.org/studio/flamenco/internal/manager/config"
+	"projects.blender.org/studio/flamenco/internal/manager/job_archive"
+	"projects.blender.org/studio/flamenco/internal/manager/local_storage"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+)
+
+var cliArgs struct {
+	version bool
+}
+
+func main() {
+	output := zerolog.ConsoleWriter{Out: colorable.NewColorableStdout(), TimeFormat: time.RFC3339}
+	log.Logger = log.Output(output)
+	log.Info().
+		Str("version", appinfo.ApplicationVersion).
+		Str("git", appinfo.ApplicationGitHash).
+		Str("releaseCycle", appinfo.ReleaseCycle).
+		Str("os", runtime.GOOS).
+		Str("arch", runtime.GOARCH).
+		Msgf("starting %v job archiver", appinfo.ApplicationName)
+
+	parseCliArgs()
+	if cliArgs.version {
+		return
+	}
+
+	args := flag.Args()
+	if len(args) < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	command := args[0]
+	switch {
+	case command == "export" && (len(args) == 2 || len(args) == 3):
+	case command == "import" && len(args) == 2:
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Load configuration.
+	configService := config.NewService()
+	err := configService.Load()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Error().Err(err).Msg("loading configuration")
+	}
+
+	isFirstRun, err := configService.IsFirstRun()
+	switch {
+	case err != nil:
+		log.Fatal().Err(err).Msg("unable to determine whether this is the first run of Flamenco or not")
+	case isFirstRun:
+		log.Info().Msg("This seems to be your first run of Flamenco, this tool won't work.")
+		return
+	}
+
+	// Construct the services.
+	persist := openDB(*configService)
+	defer persist.Close()
+
+	localStorage := local_storage.NewNextToExe(configService.Get().LocalManagerStoragePath)
+	archiver := job_archive.NewService(persist, localStorage)
+
+	// The main context determines the lifetime of the application. All
+	// long-running goroutines need to keep an eye on this, and stop their work
+	// once it closes.
+	mainCtx, mainCtxCancel := context.WithCancel(context.Background())
+	defer mainCtxCancel()
+
+	installSignalHandler(mainCtxCancel)
+
+	switch command {
+	case "export":
+		jobUUID := args[1]
+		filename := job_archive.Filename(jobUUID)
+		if len(args) > 2 {
+			filename = args[2]
+		}
+		exportJob(mainCtx, archiver, jobUUID, filename)
+	case "import":
+		importJob(mainCtx, archiver, args[1])
+	}
+}
+
+// exportJob writes the job to a ZIP file.
+func exportJob(ctx context.Context, archiver *job_archive.Service, jobUUID, filename string) {
+	logger := log.With().Str("job", jobUUID).Str("filename", filename).Logger()
+
+	file, err := os.Create(filename)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("could not create archive file")
+	}
+
+	err = archiver.Export(ctx, jobUUID, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Don't leave a broken archive behind.
+		_ = os.Remove(filename)
+		logger.Fatal().Err(err).Msg("could not export job")
+	}
+
+	logger.Info().Msg("job has been exported")
+}
+
+// importJob reads the job from a ZIP file.
+func importJob(ctx context.Context, archiver *job_archive.Service, filename string) {
+	logger := log.With().Str("filename", filename).Logger()
+
+	file, err := os.Open(filename)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("could not open archive file")
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		logger.Fatal().Err(err).Msg("could not inspect archive file")
+	}
+
+	jobUUID, err := archiver.Import(ctx, file, stat.Size())
+	switch {
+	case errors.Is(err, persistence.ErrJobAlreadyExists):
+		logger.Fatal().Msg("this job already exists, not importing it")
+	case err != nil:
+		logger.Fatal().Err(err).Msg("could not import job")
+	}
+
+	logger.Info().Str("job", jobUUID).Msg("job has been imported; refresh the web interface to see it")
+}
+
+func parseCliArgs() {
+	var quiet, debug, trace bool
+
+	flag.BoolVar(&cliArgs.version, "version", false, "Shows the application version, then exits.")
+	flag.BoolVar(&quiet, "quiet", false, "Only log warning-level and worse.")
+	flag.BoolVar(&debug, "debug", false, "Enable debug-level logging.")
+	flag.BoolVar(&trace, "trace", false, "Enable trace-level logging.")
+
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintf(out, "Usage:\n")
+		fmt.Fprintf(out, "  %s [flags] export <job-uuid> [archive.zip]\n", os.Args[0])
+		fmt.Fprintf(out, "  %s [flags] import <archive.zip>\n", os.Args[0])
+		fmt.Fprintf(out, "\nFlags:\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	var logLevel zerolog.Level
+	switch {
+	case trace:
+		logLevel = zerolog.TraceLevel
+	case debug:
+		logLevel = zerolog.DebugLevel
+	case quiet:
+		logLevel = zerolog.WarnLevel
+	default:
+		logLevel = zerolog.InfoLevel
+	}
+	zerolog.SetGlobalLevel(logLevel)
+}
+
+// openDB opens the database or dies.
+func openDB(configService config.Service) *persistence.DB {
+	dsn := configService.Get().DatabaseDSN
+	if dsn == "" {
+		log.Fatal().Msg("configure the database in flamenco-manager.yaml")
+	}
+
+	dbCtx, dbCtxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer dbCtxCancel()
+	persist, err := persistence.OpenDB(dbCtx, dsn)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("dsn", persistence.RedactDSN(dsn)).
+			Msg("error opening database")
+	}
+
+	return persist
+}
+
+// installSignalHandler spawns a goroutine that handles incoming POSIX signals.
+func installSignalHandler(cancelFunc context.CancelFunc) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	signal.Notify(signals, syscall.SIGTERM)
+	go func() {
+		for signum := range signals {
+			log.Info().Str("signal", signum.String()).Msg("signal received, shutting down")
+			cancelFunc()
+		}
+	}()
+}
@@ -185,6 +185,7 @@ func updateTaskStatuses(ctx context.Context, logger zerolog.Logger, db *persiste
 		api.JobStatusQueued:            api.TaskStatusQueued,
 		api.JobStatusRequeueing:        api.TaskStatusQueued,
 		api.JobStatusUnderConstruction: api.TaskStatusQueued,
+		api.JobStatusWaiting:           api.TaskStatusQueued,
 	}
 	newTaskStatus, ok := taskStatusMap[dbJob.Status]
 	if !ok {
@@ -240,7 +241,7 @@ func openDB(configService config.Service) *persistence.DB {
 	if err != nil {
 		log.Fatal().
 			Err(err).
-			Str("dsn", dsn).
+			Str("dsn", persistence.RedactDSN(dsn)).
 			Msg("error opening database")
 	}
 
@@ -169,7 +169,7 @@ func openDB(configService config.Service) *persistence.DB {
 	if err != nil {
 		log.Fatal().
 			Err(err).
-			Str("dsn", dsn).
+			Str("dsn", persistence.RedactDSN(dsn)).
 			Msg("error opening database")
 	}
 
@@ -4,6 +4,7 @@ go 1.21
 
 require (
 	github.com/adrg/xdg v0.4.0
+	github.com/alessio/shellescape v1.4.2
 	github.com/benbjohnson/clock v1.3.0
 	github.com/deepmap/oapi-codegen v1.9.0
 	github.com/disintegration/imaging v1.6.2
@@ -23,7 +24,7 @@ require (
 	github.com/pkg/browser v0.0.0-20210911075715-681adbf594b8
 	github.com/pressly/goose/v3 v3.15.1
 	github.com/rs/zerolog v1.26.1
-	github.com/stretchr/testify v1.7.0
+	github.com/stretchr/testify v1.8.1
 	github.com/zcalusic/sysinfo v1.0.1
 	github.com/ziflex/lecho/v3 v3.1.0
 	golang.org/x/crypto v0.14.0
@@ -31,12 +32,12 @@ require (
 	golang.org/x/net v0.17.0
 	golang.org/x/sys v0.13.0
 	gopkg.in/yaml.v2 v2.4.0
+	gorm.io/driver/postgres v1.5.2
 	gorm.io/gorm v1.25.2
 	modernc.org/sqlite v1.26.0
 )
 
 require (
-	github.com/alessio/shellescape v1.4.2 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/dlclark/regexp2 v1.7.0 // indirect
 	github.com/dustin/go-humanize v1.0.1 // indirect
@@ -48,6 +49,9 @@ require (
 	github.com/google/pprof v0.0.0-20230207041349-798e818bf904 // indirect
 	github.com/gorilla/mux v1.8.0 // indirect
 	github.com/gorilla/websocket v1.4.2 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
+	github.com/jackc/pgx/v5 v5.4.3 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/labstack/gommon v0.4.0 // indirect
@@ -9,6 +9,7 @@ import (
 
 	"projects.blender.org/studio/flamenco/internal/manager/api_impl"
 	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/shaman"
 )
 
 // DummyShaman implements the Shaman interface from `internal/manager/api_impl/interfaces.go`
@@ -27,12 +28,24 @@ func (ds *DummyShaman) Checkout(ctx context.Context, checkout api.ShamanCheckout
 func (ds *DummyShaman) Requirements(ctx context.Context, requirements api.ShamanRequirementsRequest) (api.ShamanRequirementsResponse, error) {
 	return api.ShamanRequirementsResponse{}, ErrDummyShaman
 }
-func (ds *DummyShaman) FileStoreCheck(ctx context.Context, checksum string, filesize int64) api.ShamanFileStatus {
-	return api.ShamanFileStatusUnknown
+func (ds *DummyShaman) FileStoreCheck(ctx context.Context, checksum string, filesize int64) api.ShamanSingleFileStatus {
+	return api.ShamanSingleFileStatus{Status: api.ShamanFileStatusUnknown}
 }
-func (ds *DummyShaman) FileStore(ctx context.Context, file io.ReadCloser, checksum string, filesize int64, canDefer bool, originalFilename string) error {
+func (ds *DummyShaman) FileStore(ctx context.Context, file io.ReadCloser, checksum string, filesize int64, offset int64, canDefer bool, originalFilename string) error {
 	return ErrDummyShaman
 }
 func (ds *DummyShaman) EraseCheckout(checkoutID string) error {
 	return ErrDummyShaman
 }
+func (ds *DummyShaman) DiskSpaceStatus() shaman.DiskSpaceStatus {
+	return shaman.DiskSpaceStatus{}
+}
+func (ds *DummyShaman) Chunking() *api.ShamanChunking {
+	return nil
+}
+func (ds *DummyShaman) StartScrub() error {
+	return ErrDummyShaman
+}
+func (ds *DummyShaman) ScrubStatus() (bool, *shaman.ScrubReport) {
+	return false, nil
+}
@@ -7,6 +7,7 @@ package api_impl
 
 import (
 	"context"
+	"image"
 	"io"
 	"time"
 
@@ -14,11 +15,13 @@ import (
 	"github.com/rs/zerolog"
 
 	"projects.blender.org/studio/flamenco/internal/manager/config"
+	"projects.blender.org/studio/flamenco/internal/manager/job_archive"
 	"projects.blender.org/studio/flamenco/internal/manager/job_compilers"
 	"projects.blender.org/studio/flamenco/internal/manager/job_deleter"
 	"projects.blender.org/studio/flamenco/internal/manager/last_rendered"
 	"projects.blender.org/studio/flamenco/internal/manager/persistence"
 	"projects.blender.org/studio/flamenco/internal/manager/sleep_scheduler"
+	"projects.blender.org/studio/flamenco/internal/manager/task_logs"
 	"projects.blender.org/studio/flamenco/internal/manager/task_state_machine"
 	"projects.blender.org/studio/flamenco/internal/manager/webupdates"
 	"projects.blender.org/studio/flamenco/pkg/api"
@@ -26,7 +29,7 @@ import (
 )
 
 // Generate mock implementations of these interfaces.
-//go:generate go run github.com/golang/mock/mockgen -destination mocks/api_impl_mock.gen.go -package mocks projects.blender.org/studio/flamenco/internal/manager/api_impl PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler,JobDeleter
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/api_impl_mock.gen.go -package mocks projects.blender.org/studio/flamenco/internal/manager/api_impl PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler,JobDeleter,JobArchiver
 
 type PersistenceService interface {
 	StoreAuthoredJob(ctx context.Context, authoredJob job_compilers.AuthoredJob) error
@@ -38,6 +41,11 @@ type PersistenceService interface {
 	FetchTaskFailureList(context.Context, *persistence.Task) ([]*persistence.Worker, error)
 	SaveTask(ctx context.Context, task *persistence.Task) error
 	SaveTaskActivity(ctx context.Context, t *persistence.Task) error
+	SaveTaskProgress(ctx context.Context, t *persistence.Task) error
+	// SaveTaskRetryState stores the task's failure count and retry delay.
+	SaveTaskRetryState(ctx context.Context, t *persistence.Task) error
+	// FetchJobProgress returns the average progress of the job's tasks, as percentage.
+	FetchJobProgress(ctx context.Context, job *persistence.Job) (int, error)
 	// TaskTouchedByWorker marks the task as 'touched' by a worker. This is used for timeout detection.
 	TaskTouchedByWorker(context.Context, *persistence.Task) error
 
@@ -47,6 +55,7 @@ type PersistenceService interface {
 	FetchWorkerTask(context.Context, *persistence.Worker) (*persistence.Task, error)
 	SaveWorker(ctx context.Context, w *persistence.Worker) error
 	SaveWorkerStatus(ctx context.Context, w *persistence.Worker) error
+	SaveWorkerResourceUsage(ctx context.Context, w *persistence.Worker) error
 	WorkerSeen(ctx context.Context, w *persistence.Worker) error
 	DeleteWorker(ctx context.Context, uuid string) error
 
@@ -73,6 +82,12 @@ type PersistenceService interface {
 	DeleteWorkerTag(ctx context.Context, uuid string) error
 	SaveWorkerTag(ctx context.Context, tag *persistence.WorkerTag) error
 
+	CreateJobTemplate(ctx context.Context, template *persistence.JobTemplate) error
+	FetchJobTemplate(ctx context.Context, uuid string) (*persistence.JobTemplate, error)
+	FetchJobTemplates(ctx context.Context) ([]*persistence.JobTemplate, error)
+	SaveJobTemplate(ctx context.Context, template *persistence.JobTemplate) error
+	DeleteJobTemplate(ctx context.Context, uuid string) error
+
 	// WorkersLeftToRun returns a set of worker UUIDs that can run tasks of the given type on the given job.
 	WorkersLeftToRun(ctx context.Context, job *persistence.Job, taskType string) (map[string]bool, error)
 	// CountTaskFailuresOfWorker returns the number of task failures of this worker, on this particular job and task type.
@@ -81,6 +96,8 @@ type PersistenceService interface {
 	// Database queries.
 	QueryJobs(ctx context.Context, query api.JobsQuery) ([]*persistence.Job, error)
 	QueryJobTaskSummaries(ctx context.Context, jobUUID string) ([]*persistence.Task, error)
+	// FetchJobsActiveBetween returns the jobs that were created before `until` and updated after `since`.
+	FetchJobsActiveBetween(ctx context.Context, since, until time.Time) ([]*persistence.Job, error)
 
 	// SetLastRendered sets this job as the one with the most recent rendered image.
 	SetLastRendered(ctx context.Context, j *persistence.Job) error
@@ -110,6 +127,10 @@ type ChangeBroadcaster interface {
 	BroadcastJobUpdate(jobUpdate api.SocketIOJobUpdate)
 	BroadcastLastRenderedImage(update api.SocketIOLastRenderedUpdate)
 
+	// BroadcastTaskUpdate is only used for updates that don't go through the
+	// task state machine, like render progress.
+	BroadcastTaskUpdate(taskUpdate api.SocketIOTaskUpdate)
+
 	// Note that there is no BroadcastNewTask. The 'new job' broadcast is sent
 	// after the job's tasks have been created, and thus there is no need for a
 	// separate broadcast per task.
@@ -142,6 +163,9 @@ type LogStorage interface {
 	Tail(jobID, taskID string) (string, error)
 	TaskLogSize(jobID, taskID string) (int64, error)
 	Filepath(jobID, taskID string) string
+	// Search calls `found` for every line of the task log for which `matches` returns true.
+	Search(ctx context.Context, jobID, taskID string,
+		matches func(line string) bool, found func(match task_logs.SearchMatch) error) error
 }
 
 // LastRendered processes the "last rendered" images.
@@ -157,6 +181,22 @@ type LastRendered interface {
 	// ThumbSpecs returns the thumbnail specifications.
 	ThumbSpecs() []last_rendered.Thumbspec
 
+	// QueueStats returns metrics of the processing queue.
+	QueueStats() last_rendered.QueueStats
+
+	// HistoryPathForJob returns the directory that contains the history of
+	// last-rendered images of this job.
+	HistoryPathForJob(jobUUID string) string
+
+	// JobHistory returns the thumbnails of previously rendered frames of the
+	// job, ordered by frame number.
+	JobHistory(jobUUID string) ([]last_rendered.HistoryImage, error)
+
+	// ContactSheet combines the history of last-rendered images of the job into
+	// a single image. Returns `last_rendered.ErrNoHistory` if the job has no
+	// history.
+	ContactSheet(jobUUID string, columns int) (image.Image, error)
+
 	// JobHasImage returns true only if the job actually has a last-rendered image.
 	JobHasImage(jobUUID string) bool
 }
@@ -203,15 +243,32 @@ type Shaman interface {
 	Requirements(ctx context.Context, requirements api.ShamanRequirementsRequest) (api.ShamanRequirementsResponse, error)
 
 	// Check the status of a file on the Shaman server.
-	FileStoreCheck(ctx context.Context, checksum string, filesize int64) api.ShamanFileStatus
+	FileStoreCheck(ctx context.Context, checksum string, filesize int64) api.ShamanSingleFileStatus
 
 	// Store a new file on the Shaman server. Note that the Shaman server can
 	// return early when another client finishes uploading the exact same file, to
-	// prevent double uploads.
-	FileStore(ctx context.Context, file io.ReadCloser, checksum string, filesize int64, canDefer bool, originalFilename string) error
+	// prevent double uploads. When offset > 0, an earlier, unfinished upload is
+	// resumed from that offset.
+	FileStore(ctx context.Context, file io.ReadCloser, checksum string, filesize int64, offset int64, canDefer bool, originalFilename string) error
 
 	// EraseCheckout deletes the symlinks and the directory structure that makes up the checkout.
 	EraseCheckout(checkoutID string) error
+
+	// DiskSpaceStatus returns the free disk space of the file store and checkout
+	// directories.
+	DiskSpaceStatus() shaman.DiskSpaceStatus
+
+	// Chunking returns the parameters for uploading files as content-defined
+	// chunks, or nil when chunking is disabled.
+	Chunking() *api.ShamanChunking
+
+	// StartScrub starts an integrity check of the file store in the background.
+	// Returns shaman.ErrScrubRunning when another check is already running.
+	StartScrub() error
+
+	// ScrubStatus returns whether an integrity check is running, and the report
+	// of the last finished check.
+	ScrubStatus() (isRunning bool, lastReport *shaman.ScrubReport)
 }
 
 var _ Shaman = (*shaman.Server)(nil)
@@ -227,6 +284,8 @@ var _ TimeService = (clock.Clock)(nil)
 type WorkerSleepScheduler interface {
 	FetchSchedule(ctx context.Context, workerUUID string) (*persistence.SleepSchedule, error)
 	SetSchedule(ctx context.Context, workerUUID string, schedule *persistence.SleepSchedule) error
+	FetchTagSchedule(ctx context.Context, tagUUID string) (*persistence.SleepSchedule, error)
+	SetTagSchedule(ctx context.Context, tagUUID string, schedule *persistence.SleepSchedule) error
 	WorkerStatus(ctx context.Context, workerUUID string) (api.WorkerStatus, error)
 }
 
@@ -239,3 +298,12 @@ type JobDeleter interface {
 }
 
 var _ JobDeleter = (*job_deleter.Service)(nil)
+
+type JobArchiver interface {
+	// Export writes the job as ZIP file to `w`.
+	Export(ctx context.Context, jobUUID string, w io.Writer) error
+	// Import stores the job from the ZIP file, and returns its UUID.
+	Import(ctx context.Context, r io.ReaderAt, size int64) (string, error)
+}
+
+var _ JobArchiver = (*job_archive.Service)(nil)
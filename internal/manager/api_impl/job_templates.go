@@ -0,0 +1,244 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"projects.blender.org/studio/flamenco/internal/manager/job_compilers"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/internal/manager/template_scheduler"
+	"projects.blender.org/studio/flamenco/internal/uuid"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+var _ template_scheduler.JobSubmitter = (*Flamenco)(nil)
+
+func (f *Flamenco) FetchJobTemplates(e echo.Context) error {
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+
+	dbTemplates, err := f.persist.FetchJobTemplates(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("fetching job templates")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job templates: %v", err)
+	}
+
+	apiTemplates := make([]api.JobTemplate, len(dbTemplates))
+	for idx, dbTemplate := range dbTemplates {
+		apiTemplates[idx] = jobTemplateDBtoAPI(dbTemplate)
+	}
+
+	return e.JSON(http.StatusOK, api.JobTemplateList{Templates: apiTemplates})
+}
+
+func (f *Flamenco) CreateJobTemplate(e echo.Context) error {
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+
+	var apiTemplate api.CreateJobTemplateJSONRequestBody
+	if err := e.Bind(&apiTemplate); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	dbTemplate := persistence.JobTemplate{UUID: uuid.New()}
+	logger = logger.With().
+		Str("name", apiTemplate.Name).
+		Str("uuid", dbTemplate.UUID).
+		Logger()
+
+	if ok, err := f.applyJobTemplate(e, logger, api.SubmittedJobTemplate(apiTemplate), &dbTemplate); !ok {
+		// f.applyJobTemplate already sent a response.
+		return err
+	}
+
+	if err := f.persist.CreateJobTemplate(ctx, &dbTemplate); err != nil {
+		logger.Error().Err(err).Msg("creating job template")
+		return sendAPIError(e, http.StatusInternalServerError, "error creating job template")
+	}
+
+	logger.Info().
+		Str("cron", dbTemplate.Cron).
+		Bool("isActive", dbTemplate.IsActive).
+		Msg("created new job template")
+	return e.JSON(http.StatusOK, jobTemplateDBtoAPI(&dbTemplate))
+}
+
+func (f *Flamenco) FetchJobTemplate(e echo.Context, templateUUID string) error {
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+	logger = logger.With().Str("template", templateUUID).Logger()
+
+	if !uuid.IsValid(templateUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	dbTemplate, err := f.persist.FetchJobTemplate(ctx, templateUUID)
+	switch {
+	case errors.Is(err, persistence.ErrJobTemplateNotFound):
+		logger.Debug().Msg("non-existent job template requested")
+		return sendAPIError(e, http.StatusNotFound, "job template %q not found", templateUUID)
+	case err != nil:
+		logger.Error().Err(err).Msg("fetching job template")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job template: %v", err)
+	}
+
+	return e.JSON(http.StatusOK, jobTemplateDBtoAPI(dbTemplate))
+}
+
+func (f *Flamenco) UpdateJobTemplate(e echo.Context, templateUUID string) error {
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+	logger = logger.With().Str("template", templateUUID).Logger()
+
+	if !uuid.IsValid(templateUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	var apiTemplate api.UpdateJobTemplateJSONRequestBody
+	if err := e.Bind(&apiTemplate); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	dbTemplate, err := f.persist.FetchJobTemplate(ctx, templateUUID)
+	switch {
+	case errors.Is(err, persistence.ErrJobTemplateNotFound):
+		logger.Debug().Msg("non-existent job template requested")
+		return sendAPIError(e, http.StatusNotFound, "job template %q not found", templateUUID)
+	case err != nil:
+		logger.Error().Err(err).Msg("fetching job template")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job template: %v", err)
+	}
+
+	if ok, err := f.applyJobTemplate(e, logger, api.SubmittedJobTemplate(apiTemplate), dbTemplate); !ok {
+		// f.applyJobTemplate already sent a response.
+		return err
+	}
+
+	if err := f.persist.SaveJobTemplate(ctx, dbTemplate); err != nil {
+		logger.Error().Err(err).Msg("saving job template")
+		return sendAPIError(e, http.StatusInternalServerError, "error saving job template")
+	}
+
+	logger.Info().
+		Str("cron", dbTemplate.Cron).
+		Bool("isActive", dbTemplate.IsActive).
+		Msg("job template updated")
+	return e.JSON(http.StatusOK, jobTemplateDBtoAPI(dbTemplate))
+}
+
+func (f *Flamenco) DeleteJobTemplate(e echo.Context, templateUUID string) error {
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+	logger = logger.With().Str("template", templateUUID).Logger()
+
+	if !uuid.IsValid(templateUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	err := f.persist.DeleteJobTemplate(ctx, templateUUID)
+	switch {
+	case errors.Is(err, persistence.ErrJobTemplateNotFound):
+		logger.Debug().Msg("non-existent job template deleted")
+		return sendAPIError(e, http.StatusNotFound, "job template %q not found", templateUUID)
+	case err != nil:
+		logger.Error().Err(err).Msg("deleting job template")
+		return sendAPIError(e, http.StatusInternalServerError, "error deleting job template: %v", err)
+	}
+
+	logger.Info().Msg("job template deleted")
+	return e.NoContent(http.StatusNoContent)
+}
+
+// applyJobTemplate validates the API job template and copies it into the
+// database model. It returns false when the template is invalid, in which case
+// an error response has already been sent.
+func (f *Flamenco) applyJobTemplate(
+	e echo.Context,
+	logger zerolog.Logger,
+	apiTemplate api.SubmittedJobTemplate,
+	dbTemplate *persistence.JobTemplate,
+) (bool, error) {
+	if apiTemplate.Name == "" {
+		return false, sendAPIError(e, http.StatusBadRequest, "job template name cannot be empty")
+	}
+
+	nextRun, err := template_scheduler.NextRun(apiTemplate.Cron, f.clock.Now())
+	if err != nil {
+		logger.Warn().Err(err).Str("cron", apiTemplate.Cron).Msg("rejecting job template, invalid cron expression")
+		return false, sendAPIError(e, http.StatusBadRequest, "invalid cron expression: %v", err)
+	}
+
+	// Compile the job once, to catch errors now instead of when it's submitted.
+	// Compilation changes the job in place, so it is done on a copy.
+	jobCopy, err := copySubmittedJob(apiTemplate.Job)
+	if err != nil {
+		logger.Error().Err(err).Msg("copying submitted job")
+		return false, sendAPIError(e, http.StatusInternalServerError, "error copying submitted job")
+	}
+	_, err = f.compileSubmittedJob(e.Request().Context(), logger, jobCopy)
+	switch {
+	case errors.Is(err, job_compilers.ErrJobTypeBadEtag):
+		logger.Info().Err(err).Msg("rejecting job template because its settings are outdated, refresh the job type")
+		return false, sendAPIError(e, http.StatusPreconditionFailed, "rejecting job template because its settings are outdated, refresh the job type")
+	case err != nil:
+		logger.Warn().Err(err).Msg("rejecting job template, error compiling its job")
+		return false, sendAPIError(e, http.StatusBadRequest, err.Error())
+	}
+
+	dbTemplate.Name = apiTemplate.Name
+	dbTemplate.Cron = apiTemplate.Cron
+	dbTemplate.IsActive = apiTemplate.IsActive
+	dbTemplate.Job = persistence.SubmittedJobJSON(apiTemplate.Job)
+	dbTemplate.NextRun = nextRun
+	return true, nil
+}
+
+// copySubmittedJob returns a deep copy of the submitted job.
+func copySubmittedJob(job api.SubmittedJob) (api.SubmittedJob, error) {
+	var jobCopy api.SubmittedJob
+	asJSON, err := json.Marshal(job)
+	if err != nil {
+		return jobCopy, fmt.Errorf("converting job to JSON: %w", err)
+	}
+	if err := json.Unmarshal(asJSON, &jobCopy); err != nil {
+		return jobCopy, fmt.Errorf("converting JSON to job: %w", err)
+	}
+	return jobCopy, nil
+}
+
+func jobTemplateDBtoAPI(dbTemplate *persistence.JobTemplate) api.JobTemplate {
+	apiTemplate := api.JobTemplate{
+		SubmittedJobTemplate: api.SubmittedJobTemplate{
+			Name:     dbTemplate.Name,
+			Cron:     dbTemplate.Cron,
+			IsActive: dbTemplate.IsActive,
+			Job:      api.SubmittedJob(dbTemplate.Job),
+		},
+		Id:      dbTemplate.UUID,
+		Created: dbTemplate.CreatedAt,
+		Updated: dbTemplate.UpdatedAt,
+	}
+
+	if dbTemplate.IsActive {
+		apiTemplate.NextRun = &dbTemplate.NextRun
+	}
+	if dbTemplate.LastRun.Valid {
+		apiTemplate.LastRun = &dbTemplate.LastRun.Time
+	}
+	if dbTemplate.LastJobUUID != "" {
+		apiTemplate.LastJobId = &dbTemplate.LastJobUUID
+	}
+	if dbTemplate.LastError != "" {
+		apiTemplate.LastError = &dbTemplate.LastError
+	}
+	return apiTemplate
+}
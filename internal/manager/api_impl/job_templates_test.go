@@ -0,0 +1,309 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"projects.blender.org/studio/flamenco/internal/manager/config"
+	"projects.blender.org/studio/flamenco/internal/manager/job_compilers"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+func testJobTemplate() api.SubmittedJobTemplate {
+	return api.SubmittedJobTemplate{
+		Name:     "Nightly Render",
+		Cron:     "0 3 * * *",
+		IsActive: true,
+		Job: api.SubmittedJob{
+			Name:              "nightly render",
+			Type:              "test",
+			Priority:          50,
+			SubmitterPlatform: "linux",
+		},
+	}
+}
+
+func TestCreateJobTemplate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	apiTemplate := testJobTemplate()
+
+	// The job should be compiled, to check its validity.
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform("linux"),
+		map[string]string{},
+	)
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), apiTemplate.Job).
+		Return(&job_compilers.AuthoredJob{JobID: "afc47568-bd9d-4368-8016-e91d945db36d"}, nil)
+
+	// The mocked clock is at 2022-06-09T11:14:41+02:00.
+	expectNextRun := time.Date(2022, 6, 10, 3, 0, 0, 0, mf.clock.Now().Location())
+
+	var createdTemplate *persistence.JobTemplate
+	mf.persistence.EXPECT().CreateJobTemplate(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, dbTemplate *persistence.JobTemplate) error {
+			createdTemplate = dbTemplate
+			return nil
+		})
+
+	echoCtx := mf.prepareMockedJSONRequest(apiTemplate)
+	err := mf.flamenco.CreateJobTemplate(echoCtx)
+	assert.NoError(t, err)
+
+	if !assert.NotNil(t, createdTemplate) {
+		t.FailNow()
+	}
+	assert.Equal(t, "Nightly Render", createdTemplate.Name)
+	assert.Equal(t, "0 3 * * *", createdTemplate.Cron)
+	assert.True(t, createdTemplate.IsActive)
+	assert.Equal(t, persistence.SubmittedJobJSON(apiTemplate.Job), createdTemplate.Job)
+	assert.True(t, expectNextRun.Equal(createdTemplate.NextRun),
+		"expected next run %s, got %s", expectNextRun, createdTemplate.NextRun)
+
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.JobTemplate{
+		SubmittedJobTemplate: apiTemplate,
+		Id:                   createdTemplate.UUID,
+		NextRun:              &createdTemplate.NextRun,
+	})
+}
+
+func TestCreateJobTemplateInvalid(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	{ // Empty name.
+		apiTemplate := testJobTemplate()
+		apiTemplate.Name = ""
+		echoCtx := mf.prepareMockedJSONRequest(apiTemplate)
+		err := mf.flamenco.CreateJobTemplate(echoCtx)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusBadRequest, "job template name cannot be empty")
+	}
+
+	{ // Invalid cron expression.
+		apiTemplate := testJobTemplate()
+		apiTemplate.Cron = "0 25 * * *"
+		echoCtx := mf.prepareMockedJSONRequest(apiTemplate)
+		err := mf.flamenco.CreateJobTemplate(echoCtx)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusBadRequest,
+			`invalid cron expression: invalid hour "25": value 25 out of range 0-23`)
+	}
+
+	{ // Job that cannot be compiled.
+		apiTemplate := testJobTemplate()
+		mf.expectConvertTwoWayVariables(t,
+			config.VariableAudienceWorkers,
+			config.VariablePlatform("linux"),
+			map[string]string{},
+		)
+		mf.jobCompiler.EXPECT().Compile(gomock.Any(), apiTemplate.Job).
+			Return(nil, errors.New("unknown setting"))
+
+		echoCtx := mf.prepareMockedJSONRequest(apiTemplate)
+		err := mf.flamenco.CreateJobTemplate(echoCtx)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusBadRequest, "unknown setting")
+	}
+
+	{ // Job with outdated settings.
+		apiTemplate := testJobTemplate()
+		mf.expectConvertTwoWayVariables(t,
+			config.VariableAudienceWorkers,
+			config.VariablePlatform("linux"),
+			map[string]string{},
+		)
+		mf.jobCompiler.EXPECT().Compile(gomock.Any(), apiTemplate.Job).
+			Return(nil, job_compilers.ErrJobTypeBadEtag)
+
+		echoCtx := mf.prepareMockedJSONRequest(apiTemplate)
+		err := mf.flamenco.CreateJobTemplate(echoCtx)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusPreconditionFailed,
+			"rejecting job template because its settings are outdated, refresh the job type")
+	}
+}
+
+func TestFetchJobTemplate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	templateUUID := "b0e3b0a4-6c7c-4b6e-9f38-6b3c5b5d7b8c"
+	lastRun := mf.clock.Now().Add(-time.Hour)
+	dbTemplate := persistence.JobTemplate{
+		UUID:        templateUUID,
+		Name:        "Nightly Render",
+		Cron:        "@daily",
+		IsActive:    false,
+		Job:         persistence.SubmittedJobJSON(testJobTemplate().Job),
+		NextRun:     mf.clock.Now().Add(time.Hour),
+		LastRun:     sql.NullTime{Time: lastRun, Valid: true},
+		LastJobUUID: "afc47568-bd9d-4368-8016-e91d945db36d",
+	}
+
+	{ // Existing template.
+		mf.persistence.EXPECT().FetchJobTemplate(gomock.Any(), templateUUID).Return(&dbTemplate, nil)
+
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.FetchJobTemplate(echoCtx, templateUUID)
+		assert.NoError(t, err)
+
+		// Inactive templates should not report their next run.
+		assertResponseJSON(t, echoCtx, http.StatusOK, api.JobTemplate{
+			SubmittedJobTemplate: api.SubmittedJobTemplate{
+				Name:     "Nightly Render",
+				Cron:     "@daily",
+				IsActive: false,
+				Job:      testJobTemplate().Job,
+			},
+			Id:        templateUUID,
+			LastRun:   &lastRun,
+			LastJobId: &dbTemplate.LastJobUUID,
+		})
+	}
+
+	{ // Non-existent template.
+		mf.persistence.EXPECT().FetchJobTemplate(gomock.Any(), templateUUID).
+			Return(nil, persistence.ErrJobTemplateNotFound)
+
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.FetchJobTemplate(echoCtx, templateUUID)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusNotFound,
+			"job template %q not found", templateUUID)
+	}
+
+	{ // Invalid UUID.
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.FetchJobTemplate(echoCtx, "not-a-uuid")
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusBadRequest, "not a valid UUID")
+	}
+}
+
+func TestUpdateJobTemplate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	templateUUID := "b0e3b0a4-6c7c-4b6e-9f38-6b3c5b5d7b8c"
+	dbTemplate := persistence.JobTemplate{
+		UUID:        templateUUID,
+		Name:        "Nightly Render",
+		Cron:        "@daily",
+		IsActive:    false,
+		Job:         persistence.SubmittedJobJSON(testJobTemplate().Job),
+		LastJobUUID: "afc47568-bd9d-4368-8016-e91d945db36d",
+	}
+
+	apiTemplate := testJobTemplate()
+	apiTemplate.Cron = "30 * * * *"
+
+	mf.persistence.EXPECT().FetchJobTemplate(gomock.Any(), templateUUID).Return(&dbTemplate, nil)
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform("linux"),
+		map[string]string{},
+	)
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), apiTemplate.Job).
+		Return(&job_compilers.AuthoredJob{JobID: "4df0a6b1-3e6e-4d8c-a5ad-97a94c3e1a56"}, nil)
+	mf.persistence.EXPECT().SaveJobTemplate(gomock.Any(), &dbTemplate).Return(nil)
+
+	echoCtx := mf.prepareMockedJSONRequest(apiTemplate)
+	err := mf.flamenco.UpdateJobTemplate(echoCtx, templateUUID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "30 * * * *", dbTemplate.Cron)
+	assert.True(t, dbTemplate.IsActive)
+	expectNextRun := time.Date(2022, 6, 9, 11, 30, 0, 0, mf.clock.Now().Location())
+	assert.True(t, expectNextRun.Equal(dbTemplate.NextRun),
+		"expected next run %s, got %s", expectNextRun, dbTemplate.NextRun)
+	assert.Equal(t, "afc47568-bd9d-4368-8016-e91d945db36d", dbTemplate.LastJobUUID,
+		"updating a template should keep the info about its last run")
+}
+
+func TestDeleteJobTemplate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	templateUUID := "b0e3b0a4-6c7c-4b6e-9f38-6b3c5b5d7b8c"
+
+	{ // Existing template.
+		mf.persistence.EXPECT().DeleteJobTemplate(gomock.Any(), templateUUID).Return(nil)
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.DeleteJobTemplate(echoCtx, templateUUID)
+		assert.NoError(t, err)
+		assertResponseNoContent(t, echoCtx)
+	}
+
+	{ // Non-existent template.
+		mf.persistence.EXPECT().DeleteJobTemplate(gomock.Any(), templateUUID).
+			Return(persistence.ErrJobTemplateNotFound)
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.DeleteJobTemplate(echoCtx, templateUUID)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusNotFound,
+			"job template %q not found", templateUUID)
+	}
+}
+
+func TestSubmitScheduledJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	submittedJob := testJobTemplate().Job
+
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform("linux"),
+		map[string]string{},
+	)
+	authoredJob := job_compilers.AuthoredJob{
+		JobID:    "afc47568-bd9d-4368-8016-e91d945db36d",
+		Name:     submittedJob.Name,
+		JobType:  submittedJob.Type,
+		Priority: submittedJob.Priority,
+		Status:   api.JobStatusUnderConstruction,
+		Created:  mf.clock.Now(),
+	}
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), submittedJob).Return(&authoredJob, nil)
+
+	queuedJob := authoredJob
+	queuedJob.Status = api.JobStatusQueued
+	mf.persistence.EXPECT().StoreAuthoredJob(gomock.Any(), queuedJob).Return(nil)
+
+	dbJob := persistence.Job{
+		UUID:     queuedJob.JobID,
+		Name:     queuedJob.Name,
+		JobType:  queuedJob.JobType,
+		Priority: queuedJob.Priority,
+		Status:   queuedJob.Status,
+	}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), queuedJob.JobID).Return(&dbJob, nil)
+	mf.broadcaster.EXPECT().BroadcastNewJob(gomock.Any())
+
+	job, err := mf.flamenco.SubmitScheduledJob(context.Background(), submittedJob)
+	assert.NoError(t, err)
+	assert.Equal(t, &dbJob, job)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
 
 	"projects.blender.org/studio/flamenco/internal/manager/job_compilers"
 	"projects.blender.org/studio/flamenco/internal/manager/persistence"
@@ -91,25 +92,57 @@ func (f *Flamenco) SubmitJob(e echo.Context) error {
 
 	logger = logger.With().Str("job_id", authoredJob.JobID).Logger()
 
+	dbJob, err := f.storeAuthoredJob(ctx, logger, authoredJob)
+	if err != nil {
+		logger.Error().Err(err).Msg("error storing job")
+		return sendAPIError(e, http.StatusInternalServerError, "error persisting job in database")
+	}
+
+	apiJob := jobDBtoAPI(dbJob)
+	return e.JSON(http.StatusOK, apiJob)
+}
+
+// SubmitScheduledJob compiles and stores a job on behalf of a job template. It
+// does the same as the SubmitJob API call.
+func (f *Flamenco) SubmitScheduledJob(ctx context.Context, submittedJob api.SubmittedJob) (*persistence.Job, error) {
+	logger := log.With().
+		Str("type", submittedJob.Type).
+		Str("name", submittedJob.Name).
+		Logger()
+
+	authoredJob, err := f.compileSubmittedJob(ctx, logger, submittedJob)
+	if err != nil {
+		return nil, fmt.Errorf("compiling job: %w", err)
+	}
+
+	logger = logger.With().Str("job_id", authoredJob.JobID).Logger()
+	logger.Info().Msg("new Flamenco job submitted from job template")
+	return f.storeAuthoredJob(ctx, logger, authoredJob)
+}
+
+// storeAuthoredJob stores a newly compiled job in the database, and broadcasts
+// its existence.
+func (f *Flamenco) storeAuthoredJob(ctx context.Context, logger zerolog.Logger, authoredJob *job_compilers.AuthoredJob) (*persistence.Job, error) {
 	// TODO: check whether this job should be queued immediately or start paused.
 	authoredJob.Status = api.JobStatusQueued
+	if authoredJob.NotBefore.After(f.clock.Now()) {
+		logger.Info().Time("notBefore", authoredJob.NotBefore).Msg("job will wait until its start time")
+		authoredJob.Status = api.JobStatusWaiting
+	}
 
 	if err := f.persist.StoreAuthoredJob(ctx, *authoredJob); err != nil {
-		logger.Error().Err(err).Msg("error persisting job in database")
-		return sendAPIError(e, http.StatusInternalServerError, "error persisting job in database")
+		return nil, fmt.Errorf("persisting job in database: %w", err)
 	}
 
 	dbJob, err := f.persist.FetchJob(ctx, authoredJob.JobID)
 	if err != nil {
-		logger.Error().Err(err).Msg("unable to retrieve just-stored job from database")
-		return sendAPIError(e, http.StatusInternalServerError, "error retrieving job from database")
+		return nil, fmt.Errorf("retrieving just-stored job from database: %w", err)
 	}
 
 	jobUpdate := webupdates.NewJobUpdate(dbJob)
 	f.broadcaster.BroadcastNewJob(jobUpdate)
 
-	apiJob := jobDBtoAPI(dbJob)
-	return e.JSON(http.StatusOK, apiJob)
+	return dbJob, nil
 }
 
 func (f *Flamenco) SubmitJobCheck(e echo.Context) error {
@@ -154,6 +187,10 @@ func (f *Flamenco) compileSubmittedJob(ctx context.Context, logger zerolog.Logge
 		logger.Warn().Msg("job submitted without job type etag, refresh the job types in the Blender add-on")
 	}
 
+	if err := f.checkJobDependencies(ctx, submittedJob); err != nil {
+		return nil, err
+	}
+
 	// Before compiling the job, replace the two-way variables. This ensures all
 	// the tasks also use those.
 	replaceTwoWayVariables(f.config, &submittedJob)
@@ -161,6 +198,37 @@ func (f *Flamenco) compileSubmittedJob(ctx context.Context, logger zerolog.Logge
 	return f.jobCompiler.Compile(ctx, submittedJob)
 }
 
+// checkJobDependencies returns an error when the submitted job depends on jobs
+// that do not exist, or that will never complete. The latter would make the
+// submitted job wait forever.
+func (f *Flamenco) checkJobDependencies(ctx context.Context, submittedJob api.SubmittedJob) error {
+	if submittedJob.DependsOn == nil {
+		return nil
+	}
+
+	for _, jobUUID := range *submittedJob.DependsOn {
+		if !uuid.IsValid(jobUUID) {
+			return fmt.Errorf("job depends on invalid job ID %q", jobUUID)
+		}
+
+		dependency, err := f.persist.FetchJob(ctx, jobUUID)
+		switch {
+		case errors.Is(err, persistence.ErrJobNotFound):
+			return fmt.Errorf("job depends on unknown job %s", jobUUID)
+		case err != nil:
+			return fmt.Errorf("fetching job %s this job depends on: %w", jobUUID, err)
+		case dependency.DeleteRequestedAt.Valid:
+			return fmt.Errorf("job depends on job %s, which is being deleted", jobUUID)
+		}
+
+		switch dependency.Status {
+		case api.JobStatusFailed, api.JobStatusCanceled, api.JobStatusCancelRequested:
+			return fmt.Errorf("job depends on job %s, which has status %q and will not complete", jobUUID, dependency.Status)
+		}
+	}
+	return nil
+}
+
 // DeleteJob marks the job as "deletion requested" so that the job deletion
 // service can actually delete it.
 func (f *Flamenco) DeleteJob(e echo.Context, jobID string) error {
@@ -184,6 +252,9 @@ func (f *Flamenco) DeleteJob(e echo.Context, jobID string) error {
 	ctx := context.Background()
 	err = f.jobDeleter.QueueJobDeletion(ctx, dbJob)
 	switch {
+	case errors.Is(err, persistence.ErrJobHasDependents):
+		logger.Warn().AnErr("cause", err).Msg("refusing to delete job, other jobs are waiting for it")
+		return sendAPIError(e, http.StatusConflict, "job cannot be deleted, other jobs are waiting for it to complete")
 	case persistence.ErrIsDBBusy(err):
 		logger.Error().AnErr("cause", err).Msg("database too busy to queue job deletion")
 		return sendAPIErrorDBBusy(e, "too busy to queue job deletion, try again later")
@@ -634,6 +705,19 @@ func (f *Flamenco) FetchGlobalLastRenderedInfo(e echo.Context) error {
 	return f.FetchJobLastRenderedInfo(e, jobUUID)
 }
 
+func (f *Flamenco) FetchLastRenderedQueueInfo(e echo.Context) error {
+	stats := f.lastRender.QueueStats()
+	info := api.LastRenderedQueueInfo{
+		QueuedJobs:      stats.QueuedJobs,
+		MaxQueuedJobs:   stats.MaxQueuedJobs,
+		ImagesQueued:    stats.ImagesQueued,
+		ImagesReplaced:  stats.ImagesReplaced,
+		ImagesRejected:  stats.ImagesRejected,
+		ImagesProcessed: stats.ImagesProcessed,
+	}
+	return e.JSON(http.StatusOK, info)
+}
+
 func (f *Flamenco) lastRenderedInfoForJob(logger zerolog.Logger, jobUUID string) (*api.JobLastRenderedImageInfo, error) {
 	basePath := f.lastRender.PathForJob(jobUUID)
 	relPath, err := f.localStorage.RelPath(basePath)
@@ -681,9 +765,30 @@ func jobDBtoAPI(dbJob *persistence.Job) api.Job {
 	if dbJob.DeleteRequestedAt.Valid {
 		apiJob.DeleteRequestedAt = &dbJob.DeleteRequestedAt.Time
 	}
+	if dbJob.NotBefore.Valid {
+		apiJob.NotBefore = &dbJob.NotBefore.Time
+	}
+	if dbJob.MaxWorkers > 0 {
+		apiJob.MaxWorkers = &dbJob.MaxWorkers
+	}
 	if dbJob.WorkerTag != nil {
 		apiJob.WorkerTag = &dbJob.WorkerTag.UUID
 	}
+	if dbJob.RetryPolicy != (persistence.JobRetryPolicy{}) {
+		apiJob.RetryPolicy = &api.JobRetryPolicy{
+			MaxFailures:     &dbJob.RetryPolicy.MaxFailures,
+			DelaySeconds:    &dbJob.RetryPolicy.DelaySeconds,
+			MaxDelaySeconds: &dbJob.RetryPolicy.MaxDelaySeconds,
+			AllowSameWorker: &dbJob.RetryPolicy.AllowSameWorker,
+		}
+	}
+	if len(dbJob.Dependencies) > 0 {
+		dependsOn := make([]string, len(dbJob.Dependencies))
+		for i, depJob := range dbJob.Dependencies {
+			dependsOn[i] = depJob.UUID
+		}
+		apiJob.DependsOn = &dependsOn
+	}
 
 	return apiJob
 }
@@ -698,6 +803,7 @@ func taskDBtoAPI(dbTask *persistence.Task) api.Task {
 		Updated:  dbTask.UpdatedAt,
 		Status:   dbTask.Status,
 		Activity: dbTask.Activity,
+		Progress: dbTask.Progress,
 		Commands: make([]api.Command, len(dbTask.Commands)),
 		Worker:   workerToTaskWorker(dbTask.Worker),
 	}
@@ -710,6 +816,13 @@ func taskDBtoAPI(dbTask *persistence.Task) api.Task {
 		apiTask.LastTouched = &dbTask.LastTouchedAt
 	}
 
+	if dbTask.MinCPUCount > 0 {
+		apiTask.MinCpuCount = &dbTask.MinCPUCount
+	}
+	if dbTask.MinMemoryGB > 0 {
+		apiTask.MinMemoryGb = &dbTask.MinMemoryGB
+	}
+
 	for i := range dbTask.Commands {
 		apiTask.Commands[i] = commandDBtoAPI(dbTask.Commands[i])
 	}
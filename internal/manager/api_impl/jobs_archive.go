@@ -0,0 +1,141 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"projects.blender.org/studio/flamenco/internal/manager/job_archive"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/internal/manager/webupdates"
+)
+
+func (f *Flamenco) ExportJobArchive(e echo.Context, jobID string) error {
+	logger := requestLogger(e).With().
+		Str("job", jobID).
+		Logger()
+
+	dbJob, err := f.fetchJob(e, logger, jobID)
+	if dbJob == nil {
+		// f.fetchJob already sent a response.
+		return err
+	}
+
+	logger.Info().Msg("exporting job archive")
+
+	header := e.Response().Header()
+	header.Set(echo.HeaderContentType, "application/zip")
+	header.Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=%q", job_archive.Filename(dbJob.UUID)))
+
+	// The response is only committed on the first write, so errors that happen
+	// before that can still be reported properly.
+	err = f.jobArchiver.Export(e.Request().Context(), dbJob.UUID, e.Response())
+	switch {
+	case err == nil:
+		return nil
+	case e.Response().Committed:
+		logger.Error().Err(err).Msg("error exporting job archive, response is incomplete")
+		return nil
+	default:
+		logger.Error().Err(err).Msg("error exporting job archive")
+		header.Del(echo.HeaderContentType)
+		header.Del(echo.HeaderContentDisposition)
+		return sendAPIError(e, http.StatusInternalServerError, "error exporting job archive: %v", err)
+	}
+}
+
+func (f *Flamenco) ImportJobArchive(e echo.Context) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	// ZIP files have their index at the end, so the entire file is needed
+	// before any of it can be read. It is written to a temporary file, to avoid
+	// keeping potentially large archives in memory.
+	archiveFile, err := os.CreateTemp("", "flamenco-job-archive-*.zip")
+	if err != nil {
+		logger.Error().Err(err).Msg("error creating temporary file for job archive")
+		return sendAPIError(e, http.StatusInternalServerError, "error creating temporary file: %v", err)
+	}
+	defer func() {
+		archiveFile.Close()
+		os.Remove(archiveFile.Name())
+	}()
+
+	conf := f.config.Get()
+	body := e.Request().Body
+	if maxSize := conf.JobArchiveMaxSizeBytes(); maxSize > 0 {
+		body = http.MaxBytesReader(e.Response(), body, maxSize)
+	}
+
+	archiveSize, err := io.Copy(archiveFile, body)
+	var tooLargeErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLargeErr):
+		logger.Warn().Int64("maxSizeBytes", tooLargeErr.Limit).Msg("job archive is too large")
+		return sendAPIError(e, http.StatusRequestEntityTooLarge,
+			"job archive is larger than the maximum of %d MB", conf.JobArchiveMaxSizeMB)
+	case err != nil:
+		logger.Warn().Err(err).Msg("error reading job archive from request")
+		return sendAPIError(e, http.StatusBadRequest, "error reading request body: %v", err)
+	}
+	logger = logger.With().Int64("archiveSizeBytes", archiveSize).Logger()
+
+	jobUUID, err := f.jobArchiver.Import(ctx, archiveFile, archiveSize)
+	switch {
+	case errors.Is(err, job_archive.ErrInvalidArchive):
+		logger.Warn().Err(err).Msg("invalid job archive received")
+		return sendAPIError(e, http.StatusBadRequest, err.Error())
+	case errors.Is(err, persistence.ErrJobAlreadyExists):
+		logger.Info().Msg("refusing to import job archive, job already exists")
+		return sendAPIError(e, http.StatusConflict, "job already exists")
+	case err != nil:
+		logger.Error().Err(err).Msg("error importing job archive")
+		return sendAPIError(e, http.StatusInternalServerError, "error importing job archive: %v", err)
+	}
+	logger = logger.With().Str("job", jobUUID).Logger()
+
+	dbJob, err := f.persist.FetchJob(ctx, jobUUID)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to retrieve just-imported job from database")
+		return sendAPIError(e, http.StatusInternalServerError, "error retrieving job from database")
+	}
+	logger.Info().Msg("job archive imported")
+
+	jobUpdate := webupdates.NewJobUpdate(dbJob)
+	f.broadcaster.BroadcastNewJob(jobUpdate)
+
+	// An imported last-rendered image is as recent as it gets on this Manager.
+	if f.lastRender.JobHasImage(jobUUID) {
+		f.importedJobLastRendered(ctx, logger, dbJob)
+	}
+
+	apiJob := jobDBtoAPI(dbJob)
+	return e.JSON(http.StatusOK, apiJob)
+}
+
+// importedJobLastRendered marks the job as the one with the most recent
+// rendered image, and notifies the web interface.
+func (f *Flamenco) importedJobLastRendered(ctx context.Context, logger zerolog.Logger, dbJob *persistence.Job) {
+	if err := f.persist.SetLastRendered(ctx, dbJob); err != nil {
+		logger.Error().Err(err).Msg("error marking imported job as the last one to receive render output")
+		return
+	}
+
+	thumbnailInfo, err := f.lastRenderedInfoForJob(logger, dbJob.UUID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error getting last-rendered thumbnail info for imported job")
+		return
+	}
+	update := webupdates.NewLastRenderedUpdate(dbJob.UUID)
+	update.Thumbnail = *thumbnailInfo
+	f.broadcaster.BroadcastLastRenderedImage(update)
+}
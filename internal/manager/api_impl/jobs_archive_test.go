@@ -0,0 +1,154 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"projects.blender.org/studio/flamenco/internal/manager/config"
+	"projects.blender.org/studio/flamenco/internal/manager/job_archive"
+	"projects.blender.org/studio/flamenco/internal/manager/last_rendered"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/moremock"
+)
+
+func TestExportJobArchive(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{UUID: jobID}
+
+	echoCtx := mf.prepareMockedRequest(nil)
+	mf.persistence.EXPECT().FetchJob(moremock.ContextWithDeadline(), jobID).Return(&dbJob, nil)
+	mf.jobArchiver.EXPECT().Export(gomock.Any(), jobID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, jobUUID string, w io.Writer) error {
+			_, err := w.Write([]byte("zip contents"))
+			return err
+		})
+
+	err := mf.flamenco.ExportJobArchive(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	resp := getRecordedResponse(echoCtx)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/zip", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="job-18a9b096-d77e-438c-9be2-74397038298b.zip"`,
+		resp.Header.Get("Content-Disposition"))
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "zip contents", string(body))
+}
+
+func TestExportJobArchiveError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{UUID: jobID}
+
+	// An error before anything was written should produce a proper error response.
+	echoCtx := mf.prepareMockedRequest(nil)
+	mf.persistence.EXPECT().FetchJob(moremock.ContextWithDeadline(), jobID).Return(&dbJob, nil)
+	mf.jobArchiver.EXPECT().Export(gomock.Any(), jobID, gomock.Any()).Return(fmt.Errorf("disk is on fire"))
+
+	err := mf.flamenco.ExportJobArchive(echoCtx, jobID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusInternalServerError, "error exporting job archive: disk is on fire")
+}
+
+func TestImportJobArchive(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		UUID:     jobID,
+		Name:     "imported job",
+		JobType:  "simple-blender-render",
+		Priority: 50,
+		Status:   api.JobStatusCompleted,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+	archive := []byte("zip contents")
+
+	conf := config.DefaultConfig()
+	mf.config.EXPECT().Get().Return(&conf)
+
+	echoCtx := mf.prepareMockedRequest(bytes.NewReader(archive))
+	mf.jobArchiver.EXPECT().Import(gomock.Any(), gomock.Any(), int64(len(archive))).Return(jobID, nil)
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(&dbJob, nil)
+	mf.broadcaster.EXPECT().BroadcastNewJob(gomock.Any())
+
+	// The imported job has a last-rendered image, so it should become the global one.
+	mf.lastRender.EXPECT().JobHasImage(jobID).Return(true)
+	mf.persistence.EXPECT().SetLastRendered(gomock.Any(), &dbJob)
+	mf.lastRender.EXPECT().PathForJob(jobID).Return("/absolute/path/to/local/job/dir")
+	mf.localStorage.EXPECT().RelPath("/absolute/path/to/local/job/dir").Return("relative/path", nil)
+	mf.lastRender.EXPECT().ThumbSpecs().Return([]last_rendered.Thumbspec{
+		{Filename: "last-rendered.jpg"},
+	})
+	mf.broadcaster.EXPECT().BroadcastLastRenderedImage(gomock.Any())
+
+	err := mf.flamenco.ImportJobArchive(echoCtx)
+	assert.NoError(t, err)
+	assertResponseJSON(t, echoCtx, http.StatusOK, jobDBtoAPI(&dbJob))
+}
+
+func TestImportJobArchiveErrors(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	conf := config.DefaultConfig(func(c *config.Conf) {
+		c.JobArchiveMaxSizeMB = 1
+	})
+	mf.config.EXPECT().Get().Return(&conf).AnyTimes()
+
+	// Existing job.
+	echoCtx := mf.prepareMockedRequest(bytes.NewReader([]byte("zip contents")))
+	mf.jobArchiver.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", persistence.ErrJobAlreadyExists)
+	err := mf.flamenco.ImportJobArchive(echoCtx)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusConflict, "job already exists")
+
+	// Invalid archive.
+	echoCtx = mf.prepareMockedRequest(bytes.NewReader([]byte("not a zip")))
+	mf.jobArchiver.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", fmt.Errorf("%w: zip: not a valid zip file", job_archive.ErrInvalidArchive))
+	err = mf.flamenco.ImportJobArchive(echoCtx)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusBadRequest, "invalid job archive: zip: not a valid zip file")
+
+	// Error storing the job.
+	echoCtx = mf.prepareMockedRequest(bytes.NewReader([]byte("zip contents")))
+	mf.jobArchiver.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", errors.New("disk is full"))
+	err = mf.flamenco.ImportJobArchive(echoCtx)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusInternalServerError, "error importing job archive: disk is full")
+
+	// Archive that is too large. It should be rejected before it is imported.
+	echoCtx = mf.prepareMockedRequest(bytes.NewReader(make([]byte, 1_000_001)))
+	err = mf.flamenco.ImportJobArchive(echoCtx)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusRequestEntityTooLarge, "job archive is larger than the maximum of 1 MB")
+}
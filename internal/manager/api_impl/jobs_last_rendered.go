@@ -0,0 +1,85 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"image/jpeg"
+	"net/http"
+	"path"
+
+	"github.com/labstack/echo/v4"
+
+	"projects.blender.org/studio/flamenco/internal/manager/last_rendered"
+	"projects.blender.org/studio/flamenco/internal/uuid"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+const contactSheetJPEGQuality = 85
+
+func (f *Flamenco) FetchJobLastRenderedHistory(e echo.Context, jobID string) error {
+	if !uuid.IsValid(jobID) {
+		return sendAPIError(e, http.StatusBadRequest, "job ID should be a UUID")
+	}
+	logger := requestLogger(e).With().Str("job", jobID).Logger()
+
+	history, err := f.lastRender.JobHistory(jobID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error getting last-rendered history")
+		return sendAPIError(e, http.StatusInternalServerError, "error finding last-rendered history: %v", err)
+	}
+
+	historyPath := f.lastRender.HistoryPathForJob(jobID)
+	relPath, err := f.localStorage.RelPath(historyPath)
+	if err != nil {
+		logger.Error().Err(err).Str("path", historyPath).
+			Msg("last-rendered history path is outside local storage root")
+		return sendAPIError(e, http.StatusInternalServerError, "error finding last-rendered history: %v", err)
+	}
+
+	frames := make([]api.JobLastRenderedFrame, len(history))
+	for idx, item := range history {
+		frames[idx] = api.JobLastRenderedFrame{
+			Frame:      item.Frame,
+			Filename:   item.Filename,
+			RenderedAt: item.RenderedAt,
+		}
+	}
+
+	return e.JSON(http.StatusOK, api.JobLastRenderedHistory{
+		Base:   path.Join(JobFilesURLPrefix, relPath),
+		Frames: frames,
+	})
+}
+
+func (f *Flamenco) FetchJobLastRenderedContactSheet(e echo.Context, jobID string, params api.FetchJobLastRenderedContactSheetParams) error {
+	if !uuid.IsValid(jobID) {
+		return sendAPIError(e, http.StatusBadRequest, "job ID should be a UUID")
+	}
+	logger := requestLogger(e).With().Str("job", jobID).Logger()
+
+	columns := last_rendered.DefaultContactSheetColumns
+	if params.Columns != nil {
+		columns = *params.Columns
+	}
+	if columns < 1 || columns > 32 {
+		return sendAPIError(e, http.StatusBadRequest, "columns should be between 1 and 32, not %d", columns)
+	}
+
+	sheet, err := f.lastRender.ContactSheet(jobID, columns)
+	switch {
+	case errors.Is(err, last_rendered.ErrNoHistory):
+		return e.NoContent(http.StatusNoContent)
+	case err != nil:
+		logger.Error().Err(err).Msg("error creating last-rendered contact sheet")
+		return sendAPIError(e, http.StatusInternalServerError, "error creating contact sheet: %v", err)
+	}
+
+	e.Response().Header().Set(echo.HeaderContentType, "image/jpeg")
+	e.Response().WriteHeader(http.StatusOK)
+	options := jpeg.Options{Quality: contactSheetJPEGQuality}
+	if err := jpeg.Encode(e.Response(), sheet, &options); err != nil {
+		logger.Error().Err(err).Msg("error sending last-rendered contact sheet, response is incomplete")
+	}
+	return nil
+}
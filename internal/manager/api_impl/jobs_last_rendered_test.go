@@ -0,0 +1,95 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"image"
+	"image/jpeg"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"projects.blender.org/studio/flamenco/internal/manager/last_rendered"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+func TestFetchJobLastRenderedHistory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	renderedAt := time.Date(2026, 10, 18, 11, 47, 0, 0, time.UTC)
+
+	mf.lastRender.EXPECT().JobHistory(jobID).Return([]last_rendered.HistoryImage{
+		{Frame: 3, Filename: "frame-000003.jpg", RenderedAt: renderedAt},
+		{Frame: 47, Filename: "frame-000047.jpg", RenderedAt: renderedAt.Add(time.Minute)},
+	}, nil)
+	mf.lastRender.EXPECT().HistoryPathForJob(jobID).Return("/absolute/path/to/local/job/dir/last-rendered-history")
+	mf.localStorage.EXPECT().RelPath("/absolute/path/to/local/job/dir/last-rendered-history").
+		Return("relative/path/last-rendered-history", nil)
+
+	echoCtx := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.FetchJobLastRenderedHistory(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	expectBody := api.JobLastRenderedHistory{
+		Base: "/job-files/relative/path/last-rendered-history",
+		Frames: []api.JobLastRenderedFrame{
+			{Frame: 3, Filename: "frame-000003.jpg", RenderedAt: renderedAt},
+			{Frame: 47, Filename: "frame-000047.jpg", RenderedAt: renderedAt.Add(time.Minute)},
+		},
+	}
+	assertResponseJSON(t, echoCtx, http.StatusOK, expectBody)
+}
+
+func TestFetchJobLastRenderedContactSheet(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+
+	{
+		// Default number of columns.
+		sheet := image.NewRGBA(image.Rect(0, 0, 64, 32))
+		mf.lastRender.EXPECT().ContactSheet(jobID, last_rendered.DefaultContactSheetColumns).Return(sheet, nil)
+
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.FetchJobLastRenderedContactSheet(echoCtx, jobID, api.FetchJobLastRenderedContactSheetParams{})
+		assert.NoError(t, err)
+
+		resp := getRecordedResponse(echoCtx)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "image/jpeg", resp.Header.Get("Content-Type"))
+		decoded, err := jpeg.Decode(resp.Body)
+		if assert.NoError(t, err) {
+			assert.Equal(t, sheet.Bounds(), decoded.Bounds())
+		}
+	}
+
+	{
+		// No history.
+		mf.lastRender.EXPECT().ContactSheet(jobID, 4).Return(nil, last_rendered.ErrNoHistory)
+
+		echoCtx := mf.prepareMockedRequest(nil)
+		params := api.FetchJobLastRenderedContactSheetParams{Columns: ptr(4)}
+		err := mf.flamenco.FetchJobLastRenderedContactSheet(echoCtx, jobID, params)
+		assert.NoError(t, err)
+		assertResponseNoContent(t, echoCtx)
+	}
+
+	{
+		// Invalid number of columns.
+		echoCtx := mf.prepareMockedRequest(nil)
+		params := api.FetchJobLastRenderedContactSheetParams{Columns: ptr(0)}
+		err := mf.flamenco.FetchJobLastRenderedContactSheet(echoCtx, jobID, params)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusBadRequest, "columns should be between 1 and 32, not 0")
+	}
+}
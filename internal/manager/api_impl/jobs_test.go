@@ -3,6 +3,7 @@ package api_impl
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
@@ -91,6 +92,68 @@ func TestSubmitJobWithoutSettings(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSubmitJobWithNotBefore(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	notBefore := mf.clock.Now().Add(4 * time.Hour)
+	submittedJob := api.SubmittedJob{
+		Name:              "поднео посао",
+		Type:              "test",
+		Priority:          50,
+		SubmitterPlatform: worker.Platform,
+		NotBefore:         &notBefore,
+	}
+
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(worker.Platform),
+		map[string]string{},
+	)
+
+	// Expect the job compiler to be called.
+	authoredJob := job_compilers.AuthoredJob{
+		JobID:     "afc47568-bd9d-4368-8016-e91d945db36d",
+		Name:      submittedJob.Name,
+		JobType:   submittedJob.Type,
+		Priority:  submittedJob.Priority,
+		Status:    api.JobStatusUnderConstruction,
+		Created:   mf.clock.Now(),
+		NotBefore: notBefore,
+	}
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), submittedJob).Return(&authoredJob, nil)
+
+	// Expect the job to be saved with 'waiting' status, as its start time is in the future.
+	waitingJob := authoredJob
+	waitingJob.Status = api.JobStatusWaiting
+	mf.persistence.EXPECT().StoreAuthoredJob(gomock.Any(), waitingJob).Return(nil)
+
+	dbJob := persistence.Job{
+		UUID:      waitingJob.JobID,
+		Name:      waitingJob.Name,
+		JobType:   waitingJob.JobType,
+		Priority:  waitingJob.Priority,
+		Status:    waitingJob.Status,
+		Settings:  persistence.StringInterfaceMap{},
+		Metadata:  persistence.StringStringMap{},
+		NotBefore: sql.NullTime{Time: notBefore, Valid: true},
+	}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), waitingJob.JobID).Return(&dbJob, nil)
+	mf.broadcaster.EXPECT().BroadcastNewJob(gomock.Any())
+
+	// Do the call.
+	echoCtx := mf.prepareMockedJSONRequest(submittedJob)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.SubmitJob(echoCtx)
+	require.NoError(t, err)
+
+	expectJob := jobDBtoAPI(&dbJob)
+	assertResponseJSON(t, echoCtx, http.StatusOK, expectJob)
+}
+
 func TestSubmitJobWithSettings(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -418,6 +481,61 @@ func TestSubmitJobWithWorkerTag(t *testing.T) {
 	})
 }
 
+func TestSubmitJobWithUnknownDependency(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	dependsOn := []string{"2f6e8ee6-24df-4e2b-ae8b-cc5d4b54bb13"}
+	submittedJob := api.SubmittedJob{
+		Name:              "поднео посао",
+		Type:              "test",
+		Priority:          50,
+		SubmitterPlatform: worker.Platform,
+		DependsOn:         &dependsOn,
+	}
+
+	// The job should be rejected before it is compiled.
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), dependsOn[0]).Return(nil, persistence.ErrJobNotFound)
+
+	echoCtx := mf.prepareMockedJSONRequest(submittedJob)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.SubmitJob(echoCtx)
+	require.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusBadRequest,
+		"job depends on unknown job 2f6e8ee6-24df-4e2b-ae8b-cc5d4b54bb13")
+}
+
+func TestSubmitJobWithUnfinishableDependency(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	dependsOn := []string{"2f6e8ee6-24df-4e2b-ae8b-cc5d4b54bb13"}
+	submittedJob := api.SubmittedJob{
+		Name:              "поднео посао",
+		Type:              "test",
+		Priority:          50,
+		SubmitterPlatform: worker.Platform,
+		DependsOn:         &dependsOn,
+	}
+
+	// A job that failed will never complete, so the submitted job would wait forever.
+	dependency := persistence.Job{UUID: dependsOn[0], Status: api.JobStatusFailed}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), dependsOn[0]).Return(&dependency, nil)
+
+	echoCtx := mf.prepareMockedJSONRequest(submittedJob)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.SubmitJob(echoCtx)
+	require.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusBadRequest,
+		`job depends on job 2f6e8ee6-24df-4e2b-ae8b-cc5d4b54bb13, which has status "failed" and will not complete`)
+}
+
 func TestGetJobTypeHappy(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -904,6 +1022,37 @@ func TestFetchGlobalLastRenderedInfo(t *testing.T) {
 
 }
 
+func TestFetchLastRenderedQueueInfo(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobUUID := "18a9b096-d77e-438c-9be2-74397038298b"
+	mf.lastRender.EXPECT().QueueStats().Return(last_rendered.QueueStats{
+		QueuedJobs:      []string{jobUUID},
+		MaxQueuedJobs:   8,
+		ImagesQueued:    47,
+		ImagesReplaced:  30,
+		ImagesRejected:  2,
+		ImagesProcessed: 16,
+	})
+
+	echoCtx := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.FetchLastRenderedQueueInfo(echoCtx)
+	assert.NoError(t, err)
+
+	expectBody := api.LastRenderedQueueInfo{
+		QueuedJobs:      []string{jobUUID},
+		MaxQueuedJobs:   8,
+		ImagesQueued:    47,
+		ImagesReplaced:  30,
+		ImagesRejected:  2,
+		ImagesProcessed: 16,
+	}
+	assertResponseJSON(t, echoCtx, http.StatusOK, expectBody)
+}
+
 func TestDeleteJob(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -932,6 +1081,36 @@ func TestDeleteJob(t *testing.T) {
 	assertResponseNoContent(t, echoCtx)
 }
 
+func TestDeleteJobWithDependents(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		Model:    persistence.Model{ID: 47},
+		UUID:     jobID,
+		Name:     "test job",
+		Status:   api.JobStatusActive,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+
+	// Set up expectations.
+	echoCtx := mf.prepareMockedRequest(nil)
+	mf.persistence.EXPECT().FetchJob(moremock.ContextWithDeadline(), jobID).Return(&dbJob, nil)
+	mf.jobDeleter.EXPECT().QueueJobDeletion(gomock.Any(), &dbJob).
+		Return(fmt.Errorf("requesting job deletion: %w", persistence.ErrJobHasDependents))
+
+	// Do the call.
+	err := mf.flamenco.DeleteJob(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseAPIError(t, echoCtx, http.StatusConflict,
+		"job cannot be deleted, other jobs are waiting for it to complete")
+}
+
 func TestDeleteJobMass(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1,11 +1,12 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: projects.blender.org/studio/flamenco/internal/manager/api_impl (interfaces: PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler,JobDeleter)
+// Source: projects.blender.org/studio/flamenco/internal/manager/api_impl (interfaces: PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler,JobDeleter,JobArchiver)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
 	context "context"
+	image "image"
 	io "io"
 	reflect "reflect"
 	time "time"
@@ -16,7 +17,9 @@ import (
 	job_compilers "projects.blender.org/studio/flamenco/internal/manager/job_compilers"
 	last_rendered "projects.blender.org/studio/flamenco/internal/manager/last_rendered"
 	persistence "projects.blender.org/studio/flamenco/internal/manager/persistence"
+	task_logs "projects.blender.org/studio/flamenco/internal/manager/task_logs"
 	api "projects.blender.org/studio/flamenco/pkg/api"
+	shaman "projects.blender.org/studio/flamenco/pkg/shaman"
 )
 
 // MockPersistenceService is a mock of PersistenceService interface.
@@ -128,6 +131,20 @@ func (mr *MockPersistenceServiceMockRecorder) CountTaskFailuresOfWorker(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTaskFailuresOfWorker", reflect.TypeOf((*MockPersistenceService)(nil).CountTaskFailuresOfWorker), arg0, arg1, arg2, arg3)
 }
 
+// CreateJobTemplate mocks base method.
+func (m *MockPersistenceService) CreateJobTemplate(arg0 context.Context, arg1 *persistence.JobTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateJobTemplate indicates an expected call of CreateJobTemplate.
+func (mr *MockPersistenceServiceMockRecorder) CreateJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobTemplate", reflect.TypeOf((*MockPersistenceService)(nil).CreateJobTemplate), arg0, arg1)
+}
+
 // CreateWorker mocks base method.
 func (m *MockPersistenceService) CreateWorker(arg0 context.Context, arg1 *persistence.Worker) error {
 	m.ctrl.T.Helper()
@@ -156,6 +173,20 @@ func (mr *MockPersistenceServiceMockRecorder) CreateWorkerTag(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkerTag", reflect.TypeOf((*MockPersistenceService)(nil).CreateWorkerTag), arg0, arg1)
 }
 
+// DeleteJobTemplate mocks base method.
+func (m *MockPersistenceService) DeleteJobTemplate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJobTemplate indicates an expected call of DeleteJobTemplate.
+func (mr *MockPersistenceServiceMockRecorder) DeleteJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobTemplate", reflect.TypeOf((*MockPersistenceService)(nil).DeleteJobTemplate), arg0, arg1)
+}
+
 // DeleteWorker mocks base method.
 func (m *MockPersistenceService) DeleteWorker(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -214,6 +245,66 @@ func (mr *MockPersistenceServiceMockRecorder) FetchJobBlocklist(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobBlocklist", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobBlocklist), arg0, arg1)
 }
 
+// FetchJobProgress mocks base method.
+func (m *MockPersistenceService) FetchJobProgress(arg0 context.Context, arg1 *persistence.Job) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobProgress", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobProgress indicates an expected call of FetchJobProgress.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobProgress", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobProgress), arg0, arg1)
+}
+
+// FetchJobTemplate mocks base method.
+func (m *MockPersistenceService) FetchJobTemplate(arg0 context.Context, arg1 string) (*persistence.JobTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.JobTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobTemplate indicates an expected call of FetchJobTemplate.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobTemplate", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobTemplate), arg0, arg1)
+}
+
+// FetchJobTemplates mocks base method.
+func (m *MockPersistenceService) FetchJobTemplates(arg0 context.Context) ([]*persistence.JobTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobTemplates", arg0)
+	ret0, _ := ret[0].([]*persistence.JobTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobTemplates indicates an expected call of FetchJobTemplates.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobTemplates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobTemplates", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobTemplates), arg0)
+}
+
+// FetchJobsActiveBetween mocks base method.
+func (m *MockPersistenceService) FetchJobsActiveBetween(arg0 context.Context, arg1, arg2 time.Time) ([]*persistence.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobsActiveBetween", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*persistence.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobsActiveBetween indicates an expected call of FetchJobsActiveBetween.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobsActiveBetween(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobsActiveBetween", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobsActiveBetween), arg0, arg1, arg2)
+}
+
 // FetchTask mocks base method.
 func (m *MockPersistenceService) FetchTask(arg0 context.Context, arg1 string) (*persistence.Task, error) {
 	m.ctrl.T.Helper()
@@ -392,6 +483,20 @@ func (mr *MockPersistenceServiceMockRecorder) SaveJobPriority(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJobPriority", reflect.TypeOf((*MockPersistenceService)(nil).SaveJobPriority), arg0, arg1)
 }
 
+// SaveJobTemplate mocks base method.
+func (m *MockPersistenceService) SaveJobTemplate(arg0 context.Context, arg1 *persistence.JobTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJobTemplate indicates an expected call of SaveJobTemplate.
+func (mr *MockPersistenceServiceMockRecorder) SaveJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJobTemplate", reflect.TypeOf((*MockPersistenceService)(nil).SaveJobTemplate), arg0, arg1)
+}
+
 // SaveTask mocks base method.
 func (m *MockPersistenceService) SaveTask(arg0 context.Context, arg1 *persistence.Task) error {
 	m.ctrl.T.Helper()
@@ -420,6 +525,34 @@ func (mr *MockPersistenceServiceMockRecorder) SaveTaskActivity(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaskActivity", reflect.TypeOf((*MockPersistenceService)(nil).SaveTaskActivity), arg0, arg1)
 }
 
+// SaveTaskProgress mocks base method.
+func (m *MockPersistenceService) SaveTaskProgress(arg0 context.Context, arg1 *persistence.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTaskProgress", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTaskProgress indicates an expected call of SaveTaskProgress.
+func (mr *MockPersistenceServiceMockRecorder) SaveTaskProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaskProgress", reflect.TypeOf((*MockPersistenceService)(nil).SaveTaskProgress), arg0, arg1)
+}
+
+// SaveTaskRetryState mocks base method.
+func (m *MockPersistenceService) SaveTaskRetryState(arg0 context.Context, arg1 *persistence.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTaskRetryState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTaskRetryState indicates an expected call of SaveTaskRetryState.
+func (mr *MockPersistenceServiceMockRecorder) SaveTaskRetryState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaskRetryState", reflect.TypeOf((*MockPersistenceService)(nil).SaveTaskRetryState), arg0, arg1)
+}
+
 // SaveWorker mocks base method.
 func (m *MockPersistenceService) SaveWorker(arg0 context.Context, arg1 *persistence.Worker) error {
 	m.ctrl.T.Helper()
@@ -434,6 +567,20 @@ func (mr *MockPersistenceServiceMockRecorder) SaveWorker(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWorker", reflect.TypeOf((*MockPersistenceService)(nil).SaveWorker), arg0, arg1)
 }
 
+// SaveWorkerResourceUsage mocks base method.
+func (m *MockPersistenceService) SaveWorkerResourceUsage(arg0 context.Context, arg1 *persistence.Worker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWorkerResourceUsage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWorkerResourceUsage indicates an expected call of SaveWorkerResourceUsage.
+func (mr *MockPersistenceServiceMockRecorder) SaveWorkerResourceUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWorkerResourceUsage", reflect.TypeOf((*MockPersistenceService)(nil).SaveWorkerResourceUsage), arg0, arg1)
+}
+
 // SaveWorkerStatus mocks base method.
 func (m *MockPersistenceService) SaveWorkerStatus(arg0 context.Context, arg1 *persistence.Worker) error {
 	m.ctrl.T.Helper()
@@ -645,6 +792,18 @@ func (mr *MockChangeBroadcasterMockRecorder) BroadcastNewWorkerTag(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastNewWorkerTag", reflect.TypeOf((*MockChangeBroadcaster)(nil).BroadcastNewWorkerTag), arg0)
 }
 
+// BroadcastTaskUpdate mocks base method.
+func (m *MockChangeBroadcaster) BroadcastTaskUpdate(arg0 api.SocketIOTaskUpdate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BroadcastTaskUpdate", arg0)
+}
+
+// BroadcastTaskUpdate indicates an expected call of BroadcastTaskUpdate.
+func (mr *MockChangeBroadcasterMockRecorder) BroadcastTaskUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastTaskUpdate", reflect.TypeOf((*MockChangeBroadcaster)(nil).BroadcastTaskUpdate), arg0)
+}
+
 // BroadcastWorkerTagUpdate mocks base method.
 func (m *MockChangeBroadcaster) BroadcastWorkerTagUpdate(arg0 api.SocketIOWorkerTagUpdate) {
 	m.ctrl.T.Helper()
@@ -785,6 +944,20 @@ func (mr *MockLogStorageMockRecorder) RotateFile(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateFile", reflect.TypeOf((*MockLogStorage)(nil).RotateFile), arg0, arg1, arg2)
 }
 
+// Search mocks base method.
+func (m *MockLogStorage) Search(arg0 context.Context, arg1, arg2 string, arg3 func(string) bool, arg4 func(task_logs.SearchMatch) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockLogStorageMockRecorder) Search(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockLogStorage)(nil).Search), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Tail mocks base method.
 func (m *MockLogStorage) Tail(arg0, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1094,6 +1267,34 @@ func (mr *MockShamanMockRecorder) Checkout(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockShaman)(nil).Checkout), arg0, arg1)
 }
 
+// Chunking mocks base method.
+func (m *MockShaman) Chunking() *api.ShamanChunking {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Chunking")
+	ret0, _ := ret[0].(*api.ShamanChunking)
+	return ret0
+}
+
+// Chunking indicates an expected call of Chunking.
+func (mr *MockShamanMockRecorder) Chunking() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chunking", reflect.TypeOf((*MockShaman)(nil).Chunking))
+}
+
+// DiskSpaceStatus mocks base method.
+func (m *MockShaman) DiskSpaceStatus() shaman.DiskSpaceStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiskSpaceStatus")
+	ret0, _ := ret[0].(shaman.DiskSpaceStatus)
+	return ret0
+}
+
+// DiskSpaceStatus indicates an expected call of DiskSpaceStatus.
+func (mr *MockShamanMockRecorder) DiskSpaceStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiskSpaceStatus", reflect.TypeOf((*MockShaman)(nil).DiskSpaceStatus))
+}
+
 // EraseCheckout mocks base method.
 func (m *MockShaman) EraseCheckout(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -1109,24 +1310,24 @@ func (mr *MockShamanMockRecorder) EraseCheckout(arg0 interface{}) *gomock.Call {
 }
 
 // FileStore mocks base method.
-func (m *MockShaman) FileStore(arg0 context.Context, arg1 io.ReadCloser, arg2 string, arg3 int64, arg4 bool, arg5 string) error {
+func (m *MockShaman) FileStore(arg0 context.Context, arg1 io.ReadCloser, arg2 string, arg3, arg4 int64, arg5 bool, arg6 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FileStore", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "FileStore", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // FileStore indicates an expected call of FileStore.
-func (mr *MockShamanMockRecorder) FileStore(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+func (mr *MockShamanMockRecorder) FileStore(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileStore", reflect.TypeOf((*MockShaman)(nil).FileStore), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileStore", reflect.TypeOf((*MockShaman)(nil).FileStore), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
 // FileStoreCheck mocks base method.
-func (m *MockShaman) FileStoreCheck(arg0 context.Context, arg1 string, arg2 int64) api.ShamanFileStatus {
+func (m *MockShaman) FileStoreCheck(arg0 context.Context, arg1 string, arg2 int64) api.ShamanSingleFileStatus {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FileStoreCheck", arg0, arg1, arg2)
-	ret0, _ := ret[0].(api.ShamanFileStatus)
+	ret0, _ := ret[0].(api.ShamanSingleFileStatus)
 	return ret0
 }
 
@@ -1165,6 +1366,35 @@ func (mr *MockShamanMockRecorder) Requirements(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requirements", reflect.TypeOf((*MockShaman)(nil).Requirements), arg0, arg1)
 }
 
+// ScrubStatus mocks base method.
+func (m *MockShaman) ScrubStatus() (bool, *shaman.ScrubReport) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScrubStatus")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*shaman.ScrubReport)
+	return ret0, ret1
+}
+
+// ScrubStatus indicates an expected call of ScrubStatus.
+func (mr *MockShamanMockRecorder) ScrubStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrubStatus", reflect.TypeOf((*MockShaman)(nil).ScrubStatus))
+}
+
+// StartScrub mocks base method.
+func (m *MockShaman) StartScrub() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartScrub")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartScrub indicates an expected call of StartScrub.
+func (mr *MockShamanMockRecorder) StartScrub() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartScrub", reflect.TypeOf((*MockShaman)(nil).StartScrub))
+}
+
 // MockLastRendered is a mock of LastRendered interface.
 type MockLastRendered struct {
 	ctrl     *gomock.Controller
@@ -1188,6 +1418,35 @@ func (m *MockLastRendered) EXPECT() *MockLastRenderedMockRecorder {
 	return m.recorder
 }
 
+// ContactSheet mocks base method.
+func (m *MockLastRendered) ContactSheet(arg0 string, arg1 int) (image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContactSheet", arg0, arg1)
+	ret0, _ := ret[0].(image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContactSheet indicates an expected call of ContactSheet.
+func (mr *MockLastRenderedMockRecorder) ContactSheet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContactSheet", reflect.TypeOf((*MockLastRendered)(nil).ContactSheet), arg0, arg1)
+}
+
+// HistoryPathForJob mocks base method.
+func (m *MockLastRendered) HistoryPathForJob(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistoryPathForJob", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HistoryPathForJob indicates an expected call of HistoryPathForJob.
+func (mr *MockLastRenderedMockRecorder) HistoryPathForJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoryPathForJob", reflect.TypeOf((*MockLastRendered)(nil).HistoryPathForJob), arg0)
+}
+
 // JobHasImage mocks base method.
 func (m *MockLastRendered) JobHasImage(arg0 string) bool {
 	m.ctrl.T.Helper()
@@ -1202,6 +1461,21 @@ func (mr *MockLastRenderedMockRecorder) JobHasImage(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobHasImage", reflect.TypeOf((*MockLastRendered)(nil).JobHasImage), arg0)
 }
 
+// JobHistory mocks base method.
+func (m *MockLastRendered) JobHistory(arg0 string) ([]last_rendered.HistoryImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobHistory", arg0)
+	ret0, _ := ret[0].([]last_rendered.HistoryImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobHistory indicates an expected call of JobHistory.
+func (mr *MockLastRenderedMockRecorder) JobHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobHistory", reflect.TypeOf((*MockLastRendered)(nil).JobHistory), arg0)
+}
+
 // PathForJob mocks base method.
 func (m *MockLastRendered) PathForJob(arg0 string) string {
 	m.ctrl.T.Helper()
@@ -1230,6 +1504,20 @@ func (mr *MockLastRenderedMockRecorder) QueueImage(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueImage", reflect.TypeOf((*MockLastRendered)(nil).QueueImage), arg0)
 }
 
+// QueueStats mocks base method.
+func (m *MockLastRendered) QueueStats() last_rendered.QueueStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueStats")
+	ret0, _ := ret[0].(last_rendered.QueueStats)
+	return ret0
+}
+
+// QueueStats indicates an expected call of QueueStats.
+func (mr *MockLastRenderedMockRecorder) QueueStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueStats", reflect.TypeOf((*MockLastRendered)(nil).QueueStats))
+}
+
 // ThumbSpecs mocks base method.
 func (m *MockLastRendered) ThumbSpecs() []last_rendered.Thumbspec {
 	m.ctrl.T.Helper()
@@ -1320,6 +1608,21 @@ func (mr *MockWorkerSleepSchedulerMockRecorder) FetchSchedule(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSchedule", reflect.TypeOf((*MockWorkerSleepScheduler)(nil).FetchSchedule), arg0, arg1)
 }
 
+// FetchTagSchedule mocks base method.
+func (m *MockWorkerSleepScheduler) FetchTagSchedule(arg0 context.Context, arg1 string) (*persistence.SleepSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTagSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.SleepSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTagSchedule indicates an expected call of FetchTagSchedule.
+func (mr *MockWorkerSleepSchedulerMockRecorder) FetchTagSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTagSchedule", reflect.TypeOf((*MockWorkerSleepScheduler)(nil).FetchTagSchedule), arg0, arg1)
+}
+
 // SetSchedule mocks base method.
 func (m *MockWorkerSleepScheduler) SetSchedule(arg0 context.Context, arg1 string, arg2 *persistence.SleepSchedule) error {
 	m.ctrl.T.Helper()
@@ -1334,6 +1637,20 @@ func (mr *MockWorkerSleepSchedulerMockRecorder) SetSchedule(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedule", reflect.TypeOf((*MockWorkerSleepScheduler)(nil).SetSchedule), arg0, arg1, arg2)
 }
 
+// SetTagSchedule mocks base method.
+func (m *MockWorkerSleepScheduler) SetTagSchedule(arg0 context.Context, arg1 string, arg2 *persistence.SleepSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagSchedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTagSchedule indicates an expected call of SetTagSchedule.
+func (mr *MockWorkerSleepSchedulerMockRecorder) SetTagSchedule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagSchedule", reflect.TypeOf((*MockWorkerSleepScheduler)(nil).SetTagSchedule), arg0, arg1, arg2)
+}
+
 // WorkerStatus mocks base method.
 func (m *MockWorkerSleepScheduler) WorkerStatus(arg0 context.Context, arg1 string) (api.WorkerStatus, error) {
 	m.ctrl.T.Helper()
@@ -1413,3 +1730,55 @@ func (mr *MockJobDeleterMockRecorder) WhatWouldBeDeleted(arg0 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhatWouldBeDeleted", reflect.TypeOf((*MockJobDeleter)(nil).WhatWouldBeDeleted), arg0)
 }
+
+// MockJobArchiver is a mock of JobArchiver interface.
+type MockJobArchiver struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobArchiverMockRecorder
+}
+
+// MockJobArchiverMockRecorder is the mock recorder for MockJobArchiver.
+type MockJobArchiverMockRecorder struct {
+	mock *MockJobArchiver
+}
+
+// NewMockJobArchiver creates a new mock instance.
+func NewMockJobArchiver(ctrl *gomock.Controller) *MockJobArchiver {
+	mock := &MockJobArchiver{ctrl: ctrl}
+	mock.recorder = &MockJobArchiverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobArchiver) EXPECT() *MockJobArchiverMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockJobArchiver) Export(arg0 context.Context, arg1 string, arg2 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockJobArchiverMockRecorder) Export(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockJobArchiver)(nil).Export), arg0, arg1, arg2)
+}
+
+// Import mocks base method.
+func (m *MockJobArchiver) Import(arg0 context.Context, arg1 io.ReaderAt, arg2 int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockJobArchiverMockRecorder) Import(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockJobArchiver)(nil).Import), arg0, arg1, arg2)
+}
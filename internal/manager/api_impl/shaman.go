@@ -3,18 +3,120 @@ package api_impl
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
 	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/shaman"
 	"projects.blender.org/studio/flamenco/pkg/shaman/fileserver"
+	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
 )
 
 func (f *Flamenco) isShamanEnabled() bool {
 	return f.shaman.IsEnabled()
 }
 
+// Get the status of the Shaman file storage, including its free disk space.
+// (GET /shaman/status)
+func (f *Flamenco) GetShamanStatus(e echo.Context) error {
+	if !f.isShamanEnabled() {
+		return e.JSON(http.StatusOK, api.ShamanStatus{Enabled: false})
+	}
+
+	status := f.shaman.DiskSpaceStatus()
+	fileStore := diskSpaceToAPI(status.FileStore)
+	checkout := diskSpaceToAPI(status.Checkout)
+	return e.JSON(http.StatusOK, api.ShamanStatus{
+		Enabled:   true,
+		FileStore: &fileStore,
+		Checkout:  &checkout,
+		Chunking:  f.shaman.Chunking(),
+	})
+}
+
+func diskSpaceToAPI(diskSpace shaman.DiskSpace) api.ShamanDiskSpace {
+	apiDiskSpace := api.ShamanDiskSpace{
+		Path:      diskSpace.Path,
+		FreeBytes: int64(diskSpace.FreeBytes),
+		IsLow:     diskSpace.IsLow,
+	}
+	if !diskSpace.CheckedAt.IsZero() {
+		apiDiskSpace.CheckedAt = &diskSpace.CheckedAt
+	}
+	return apiDiskSpace
+}
+
+// Get whether an integrity check of the Shaman file store is running, and the
+// report of the last finished check.
+// (GET /shaman/scrub)
+func (f *Flamenco) GetShamanScrubStatus(e echo.Context) error {
+	logger := requestLogger(e)
+	if !f.isShamanEnabled() {
+		logger.Error().Msg("shaman server not active, unable to serve request")
+		return sendAPIError(e, http.StatusServiceUnavailable, "shaman server not active")
+	}
+
+	isRunning, lastReport := f.shaman.ScrubStatus()
+	status := api.ShamanScrubStatus{Running: isRunning}
+	if lastReport != nil {
+		apiReport := scrubReportToAPI(*lastReport)
+		status.LastReport = &apiReport
+	}
+	return e.JSON(http.StatusOK, status)
+}
+
+// Start checking all files in the Shaman file store against their checksum.
+// (POST /shaman/scrub)
+func (f *Flamenco) StartShamanScrub(e echo.Context) error {
+	logger := requestLogger(e)
+	if !f.isShamanEnabled() {
+		logger.Error().Msg("shaman server not active, unable to serve request")
+		return sendAPIError(e, http.StatusServiceUnavailable, "shaman server not active")
+	}
+
+	err := f.shaman.StartScrub()
+	switch {
+	case errors.Is(err, shaman.ErrScrubRunning):
+		return sendAPIError(e, http.StatusConflict, "an integrity check of the file store is already running")
+	case err != nil:
+		logger.Error().Err(err).Msg("shaman: unable to start integrity check of the file store")
+		return sendAPIError(e, http.StatusInternalServerError, "unexpected error: %v", err)
+	}
+
+	logger.Info().Msg("shaman: integrity check of the file store started")
+	return e.NoContent(http.StatusNoContent)
+}
+
+func scrubReportToAPI(report shaman.ScrubReport) api.ShamanScrubReport {
+	apiReport := api.ShamanScrubReport{
+		StartedAt:       report.StartedAt,
+		FinishedAt:      report.FinishedAt,
+		NumFilesChecked: report.NumFilesChecked,
+		BytesChecked:    report.BytesChecked,
+		CorruptFiles:    make([]api.ShamanCorruptFile, len(report.CorruptFiles)),
+	}
+	for idx, corrupt := range report.CorruptFiles {
+		apiCorrupt := api.ShamanCorruptFile{
+			Checksum:       corrupt.Checksum,
+			Size:           corrupt.Size,
+			ActualChecksum: corrupt.ActualChecksum,
+			ActualSize:     corrupt.ActualSize,
+			Path:           corrupt.Path,
+			ReferencedBy:   corrupt.ReferencedBy,
+		}
+		if apiCorrupt.ReferencedBy == nil {
+			apiCorrupt.ReferencedBy = []string{}
+		}
+		if corrupt.QuarantinePath != "" {
+			apiCorrupt.QuarantinePath = &corrupt.QuarantinePath
+		}
+		apiReport.CorruptFiles[idx] = apiCorrupt
+	}
+	return apiReport
+}
+
 // Create a directory, and symlink the required files into it. The files must all have been uploaded to Shaman before calling this endpoint.
 // (POST /shaman/checkout/create/{checkoutID})
 func (f *Flamenco) ShamanCheckout(e echo.Context) error {
@@ -32,7 +134,14 @@ func (f *Flamenco) ShamanCheckout(e echo.Context) error {
 	}
 
 	checkoutPath, err := f.shaman.Checkout(e.Request().Context(), api.ShamanCheckout(reqBody))
-	if err != nil {
+	switch {
+	case errors.Is(err, shaman.ErrAssemblyPending):
+		logger.Debug().Msg("Shaman: checkout requested while files are being assembled from their chunks")
+		return sendAPIError(e, http.StatusTooEarly, err.Error())
+	case errors.Is(err, shaman.ErrMissingFiles):
+		logger.Warn().Err(err).Msg("Shaman: creating checkout")
+		return sendAPIError(e, http.StatusFailedDependency, err.Error())
+	case err != nil:
 		// TODO: return 409 when checkout already exists.
 		logger.Warn().Err(err).Msg("Shaman: creating checkout")
 		return sendAPIError(e, http.StatusInternalServerError, "unexpected error: %v", err)
@@ -81,10 +190,7 @@ func (f *Flamenco) ShamanFileStoreCheck(e echo.Context, checksum string, filesiz
 
 	logger.Debug().Msg("shaman: checking file")
 	status := f.shaman.FileStoreCheck(e.Request().Context(), checksum, int64(filesize))
-
-	return e.JSON(http.StatusOK, api.ShamanSingleFileStatus{
-		Status: status,
-	})
+	return e.JSON(http.StatusOK, status)
 }
 
 // Store a new file on the Shaman server. Note that the Shaman server can
@@ -95,6 +201,7 @@ func (f *Flamenco) ShamanFileStore(e echo.Context, checksum string, filesize int
 	var (
 		origFilename string
 		canDefer     bool
+		offset       int64
 	)
 
 	logCtx := requestLogger(e).With().
@@ -114,10 +221,14 @@ func (f *Flamenco) ShamanFileStore(e echo.Context, checksum string, filesize int
 		origFilename = *params.XShamanOriginalFilename
 		logCtx = logCtx.Str("originalFilename", origFilename)
 	}
+	if params.XShamanUploadOffset != nil {
+		offset = *params.XShamanUploadOffset
+		logCtx = logCtx.Int64("offset", offset)
+	}
 	logger := logCtx.Logger()
 
 	err := f.shaman.FileStore(e.Request().Context(), e.Request().Body,
-		checksum, int64(filesize),
+		checksum, int64(filesize), offset,
 		canDefer, origFilename,
 	)
 	if err != nil {
@@ -126,6 +237,8 @@ func (f *Flamenco) ShamanFileStore(e echo.Context, checksum string, filesize int
 			return e.String(http.StatusAlreadyReported, "")
 		case fileserver.ErrFileShouldDefer:
 			return e.String(http.StatusTooEarly, "")
+		case fileserver.ErrUploadInProgress:
+			return sendAPIError(e, http.StatusConflict, err.Error())
 		}
 
 		logger.Warn().Err(err).Msg("shaman: checking stored file")
@@ -133,6 +246,10 @@ func (f *Flamenco) ShamanFileStore(e echo.Context, checksum string, filesize int
 		switch v := err.(type) {
 		case fileserver.ErrFileSizeMismatch, fileserver.ErrFileChecksumMismatch:
 			return sendAPIError(e, http.StatusExpectationFailed, v.Error())
+		case fileserver.ErrInvalidOffset:
+			return sendAPIError(e, http.StatusRequestedRangeNotSatisfiable, v.Error())
+		case filestore.ErrInsufficientDiskSpace:
+			return sendAPIError(e, http.StatusInsufficientStorage, v.Error())
 		default:
 			return sendAPIError(e, http.StatusInternalServerError, "unexpected error: %v", err)
 		}
@@ -0,0 +1,245 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/shaman"
+	"projects.blender.org/studio/flamenco/pkg/shaman/fileserver"
+	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
+)
+
+func TestGetShamanStatus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	// Shaman disabled.
+	mf.shaman.EXPECT().IsEnabled().Return(false)
+	echoCtx := mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.GetShamanStatus(echoCtx))
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.ShamanStatus{Enabled: false})
+
+	// Shaman enabled.
+	checkedAt := time.Date(2024, 3, 20, 14, 15, 16, 0, time.UTC)
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().DiskSpaceStatus().Return(shaman.DiskSpaceStatus{
+		FileStore: shaman.DiskSpace{Path: "/shaman/file-store", FreeBytes: 500, IsLow: true, CheckedAt: checkedAt},
+		Checkout:  shaman.DiskSpace{Path: "/shaman/jobs"},
+	})
+	chunking := api.ShamanChunking{MinFileSize: 64_000_000, MinChunkSize: 1, AvgChunkSize: 2, MaxChunkSize: 4}
+	mf.shaman.EXPECT().Chunking().Return(&chunking)
+	echoCtx = mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.GetShamanStatus(echoCtx))
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.ShamanStatus{
+		Enabled: true,
+		FileStore: &api.ShamanDiskSpace{
+			Path:      "/shaman/file-store",
+			FreeBytes: 500,
+			IsLow:     true,
+			CheckedAt: &checkedAt,
+		},
+		Checkout: &api.ShamanDiskSpace{Path: "/shaman/jobs"},
+		Chunking: &chunking,
+	})
+}
+
+func TestShamanFileStoreInsufficientDiskSpace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	errDiskSpace := filestore.ErrInsufficientDiskSpace{
+		FreeBytes:    1000,
+		FileSize:     900,
+		MinFreeBytes: 500,
+		StoragePath:  "/shaman/file-store",
+	}
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().FileStore(gomock.Any(), gomock.Any(), "checksum", int64(900), int64(0), false, "").
+		Return(errDiskSpace)
+
+	echoCtx := mf.prepareMockedRequest(bytes.NewBufferString("file contents"))
+	err := mf.flamenco.ShamanFileStore(echoCtx, "checksum", 900, api.ShamanFileStoreParams{})
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusInsufficientStorage, errDiskSpace.Error())
+}
+
+func TestShamanFileStoreCheckOffset(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	fileStatus := api.ShamanSingleFileStatus{
+		Status: api.ShamanFileStatusUnknown,
+		Offset: ptr(int64(47)),
+	}
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().FileStoreCheck(gomock.Any(), "checksum", int64(900)).Return(fileStatus)
+
+	echoCtx := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.ShamanFileStoreCheck(echoCtx, "checksum", 900)
+	assert.NoError(t, err)
+	assertResponseJSON(t, echoCtx, http.StatusOK, fileStatus)
+}
+
+func TestShamanFileStoreResume(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	params := api.ShamanFileStoreParams{XShamanUploadOffset: ptr(int64(47))}
+
+	// Happy flow.
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().FileStore(gomock.Any(), gomock.Any(), "checksum", int64(900), int64(47), false, "")
+	echoCtx := mf.prepareMockedRequest(bytes.NewBufferString("file contents"))
+	err := mf.flamenco.ShamanFileStore(echoCtx, "checksum", 900, params)
+	assert.NoError(t, err)
+
+	// Offset beyond what was received.
+	errOffset := fileserver.ErrInvalidOffset{RequestedOffset: 47, ReceivedBytes: 30}
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().FileStore(gomock.Any(), gomock.Any(), "checksum", int64(900), int64(47), false, "").
+		Return(errOffset)
+	echoCtx = mf.prepareMockedRequest(bytes.NewBufferString("file contents"))
+	err = mf.flamenco.ShamanFileStore(echoCtx, "checksum", 900, params)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusRequestedRangeNotSatisfiable, errOffset.Error())
+
+	// Someone else is uploading the file.
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().FileStore(gomock.Any(), gomock.Any(), "checksum", int64(900), int64(47), false, "").
+		Return(fileserver.ErrUploadInProgress)
+	echoCtx = mf.prepareMockedRequest(bytes.NewBufferString("file contents"))
+	err = mf.flamenco.ShamanFileStore(echoCtx, "checksum", 900, params)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusConflict, fileserver.ErrUploadInProgress.Error())
+}
+
+func TestShamanCheckout(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	checkout := api.ShamanCheckout{
+		CheckoutPath: "some/path",
+		Files:        []api.ShamanFileSpec{{Sha: "checksum", Size: 900, Path: "cache.vdb"}},
+	}
+
+	// Files are still being assembled from their chunks.
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().Checkout(gomock.Any(), checkout).Return("", shaman.ErrAssemblyPending)
+	echoCtx := mf.prepareMockedJSONRequest(checkout)
+	err := mf.flamenco.ShamanCheckout(echoCtx)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusTooEarly, shaman.ErrAssemblyPending.Error())
+
+	// Files are missing.
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().Checkout(gomock.Any(), checkout).Return("", shaman.ErrMissingFiles)
+	echoCtx = mf.prepareMockedJSONRequest(checkout)
+	err = mf.flamenco.ShamanCheckout(echoCtx)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusFailedDependency, shaman.ErrMissingFiles.Error())
+
+	// Happy flow.
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().Checkout(gomock.Any(), checkout).Return("some/path-2", nil)
+	echoCtx = mf.prepareMockedJSONRequest(checkout)
+	err = mf.flamenco.ShamanCheckout(echoCtx)
+	assert.NoError(t, err)
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.ShamanCheckoutResult{CheckoutPath: "some/path-2"})
+}
+
+func TestGetShamanScrubStatus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	// No check has finished yet.
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().ScrubStatus().Return(true, nil)
+	echoCtx := mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.GetShamanScrubStatus(echoCtx))
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.ShamanScrubStatus{Running: true})
+
+	// Report of the last check.
+	startedAt := time.Date(2024, 3, 20, 14, 15, 16, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Hour)
+	report := shaman.ScrubReport{
+		StartedAt:       startedAt,
+		FinishedAt:      finishedAt,
+		NumFilesChecked: 3,
+		BytesChecked:    4096,
+		CorruptFiles: []shaman.CorruptFile{{
+			Path:           "/shaman/file-store/stored/ab/cdef/123.blob",
+			QuarantinePath: "/shaman/file-store/quarantine/ab/cdef/123.blob",
+			Checksum:       "abcdef",
+			Size:           123,
+			ActualChecksum: "fedcba",
+			ActualSize:     122,
+			ReferencedBy:   []string{"/shaman/jobs/some-job/file.blend"},
+		}},
+	}
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().ScrubStatus().Return(false, &report)
+	echoCtx = mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.GetShamanScrubStatus(echoCtx))
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.ShamanScrubStatus{
+		Running: false,
+		LastReport: &api.ShamanScrubReport{
+			StartedAt:       startedAt,
+			FinishedAt:      finishedAt,
+			NumFilesChecked: 3,
+			BytesChecked:    4096,
+			CorruptFiles: []api.ShamanCorruptFile{{
+				Path:           "/shaman/file-store/stored/ab/cdef/123.blob",
+				QuarantinePath: ptr("/shaman/file-store/quarantine/ab/cdef/123.blob"),
+				Checksum:       "abcdef",
+				Size:           123,
+				ActualChecksum: "fedcba",
+				ActualSize:     122,
+				ReferencedBy:   []string{"/shaman/jobs/some-job/file.blend"},
+			}},
+		},
+	})
+}
+
+func TestStartShamanScrub(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().StartScrub()
+	echoCtx := mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.StartShamanScrub(echoCtx))
+	assertResponseNoContent(t, echoCtx)
+
+	// Already running.
+	mf.shaman.EXPECT().IsEnabled().Return(true)
+	mf.shaman.EXPECT().StartScrub().Return(shaman.ErrScrubRunning)
+	echoCtx = mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.StartShamanScrub(echoCtx))
+	assertResponseAPIError(t, echoCtx, http.StatusConflict, "an integrity check of the file store is already running")
+
+	// Shaman disabled.
+	mf.shaman.EXPECT().IsEnabled().Return(false)
+	echoCtx = mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.StartShamanScrub(echoCtx))
+	assertResponseAPIError(t, echoCtx, http.StatusServiceUnavailable, "shaman server not active")
+}
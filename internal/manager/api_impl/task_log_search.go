@@ -0,0 +1,174 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/internal/manager/task_logs"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// defaultTaskLogSearchLimit is the maximum number of matches returned by a
+// task log search, when the query doesn't specify a limit.
+const defaultTaskLogSearchLimit = 1000
+
+var errTaskLogSearchLimitReached = errors.New("task log search limit reached")
+
+// SearchTaskLogs searches through task logs, and streams the matching lines to
+// the client as newline-delimited JSON.
+func (f *Flamenco) SearchTaskLogs(e echo.Context) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	var query api.SearchTaskLogsJSONRequestBody
+	if err := e.Bind(&query); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	matcher, err := taskLogMatcher(api.TaskLogSearchQuery(query))
+	if err != nil {
+		return sendAPIError(e, http.StatusBadRequest, "invalid regular expression: %v", err)
+	}
+
+	limit := defaultTaskLogSearchLimit
+	if query.Limit != nil {
+		limit = *query.Limit
+	}
+
+	var jobs []*persistence.Job
+	switch {
+	case query.JobId != nil:
+		logger = logger.With().Str("job", *query.JobId).Logger()
+		dbJob, err := f.fetchJob(e, logger, *query.JobId)
+		if dbJob == nil {
+			// f.fetchJob already sent a response.
+			return err
+		}
+		jobs = []*persistence.Job{dbJob}
+	case query.Since != nil:
+		until := f.clock.Now()
+		if query.Until != nil {
+			until = *query.Until
+		}
+		logger = logger.With().Time("since", *query.Since).Time("until", until).Logger()
+		jobs, err = f.persist.FetchJobsActiveBetween(ctx, *query.Since, until)
+		if err != nil {
+			logger.Error().Err(err).Msg("error fetching jobs to search the task logs of")
+			return sendAPIError(e, http.StatusInternalServerError, "error fetching jobs: %v", err)
+		}
+	default:
+		return sendAPIError(e, http.StatusBadRequest, "either job_id or since should be given")
+	}
+
+	logger = logger.With().
+		Str("pattern", query.Pattern).
+		Int("numJobs", len(jobs)).
+		Logger()
+	logger.Info().Msg("searching task logs")
+
+	resp := e.Response()
+	resp.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	encoder := json.NewEncoder(resp)
+
+	// Once the first match has been sent, the response is committed and errors
+	// can no longer be reported to the client.
+	searchFailed := func(logger zerolog.Logger, err error, message string) error {
+		logger.Error().Err(err).Msg(message)
+		if resp.Committed {
+			return nil
+		}
+		resp.Header().Del(echo.HeaderContentType)
+		return sendAPIError(e, http.StatusInternalServerError, "%s: %v", message, err)
+	}
+
+	numMatches := 0
+	for _, job := range jobs {
+		jobLogger := logger.With().Str("job", job.UUID).Logger()
+
+		tasks, err := f.persist.QueryJobTaskSummaries(ctx, job.UUID)
+		if err != nil {
+			return searchFailed(jobLogger, err, "error fetching tasks")
+		}
+
+		for _, task := range tasks {
+			err := f.logStorage.Search(ctx, job.UUID, task.UUID, matcher,
+				func(match task_logs.SearchMatch) error {
+					err := encoder.Encode(api.TaskLogSearchMatch{
+						JobId:      job.UUID,
+						TaskId:     task.UUID,
+						TaskName:   task.Name,
+						LineNumber: match.LineNumber,
+						Line:       match.Line,
+					})
+					if err != nil {
+						return err
+					}
+					numMatches++
+					if numMatches >= limit {
+						return errTaskLogSearchLimitReached
+					}
+					return nil
+				})
+
+			switch {
+			case errors.Is(err, errTaskLogSearchLimitReached):
+				logger.Info().Int("numMatches", numMatches).Msg("task log search stopped, limit reached")
+				resp.Flush()
+				return nil
+			case ctx.Err() != nil:
+				logger.Debug().Err(ctx.Err()).Msg("task log search aborted")
+				return nil
+			case err != nil:
+				taskLogger := jobLogger.With().Str("task", task.UUID).Logger()
+				return searchFailed(taskLogger, err, "error searching task log")
+			}
+		}
+
+		if resp.Committed {
+			resp.Flush()
+		}
+	}
+
+	if !resp.Committed {
+		// Nothing was found, but that's still a successful search.
+		resp.WriteHeader(http.StatusOK)
+	}
+	logger.Info().Int("numMatches", numMatches).Msg("task log search done")
+	return nil
+}
+
+// taskLogMatcher returns a function that returns whether a log line matches the query.
+func taskLogMatcher(query api.TaskLogSearchQuery) (func(line string) bool, error) {
+	isRegexp := query.Regexp != nil && *query.Regexp
+	ignoreCase := query.IgnoreCase != nil && *query.IgnoreCase
+
+	if !isRegexp && !ignoreCase {
+		return func(line string) bool {
+			return strings.Contains(line, query.Pattern)
+		}, nil
+	}
+
+	pattern := query.Pattern
+	if !isRegexp {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString, nil
+}
@@ -0,0 +1,162 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/internal/manager/task_logs"
+	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/moremock"
+)
+
+func TestSearchTaskLogsOfJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{UUID: jobID}
+	tasks := []*persistence.Task{
+		{UUID: "4b8f7e4d-5a55-4c6d-a7b5-7f3b0f0a3d2e", Name: "render-1-10"},
+		{UUID: "6c1f2e3d-4b5a-4f69-8e7d-6c5b4a3f2e1d", Name: "render-11-20"},
+	}
+
+	mf.persistence.EXPECT().FetchJob(moremock.ContextWithDeadline(), jobID).Return(&dbJob, nil)
+	mf.persistence.EXPECT().QueryJobTaskSummaries(gomock.Any(), jobID).Return(tasks, nil)
+
+	// Fake the log contents of both tasks, and let the actual matcher decide what to return.
+	logs := map[string][]string{
+		tasks[0].UUID: {"Fra:1 Mem:2.3M", "Warning: Unable to open /textures/wood.png"},
+		tasks[1].UUID: {"warning: unable to open /textures/brick.png", "Fra:11 Mem:2.3M"},
+	}
+	mf.logStorage.EXPECT().Search(gomock.Any(), jobID, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(fakeLogSearch(logs)).Times(2)
+
+	echoCtx := mf.prepareMockedJSONRequest(api.TaskLogSearchQuery{
+		Pattern:    "Warning: Unable to open",
+		IgnoreCase: ptr(true),
+		JobId:      &jobID,
+	})
+	err := mf.flamenco.SearchTaskLogs(echoCtx)
+	require.NoError(t, err)
+
+	resp := getRecordedResponse(echoCtx)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t,
+		`{"job_id":"18a9b096-d77e-438c-9be2-74397038298b","line":"Warning: Unable to open /textures/wood.png","line_number":2,"task_id":"4b8f7e4d-5a55-4c6d-a7b5-7f3b0f0a3d2e","task_name":"render-1-10"}`+"\n"+
+			`{"job_id":"18a9b096-d77e-438c-9be2-74397038298b","line":"warning: unable to open /textures/brick.png","line_number":1,"task_id":"6c1f2e3d-4b5a-4f69-8e7d-6c5b4a3f2e1d","task_name":"render-11-20"}`+"\n",
+		string(body))
+}
+
+func TestSearchTaskLogsTimeRangeAndLimit(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	since := mf.clock.Now().Add(-24 * time.Hour)
+	jobs := []*persistence.Job{
+		{UUID: "18a9b096-d77e-438c-9be2-74397038298b"},
+		{UUID: "2f7d910f-08a6-4b0f-8ecb-b3946939ed1b"},
+	}
+	task := &persistence.Task{UUID: "4b8f7e4d-5a55-4c6d-a7b5-7f3b0f0a3d2e", Name: "render-1-10"}
+	logs := map[string][]string{
+		task.UUID: {"Error: out of memory", "Error: out of memory", "Error: out of memory"},
+	}
+
+	// The search should stop after the limit is reached, so the second job is never searched.
+	mf.persistence.EXPECT().FetchJobsActiveBetween(gomock.Any(), since, mf.clock.Now()).Return(jobs, nil)
+	mf.persistence.EXPECT().QueryJobTaskSummaries(gomock.Any(), jobs[0].UUID).Return([]*persistence.Task{task}, nil)
+	mf.logStorage.EXPECT().Search(gomock.Any(), jobs[0].UUID, task.UUID, gomock.Any(), gomock.Any()).
+		DoAndReturn(fakeLogSearch(logs))
+
+	echoCtx := mf.prepareMockedJSONRequest(api.TaskLogSearchQuery{
+		Pattern: "out of memory",
+		Since:   &since,
+		Limit:   ptr(2),
+	})
+	err := mf.flamenco.SearchTaskLogs(echoCtx)
+	require.NoError(t, err)
+
+	resp := getRecordedResponse(echoCtx)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(body), "\n"))
+}
+
+func TestSearchTaskLogsBadQuery(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	// Without job ID or time range.
+	echoCtx := mf.prepareMockedJSONRequest(api.TaskLogSearchQuery{Pattern: "error"})
+	err := mf.flamenco.SearchTaskLogs(echoCtx)
+	require.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusBadRequest, "either job_id or since should be given")
+
+	// Invalid regular expression.
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	echoCtx = mf.prepareMockedJSONRequest(api.TaskLogSearchQuery{
+		Pattern: "Fra:(",
+		Regexp:  ptr(true),
+		JobId:   &jobID,
+	})
+	err = mf.flamenco.SearchTaskLogs(echoCtx)
+	require.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusBadRequest,
+		"invalid regular expression: error parsing regexp: missing closing ): `Fra:(`")
+}
+
+func TestTaskLogMatcher(t *testing.T) {
+	match := func(query api.TaskLogSearchQuery, line string) bool {
+		matcher, err := taskLogMatcher(query)
+		require.NoError(t, err)
+		return matcher(line)
+	}
+
+	literal := api.TaskLogSearchQuery{Pattern: "Fra:1 ("}
+	assert.True(t, match(literal, "Fra:1 (Mem:3M)"))
+	assert.False(t, match(literal, "fra:1 (Mem:3M)"))
+
+	literal.IgnoreCase = ptr(true)
+	assert.True(t, match(literal, "fra:1 (Mem:3M)"))
+
+	re := api.TaskLogSearchQuery{Pattern: `^Fra:\d+ `, Regexp: ptr(true)}
+	assert.True(t, match(re, "Fra:47 Mem:3M"))
+	assert.False(t, match(re, "Time 00:01 Fra:47 Mem:3M"))
+}
+
+// fakeLogSearch returns a function that mimics task_logs.Storage.Search on the given task logs.
+func fakeLogSearch(logs map[string][]string) func(
+	context.Context, string, string, func(string) bool, func(task_logs.SearchMatch) error) error {
+	return func(ctx context.Context, jobID, taskID string,
+		matches func(line string) bool, found func(match task_logs.SearchMatch) error) error {
+		for idx, line := range logs[taskID] {
+			if !matches(line) {
+				continue
+			}
+			if err := found(task_logs.SearchMatch{LineNumber: idx + 1, Line: line}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -463,9 +463,55 @@ func workerDBtoAPI(w persistence.Worker) api.Worker {
 		apiWorker.Tags = &tags
 	}
 
+	apiWorker.Resources = workerResourcesDBtoAPI(w)
+	if w.ResourcesSampledAt.Valid {
+		sample := api.WorkerResourceSample{
+			Timestamp: w.ResourcesSampledAt.Time,
+		}
+		if w.LoadAverage.Valid {
+			sample.LoadAverage = &w.LoadAverage.Float64
+		}
+		if w.MemoryUsed > 0 {
+			sample.MemoryUsed = &w.MemoryUsed
+		}
+		if w.TempDiskFree > 0 {
+			sample.TempDiskFree = &w.TempDiskFree
+		}
+		apiWorker.ResourceUsage = &sample
+	}
+
 	return apiWorker
 }
 
+// workerResourcesDBtoAPI returns the Worker's resources, or nil when the Worker
+// did not report any.
+func workerResourcesDBtoAPI(w persistence.Worker) *api.WorkerResources {
+	resources := api.WorkerResources{}
+	hasResources := false
+
+	if w.CPUCount > 0 {
+		resources.CpuCount = &w.CPUCount
+		hasResources = true
+	}
+	if w.MemoryTotal > 0 {
+		resources.MemoryTotal = &w.MemoryTotal
+		hasResources = true
+	}
+	if w.OSDescription != "" {
+		resources.OsDescription = &w.OSDescription
+		hasResources = true
+	}
+	if w.TempDiskFree > 0 {
+		resources.TempDiskFree = &w.TempDiskFree
+		hasResources = true
+	}
+
+	if !hasResources {
+		return nil
+	}
+	return &resources
+}
+
 func workerTagDBtoAPI(wc persistence.WorkerTag) api.WorkerTag {
 	uuid := wc.UUID // Take a copy for safety.
 
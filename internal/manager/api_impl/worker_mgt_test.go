@@ -3,6 +3,7 @@ package api_impl
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
@@ -140,6 +141,47 @@ func TestFetchWorker(t *testing.T) {
 		SupportedTaskTypes: []string{"blender", "ffmpeg", "file-management", "misc"},
 		Task:               nil,
 	})
+
+	// Test with worker that reported its resources and resource usage.
+	worker.StatusChangeClear()
+	worker.CPUCount = 16
+	worker.MemoryTotal = 64_000_000_000
+	worker.OSDescription = "Ubuntu 24.04 LTS (6.8.0-45-generic)"
+	worker.TempDiskFree = 50_000_000_000
+	worker.MemoryUsed = 12_000_000_000
+	worker.LoadAverage = sql.NullFloat64{Float64: 3.25, Valid: true}
+	worker.ResourcesSampledAt = sql.NullTime{Time: mf.clock.Now().UTC(), Valid: true}
+	mf.persistence.EXPECT().FetchWorker(gomock.Any(), workerUUID).Return(&worker, nil)
+	mf.persistence.EXPECT().FetchWorkerTask(gomock.Any(), &worker).Return(nil, nil)
+
+	echo = mf.prepareMockedRequest(nil)
+	err = mf.flamenco.FetchWorker(echo, worker.UUID)
+	assert.NoError(t, err)
+	assertResponseJSON(t, echo, http.StatusOK, api.Worker{
+		WorkerSummary: api.WorkerSummary{
+			Id:      workerUUID,
+			Name:    "дрон",
+			Version: "3.0",
+			Status:  api.WorkerStatusAwake,
+		},
+		IpAddress:          "fe80::5054:ff:fede:2ad7",
+		Platform:           "linux",
+		SupportedTaskTypes: []string{"blender", "ffmpeg", "file-management", "misc"},
+		Resources: &api.WorkerResources{
+			CpuCount:      ptr(16),
+			MemoryTotal:   ptr(int64(64_000_000_000)),
+			OsDescription: ptr("Ubuntu 24.04 LTS (6.8.0-45-generic)"),
+			TempDiskFree:  ptr(int64(50_000_000_000)),
+		},
+		ResourceUsage: &api.WorkerResourceSample{
+			WorkerResourceUsage: api.WorkerResourceUsage{
+				LoadAverage:  ptr(3.25),
+				MemoryUsed:   ptr(int64(12_000_000_000)),
+				TempDiskFree: ptr(int64(50_000_000_000)),
+			},
+			Timestamp: mf.clock.Now().UTC(),
+		},
+	})
 }
 
 func TestDeleteWorker(t *testing.T) {
@@ -4,7 +4,10 @@ package api_impl
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"projects.blender.org/studio/flamenco/internal/manager/persistence"
@@ -33,13 +36,7 @@ func (f *Flamenco) FetchWorkerSleepSchedule(e echo.Context, workerUUID string) e
 		return e.NoContent(http.StatusNoContent)
 	}
 
-	apiSchedule := api.WorkerSleepSchedule{
-		DaysOfWeek: schedule.DaysOfWeek,
-		EndTime:    schedule.EndTime.String(),
-		IsActive:   schedule.IsActive,
-		StartTime:  schedule.StartTime.String(),
-	}
-	return e.JSON(http.StatusOK, apiSchedule)
+	return e.JSON(http.StatusOK, sleepScheduleDBtoAPI(schedule))
 }
 
 func (f *Flamenco) SetWorkerSleepSchedule(e echo.Context, workerUUID string) error {
@@ -60,21 +57,14 @@ func (f *Flamenco) SetWorkerSleepSchedule(e echo.Context, workerUUID string) err
 	schedule := api.WorkerSleepSchedule(req)
 
 	// Create a sleep schedule that can be persisted.
-	dbSchedule := persistence.SleepSchedule{
-		IsActive:   schedule.IsActive,
-		DaysOfWeek: schedule.DaysOfWeek,
-	}
-	if err := dbSchedule.StartTime.Scan(schedule.StartTime); err != nil {
-		logger.Warn().Interface("schedule", schedule).Err(err).Msg("bad request received, cannot parse schedule start time")
-		return sendAPIError(e, http.StatusBadRequest, "invalid format for schedule start time")
-	}
-	if err := dbSchedule.EndTime.Scan(schedule.EndTime); err != nil {
-		logger.Warn().Interface("schedule", schedule).Err(err).Msg("bad request received, cannot parse schedule end time")
-		return sendAPIError(e, http.StatusBadRequest, "invalid format for schedule end time")
+	dbSchedule, err := sleepScheduleAPItoDB(schedule)
+	if err != nil {
+		logger.Warn().Interface("schedule", schedule).Err(err).Msg("bad request received, cannot parse schedule")
+		return sendAPIError(e, http.StatusBadRequest, err.Error())
 	}
 
 	// Send the sleep schedule to the scheduler.
-	err = f.sleepScheduler.SetSchedule(ctx, workerUUID, &dbSchedule)
+	err = f.sleepScheduler.SetSchedule(ctx, workerUUID, dbSchedule)
 	switch {
 	case errors.Is(err, persistence.ErrWorkerNotFound):
 		logger.Warn().Msg("SetWorkerSleepSchedule: worker does not exist")
@@ -86,3 +76,126 @@ func (f *Flamenco) SetWorkerSleepSchedule(e echo.Context, workerUUID string) err
 
 	return e.NoContent(http.StatusNoContent)
 }
+
+func (f *Flamenco) FetchWorkerTagSleepSchedule(e echo.Context, tagUUID string) error {
+	if !uuid.IsValid(tagUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+	logger = logger.With().Str("tag", tagUUID).Logger()
+	schedule, err := f.sleepScheduler.FetchTagSchedule(ctx, tagUUID)
+
+	switch {
+	case err != nil:
+		logger.Error().Err(err).Msg("FetchWorkerTagSleepSchedule: error fetching sleep schedule")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching sleep schedule: %v", err)
+	case schedule == nil:
+		return e.NoContent(http.StatusNoContent)
+	}
+
+	return e.JSON(http.StatusOK, sleepScheduleDBtoAPI(schedule))
+}
+
+func (f *Flamenco) SetWorkerTagSleepSchedule(e echo.Context, tagUUID string) error {
+	if !uuid.IsValid(tagUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+	logger = logger.With().Str("tag", tagUUID).Logger()
+
+	var req api.SetWorkerTagSleepScheduleJSONRequestBody
+	err := e.Bind(&req)
+	if err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+	schedule := api.WorkerSleepSchedule(req)
+
+	dbSchedule, err := sleepScheduleAPItoDB(schedule)
+	if err != nil {
+		logger.Warn().Interface("schedule", schedule).Err(err).Msg("bad request received, cannot parse schedule")
+		return sendAPIError(e, http.StatusBadRequest, err.Error())
+	}
+
+	err = f.sleepScheduler.SetTagSchedule(ctx, tagUUID, dbSchedule)
+	switch {
+	case errors.Is(err, persistence.ErrWorkerTagNotFound):
+		logger.Warn().Msg("SetWorkerTagSleepSchedule: worker tag does not exist")
+		return sendAPIError(e, http.StatusNotFound, "worker tag %q does not exist", tagUUID)
+	case err != nil:
+		logger.Error().Err(err).Msg("SetWorkerTagSleepSchedule: error storing sleep schedule")
+		return sendAPIError(e, http.StatusInternalServerError, "error storing sleep schedule: %v", err)
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+func sleepScheduleDBtoAPI(schedule *persistence.SleepSchedule) api.WorkerSleepSchedule {
+	apiSchedule := api.WorkerSleepSchedule{
+		DaysOfWeek: schedule.DaysOfWeek,
+		EndTime:    schedule.EndTime.String(),
+		IsActive:   schedule.IsActive,
+		StartTime:  schedule.StartTime.String(),
+	}
+	if len(schedule.ExtraWindows) > 0 {
+		extraWindows := make([]api.WorkerSleepWindow, len(schedule.ExtraWindows))
+		for idx, window := range schedule.ExtraWindows {
+			extraWindows[idx] = api.WorkerSleepWindow{
+				StartTime: window.Start.String(),
+				EndTime:   window.End.String(),
+			}
+		}
+		apiSchedule.ExtraWindows = &extraWindows
+	}
+	if schedule.ExceptionDates != "" {
+		exceptionDates := strings.Fields(schedule.ExceptionDates)
+		apiSchedule.ExceptionDates = &exceptionDates
+	}
+	return apiSchedule
+}
+
+// sleepScheduleAPItoDB converts the API sleep schedule to one that can be
+// persisted. The returned error is suitable for sending to the API client.
+func sleepScheduleAPItoDB(schedule api.WorkerSleepSchedule) (*persistence.SleepSchedule, error) {
+	dbSchedule := persistence.SleepSchedule{
+		IsActive:   schedule.IsActive,
+		DaysOfWeek: schedule.DaysOfWeek,
+	}
+	if err := dbSchedule.StartTime.Scan(schedule.StartTime); err != nil {
+		return nil, errors.New("invalid format for schedule start time")
+	}
+	if err := dbSchedule.EndTime.Scan(schedule.EndTime); err != nil {
+		return nil, errors.New("invalid format for schedule end time")
+	}
+
+	if schedule.ExtraWindows != nil {
+		for _, apiWindow := range *schedule.ExtraWindows {
+			var window persistence.TimeWindow
+			if err := window.Start.Scan(apiWindow.StartTime); err != nil {
+				return nil, errors.New("invalid format for time window start time")
+			}
+			if err := window.End.Scan(apiWindow.EndTime); err != nil {
+				return nil, errors.New("invalid format for time window end time")
+			}
+			// A window without start and end time would span the entire day.
+			if !window.Start.HasValue() && !window.End.HasValue() {
+				return nil, errors.New("time window should have a start time, an end time, or both")
+			}
+			dbSchedule.ExtraWindows = append(dbSchedule.ExtraWindows, window)
+		}
+	}
+	if schedule.ExceptionDates != nil {
+		for _, date := range *schedule.ExceptionDates {
+			if _, err := time.Parse(time.DateOnly, date); err != nil {
+				return nil, fmt.Errorf("invalid exception date %q, should be in YYYY-MM-DD format", date)
+			}
+		}
+		dbSchedule.ExceptionDates = strings.Join(*schedule.ExceptionDates, " ")
+	}
+
+	return &dbSchedule, nil
+}
@@ -0,0 +1,147 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+func TestFetchWorkerTagSleepSchedule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	tagUUID := "7e8b9d1c-2f6c-4f4c-9a0e-3c1d6e5b2a17"
+
+	{ // No schedule.
+		mf.sleepScheduler.EXPECT().FetchTagSchedule(gomock.Any(), tagUUID).Return(nil, nil)
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.FetchWorkerTagSleepSchedule(echoCtx, tagUUID)
+		assert.NoError(t, err)
+		assertResponseNoContent(t, echoCtx)
+	}
+
+	{ // Existing schedule.
+		dbSchedule := persistence.SleepSchedule{
+			IsActive:   true,
+			DaysOfWeek: "mo tu",
+			StartTime:  persistence.TimeOfDay{Hour: 9, Minute: 0},
+			EndTime:    persistence.TimeOfDay{Hour: 12, Minute: 0},
+			ExtraWindows: persistence.TimeWindows{
+				{Start: persistence.TimeOfDay{Hour: 13, Minute: 0}, End: persistence.EmptyTimeOfDay()},
+			},
+			ExceptionDates: "2026-12-24 2026-12-25",
+		}
+		mf.sleepScheduler.EXPECT().FetchTagSchedule(gomock.Any(), tagUUID).Return(&dbSchedule, nil)
+		echoCtx := mf.prepareMockedRequest(nil)
+		err := mf.flamenco.FetchWorkerTagSleepSchedule(echoCtx, tagUUID)
+		assert.NoError(t, err)
+		assertResponseJSON(t, echoCtx, http.StatusOK, api.WorkerSleepSchedule{
+			IsActive:       true,
+			DaysOfWeek:     "mo tu",
+			StartTime:      "09:00",
+			EndTime:        "12:00",
+			ExtraWindows:   &[]api.WorkerSleepWindow{{StartTime: "13:00", EndTime: ""}},
+			ExceptionDates: &[]string{"2026-12-24", "2026-12-25"},
+		})
+	}
+}
+
+func TestSetWorkerTagSleepSchedule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	tagUUID := "7e8b9d1c-2f6c-4f4c-9a0e-3c1d6e5b2a17"
+
+	apiSchedule := api.WorkerSleepSchedule{
+		IsActive:       true,
+		DaysOfWeek:     "mo tu",
+		StartTime:      "09:00",
+		EndTime:        "12:00",
+		ExtraWindows:   &[]api.WorkerSleepWindow{{StartTime: "13:00", EndTime: "18:00"}},
+		ExceptionDates: &[]string{"2026-12-24"},
+	}
+
+	{ // Happy flow.
+		expectSchedule := persistence.SleepSchedule{
+			IsActive:   true,
+			DaysOfWeek: "mo tu",
+			StartTime:  persistence.TimeOfDay{Hour: 9, Minute: 0},
+			EndTime:    persistence.TimeOfDay{Hour: 12, Minute: 0},
+			ExtraWindows: persistence.TimeWindows{
+				{Start: persistence.TimeOfDay{Hour: 13, Minute: 0}, End: persistence.TimeOfDay{Hour: 18, Minute: 0}},
+			},
+			ExceptionDates: "2026-12-24",
+		}
+		mf.sleepScheduler.EXPECT().SetTagSchedule(gomock.Any(), tagUUID, &expectSchedule)
+
+		echoCtx := mf.prepareMockedJSONRequest(apiSchedule)
+		err := mf.flamenco.SetWorkerTagSleepSchedule(echoCtx, tagUUID)
+		assert.NoError(t, err)
+		assertResponseNoContent(t, echoCtx)
+	}
+
+	{ // Non-existent tag.
+		mf.sleepScheduler.EXPECT().SetTagSchedule(gomock.Any(), tagUUID, gomock.Any()).
+			Return(persistence.ErrWorkerTagNotFound)
+
+		echoCtx := mf.prepareMockedJSONRequest(apiSchedule)
+		err := mf.flamenco.SetWorkerTagSleepSchedule(echoCtx, tagUUID)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusNotFound, "worker tag %q does not exist", tagUUID)
+	}
+
+	{ // Invalid exception date.
+		badSchedule := apiSchedule
+		badSchedule.ExceptionDates = &[]string{"24-12-2026"}
+
+		echoCtx := mf.prepareMockedJSONRequest(badSchedule)
+		err := mf.flamenco.SetWorkerTagSleepSchedule(echoCtx, tagUUID)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusBadRequest,
+			`invalid exception date "24-12-2026", should be in YYYY-MM-DD format`)
+	}
+
+	{ // Extra window without start and end time.
+		badSchedule := apiSchedule
+		badSchedule.ExtraWindows = &[]api.WorkerSleepWindow{{StartTime: "", EndTime: ""}}
+
+		echoCtx := mf.prepareMockedJSONRequest(badSchedule)
+		err := mf.flamenco.SetWorkerTagSleepSchedule(echoCtx, tagUUID)
+		assert.NoError(t, err)
+		assertResponseAPIError(t, echoCtx, http.StatusBadRequest,
+			"time window should have a start time, an end time, or both")
+	}
+}
+
+func TestSetWorkerSleepScheduleEmptyWindow(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	workerUUID := "2b4a6e3c-5d2f-4b8e-8f1a-9c7d3e5f1a24"
+
+	// An extra window without start and end time would make the worker sleep
+	// all day, so it should be rejected without storing the schedule.
+	apiSchedule := api.WorkerSleepSchedule{
+		IsActive:     true,
+		DaysOfWeek:   "mo tu",
+		StartTime:    "09:00",
+		EndTime:      "12:00",
+		ExtraWindows: &[]api.WorkerSleepWindow{{StartTime: "", EndTime: ""}},
+	}
+
+	echoCtx := mf.prepareMockedJSONRequest(apiSchedule)
+	err := mf.flamenco.SetWorkerSleepSchedule(echoCtx, workerUUID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusBadRequest,
+		"time window should have a start time, an end time, or both")
+}
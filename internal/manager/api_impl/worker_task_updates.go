@@ -4,15 +4,18 @@ package api_impl
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gertd/go-pluralize"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 
 	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/internal/manager/webupdates"
 	"projects.blender.org/studio/flamenco/internal/uuid"
 	"projects.blender.org/studio/flamenco/pkg/api"
 )
@@ -108,6 +111,12 @@ func (f *Flamenco) doTaskUpdate(
 		dbErrActivity = f.persist.SaveTaskActivity(ctx, dbTask)
 	}
 
+	var dbErrProgress error
+	if update.Progress != nil {
+		dbTask.Progress = *update.Progress
+		dbErrProgress = f.persist.SaveTaskProgress(ctx, dbTask)
+	}
+
 	// Write the log first, because that's likely to contain the cause of the task
 	// state change. Any subsequent task logs, for example generated by the
 	// Manager in response to a status change, should be logged after that.
@@ -116,8 +125,23 @@ func (f *Flamenco) doTaskUpdate(
 		_ = f.logStorage.Write(logger, dbTask.Job.UUID, dbTask.UUID, *update.Log)
 	}
 
+	// Progress changes do not go through the state machine, so they have to be
+	// broadcast here. Render progress is not stored, only passed on to the web
+	// interface.
+	if update.Progress != nil || update.RenderProgress != nil {
+		taskUpdate := webupdates.NewTaskUpdate(dbTask)
+		taskUpdate.RenderProgress = update.RenderProgress
+		f.broadcaster.BroadcastTaskUpdate(taskUpdate)
+	}
+	if update.Progress != nil && dbErrProgress == nil {
+		f.broadcastJobProgress(ctx, logger, dbTask.Job)
+	}
+
 	if update.TaskStatus == nil {
-		return dbErrActivity
+		if dbErrActivity != nil {
+			return dbErrActivity
+		}
+		return dbErrProgress
 	}
 
 	oldTaskStatus := dbTask.Status
@@ -142,6 +166,19 @@ func (f *Flamenco) doTaskUpdate(
 	return nil
 }
 
+// broadcastJobProgress sends the progress of the job to SocketIO clients.
+func (f *Flamenco) broadcastJobProgress(ctx context.Context, logger zerolog.Logger, job *persistence.Job) {
+	progress, err := f.persist.FetchJobProgress(ctx, job)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to fetch job progress")
+		return
+	}
+
+	jobUpdate := webupdates.NewJobUpdate(job)
+	jobUpdate.Progress = &progress
+	f.broadcaster.BroadcastJobUpdate(jobUpdate)
+}
+
 // onTaskFailed decides whether a task is soft- or hard-failed. Note that this
 // means that the task may NOT go to the status mentioned in the `update`
 // parameter, but go to `soft-failed` instead.
@@ -158,10 +195,14 @@ func (f *Flamenco) onTaskFailed(
 	}
 
 	// Bookkeeping of failure.
-	numFailed, err := f.persist.AddWorkerToTaskFailedList(ctx, task, worker)
+	numFailedWorkers, err := f.persist.AddWorkerToTaskFailedList(ctx, task, worker)
 	if err != nil {
 		return fmt.Errorf("adding worker to failure list of task: %w", err)
 	}
+	task.FailureCount++
+	if err := f.persist.SaveTaskRetryState(ctx, task); err != nil {
+		return fmt.Errorf("saving failure count of task: %w", err)
+	}
 
 	logger = logger.With().Str("taskType", task.Type).Logger()
 	wasBlacklisted, shoudlFailJob, err := f.maybeBlocklistWorker(ctx, logger, worker, task)
@@ -181,31 +222,44 @@ func (f *Flamenco) onTaskFailed(
 		}
 	}
 
-	// Determine whether this is soft or hard failure.
-	threshold := f.config.Get().TaskFailAfterSoftFailCount
+	// Determine whether this is soft or hard failure. When the job allows
+	// retrying on the same worker, every failure counts; otherwise only the
+	// number of distinct workers that failed the task is relevant.
+	policy := task.Job.RetryPolicy
+	threshold := policy.MaxFailures
+	if threshold <= 0 {
+		threshold = f.config.Get().TaskFailAfterSoftFailCount
+	}
+	numFailed := numFailedWorkers
+	if policy.AllowSameWorker {
+		numFailed = task.FailureCount
+	}
 	logger = logger.With().
-		Int("failedByWorkerCount", numFailed).
+		Int("failedByWorkerCount", numFailedWorkers).
+		Int("failureCount", task.FailureCount).
 		Int("threshold", threshold).
 		Logger()
 
 	if numFailed >= threshold {
-		return f.hardFailTask(ctx, logger, worker, task, numFailed)
+		return f.hardFailTask(ctx, logger, worker, task, numFailedWorkers)
 	}
 
-	numWorkers, err := f.numWorkersCapableOfRunningTask(ctx, task)
-	if err != nil {
-		return err
-	}
+	if !policy.AllowSameWorker {
+		numWorkers, err := f.numWorkersCapableOfRunningTask(ctx, task)
+		if err != nil {
+			return err
+		}
 
-	// If number of workers capable of running the failed task again is "1",
-	// that means we have no worker besides the one that actually failed the task.
-	// Because at this point in code the worker hasn't been registered as failing this task yet,
-	// and thus it is still counted.
-	// In such condition we should just fail the job itself.
-	if numWorkers <= 1 {
-		return f.failJobAfterCatastroficTaskFailure(ctx, logger, worker, task)
+		// If number of workers capable of running the failed task again is "1",
+		// that means we have no worker besides the one that actually failed the task.
+		// Because at this point in code the worker hasn't been registered as failing this task yet,
+		// and thus it is still counted.
+		// In such condition we should just fail the job itself.
+		if numWorkers <= 1 {
+			return f.failJobAfterCatastroficTaskFailure(ctx, logger, worker, task)
+		}
 	}
-	return f.softFailTask(ctx, logger, worker, task, numFailed)
+	return f.softFailTask(ctx, logger, worker, task, numFailedWorkers, threshold-numFailed)
 }
 
 // maybeBlocklistWorker potentially block-lists the Worker, and checks whether
@@ -345,9 +399,17 @@ func (f *Flamenco) softFailTask(
 	worker *persistence.Worker,
 	task *persistence.Task,
 	numFailed int,
+	failsToThreshold int,
 ) error {
-	threshold := f.config.Get().TaskFailAfterSoftFailCount
-	failsToThreshold := threshold - numFailed
+	// Hold off on retrying the task if the job asks for that.
+	retryDelay := taskRetryDelay(task.Job.RetryPolicy, task.FailureCount)
+	if retryDelay > 0 {
+		task.RetryAfter = sql.NullTime{Time: f.clock.Now().UTC().Add(retryDelay), Valid: true}
+		if err := f.persist.SaveTaskRetryState(ctx, task); err != nil {
+			return fmt.Errorf("saving retry delay of task: %w", err)
+		}
+		logger = logger.With().Stringer("retryDelay", retryDelay).Logger()
+	}
 
 	// Add the failure to the task log.
 	pluralizer := pluralize.NewClient()
@@ -357,6 +419,9 @@ func (f *Flamenco) softFailTask(
 		failsToThreshold,
 		pluralizer.Pluralize("failure", failsToThreshold, false),
 	)
+	if retryDelay > 0 {
+		taskLog += fmt.Sprintf(" The task will be retried after %v.", retryDelay)
+	}
 	if err := f.logStorage.WriteTimestamped(logger, task.Job.UUID, task.UUID, taskLog); err != nil {
 		logger.Error().Err(err).Msg("error writing failure notice to task log")
 	}
@@ -366,3 +431,29 @@ func (f *Flamenco) softFailTask(
 		Msg("worker failed this task, soft-failing to give another worker a try")
 	return f.stateMachine.TaskStatusChange(ctx, task, api.TaskStatusSoftFailed)
 }
+
+// taskRetryDelay returns how long a task should wait before it is retried,
+// after having failed `failureCount` times. The delay doubles with every
+// failure, and is capped at the policy's maximum delay (if any).
+func taskRetryDelay(policy persistence.JobRetryPolicy, failureCount int) time.Duration {
+	if policy.DelaySeconds <= 0 || failureCount <= 0 {
+		return 0
+	}
+
+	maxDelay := time.Duration(policy.MaxDelaySeconds) * time.Second
+	delay := time.Duration(policy.DelaySeconds) * time.Second
+	for i := 1; i < failureCount; i++ {
+		delay *= 2
+		if maxDelay > 0 && delay >= maxDelay {
+			break
+		}
+		if delay > 24*time.Hour {
+			// Prevent overflow when there is no maximum delay.
+			break
+		}
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
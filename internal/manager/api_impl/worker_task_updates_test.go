@@ -5,6 +5,7 @@ package api_impl
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -85,6 +86,110 @@ func TestTaskUpdate(t *testing.T) {
 	assert.Equal(t, "testing", actUpdatedTask.Activity)
 }
 
+func TestTaskUpdateRenderProgress(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	progress := api.TaskRenderProgress{
+		Frame:        ptr(3),
+		FramesDone:   ptr(2),
+		FramesTotal:  ptr(10),
+		MissingFiles: &[]string{"/textures/wood.png"},
+	}
+	taskUpdate := api.TaskUpdateJSONRequestBody{
+		Activity:       ptr("Rendering frame 3"),
+		RenderProgress: &progress,
+	}
+
+	taskID := "181eab68-1123-4790-93b1-94309a899411"
+	jobID := "e4719398-7cfa-4877-9bab-97c2d6c158b5"
+	mockJob := persistence.Job{UUID: jobID}
+	mockTask := persistence.Task{
+		UUID:     taskID,
+		Name:     "render-1-10",
+		Status:   api.TaskStatusActive,
+		Worker:   &worker,
+		WorkerID: &worker.ID,
+		Job:      &mockJob,
+	}
+
+	mf.persistence.EXPECT().FetchTask(gomock.Any(), taskID).Return(&mockTask, nil)
+	mf.persistence.EXPECT().SaveTaskActivity(gomock.Any(), &mockTask)
+	mf.persistence.EXPECT().TaskTouchedByWorker(gomock.Any(), &mockTask)
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+
+	// The render progress should be broadcast, without changing the task status.
+	mf.broadcaster.EXPECT().BroadcastTaskUpdate(api.SocketIOTaskUpdate{
+		Id:             taskID,
+		JobId:          jobID,
+		Name:           "render-1-10",
+		Status:         api.TaskStatusActive,
+		Activity:       "Rendering frame 3",
+		RenderProgress: &progress,
+	})
+
+	echoCtx := mf.prepareMockedJSONRequest(taskUpdate)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.TaskUpdate(echoCtx, taskID)
+	assert.NoError(t, err)
+}
+
+func TestTaskUpdateProgress(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	taskID := "181eab68-1123-4790-93b1-94309a899411"
+	jobID := "e4719398-7cfa-4877-9bab-97c2d6c158b5"
+	mockJob := persistence.Job{UUID: jobID, Name: "test job", Status: api.JobStatusActive, JobType: "simple-blender-render"}
+	mockTask := persistence.Task{
+		UUID:     taskID,
+		Name:     "render-1-10",
+		Status:   api.TaskStatusActive,
+		Worker:   &worker,
+		WorkerID: &worker.ID,
+		Job:      &mockJob,
+	}
+
+	mf.persistence.EXPECT().FetchTask(gomock.Any(), taskID).Return(&mockTask, nil)
+	mf.persistence.EXPECT().TaskTouchedByWorker(gomock.Any(), &mockTask)
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+
+	// The progress should be saved, and broadcast for both the task and the job.
+	var savedProgress int
+	mf.persistence.EXPECT().SaveTaskProgress(gomock.Any(), &mockTask).DoAndReturn(
+		func(ctx context.Context, task *persistence.Task) error {
+			savedProgress = task.Progress
+			return nil
+		})
+	mf.broadcaster.EXPECT().BroadcastTaskUpdate(api.SocketIOTaskUpdate{
+		Id:       taskID,
+		JobId:    jobID,
+		Name:     "render-1-10",
+		Status:   api.TaskStatusActive,
+		Progress: 25,
+	})
+	mf.persistence.EXPECT().FetchJobProgress(gomock.Any(), &mockJob).Return(40, nil)
+	mf.broadcaster.EXPECT().BroadcastJobUpdate(api.SocketIOJobUpdate{
+		Id:       jobID,
+		Name:     ptr("test job"),
+		Status:   api.JobStatusActive,
+		Type:     "simple-blender-render",
+		Progress: ptr(40),
+	})
+
+	echoCtx := mf.prepareMockedJSONRequest(api.TaskUpdateJSONRequestBody{Progress: ptr(25)})
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.TaskUpdate(echoCtx, taskID)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, savedProgress)
+}
+
 func TestTaskUpdateFailed(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -133,6 +238,7 @@ func TestTaskUpdateFailed(t *testing.T) {
 		// Expect the Worker to be added to the list of failed workers.
 		// This returns 1, which is less than the failure threshold -> soft failure expected.
 		mf.persistence.EXPECT().AddWorkerToTaskFailedList(gomock.Any(), &mockTask, &worker).Return(1, nil)
+		mf.persistence.EXPECT().SaveTaskRetryState(gomock.Any(), &mockTask)
 
 		mf.persistence.EXPECT().WorkersLeftToRun(gomock.Any(), &mockJob, "misc").
 			Return(map[string]bool{"60453eec-5a26-43e9-9da2-d00506d492cc": true, "ce312357-29cd-4389-81ab-4d43e30945f8": true}, nil)
@@ -156,6 +262,7 @@ func TestTaskUpdateFailed(t *testing.T) {
 		// Test with more (mocked) failures in the past, pushing the task over the threshold.
 		mf.persistence.EXPECT().AddWorkerToTaskFailedList(gomock.Any(), &mockTask, &worker).
 			Return(conf.TaskFailAfterSoftFailCount, nil)
+		mf.persistence.EXPECT().SaveTaskRetryState(gomock.Any(), &mockTask)
 		mf.stateMachine.EXPECT().TaskStatusChange(gomock.Any(), &mockTask, api.TaskStatusFailed)
 		mf.logStorage.EXPECT().WriteTimestamped(gomock.Any(), jobID, taskID,
 			"Task failed by 3 workers, Manager will mark it as hard failure")
@@ -169,6 +276,91 @@ func TestTaskUpdateFailed(t *testing.T) {
 	}
 }
 
+func TestTaskUpdateFailedRetryPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	// Construct the JSON request object.
+	taskUpdate := api.TaskUpdateJSONRequestBody{
+		TaskStatus: ptr(api.TaskStatusFailed),
+	}
+
+	// Construct the task that's supposed to be updated. The job allows retrying
+	// on the same worker, with exponential backoff.
+	taskID := "181eab68-1123-4790-93b1-94309a899411"
+	jobID := "e4719398-7cfa-4877-9bab-97c2d6c158b5"
+	mockJob := persistence.Job{
+		UUID: jobID,
+		RetryPolicy: persistence.JobRetryPolicy{
+			MaxFailures:     5,
+			DelaySeconds:    30,
+			MaxDelaySeconds: 90,
+			AllowSameWorker: true,
+		},
+	}
+	mockTask := persistence.Task{
+		UUID:         taskID,
+		Worker:       &worker,
+		WorkerID:     &worker.ID,
+		Job:          &mockJob,
+		Activity:     "pre-update activity",
+		Type:         "misc",
+		FailureCount: 2,
+	}
+
+	conf := config.Conf{
+		Base: config.Base{
+			TaskFailAfterSoftFailCount: 3,
+			BlocklistThreshold:         65535, // This test doesn't cover blocklisting.
+		},
+	}
+	mf.config.EXPECT().Get().Return(&conf).AnyTimes()
+
+	mf.persistence.EXPECT().FetchTask(gomock.Any(), taskID).Return(&mockTask, nil)
+	mf.persistence.EXPECT().TaskTouchedByWorker(gomock.Any(), &mockTask)
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+	mf.persistence.EXPECT().CountTaskFailuresOfWorker(gomock.Any(), &mockJob, &worker, "misc").Return(0, nil)
+
+	// Only one worker ever failed this task, but as the same worker is allowed
+	// to retry, this should not fail the job. The failure count (3) is still
+	// below the job's maximum (5), so a soft failure is expected.
+	mf.persistence.EXPECT().AddWorkerToTaskFailedList(gomock.Any(), &mockTask, &worker).Return(1, nil)
+	mf.persistence.EXPECT().SaveTaskRetryState(gomock.Any(), &mockTask).Times(2)
+
+	mf.stateMachine.EXPECT().TaskStatusChange(gomock.Any(), &mockTask, api.TaskStatusSoftFailed)
+	mf.logStorage.EXPECT().WriteTimestamped(gomock.Any(), jobID, taskID,
+		"Task failed by 1 worker, Manager will mark it as soft failure. 2 more failures will cause hard failure. The task will be retried after 1m30s.")
+
+	// Do the call.
+	echoCtx := mf.prepareMockedJSONRequest(taskUpdate)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.TaskUpdate(echoCtx, taskID)
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echoCtx)
+
+	assert.Equal(t, 3, mockTask.FailureCount)
+	// 30 seconds, doubled twice, capped at 90 seconds.
+	assert.True(t, mockTask.RetryAfter.Valid)
+	assert.Equal(t, mf.clock.Now().UTC().Add(90*time.Second), mockTask.RetryAfter.Time)
+}
+
+func TestTaskRetryDelay(t *testing.T) {
+	policy := persistence.JobRetryPolicy{DelaySeconds: 10}
+	assert.Equal(t, time.Duration(0), taskRetryDelay(persistence.JobRetryPolicy{}, 3))
+	assert.Equal(t, time.Duration(0), taskRetryDelay(policy, 0))
+	assert.Equal(t, 10*time.Second, taskRetryDelay(policy, 1))
+	assert.Equal(t, 20*time.Second, taskRetryDelay(policy, 2))
+	assert.Equal(t, 80*time.Second, taskRetryDelay(policy, 4))
+
+	policy.MaxDelaySeconds = 60
+	assert.Equal(t, 40*time.Second, taskRetryDelay(policy, 3))
+	assert.Equal(t, 60*time.Second, taskRetryDelay(policy, 4))
+	assert.Equal(t, 60*time.Second, taskRetryDelay(policy, 1000))
+}
+
 func TestBlockingAfterFailure(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -232,6 +424,7 @@ func TestBlockingAfterFailure(t *testing.T) {
 		// Expect the Worker to be added to the list of failed workers for this task.
 		// This returns 1, which is less than the failure threshold -> soft failure.
 		mf.persistence.EXPECT().AddWorkerToTaskFailedList(gomock.Any(), &mockTask, &worker).Return(1, nil)
+		mf.persistence.EXPECT().SaveTaskRetryState(gomock.Any(), &mockTask)
 
 		// Expect soft failure of the task.
 		mf.stateMachine.EXPECT().TaskStatusChange(gomock.Any(), &mockTask, api.TaskStatusSoftFailed)
@@ -262,6 +455,7 @@ func TestBlockingAfterFailure(t *testing.T) {
 		// Expect the Worker to be added to the list of failed workers for this task.
 		// This returns 1, which is less than the failure threshold -> soft failure if it were only based on this metric.
 		mf.persistence.EXPECT().AddWorkerToTaskFailedList(gomock.Any(), &mockTask, &worker).Return(1, nil)
+		mf.persistence.EXPECT().SaveTaskRetryState(gomock.Any(), &mockTask)
 
 		// Expect hard failure of the task, because there are no workers left to perfom it.
 		mf.stateMachine.EXPECT().TaskStatusChange(gomock.Any(), &mockTask, api.TaskStatusFailed)
@@ -297,6 +491,7 @@ func TestBlockingAfterFailure(t *testing.T) {
 		// Expect the Worker to be added to the list of failed workers for this task.
 		// This returns 1, which is less than the failure threshold -> soft failure if it were only based on this metric.
 		mf.persistence.EXPECT().AddWorkerToTaskFailedList(gomock.Any(), &mockTask, &worker).Return(1, nil)
+		mf.persistence.EXPECT().SaveTaskRetryState(gomock.Any(), &mockTask)
 
 		// Expect hard failure of the task, because there are no workers left to perfom it.
 		mf.stateMachine.EXPECT().TaskStatusChange(gomock.Any(), &mockTask, api.TaskStatusFailed)
@@ -361,6 +556,7 @@ func TestJobFailureAfterWorkerTaskFailure(t *testing.T) {
 	mf.persistence.EXPECT().CountTaskFailuresOfWorker(gomock.Any(), &mockJob, &worker, "misc").Return(0, nil)
 
 	mf.persistence.EXPECT().AddWorkerToTaskFailedList(gomock.Any(), &mockTask, &worker).Return(1, nil)
+	mf.persistence.EXPECT().SaveTaskRetryState(gomock.Any(), &mockTask)
 
 	mf.persistence.EXPECT().WorkersLeftToRun(gomock.Any(), &mockJob, "misc").
 		Return(map[string]bool{"e7632d62-c3b8-4af0-9e78-01752928952c": true}, nil)
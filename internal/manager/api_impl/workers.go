@@ -4,6 +4,7 @@ package api_impl
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 
+	"projects.blender.org/studio/flamenco/internal/manager/config"
 	"projects.blender.org/studio/flamenco/internal/manager/last_rendered"
 	"projects.blender.org/studio/flamenco/internal/manager/persistence"
 	"projects.blender.org/studio/flamenco/internal/manager/task_state_machine"
@@ -152,6 +154,10 @@ func (f *Flamenco) workerUpdateAfterSignOn(e echo.Context, update api.SignOnJSON
 	}
 	w.SupportedTaskTypes = strings.Join(update.SupportedTaskTypes, ",")
 
+	if update.Resources != nil {
+		workerResourcesAPItoDB(*update.Resources, w)
+	}
+
 	// Save the new Worker info to the database.
 	err := f.persist.SaveWorker(ctx, w)
 	if err != nil {
@@ -161,6 +167,8 @@ func (f *Flamenco) workerUpdateAfterSignOn(e echo.Context, update api.SignOnJSON
 		return nil, "", err
 	}
 
+	f.applyWorkerTagRules(ctx, logger, w)
+
 	err = f.workerSeen(logger, w)
 	if err != nil {
 		return nil, "", err
@@ -169,6 +177,63 @@ func (f *Flamenco) workerUpdateAfterSignOn(e echo.Context, update api.SignOnJSON
 	return w, prevStatus, nil
 }
 
+// applyWorkerTagRules assigns the tags of the configured worker tag rules that
+// match the Worker. Tags that were already assigned are kept. Errors are only
+// logged, as they should not prevent the Worker from signing on.
+func (f *Flamenco) applyWorkerTagRules(ctx context.Context, logger zerolog.Logger, w *persistence.Worker) {
+	tagNames := f.config.Get().MatchingWorkerTags(config.WorkerTagRuleSubject{
+		Name:      w.Name,
+		Platform:  w.Platform,
+		Address:   w.Address,
+		TaskTypes: w.TaskTypes(),
+	})
+	if len(tagNames) == 0 {
+		return
+	}
+
+	allTags, err := f.persist.FetchWorkerTags(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to fetch worker tags, not applying worker tag rules")
+		return
+	}
+
+	tagUUIDs := []string{}
+	isAssigned := map[string]bool{}
+	for _, tag := range w.Tags {
+		tagUUIDs = append(tagUUIDs, tag.UUID)
+		isAssigned[tag.UUID] = true
+	}
+
+	addedTags := []string{}
+	for _, tagName := range tagNames {
+		var tag *persistence.WorkerTag
+		for _, candidate := range allTags {
+			if strings.EqualFold(candidate.Name, tagName) {
+				tag = candidate
+				break
+			}
+		}
+
+		switch {
+		case tag == nil:
+			logger.Warn().Str("tag", tagName).Msg("worker tag rule refers to non-existent worker tag, ignoring")
+		case !isAssigned[tag.UUID]:
+			tagUUIDs = append(tagUUIDs, tag.UUID)
+			isAssigned[tag.UUID] = true
+			addedTags = append(addedTags, tag.Name)
+		}
+	}
+	if len(addedTags) == 0 {
+		return
+	}
+
+	if err := f.persist.WorkerSetTags(ctx, w, tagUUIDs); err != nil {
+		logger.Error().Err(err).Strs("tags", addedTags).Msg("unable to assign worker tags from worker tag rules")
+		return
+	}
+	logger.Info().Strs("tags", addedTags).Msg("worker tags assigned by worker tag rules")
+}
+
 func (f *Flamenco) SignOff(e echo.Context) error {
 	logger := requestLogger(e)
 
@@ -292,6 +357,74 @@ func (f *Flamenco) WorkerStateChanged(e echo.Context) error {
 	return e.NoContent(http.StatusNoContent)
 }
 
+// WorkerHeartbeat stores the Worker's resource usage.
+// (POST /api/v3/worker/heartbeat)
+func (f *Flamenco) WorkerHeartbeat(e echo.Context) error {
+	logger := requestLogger(e)
+
+	var req api.WorkerHeartbeatJSONRequestBody
+	err := e.Bind(&req)
+	if err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	w := requestWorkerOrPanic(e)
+	workerResourceUsageAPItoDB(api.WorkerResourceUsage(req), w)
+	w.ResourcesSampledAt = sql.NullTime{Time: f.clock.Now().UTC(), Valid: true}
+
+	bgCtx, bgCtxCancel := bgContext()
+	defer bgCtxCancel()
+
+	if err := f.persist.SaveWorkerResourceUsage(bgCtx, w); err != nil {
+		logger.Warn().Err(err).Msg("error storing Worker resource usage in database")
+		return sendAPIError(e, http.StatusInternalServerError, "error storing resource usage in database")
+	}
+
+	// Any error has already been logged, and the heartbeat was received fine.
+	_ = f.workerSeen(logger, w)
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// workerResourcesAPItoDB copies the resources reported at sign-on to the
+// Worker. Values that were not reported are reset to 'unknown'.
+func workerResourcesAPItoDB(resources api.WorkerResources, w *persistence.Worker) {
+	w.CPUCount = 0
+	if resources.CpuCount != nil {
+		w.CPUCount = *resources.CpuCount
+	}
+	w.MemoryTotal = 0
+	if resources.MemoryTotal != nil {
+		w.MemoryTotal = *resources.MemoryTotal
+	}
+	w.OSDescription = ""
+	if resources.OsDescription != nil {
+		w.OSDescription = *resources.OsDescription
+	}
+	w.TempDiskFree = 0
+	if resources.TempDiskFree != nil {
+		w.TempDiskFree = *resources.TempDiskFree
+	}
+}
+
+// workerResourceUsageAPItoDB copies the reported resource usage to the Worker.
+// Values that were not reported are reset to 'unknown'.
+func workerResourceUsageAPItoDB(usage api.WorkerResourceUsage, w *persistence.Worker) {
+	w.LoadAverage = sql.NullFloat64{}
+	if usage.LoadAverage != nil {
+		w.LoadAverage = sql.NullFloat64{Float64: *usage.LoadAverage, Valid: true}
+	}
+	w.MemoryUsed = 0
+	if usage.MemoryUsed != nil {
+		w.MemoryUsed = *usage.MemoryUsed
+	}
+	w.TempDiskFree = 0
+	if usage.TempDiskFree != nil {
+		w.TempDiskFree = *usage.TempDiskFree
+	}
+}
+
 func (f *Flamenco) ScheduleTask(e echo.Context) error {
 	logger := requestLogger(e)
 	worker := requestWorkerOrPanic(e)
@@ -395,7 +528,7 @@ func (f *Flamenco) ScheduleTask(e echo.Context) error {
 	return e.JSON(http.StatusOK, customisedTask)
 }
 
-func (f *Flamenco) TaskOutputProduced(e echo.Context, taskID string) error {
+func (f *Flamenco) TaskOutputProduced(e echo.Context, taskID string, params api.TaskOutputProducedParams) error {
 	ctx := e.Request().Context()
 	filesize := e.Request().ContentLength
 	worker := requestWorkerOrPanic(e)
@@ -451,11 +584,16 @@ func (f *Flamenco) TaskOutputProduced(e echo.Context, taskID string) error {
 		logger.Error().Err(err).Msg("TaskOutputProduced: error getting last-rendered thumbnail info for job")
 		return sendAPIError(e, http.StatusInternalServerError, "error getting last-rendered thumbnail info for job: %v", err)
 	}
+	outputFilename := ""
+	if params.XFlamencoOutputFilename != nil {
+		outputFilename = *params.XFlamencoOutputFilename
+	}
 	payload := last_rendered.Payload{
 		JobUUID:    jobUUID,
 		WorkerUUID: worker.UUID,
 		MimeType:   e.Request().Header.Get("Content-Type"),
 		Image:      imageBytes,
+		Filename:   outputFilename,
 
 		Callback: func(ctx context.Context) {
 			// Store this job as the last one to get a rendered image.
@@ -5,6 +5,7 @@ package api_impl
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"io"
 	"net/http"
 	"testing"
@@ -198,6 +199,8 @@ func TestWorkerSignOn(t *testing.T) {
 
 	mf.persistence.EXPECT().SaveWorker(gomock.Any(), &worker).Return(nil)
 	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+	conf := config.GetTestConfig()
+	mf.config.EXPECT().Get().Return(&conf)
 
 	echo := mf.prepareMockedJSONRequest(api.WorkerSignOn{
 		Name:               "Lazy Boi",
@@ -213,6 +216,123 @@ func TestWorkerSignOn(t *testing.T) {
 	})
 }
 
+func TestWorkerSignOnWithResources(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	mf.sleepScheduler.EXPECT().WorkerStatus(gomock.Any(), worker.UUID).
+		Return(api.WorkerStatusAwake, nil)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+	conf := config.GetTestConfig()
+	mf.config.EXPECT().Get().Return(&conf)
+	mf.persistence.EXPECT().SaveWorker(gomock.Any(), &worker).
+		DoAndReturn(func(ctx context.Context, w *persistence.Worker) error {
+			assert.Equal(t, 16, w.CPUCount)
+			assert.Equal(t, int64(64_000_000_000), w.MemoryTotal)
+			assert.Equal(t, "Ubuntu 24.04 LTS (6.8.0-45-generic)", w.OSDescription)
+			assert.Equal(t, int64(100_000_000_000), w.TempDiskFree)
+			return nil
+		})
+
+	echo := mf.prepareMockedJSONRequest(api.WorkerSignOn{
+		Name:               "Lazy Boi",
+		SoftwareVersion:    "3.0-testing",
+		SupportedTaskTypes: []string{"testing"},
+		Resources: &api.WorkerResources{
+			CpuCount:      ptr(16),
+			MemoryTotal:   ptr(int64(64_000_000_000)),
+			OsDescription: ptr("Ubuntu 24.04 LTS (6.8.0-45-generic)"),
+			TempDiskFree:  ptr(int64(100_000_000_000)),
+		},
+	})
+	requestWorkerStore(echo, &worker)
+	err := mf.flamenco.SignOn(echo)
+	assert.NoError(t, err)
+
+	assertResponseJSON(t, echo, http.StatusOK, api.WorkerStateChange{
+		StatusRequested: api.WorkerStatusAwake,
+	})
+}
+
+func TestWorkerSignOnTagRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	manualTag := persistence.WorkerTag{UUID: "f3a4b1c2-7d8e-4f9a-8b0c-1d2e3f4a5b6c", Name: "manual"}
+	farmTag := persistence.WorkerTag{UUID: "0c6b1b25-3b4a-4c2e-9c2d-7e0f7a8b9c1d", Name: "Render Farm"}
+	worker.Tags = []*persistence.WorkerTag{&manualTag}
+
+	conf := config.GetTestConfig(func(c *config.Conf) {
+		c.WorkerTagRules = []config.WorkerTagRule{
+			{Tag: "render farm", Name: "lazy-*", Platform: "linux"},
+			{Tag: "manual", TaskTypes: []string{"testing"}},
+			{Tag: "does-not-exist", Platform: "linux"},
+			{Tag: "windows", Platform: "windows"},
+		}
+	})
+	mf.config.EXPECT().Get().Return(&conf)
+
+	mf.sleepScheduler.EXPECT().WorkerStatus(gomock.Any(), worker.UUID).
+		Return(api.WorkerStatusAwake, nil)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+	mf.persistence.EXPECT().SaveWorker(gomock.Any(), &worker)
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+	mf.persistence.EXPECT().FetchWorkerTags(gomock.Any()).
+		Return([]*persistence.WorkerTag{&manualTag, &farmTag}, nil)
+
+	// The manually assigned tag should be kept.
+	mf.persistence.EXPECT().WorkerSetTags(gomock.Any(), &worker, []string{manualTag.UUID, farmTag.UUID})
+
+	echo := mf.prepareMockedJSONRequest(api.WorkerSignOn{
+		Name:               "Lazy-Boi",
+		SoftwareVersion:    "3.0-testing",
+		SupportedTaskTypes: []string{"testing"},
+	})
+	requestWorkerStore(echo, &worker)
+	err := mf.flamenco.SignOn(echo)
+	assert.NoError(t, err)
+
+	assertResponseJSON(t, echo, http.StatusOK, api.WorkerStateChange{
+		StatusRequested: api.WorkerStatusAwake,
+	})
+}
+
+func TestWorkerHeartbeat(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+	worker.MemoryUsed = 47
+	worker.LoadAverage = sql.NullFloat64{Float64: 1.5, Valid: true}
+
+	// Load average is not reported, so it should be cleared.
+	savedWorker := worker
+	savedWorker.MemoryUsed = 12_000_000_000
+	savedWorker.TempDiskFree = 50_000_000_000
+	savedWorker.LoadAverage = sql.NullFloat64{}
+	savedWorker.ResourcesSampledAt = sql.NullTime{Time: mf.clock.Now().UTC(), Valid: true}
+
+	mf.persistence.EXPECT().SaveWorkerResourceUsage(gomock.Any(), &savedWorker)
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &savedWorker)
+
+	echo := mf.prepareMockedJSONRequest(api.WorkerResourceUsage{
+		MemoryUsed:   ptr(int64(12_000_000_000)),
+		TempDiskFree: ptr(int64(50_000_000_000)),
+	})
+	requestWorkerStore(echo, &worker)
+	err := mf.flamenco.WorkerHeartbeat(echo)
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echo)
+}
+
 func TestWorkerSignoffTaskRequeue(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -617,7 +737,7 @@ func TestTaskOutputProduced(t *testing.T) {
 		mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
 
 		echo := prepareRequest(nil)
-		err := mf.flamenco.TaskOutputProduced(echo, task.UUID)
+		err := mf.flamenco.TaskOutputProduced(echo, task.UUID, api.TaskOutputProducedParams{})
 		assert.NoError(t, err)
 		assertResponseAPIError(t, echo, http.StatusLengthRequired, "Content-Length header required")
 	}
@@ -632,7 +752,7 @@ func TestTaskOutputProduced(t *testing.T) {
 		}
 
 		echo := prepareRequest(bytes.NewReader(bodyBytes))
-		err := mf.flamenco.TaskOutputProduced(echo, task.UUID)
+		err := mf.flamenco.TaskOutputProduced(echo, task.UUID, api.TaskOutputProducedParams{})
 		assert.NoError(t, err)
 		assertResponseAPIError(t, echo, http.StatusRequestEntityTooLarge,
 			"image too large; should be max %v bytes", last_rendered.MaxImageSizeBytes)
@@ -647,7 +767,7 @@ func TestTaskOutputProduced(t *testing.T) {
 		echo.Request().Header.Set("Content-Type", "image/openexr")
 		mf.lastRender.EXPECT().QueueImage(gomock.Any()).Return(last_rendered.ErrMimeTypeUnsupported)
 
-		err := mf.flamenco.TaskOutputProduced(echo, task.UUID)
+		err := mf.flamenco.TaskOutputProduced(echo, task.UUID, api.TaskOutputProducedParams{})
 		assert.NoError(t, err)
 		assertResponseAPIError(t, echo, http.StatusUnsupportedMediaType, `unsupported mime type "image/openexr"`)
 	}
@@ -660,7 +780,7 @@ func TestTaskOutputProduced(t *testing.T) {
 		echo := prepareRequest(bytes.NewReader(bodyBytes))
 		mf.lastRender.EXPECT().QueueImage(gomock.Any()).Return(last_rendered.ErrQueueFull)
 
-		err := mf.flamenco.TaskOutputProduced(echo, task.UUID)
+		err := mf.flamenco.TaskOutputProduced(echo, task.UUID, api.TaskOutputProducedParams{})
 		assert.NoError(t, err)
 		assertResponseAPIError(t, echo, http.StatusTooManyRequests, "image processing queue is full")
 	}
@@ -679,6 +799,7 @@ func TestTaskOutputProduced(t *testing.T) {
 			WorkerUUID: worker.UUID,
 			MimeType:   "image/jpeg",
 			Image:      bodyBytes,
+			Filename:   "frame-0047.png",
 		}
 		var actualPayload *last_rendered.Payload
 		mf.lastRender.EXPECT().QueueImage(gomock.Any()).DoAndReturn(func(payload last_rendered.Payload) error {
@@ -686,7 +807,8 @@ func TestTaskOutputProduced(t *testing.T) {
 			return nil
 		})
 
-		err := mf.flamenco.TaskOutputProduced(echo, task.UUID)
+		params := api.TaskOutputProducedParams{XFlamencoOutputFilename: ptr("frame-0047.png")}
+		err := mf.flamenco.TaskOutputProduced(echo, task.UUID, params)
 		assert.NoError(t, err)
 		assertResponseNoBody(t, echo, http.StatusAccepted)
 
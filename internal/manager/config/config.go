@@ -101,6 +101,31 @@ type Base struct {
 	// When this many workers have tried the task and failed, it will be hard-failed
 	// (even when there are workers left that could technically retry the task).
 	TaskFailAfterSoftFailCount int `yaml:"task_fail_after_softfail_count"`
+
+	// LastRenderedEXRLayer is the layer of EXR images that is shown as
+	// last-rendered image, like "ViewLayer.Combined". When empty, the layer is
+	// chosen automatically.
+	LastRenderedEXRLayer string `yaml:"last_rendered_exr_layer,omitempty"`
+
+	FairShare FairShare `yaml:"fair_share"`
+
+	// JobArchiveMaxSizeMB is the maximum size of job archives that can be
+	// imported via the web interface. Zero means there is no limit.
+	JobArchiveMaxSizeMB int64 `yaml:"job_archive_max_size_mb"`
+
+	// WorkerTagRules assign worker tags to Workers when they sign on.
+	WorkerTagRules []WorkerTagRule `yaml:"worker_tag_rules,omitempty"`
+}
+
+// FairShare contains the config options for fair-share task scheduling.
+// When enabled, the task scheduler spreads the Workers over all jobs of the
+// same priority, instead of handing out all tasks of one job before starting
+// on the next.
+type FairShare struct {
+	Enabled bool `yaml:"enabled"`
+	// Job metadata key that identifies who submitted the job. When non-empty,
+	// Workers are spread over submitters first, and then over their jobs.
+	SubmitterMetadataKey string `yaml:"submitter_metadata_key"`
 }
 
 // GarbageCollect contains the config options for the GC.
@@ -238,6 +263,7 @@ func (c *Conf) processAfterLoading(override ...func(c *Conf)) {
 	c.constructVariableLookupTable()
 	c.checkDatabase()
 	c.checkVariables()
+	c.checkWorkerTagRules()
 }
 
 // MockCurrentGOOSForTests can be used in unit tests to make the variable
@@ -287,6 +313,12 @@ func (c *Conf) EffectiveStoragePath() string {
 	return absPath
 }
 
+// JobArchiveMaxSizeBytes returns the maximum size of job archives that can be
+// imported via the web interface, in bytes. Zero means there is no limit.
+func (c *Conf) JobArchiveMaxSizeBytes() int64 {
+	return c.JobArchiveMaxSizeMB * 1_000_000
+}
+
 func (c *Conf) addImplicitVariables() {
 	c.implicitVariables = make(map[string]Variable)
 
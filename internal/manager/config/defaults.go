@@ -27,11 +27,26 @@ var defaultConfig = Conf{
 
 		Shaman: shaman_config.Config{
 			// Enable Shaman by default, except on Windows where symlinks are still tricky.
-			Enabled: runtime.GOOS != "windows",
+			Enabled:      runtime.GOOS != "windows",
+			CheckoutMode: shaman_config.CheckoutModeSymlink,
 			GarbageCollect: shaman_config.GarbageCollect{
-				Period:            24 * time.Hour,
-				MaxAge:            31 * 24 * time.Hour,
-				ExtraCheckoutDirs: []string{},
+				Period:                 24 * time.Hour,
+				MaxAge:                 31 * 24 * time.Hour,
+				ExtraCheckoutDirs:      []string{},
+				UnfinishedUploadMaxAge: 7 * 24 * time.Hour,
+			},
+			DiskSpace: shaman_config.DiskSpace{
+				CheckPeriod:           1 * time.Minute,
+				MinFreeMB:             1_000,
+				GarbageCollectBelowMB: 10_000,
+			},
+			Chunking: shaman_config.Chunking{
+				Enabled:       false,
+				MinFileSizeMB: 64,
+			},
+			Scrub: shaman_config.Scrub{
+				Period:               7 * 24 * time.Hour,
+				RateLimitMBPerSecond: 50,
 			},
 		},
 
@@ -45,6 +60,14 @@ var defaultConfig = Conf{
 		BlocklistThreshold:         3,
 		TaskFailAfterSoftFailCount: 3,
 
+		FairShare: FairShare{
+			Enabled: false,
+			// Conventional metadata key for the name of the submitter.
+			SubmitterMetadataKey: "user.name",
+		},
+
+		JobArchiveMaxSizeMB: 2_000,
+
 		// WorkerCleanupStatus: []string{string(api.WorkerStatusOffline)},
 
 		// TestTasks: TestTasks{
@@ -0,0 +1,129 @@
+package config
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// WorkerTagRule assigns a worker tag to Workers when they sign on. A Worker
+// matches the rule when it matches all of its non-empty conditions.
+type WorkerTagRule struct {
+	// Tag is the name of the worker tag to assign.
+	Tag string `yaml:"tag"`
+
+	// Name is a glob pattern, like "render-*", that is matched against the
+	// Worker name. Matching is case-insensitive.
+	Name string `yaml:"name,omitempty"`
+	// Platform is the Worker's operating system, like "linux" or "windows".
+	Platform string `yaml:"platform,omitempty"`
+	// Subnet is an IP subnet in CIDR notation, like "192.168.3.0/24", that should
+	// contain the Worker's IP address.
+	Subnet string `yaml:"subnet,omitempty"`
+	// TaskTypes are task types that the Worker should all support.
+	TaskTypes []string `yaml:"task_types,omitempty,flow"`
+}
+
+// WorkerTagRuleSubject contains the Worker properties that WorkerTagRules can
+// match against.
+type WorkerTagRuleSubject struct {
+	Name      string
+	Platform  string
+	Address   string
+	TaskTypes []string
+}
+
+// Validate returns an error when the rule cannot be used.
+func (r WorkerTagRule) Validate() error {
+	if strings.TrimSpace(r.Tag) == "" {
+		return errors.New("no tag given")
+	}
+	if _, err := path.Match(r.Name, ""); err != nil {
+		return fmt.Errorf("invalid name pattern %q: %w", r.Name, err)
+	}
+	if r.Subnet != "" {
+		if _, _, err := net.ParseCIDR(r.Subnet); err != nil {
+			return fmt.Errorf("invalid subnet %q: %w", r.Subnet, err)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the subject matches all the conditions of the rule.
+// Invalid rules never match.
+func (r WorkerTagRule) Matches(subject WorkerTagRuleSubject) bool {
+	if r.Validate() != nil {
+		return false
+	}
+
+	if r.Name != "" {
+		matches, _ := path.Match(strings.ToLower(r.Name), strings.ToLower(subject.Name))
+		if !matches {
+			return false
+		}
+	}
+
+	if r.Platform != "" && !strings.EqualFold(r.Platform, subject.Platform) {
+		return false
+	}
+
+	if r.Subnet != "" {
+		_, subnet, _ := net.ParseCIDR(r.Subnet)
+		ip := net.ParseIP(subject.Address)
+		if ip == nil || !subnet.Contains(ip) {
+			return false
+		}
+	}
+
+	for _, taskType := range r.TaskTypes {
+		if !containsFold(subject.TaskTypes, taskType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchingWorkerTags returns the names of the tags of all rules that match the
+// subject, without duplicates.
+func (c *Conf) MatchingWorkerTags(subject WorkerTagRuleSubject) []string {
+	tags := []string{}
+	for _, rule := range c.WorkerTagRules {
+		if !rule.Matches(subject) {
+			continue
+		}
+		tag := strings.TrimSpace(rule.Tag)
+		if !containsFold(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// checkWorkerTagRules logs an error for each invalid worker tag rule.
+func (c *Conf) checkWorkerTagRules() {
+	for index, rule := range c.WorkerTagRules {
+		if err := rule.Validate(); err != nil {
+			log.Error().
+				Int("index", index).
+				Interface("rule", rule).
+				Err(err).
+				Msg("invalid worker tag rule, it will be ignored")
+		}
+	}
+}
+
+func containsFold(haystack []string, needle string) bool {
+	for _, item := range haystack {
+		if strings.EqualFold(strings.TrimSpace(item), strings.TrimSpace(needle)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package config
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerTagRuleValidate(t *testing.T) {
+	assert.NoError(t, WorkerTagRule{Tag: "farm"}.Validate())
+	assert.NoError(t, WorkerTagRule{Tag: "farm", Name: "render-*", Subnet: "fe80::/64"}.Validate())
+
+	assert.Error(t, WorkerTagRule{Name: "render-*"}.Validate(), "tag is required")
+	assert.Error(t, WorkerTagRule{Tag: "farm", Name: "render-["}.Validate())
+	assert.Error(t, WorkerTagRule{Tag: "farm", Subnet: "192.168.3.0"}.Validate())
+}
+
+func TestWorkerTagRuleMatches(t *testing.T) {
+	subject := WorkerTagRuleSubject{
+		Name:      "Render-047",
+		Platform:  "linux",
+		Address:   "192.168.3.47",
+		TaskTypes: []string{"blender", "ffmpeg", "file-management", "misc"},
+	}
+
+	tests := []struct {
+		name    string
+		rule    WorkerTagRule
+		matches bool
+	}{
+		{"no conditions", WorkerTagRule{Tag: "all"}, true},
+		{"name", WorkerTagRule{Tag: "farm", Name: "render-*"}, true},
+		{"other name", WorkerTagRule{Tag: "farm", Name: "artist-*"}, false},
+		{"platform", WorkerTagRule{Tag: "farm", Platform: "Linux"}, true},
+		{"other platform", WorkerTagRule{Tag: "farm", Platform: "windows"}, false},
+		{"subnet", WorkerTagRule{Tag: "farm", Subnet: "192.168.0.0/16"}, true},
+		{"other subnet", WorkerTagRule{Tag: "farm", Subnet: "10.0.0.0/8"}, false},
+		{"IPv6 subnet", WorkerTagRule{Tag: "farm", Subnet: "fe80::/64"}, false},
+		{"task types", WorkerTagRule{Tag: "farm", TaskTypes: []string{"blender", "ffmpeg"}}, true},
+		{"unsupported task type", WorkerTagRule{Tag: "farm", TaskTypes: []string{"blender", "houdini"}}, false},
+		{"all conditions", WorkerTagRule{Tag: "farm", Name: "render-0*", Platform: "linux",
+			Subnet: "192.168.3.0/24", TaskTypes: []string{"blender"}}, true},
+		{"one condition fails", WorkerTagRule{Tag: "farm", Name: "render-0*", Platform: "darwin"}, false},
+		{"invalid rule", WorkerTagRule{Tag: "farm", Subnet: "invalid"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.matches, test.rule.Matches(subject))
+		})
+	}
+
+	// Workers without a (valid) address never match a subnet.
+	assert.False(t, WorkerTagRule{Tag: "farm", Subnet: "0.0.0.0/0"}.Matches(WorkerTagRuleSubject{}))
+}
+
+func TestMatchingWorkerTags(t *testing.T) {
+	conf := DefaultConfig(func(c *Conf) {
+		c.WorkerTagRules = []WorkerTagRule{
+			{Tag: "farm", Platform: "linux"},
+			{Tag: "video", TaskTypes: []string{"ffmpeg"}},
+			{Tag: "Farm", Name: "render-*"},
+			{Tag: "windows", Platform: "windows"},
+		}
+	})
+
+	subject := WorkerTagRuleSubject{
+		Name:      "render-047",
+		Platform:  "linux",
+		TaskTypes: []string{"blender", "ffmpeg"},
+	}
+	assert.Equal(t, []string{"farm", "video"}, conf.MatchingWorkerTags(subject))
+
+	subject.Platform = "darwin"
+	subject.Name = "artist-workstation"
+	subject.TaskTypes = []string{"blender"}
+	assert.Empty(t, conf.MatchingWorkerTags(subject))
+}
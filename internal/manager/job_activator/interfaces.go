@@ -0,0 +1,28 @@
+package job_activator
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"time"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/internal/manager/task_state_machine"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// Generate mock implementations of these interfaces.
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks projects.blender.org/studio/flamenco/internal/manager/job_activator PersistenceService,TaskStateMachine
+
+type PersistenceService interface {
+	FetchWaitingJobsDue(ctx context.Context, now time.Time) ([]*persistence.Job, error)
+}
+
+var _ PersistenceService = (*persistence.DB)(nil)
+
+type TaskStateMachine interface {
+	// JobStatusChange gives a Job a new status, and handles the resulting status changes on its tasks.
+	JobStatusChange(ctx context.Context, job *persistence.Job, newJobStatus api.JobStatus, reason string) error
+}
+
+var _ TaskStateMachine = (*task_state_machine.StateMachine)(nil)
@@ -0,0 +1,82 @@
+package job_activator
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// Interval for checking whether waiting jobs can be queued.
+const checkInterval = 1 * time.Minute
+
+// JobActivator periodically queues jobs that were waiting for their 'not before' time.
+type JobActivator struct {
+	clock            clock.Clock
+	persist          PersistenceService
+	taskStateMachine TaskStateMachine
+}
+
+// New creates a new JobActivator.
+func New(clock clock.Clock, persist PersistenceService, taskStateMachine TaskStateMachine) *JobActivator {
+	return &JobActivator{
+		clock:            clock,
+		persist:          persist,
+		taskStateMachine: taskStateMachine,
+	}
+}
+
+// Run occasionally checks for waiting jobs and queues them when their time has come.
+// It stops running when the context closes.
+func (ja *JobActivator) Run(ctx context.Context) {
+	log.Info().
+		Str("checkInterval", checkInterval.String()).
+		Msg("job activator starting")
+	defer log.Info().Msg("job activator shutting down")
+
+	waitDuration := 2 * time.Second // First check should be quickly after startup.
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ja.clock.After(waitDuration):
+			ja.ActivateWaitingJobs(ctx)
+			waitDuration = checkInterval
+		}
+	}
+}
+
+// ActivateWaitingJobs queues all the waiting jobs whose 'not before' time has passed.
+func (ja *JobActivator) ActivateWaitingJobs(ctx context.Context) {
+	now := ja.clock.Now()
+	jobs, err := ja.persist.FetchWaitingJobsDue(ctx, now)
+	if err != nil {
+		log.Error().Err(err).Msg("job activator: unable to fetch waiting jobs")
+		return
+	}
+	if len(jobs) == 0 {
+		log.Trace().Msg("job activator: no waiting jobs are due")
+		return
+	}
+
+	for _, job := range jobs {
+		logger := log.With().
+			Str("job", job.UUID).
+			Str("name", job.Name).
+			Logger()
+		if job.NotBefore.Valid {
+			logger = logger.With().Time("notBefore", job.NotBefore.Time).Logger()
+		}
+
+		logger.Info().Msg("job activator: queueing job, its start time has come")
+		err := ja.taskStateMachine.JobStatusChange(ctx, job, api.JobStatusQueued, "start time has come")
+		if err != nil {
+			logger.Error().Err(err).Msg("job activator: unable to queue job")
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package job_activator
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+
+	"projects.blender.org/studio/flamenco/internal/manager/job_activator/mocks"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+type JobActivatorMocks struct {
+	clock            *clock.Mock
+	persist          *mocks.MockPersistenceService
+	taskStateMachine *mocks.MockTaskStateMachine
+}
+
+func TestActivateWaitingJobs(t *testing.T) {
+	ja, finish, mocks := jobActivatorTestFixtures(t)
+	defer finish()
+
+	now := mocks.clock.Now()
+	job1 := persistence.Job{
+		UUID:      "6e4e7b3a-4aa3-4de1-9a2b-6e3c9bd61f42",
+		Status:    api.JobStatusWaiting,
+		NotBefore: sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+	}
+	job2 := persistence.Job{
+		UUID:      "a4a1b3c1-9a6c-4e0a-8a33-1c0a4c0b86f0",
+		Status:    api.JobStatusWaiting,
+		NotBefore: sql.NullTime{Time: now, Valid: true},
+	}
+
+	ctx := context.Background()
+	mocks.persist.EXPECT().FetchWaitingJobsDue(ctx, now).Return([]*persistence.Job{&job1, &job2}, nil)
+	mocks.taskStateMachine.EXPECT().JobStatusChange(ctx, &job1, api.JobStatusQueued, "start time has come")
+	// An error queueing one job should not stop the others from being queued.
+	mocks.taskStateMachine.EXPECT().JobStatusChange(ctx, &job2, api.JobStatusQueued, "start time has come").
+		Return(errors.New("this is an unit test error"))
+
+	ja.ActivateWaitingJobs(ctx)
+}
+
+func TestActivateWaitingJobsNoneDue(t *testing.T) {
+	ja, finish, mocks := jobActivatorTestFixtures(t)
+	defer finish()
+
+	ctx := context.Background()
+	mocks.persist.EXPECT().FetchWaitingJobsDue(ctx, mocks.clock.Now()).Return([]*persistence.Job{}, nil)
+
+	ja.ActivateWaitingJobs(ctx)
+}
+
+func jobActivatorTestFixtures(t *testing.T) (*JobActivator, func(), *JobActivatorMocks) {
+	mockCtrl := gomock.NewController(t)
+
+	mocks := &JobActivatorMocks{
+		clock:            clock.NewMock(),
+		persist:          mocks.NewMockPersistenceService(mockCtrl),
+		taskStateMachine: mocks.NewMockTaskStateMachine(mockCtrl),
+	}
+
+	mockedNow, err := time.Parse(time.RFC3339, "2023-10-17T20:00:00+02:00")
+	if err != nil {
+		panic(err)
+	}
+	mocks.clock.Set(mockedNow)
+
+	// This should be called at the end of each unit test.
+	finish := func() {
+		mockCtrl.Finish()
+	}
+
+	ja := New(mocks.clock, mocks.persist, mocks.taskStateMachine)
+	return ja, finish, mocks
+}
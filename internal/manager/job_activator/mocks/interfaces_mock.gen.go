@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projects.blender.org/studio/flamenco/internal/manager/job_activator (interfaces: PersistenceService,TaskStateMachine)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	persistence "projects.blender.org/studio/flamenco/internal/manager/persistence"
+	api "projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// MockPersistenceService is a mock of PersistenceService interface.
+type MockPersistenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersistenceServiceMockRecorder
+}
+
+// MockPersistenceServiceMockRecorder is the mock recorder for MockPersistenceService.
+type MockPersistenceServiceMockRecorder struct {
+	mock *MockPersistenceService
+}
+
+// NewMockPersistenceService creates a new mock instance.
+func NewMockPersistenceService(ctrl *gomock.Controller) *MockPersistenceService {
+	mock := &MockPersistenceService{ctrl: ctrl}
+	mock.recorder = &MockPersistenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersistenceService) EXPECT() *MockPersistenceServiceMockRecorder {
+	return m.recorder
+}
+
+// FetchWaitingJobsDue mocks base method.
+func (m *MockPersistenceService) FetchWaitingJobsDue(arg0 context.Context, arg1 time.Time) ([]*persistence.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchWaitingJobsDue", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWaitingJobsDue indicates an expected call of FetchWaitingJobsDue.
+func (mr *MockPersistenceServiceMockRecorder) FetchWaitingJobsDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWaitingJobsDue", reflect.TypeOf((*MockPersistenceService)(nil).FetchWaitingJobsDue), arg0, arg1)
+}
+
+// MockTaskStateMachine is a mock of TaskStateMachine interface.
+type MockTaskStateMachine struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskStateMachineMockRecorder
+}
+
+// MockTaskStateMachineMockRecorder is the mock recorder for MockTaskStateMachine.
+type MockTaskStateMachineMockRecorder struct {
+	mock *MockTaskStateMachine
+}
+
+// NewMockTaskStateMachine creates a new mock instance.
+func NewMockTaskStateMachine(ctrl *gomock.Controller) *MockTaskStateMachine {
+	mock := &MockTaskStateMachine{ctrl: ctrl}
+	mock.recorder = &MockTaskStateMachineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskStateMachine) EXPECT() *MockTaskStateMachineMockRecorder {
+	return m.recorder
+}
+
+// JobStatusChange mocks base method.
+func (m *MockTaskStateMachine) JobStatusChange(arg0 context.Context, arg1 *persistence.Job, arg2 api.JobStatus, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobStatusChange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JobStatusChange indicates an expected call of JobStatusChange.
+func (mr *MockTaskStateMachineMockRecorder) JobStatusChange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobStatusChange", reflect.TypeOf((*MockTaskStateMachine)(nil).JobStatusChange), arg0, arg1, arg2, arg3)
+}
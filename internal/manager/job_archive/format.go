@@ -0,0 +1,298 @@
+package job_archive
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// jobArchive is the contents of `job.json` in the archive. It uses its own
+// types instead of the persistence types, so that the archive format is not
+// tied to the database layout.
+type jobArchive struct {
+	FormatVersion int               `json:"format_version"`
+	Job           archivedJob       `json:"job"`
+	Tasks         []archivedTask    `json:"tasks"`
+	TaskFailures  []archivedFailure `json:"task_failures,omitempty"`
+	Blocklist     []archivedBlock   `json:"blocklist,omitempty"`
+}
+
+type archivedJob struct {
+	UUID       string                 `json:"uuid"`
+	Name       string                 `json:"name"`
+	Type       string                 `json:"type"`
+	Priority   int                    `json:"priority"`
+	Status     api.JobStatus          `json:"status"`
+	Activity   string                 `json:"activity"`
+	MaxWorkers int                    `json:"max_workers,omitempty"`
+	Settings   map[string]interface{} `json:"settings"`
+	Metadata   map[string]string      `json:"metadata"`
+
+	RetryPolicy *archivedRetryPolicy `json:"retry_policy,omitempty"`
+
+	Created           time.Time  `json:"created"`
+	Updated           time.Time  `json:"updated"`
+	DeleteRequestedAt *time.Time `json:"delete_requested_at,omitempty"`
+	NotBefore         *time.Time `json:"not_before,omitempty"`
+
+	ShamanCheckoutID string   `json:"shaman_checkout_id,omitempty"`
+	WorkerTag        string   `json:"worker_tag,omitempty"`
+	DependsOn        []string `json:"depends_on,omitempty"`
+}
+
+type archivedTask struct {
+	UUID     string               `json:"uuid"`
+	Name     string               `json:"name"`
+	Type     string               `json:"type"`
+	Priority int                  `json:"priority"`
+	Status   api.TaskStatus       `json:"status"`
+	Activity string               `json:"activity"`
+	Progress int                  `json:"progress,omitempty"`
+	Commands persistence.Commands `json:"commands"`
+
+	MinCPUCount int `json:"min_cpu_count,omitempty"`
+	MinMemoryGB int `json:"min_memory_gb,omitempty"`
+
+	FailureCount int        `json:"failure_count,omitempty"`
+	RetryAfter   *time.Time `json:"retry_after,omitempty"`
+
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+	LastTouched time.Time `json:"last_touched"`
+
+	Worker       string   `json:"worker,omitempty"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+type archivedRetryPolicy struct {
+	MaxFailures     int  `json:"max_failures,omitempty"`
+	DelaySeconds    int  `json:"delay_seconds,omitempty"`
+	MaxDelaySeconds int  `json:"max_delay_seconds,omitempty"`
+	AllowSameWorker bool `json:"allow_same_worker,omitempty"`
+}
+
+type archivedFailure struct {
+	Task    string    `json:"task"`
+	Worker  string    `json:"worker"`
+	Created time.Time `json:"created"`
+}
+
+type archivedBlock struct {
+	Worker   string    `json:"worker"`
+	TaskType string    `json:"task_type"`
+	Created  time.Time `json:"created"`
+}
+
+func archiveFromPersistence(data *persistence.JobArchiveData) jobArchive {
+	dbJob := data.Job
+	archive := jobArchive{
+		FormatVersion: formatVersion,
+		Job: archivedJob{
+			UUID:              dbJob.UUID,
+			Name:              dbJob.Name,
+			Type:              dbJob.JobType,
+			Priority:          dbJob.Priority,
+			Status:            dbJob.Status,
+			Activity:          dbJob.Activity,
+			MaxWorkers:        dbJob.MaxWorkers,
+			Settings:          dbJob.Settings,
+			Metadata:          dbJob.Metadata,
+			Created:           dbJob.CreatedAt,
+			Updated:           dbJob.UpdatedAt,
+			DeleteRequestedAt: timeFromNull(dbJob.DeleteRequestedAt),
+			NotBefore:         timeFromNull(dbJob.NotBefore),
+			ShamanCheckoutID:  dbJob.Storage.ShamanCheckoutID,
+		},
+		Tasks: make([]archivedTask, 0, len(data.Tasks)),
+	}
+	if dbJob.WorkerTag != nil {
+		archive.Job.WorkerTag = dbJob.WorkerTag.UUID
+	}
+	if dbJob.RetryPolicy != (persistence.JobRetryPolicy{}) {
+		archive.Job.RetryPolicy = &archivedRetryPolicy{
+			MaxFailures:     dbJob.RetryPolicy.MaxFailures,
+			DelaySeconds:    dbJob.RetryPolicy.DelaySeconds,
+			MaxDelaySeconds: dbJob.RetryPolicy.MaxDelaySeconds,
+			AllowSameWorker: dbJob.RetryPolicy.AllowSameWorker,
+		}
+	}
+	for _, depJob := range dbJob.Dependencies {
+		archive.Job.DependsOn = append(archive.Job.DependsOn, depJob.UUID)
+	}
+
+	for _, dbTask := range data.Tasks {
+		task := archivedTask{
+			UUID:         dbTask.UUID,
+			Name:         dbTask.Name,
+			Type:         dbTask.Type,
+			Priority:     dbTask.Priority,
+			Status:       dbTask.Status,
+			Activity:     dbTask.Activity,
+			Progress:     dbTask.Progress,
+			Commands:     dbTask.Commands,
+			MinCPUCount:  dbTask.MinCPUCount,
+			MinMemoryGB:  dbTask.MinMemoryGB,
+			FailureCount: dbTask.FailureCount,
+			RetryAfter:   timeFromNull(dbTask.RetryAfter),
+			Created:      dbTask.CreatedAt,
+			Updated:      dbTask.UpdatedAt,
+			LastTouched:  dbTask.LastTouchedAt,
+		}
+		if dbTask.Worker != nil {
+			task.Worker = dbTask.Worker.UUID
+		}
+		for _, depTask := range dbTask.Dependencies {
+			task.Dependencies = append(task.Dependencies, depTask.UUID)
+		}
+		archive.Tasks = append(archive.Tasks, task)
+	}
+
+	for _, dbFailure := range data.TaskFailures {
+		if dbFailure.Task == nil || dbFailure.Worker == nil {
+			continue
+		}
+		archive.TaskFailures = append(archive.TaskFailures, archivedFailure{
+			Task:    dbFailure.Task.UUID,
+			Worker:  dbFailure.Worker.UUID,
+			Created: dbFailure.CreatedAt,
+		})
+	}
+
+	for _, dbBlock := range data.Blocklist {
+		if dbBlock.Worker == nil {
+			continue
+		}
+		archive.Blocklist = append(archive.Blocklist, archivedBlock{
+			Worker:   dbBlock.Worker.UUID,
+			TaskType: dbBlock.TaskType,
+			Created:  dbBlock.CreatedAt,
+		})
+	}
+
+	return archive
+}
+
+// toPersistence converts the archive to the structures the persistence layer
+// needs. References to other entities only have their UUID set.
+func (archive *jobArchive) toPersistence() (*persistence.JobArchiveData, error) {
+	job := &persistence.Job{
+		Model: persistence.Model{
+			CreatedAt: archive.Job.Created,
+			UpdatedAt: archive.Job.Updated,
+		},
+		UUID:              archive.Job.UUID,
+		Name:              archive.Job.Name,
+		JobType:           archive.Job.Type,
+		Priority:          archive.Job.Priority,
+		Status:            archive.Job.Status,
+		Activity:          archive.Job.Activity,
+		MaxWorkers:        archive.Job.MaxWorkers,
+		Settings:          archive.Job.Settings,
+		Metadata:          archive.Job.Metadata,
+		DeleteRequestedAt: nullFromTime(archive.Job.DeleteRequestedAt),
+		NotBefore:         nullFromTime(archive.Job.NotBefore),
+		Storage: persistence.JobStorageInfo{
+			ShamanCheckoutID: archive.Job.ShamanCheckoutID,
+		},
+	}
+	if archive.Job.WorkerTag != "" {
+		job.WorkerTag = &persistence.WorkerTag{UUID: archive.Job.WorkerTag}
+	}
+	if archive.Job.RetryPolicy != nil {
+		job.RetryPolicy = persistence.JobRetryPolicy{
+			MaxFailures:     archive.Job.RetryPolicy.MaxFailures,
+			DelaySeconds:    archive.Job.RetryPolicy.DelaySeconds,
+			MaxDelaySeconds: archive.Job.RetryPolicy.MaxDelaySeconds,
+			AllowSameWorker: archive.Job.RetryPolicy.AllowSameWorker,
+		}
+	}
+	for _, depUUID := range archive.Job.DependsOn {
+		job.Dependencies = append(job.Dependencies, &persistence.Job{UUID: depUUID})
+	}
+
+	data := persistence.JobArchiveData{
+		Job:   job,
+		Tasks: make([]*persistence.Task, 0, len(archive.Tasks)),
+	}
+
+	knownTasks := map[string]bool{}
+	for _, task := range archive.Tasks {
+		if knownTasks[task.UUID] {
+			return nil, fmt.Errorf("%w: duplicate task %s", ErrInvalidArchive, task.UUID)
+		}
+		knownTasks[task.UUID] = true
+	}
+
+	for _, task := range archive.Tasks {
+		dbTask := persistence.Task{
+			Model: persistence.Model{
+				CreatedAt: task.Created,
+				UpdatedAt: task.Updated,
+			},
+			UUID:          task.UUID,
+			Name:          task.Name,
+			Type:          task.Type,
+			Priority:      task.Priority,
+			Status:        task.Status,
+			Activity:      task.Activity,
+			Progress:      task.Progress,
+			Commands:      task.Commands,
+			MinCPUCount:   task.MinCPUCount,
+			MinMemoryGB:   task.MinMemoryGB,
+			FailureCount:  task.FailureCount,
+			RetryAfter:    nullFromTime(task.RetryAfter),
+			LastTouchedAt: task.LastTouched,
+		}
+		if task.Worker != "" {
+			dbTask.Worker = &persistence.Worker{UUID: task.Worker}
+		}
+		for _, depUUID := range task.Dependencies {
+			if !knownTasks[depUUID] {
+				return nil, fmt.Errorf("%w: task %s depends on unknown task %s", ErrInvalidArchive, task.UUID, depUUID)
+			}
+			dbTask.Dependencies = append(dbTask.Dependencies, &persistence.Task{UUID: depUUID})
+		}
+		data.Tasks = append(data.Tasks, &dbTask)
+	}
+
+	for _, failure := range archive.TaskFailures {
+		if !knownTasks[failure.Task] {
+			return nil, fmt.Errorf("%w: task failure refers to unknown task %s", ErrInvalidArchive, failure.Task)
+		}
+		data.TaskFailures = append(data.TaskFailures, &persistence.TaskFailure{
+			CreatedAt: failure.Created,
+			Task:      &persistence.Task{UUID: failure.Task},
+			Worker:    &persistence.Worker{UUID: failure.Worker},
+		})
+	}
+
+	for _, block := range archive.Blocklist {
+		data.Blocklist = append(data.Blocklist, &persistence.JobBlock{
+			CreatedAt: block.Created,
+			Worker:    &persistence.Worker{UUID: block.Worker},
+			TaskType:  block.TaskType,
+		})
+	}
+
+	return &data, nil
+}
+
+func timeFromNull(nullTime sql.NullTime) *time.Time {
+	if !nullTime.Valid {
+		return nil
+	}
+	t := nullTime.Time
+	return &t
+}
+
+func nullFromTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
@@ -0,0 +1,28 @@
+package job_archive
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+
+	"projects.blender.org/studio/flamenco/internal/manager/local_storage"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+)
+
+// Generate mock implementations of these interfaces.
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks projects.blender.org/studio/flamenco/internal/manager/job_archive PersistenceService,Storage
+
+type PersistenceService interface {
+	FetchJobArchiveData(ctx context.Context, jobUUID string) (*persistence.JobArchiveData, error)
+	StoreJobArchiveData(ctx context.Context, data *persistence.JobArchiveData, beforeCommit func() error) error
+}
+
+// PersistenceService should be a subset of persistence.DB
+var _ PersistenceService = (*persistence.DB)(nil)
+
+type Storage interface {
+	// ForJob returns the directory path for storing job-related files.
+	ForJob(jobUUID string) string
+}
+
+var _ Storage = (*local_storage.StorageInfo)(nil)
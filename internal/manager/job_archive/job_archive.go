@@ -0,0 +1,332 @@
+// Package job_archive can export a job to a ZIP file, and import such a file
+// into the database again.
+//
+// The archive contains the job, its tasks, their failure lists, and the job's
+// blocklist, as JSON in `job.json`. The job's local storage directory (task
+// logs, last-rendered images) is stored under `files/`.
+//
+// Workers, worker tags, and dependency jobs are referenced by UUID. When
+// importing into a Manager that doesn't know them, those references are
+// dropped.
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+package job_archive
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/internal/uuid"
+)
+
+const (
+	// formatVersion is stored in the archive, and should be increased whenever
+	// the format changes in an incompatible way.
+	formatVersion = 1
+
+	jobFilename = "job.json"
+	filesPrefix = "files/"
+)
+
+// ErrInvalidArchive is returned when importing a file that is not a valid job archive.
+var ErrInvalidArchive = errors.New("invalid job archive")
+
+type Service struct {
+	persist PersistenceService
+	storage Storage
+}
+
+func NewService(persist PersistenceService, storage Storage) *Service {
+	return &Service{
+		persist: persist,
+		storage: storage,
+	}
+}
+
+// Export writes the job with the given UUID as ZIP file to `w`.
+func (s *Service) Export(ctx context.Context, jobUUID string, w io.Writer) error {
+	data, err := s.persist.FetchJobArchiveData(ctx, jobUUID)
+	if err != nil {
+		return err
+	}
+
+	zipWriter := zip.NewWriter(w)
+
+	jobWriter, err := zipWriter.Create(jobFilename)
+	if err != nil {
+		return fmt.Errorf("adding %s to archive: %w", jobFilename, err)
+	}
+	encoder := json.NewEncoder(jobWriter)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(archiveFromPersistence(data)); err != nil {
+		return fmt.Errorf("encoding job to JSON: %w", err)
+	}
+
+	if err := s.exportFiles(ctx, zipWriter, jobUUID); err != nil {
+		return err
+	}
+
+	return zipWriter.Close()
+}
+
+// exportFiles adds the files from the job's local storage to the archive.
+func (s *Service) exportFiles(ctx context.Context, zipWriter *zip.Writer, jobUUID string) error {
+	jobDir := s.storage.ForJob(jobUUID)
+
+	err := filepath.WalkDir(jobDir, func(filePath string, entry fs.DirEntry, err error) error {
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && filePath == jobDir:
+			// A job without local storage is fine.
+			return filepath.SkipDir
+		case err != nil:
+			return err
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case !entry.Type().IsRegular():
+			return nil
+		}
+
+		relPath, err := filepath.Rel(jobDir, filePath)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filesPrefix + filepath.ToSlash(relPath)
+		header.Method = zip.Deflate
+
+		fileWriter, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(fileWriter, file)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("adding job files to archive: %w", err)
+	}
+	return nil
+}
+
+// Import reads a ZIP file created by Export, and stores the job in the
+// database. Returns the UUID of the imported job.
+//
+// The job's files are extracted before the database transaction is committed,
+// so that a failure to store them doesn't result in a half-imported job.
+//
+// Returns persistence.ErrJobAlreadyExists when the job already exists, and an
+// error wrapping ErrInvalidArchive when the file is not a valid job archive.
+func (s *Service) Import(ctx context.Context, r io.ReaderAt, size int64) (string, error) {
+	zipReader, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	archive, err := readJobFile(zipReader)
+	if err != nil {
+		return "", err
+	}
+	jobUUID := archive.Job.UUID
+	logger := log.With().Str("job", jobUUID).Logger()
+
+	// Check the files before touching the database, so that a bad archive
+	// doesn't result in a half-imported job.
+	var files []*zip.File
+	for _, file := range zipReader.File {
+		if !strings.HasPrefix(file.Name, filesPrefix) || strings.HasSuffix(file.Name, "/") {
+			continue
+		}
+		relPath := strings.TrimPrefix(file.Name, filesPrefix)
+		if !filepath.IsLocal(filepath.FromSlash(relPath)) {
+			return "", fmt.Errorf("%w: file %q would be stored outside the job directory", ErrInvalidArchive, file.Name)
+		}
+		files = append(files, file)
+	}
+
+	data, err := archive.toPersistence()
+	if err != nil {
+		return "", err
+	}
+
+	// Extract the files next to the job directory, and only move them into
+	// place when the job has been stored.
+	jobDir := s.storage.ForJob(jobUUID)
+	var stagingDir string
+	if len(files) > 0 {
+		stagingDir, err = extractFiles(files, jobDir)
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(stagingDir)
+	}
+
+	filesMoved := false
+	staleDir := ""
+	moveFiles := func() error {
+		// The job directory can be left behind by an earlier job with the same
+		// UUID, when that job was deleted but its files could not be removed.
+		// That job no longer exists, so its files are replaced by the imported ones.
+		_, err := os.Stat(jobDir)
+		switch {
+		case err == nil:
+			logger.Warn().Str("path", jobDir).Msg("job archive: job directory already exists, replacing its contents")
+			staleDir, err = moveAside(jobDir)
+			if err != nil {
+				return fmt.Errorf("moving existing job directory out of the way: %w", err)
+			}
+		case !errors.Is(err, fs.ErrNotExist):
+			return fmt.Errorf("checking job directory: %w", err)
+		}
+
+		if stagingDir == "" {
+			return nil
+		}
+		if err := os.Rename(stagingDir, jobDir); err != nil {
+			return fmt.Errorf("moving job files into place: %w", err)
+		}
+		filesMoved = true
+		return nil
+	}
+	if err := s.persist.StoreJobArchiveData(ctx, data, moveFiles); err != nil {
+		if filesMoved {
+			// The transaction failed to commit after the files were moved.
+			os.RemoveAll(jobDir)
+		}
+		if staleDir != "" {
+			// Put back what was there before.
+			if err := os.Rename(staleDir, jobDir); err != nil {
+				logger.Error().Err(err).Str("path", staleDir).Msg("job archive: unable to move existing job directory back")
+			} else {
+				os.Remove(filepath.Dir(staleDir))
+			}
+		}
+		return "", err
+	}
+	if staleDir != "" {
+		if err := os.RemoveAll(filepath.Dir(staleDir)); err != nil {
+			logger.Warn().Err(err).Str("path", staleDir).Msg("job archive: unable to remove files of earlier job")
+		}
+	}
+
+	logger.Info().
+		Int("tasks", len(data.Tasks)).
+		Int("files", len(files)).
+		Msg("job archive: job imported")
+	return jobUUID, nil
+}
+
+// moveAside moves the directory into a new directory next to it, and returns
+// the path it was moved to.
+func moveAside(dir string) (string, error) {
+	tempDir, err := os.MkdirTemp(filepath.Dir(dir), filepath.Base(dir)+"-stale-")
+	if err != nil {
+		return "", err
+	}
+	movedDir := filepath.Join(tempDir, filepath.Base(dir))
+	if err := os.Rename(dir, movedDir); err != nil {
+		os.Remove(tempDir)
+		return "", err
+	}
+	return movedDir, nil
+}
+
+// extractFiles extracts the files into a new directory next to `jobDir`, and
+// returns the path of that directory.
+func extractFiles(files []*zip.File, jobDir string) (string, error) {
+	parentDir := filepath.Dir(jobDir)
+	if err := os.MkdirAll(parentDir, 0755); err != nil {
+		return "", fmt.Errorf("creating job storage directory: %w", err)
+	}
+	stagingDir, err := os.MkdirTemp(parentDir, filepath.Base(jobDir)+"-import-")
+	if err != nil {
+		return "", fmt.Errorf("creating job storage directory: %w", err)
+	}
+	// os.MkdirTemp() creates the directory only accessible by the current user.
+	if err := os.Chmod(stagingDir, 0755); err != nil {
+		os.RemoveAll(stagingDir)
+		return "", fmt.Errorf("creating job storage directory: %w", err)
+	}
+
+	for _, file := range files {
+		relPath := filepath.FromSlash(strings.TrimPrefix(file.Name, filesPrefix))
+		if err := extractFile(file, filepath.Join(stagingDir, relPath)); err != nil {
+			os.RemoveAll(stagingDir)
+			return "", fmt.Errorf("extracting %s: %w", file.Name, err)
+		}
+	}
+	return stagingDir, nil
+}
+
+func readJobFile(zipReader *zip.Reader) (*jobArchive, error) {
+	jobFile, err := zipReader.Open(jobFilename)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	defer jobFile.Close()
+
+	archive := jobArchive{}
+	if err := json.NewDecoder(jobFile).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("%w: decoding %s: %v", ErrInvalidArchive, jobFilename, err)
+	}
+	if archive.FormatVersion != formatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d, expected %d",
+			ErrInvalidArchive, archive.FormatVersion, formatVersion)
+	}
+	if !uuid.IsValid(archive.Job.UUID) {
+		return nil, fmt.Errorf("%w: invalid job UUID %q", ErrInvalidArchive, archive.Job.UUID)
+	}
+	return &archive, nil
+}
+
+func extractFile(file *zip.File, targetPath string) error {
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+		return err
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	target, err := os.Create(targetPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(target, reader); err != nil {
+		target.Close()
+		return err
+	}
+	if err := target.Close(); err != nil {
+		return err
+	}
+
+	return os.Chtimes(targetPath, file.Modified, file.Modified)
+}
+
+// Filename returns a suitable filename for the archive of the given job.
+func Filename(jobUUID string) string {
+	return "job-" + jobUUID + ".zip"
+}
@@ -0,0 +1,291 @@
+package job_archive
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/internal/manager/job_archive/mocks"
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+const jobUUID = "7c43a79d-5d25-4eb6-9a1e-5e3c9b6e7b32"
+
+type JobArchiveMocks struct {
+	persist *mocks.MockPersistenceService
+	storage *mocks.MockStorage
+
+	ctx context.Context
+}
+
+func TestExportImport(t *testing.T) {
+	s, finish, mocks := jobArchiveTestFixtures(t)
+	defer finish()
+
+	exportDir := t.TempDir()
+	writeFile(t, filepath.Join(exportDir, "task-1.txt"), "task log contents")
+	writeFile(t, filepath.Join(exportDir, "last-rendered.jpg"), "not really a JPEG")
+
+	data := testJobArchiveData()
+	mocks.persist.EXPECT().FetchJobArchiveData(mocks.ctx, jobUUID).Return(data, nil)
+	mocks.storage.EXPECT().ForJob(jobUUID).Return(exportDir)
+
+	buffer := bytes.Buffer{}
+	require.NoError(t, s.Export(mocks.ctx, jobUUID, &buffer))
+
+	// Import the archive, and check that the persistence layer gets the same data.
+	importDir := filepath.Join(t.TempDir(), jobUUID)
+	var stored *persistence.JobArchiveData
+	mocks.persist.EXPECT().StoreJobArchiveData(mocks.ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, data *persistence.JobArchiveData, beforeCommit func() error) error {
+			// The files should only be in place when the job is about to be committed.
+			assert.NoDirExists(t, importDir)
+			require.NoError(t, beforeCommit())
+			stored = data
+			return nil
+		})
+	mocks.storage.EXPECT().ForJob(jobUUID).Return(importDir)
+
+	importedUUID, err := s.Import(mocks.ctx, bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, jobUUID, importedUUID)
+
+	require.NotNil(t, stored)
+	assert.Equal(t, data.Job.UUID, stored.Job.UUID)
+	assert.Equal(t, data.Job.Name, stored.Job.Name)
+	assert.Equal(t, data.Job.Status, stored.Job.Status)
+	assert.Equal(t, data.Job.WorkerTag.UUID, stored.Job.WorkerTag.UUID)
+	assert.True(t, data.Job.NotBefore.Time.Equal(stored.Job.NotBefore.Time))
+	assert.False(t, stored.Job.DeleteRequestedAt.Valid)
+	assert.Equal(t, data.Job.RetryPolicy, stored.Job.RetryPolicy)
+
+	require.Len(t, stored.Tasks, 2)
+	assert.Equal(t, data.Tasks[0].UUID, stored.Tasks[0].UUID)
+	assert.Equal(t, data.Tasks[0].Worker.UUID, stored.Tasks[0].Worker.UUID)
+	assert.Nil(t, stored.Tasks[1].Worker)
+	if assert.Len(t, stored.Tasks[1].Dependencies, 1) {
+		assert.Equal(t, data.Tasks[0].UUID, stored.Tasks[1].Dependencies[0].UUID)
+	}
+
+	require.Len(t, stored.TaskFailures, 1)
+	assert.Equal(t, data.Tasks[0].UUID, stored.TaskFailures[0].Task.UUID)
+	require.Len(t, stored.Blocklist, 1)
+	assert.Equal(t, "blender", stored.Blocklist[0].TaskType)
+
+	contents, err := os.ReadFile(filepath.Join(importDir, "task-1.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "task log contents", string(contents))
+	assert.FileExists(t, filepath.Join(importDir, "last-rendered.jpg"))
+}
+
+func TestImportStoreError(t *testing.T) {
+	s, finish, mocks := jobArchiveTestFixtures(t)
+	defer finish()
+
+	zipData := createZip(t, map[string]string{
+		jobFilename:        `{"format_version": 1, "job": {"uuid": "` + jobUUID + `"}}`,
+		"files/task-1.txt": "log",
+	})
+
+	storageDir := t.TempDir()
+	importDir := filepath.Join(storageDir, jobUUID)
+	mocks.storage.EXPECT().ForJob(jobUUID).Return(importDir)
+	mocks.persist.EXPECT().StoreJobArchiveData(mocks.ctx, gomock.Any(), gomock.Any()).
+		Return(persistence.ErrJobAlreadyExists)
+
+	_, err := s.Import(mocks.ctx, bytes.NewReader(zipData), int64(len(zipData)))
+	assert.ErrorIs(t, err, persistence.ErrJobAlreadyExists)
+
+	// Nothing should be left behind.
+	assert.NoDirExists(t, importDir)
+	entries, err := os.ReadDir(storageDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestImportExistingJobDir(t *testing.T) {
+	s, finish, mocks := jobArchiveTestFixtures(t)
+	defer finish()
+
+	zipData := createZip(t, map[string]string{
+		jobFilename:        `{"format_version": 1, "job": {"uuid": "` + jobUUID + `"}}`,
+		"files/task-1.txt": "imported log",
+	})
+
+	// Files left behind by an earlier job with the same UUID.
+	storageDir := t.TempDir()
+	importDir := filepath.Join(storageDir, jobUUID)
+	require.NoError(t, os.Mkdir(importDir, 0755))
+	writeFile(t, filepath.Join(importDir, "task-1.txt"), "stale log")
+	writeFile(t, filepath.Join(importDir, "stale.txt"), "stale file")
+
+	{ // Failing to store the job should keep the existing files.
+		mocks.storage.EXPECT().ForJob(jobUUID).Return(importDir)
+		mocks.persist.EXPECT().StoreJobArchiveData(mocks.ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, data *persistence.JobArchiveData, beforeCommit func() error) error {
+				require.NoError(t, beforeCommit())
+				return persistence.ErrJobAlreadyExists
+			})
+
+		_, err := s.Import(mocks.ctx, bytes.NewReader(zipData), int64(len(zipData)))
+		require.ErrorIs(t, err, persistence.ErrJobAlreadyExists)
+
+		assert.FileExists(t, filepath.Join(importDir, "stale.txt"))
+		entries, err := os.ReadDir(storageDir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "only the job directory should exist")
+	}
+
+	{ // Storing the job should replace the existing files.
+		mocks.storage.EXPECT().ForJob(jobUUID).Return(importDir)
+		mocks.persist.EXPECT().StoreJobArchiveData(mocks.ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, data *persistence.JobArchiveData, beforeCommit func() error) error {
+				return beforeCommit()
+			})
+
+		_, err := s.Import(mocks.ctx, bytes.NewReader(zipData), int64(len(zipData)))
+		require.NoError(t, err)
+
+		contents, err := os.ReadFile(filepath.Join(importDir, "task-1.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "imported log", string(contents))
+		assert.NoFileExists(t, filepath.Join(importDir, "stale.txt"))
+		entries, err := os.ReadDir(storageDir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "only the job directory should exist")
+	}
+}
+
+func TestImportInvalidArchive(t *testing.T) {
+	s, finish, mocks := jobArchiveTestFixtures(t)
+	defer finish()
+
+	notAZip := []byte("this is not a ZIP file")
+	_, err := s.Import(mocks.ctx, bytes.NewReader(notAZip), int64(len(notAZip)))
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+
+	// ZIP file without job.json.
+	zipData := createZip(t, map[string]string{"files/task-1.txt": "log"})
+	_, err = s.Import(mocks.ctx, bytes.NewReader(zipData), int64(len(zipData)))
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+
+	// Unsupported format version.
+	zipData = createZip(t, map[string]string{
+		jobFilename: `{"format_version": 47, "job": {"uuid": "` + jobUUID + `"}}`,
+	})
+	_, err = s.Import(mocks.ctx, bytes.NewReader(zipData), int64(len(zipData)))
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func TestImportZipSlip(t *testing.T) {
+	s, finish, mocks := jobArchiveTestFixtures(t)
+	defer finish()
+
+	// The persistence layer should not be called at all.
+	zipData := createZip(t, map[string]string{
+		jobFilename:              `{"format_version": 1, "job": {"uuid": "` + jobUUID + `"}}`,
+		"files/../../etc/passwd": "root:x:0:0",
+	})
+	_, err := s.Import(mocks.ctx, bytes.NewReader(zipData), int64(len(zipData)))
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+func testJobArchiveData() *persistence.JobArchiveData {
+	created := time.Date(2024, 3, 14, 15, 9, 26, 0, time.UTC)
+	worker := &persistence.Worker{UUID: "5c4a2a3b-6a43-4f7e-9b2f-5f6a4c9c4a7e"}
+
+	task1 := &persistence.Task{
+		Model:  persistence.Model{CreatedAt: created, UpdatedAt: created},
+		UUID:   "b8d4c7e5-2c5e-4d3b-8f65-41a3a5f8c0d1",
+		Name:   "render-1-10",
+		Type:   "blender",
+		Status: api.TaskStatusFailed,
+		Worker: worker,
+		Commands: persistence.Commands{
+			{Name: "blender-render", Parameters: persistence.StringInterfaceMap{"frames": "1-10"}},
+		},
+	}
+	task2 := &persistence.Task{
+		Model:        persistence.Model{CreatedAt: created, UpdatedAt: created},
+		UUID:         "e1f0d2a4-7b6c-4c1e-9a0e-2d7f3b5e6c8a",
+		Name:         "preview-video",
+		Type:         "ffmpeg",
+		Status:       api.TaskStatusQueued,
+		Dependencies: []*persistence.Task{task1},
+	}
+
+	return &persistence.JobArchiveData{
+		Job: &persistence.Job{
+			Model:     persistence.Model{CreatedAt: created, UpdatedAt: created},
+			UUID:      jobUUID,
+			Name:      "archived job",
+			JobType:   "simple-blender-render",
+			Priority:  50,
+			Status:    api.JobStatusFailed,
+			Settings:  persistence.StringInterfaceMap{"frames": "1-10"},
+			Metadata:  persistence.StringStringMap{"project": "Sprite Fright"},
+			NotBefore: sql.NullTime{Time: created, Valid: true},
+			WorkerTag: &persistence.WorkerTag{UUID: "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"},
+			RetryPolicy: persistence.JobRetryPolicy{
+				DelaySeconds:    30,
+				AllowSameWorker: true,
+			},
+		},
+		Tasks: []*persistence.Task{task1, task2},
+		TaskFailures: []*persistence.TaskFailure{
+			{CreatedAt: created, Task: task1, Worker: worker},
+		},
+		Blocklist: []*persistence.JobBlock{
+			{CreatedAt: created, Worker: worker, TaskType: "blender"},
+		},
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+}
+
+func createZip(t *testing.T, files map[string]string) []byte {
+	buffer := bytes.Buffer{}
+	zipWriter := zip.NewWriter(&buffer)
+	for name, contents := range files {
+		fileWriter, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = fileWriter.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+	return buffer.Bytes()
+}
+
+func jobArchiveTestFixtures(t *testing.T) (*Service, func(), *JobArchiveMocks) {
+	mockCtrl := gomock.NewController(t)
+
+	mocks := &JobArchiveMocks{
+		persist: mocks.NewMockPersistenceService(mockCtrl),
+		storage: mocks.NewMockStorage(mockCtrl),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.ctx = ctx
+
+	finish := func() {
+		cancel()
+		mockCtrl.Finish()
+	}
+
+	s := NewService(mocks.persist, mocks.storage)
+	return s, finish, mocks
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projects.blender.org/studio/flamenco/internal/manager/job_archive (interfaces: PersistenceService,Storage)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	persistence "projects.blender.org/studio/flamenco/internal/manager/persistence"
+)
+
+// MockPersistenceService is a mock of PersistenceService interface.
+type MockPersistenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersistenceServiceMockRecorder
+}
+
+// MockPersistenceServiceMockRecorder is the mock recorder for MockPersistenceService.
+type MockPersistenceServiceMockRecorder struct {
+	mock *MockPersistenceService
+}
+
+// NewMockPersistenceService creates a new mock instance.
+func NewMockPersistenceService(ctrl *gomock.Controller) *MockPersistenceService {
+	mock := &MockPersistenceService{ctrl: ctrl}
+	mock.recorder = &MockPersistenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersistenceService) EXPECT() *MockPersistenceServiceMockRecorder {
+	return m.recorder
+}
+
+// FetchJobArchiveData mocks base method.
+func (m *MockPersistenceService) FetchJobArchiveData(arg0 context.Context, arg1 string) (*persistence.JobArchiveData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobArchiveData", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.JobArchiveData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobArchiveData indicates an expected call of FetchJobArchiveData.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobArchiveData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobArchiveData", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobArchiveData), arg0, arg1)
+}
+
+// StoreJobArchiveData mocks base method.
+func (m *MockPersistenceService) StoreJobArchiveData(arg0 context.Context, arg1 *persistence.JobArchiveData, arg2 func() error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreJobArchiveData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreJobArchiveData indicates an expected call of StoreJobArchiveData.
+func (mr *MockPersistenceServiceMockRecorder) StoreJobArchiveData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreJobArchiveData", reflect.TypeOf((*MockPersistenceService)(nil).StoreJobArchiveData), arg0, arg1, arg2)
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// ForJob mocks base method.
+func (m *MockStorage) ForJob(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForJob", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ForJob indicates an expected call of ForJob.
+func (mr *MockStorageMockRecorder) ForJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForJob", reflect.TypeOf((*MockStorage)(nil).ForJob), arg0)
+}
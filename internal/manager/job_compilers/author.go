@@ -34,9 +34,44 @@ type AuthoredJob struct {
 	Metadata JobMetadata
 	Storage  JobStorageInfo
 
+	// DependsOn contains the UUIDs of jobs that need to be completed before this
+	// one can run.
+	DependsOn []string
+
+	// NotBefore is the time before which the job should not start. The zero
+	// value means the job can start immediately.
+	NotBefore time.Time
+
+	// MaxWorkers is the maximum number of workers that can work on this job
+	// concurrently. Zero means unlimited.
+	MaxWorkers int
+
+	// RetryPolicy determines how failed tasks are retried. Job compiler scripts
+	// can set this in compileJob(), and it can be overridden per job when
+	// submitting it.
+	RetryPolicy RetryPolicy
+
 	Tasks []AuthoredTask
 }
 
+// RetryPolicy determines how failed tasks of a job are retried. The zero value
+// uses the Manager's configuration, and retries immediately on another Worker.
+type RetryPolicy struct {
+	// MaxFailures is the number of failures after which a task is hard-failed.
+	// Zero means the Manager's `task_fail_after_softfail_count` setting is used.
+	MaxFailures int
+
+	// DelaySeconds is the time a soft-failed task waits before it can be
+	// scheduled again. The delay doubles with every subsequent failure, up to
+	// MaxDelaySeconds. Zero means no delay.
+	DelaySeconds    int
+	MaxDelaySeconds int // Zero means no maximum.
+
+	// AllowSameWorker allows a Worker to retry a task it failed before. When
+	// false, each retry is done by a different Worker.
+	AllowSameWorker bool
+}
+
 type JobSettings map[string]interface{}
 type JobMetadata map[string]string
 
@@ -57,6 +92,12 @@ type AuthoredTask struct {
 
 	// Dependencies are tasks that need to be completed before this one can run.
 	Dependencies []*AuthoredTask `json:"omitempty" yaml:"omitempty"`
+
+	// Minimum resources a Worker needs to get this task. Workers that report
+	// fewer resources, or did not report them at all, are skipped. Zero means
+	// 'no requirement'.
+	MinCPUCount int
+	MinMemoryGB int // 1 GB = 10^9 bytes.
 }
 
 type AuthoredCommand struct {
@@ -76,12 +117,12 @@ func (a *Author) Task(name string, taskType string) (*AuthoredTask, error) {
 	}
 
 	at := AuthoredTask{
-		uuid.New(),
-		name,
-		taskType,
-		50, // TODO: handle default priority somehow.
-		make([]AuthoredCommand, 0),
-		make([]*AuthoredTask, 0),
+		UUID:         uuid.New(),
+		Name:         name,
+		Type:         taskType,
+		Priority:     50, // TODO: handle default priority somehow.
+		Commands:     make([]AuthoredCommand, 0),
+		Dependencies: make([]*AuthoredTask, 0),
 	}
 	return &at, nil
 }
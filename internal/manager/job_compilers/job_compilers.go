@@ -131,6 +131,16 @@ func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJo
 		aj.WorkerTagUUID = *sj.WorkerTag
 	}
 
+	if sj.DependsOn != nil {
+		aj.DependsOn = append(aj.DependsOn, *sj.DependsOn...)
+	}
+	if sj.NotBefore != nil {
+		aj.NotBefore = *sj.NotBefore
+	}
+	if sj.MaxWorkers != nil {
+		aj.MaxWorkers = *sj.MaxWorkers
+	}
+
 	compiler, err := vm.getCompileJob()
 	if err != nil {
 		return nil, err
@@ -139,6 +149,11 @@ func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJo
 		return nil, err
 	}
 
+	// The job's own retry policy overrides the one set by the job type.
+	if sj.RetryPolicy != nil {
+		applyRetryPolicy(&aj.RetryPolicy, *sj.RetryPolicy)
+	}
+
 	log.Info().
 		Int("num_tasks", len(aj.Tasks)).
 		Str("name", aj.Name).
@@ -149,6 +164,23 @@ func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJo
 	return &aj, nil
 }
 
+// applyRetryPolicy overwrites the retry policy with the properties that are set
+// in the API retry policy.
+func applyRetryPolicy(policy *RetryPolicy, apiPolicy api.JobRetryPolicy) {
+	if apiPolicy.MaxFailures != nil {
+		policy.MaxFailures = *apiPolicy.MaxFailures
+	}
+	if apiPolicy.DelaySeconds != nil {
+		policy.DelaySeconds = *apiPolicy.DelaySeconds
+	}
+	if apiPolicy.MaxDelaySeconds != nil {
+		policy.MaxDelaySeconds = *apiPolicy.MaxDelaySeconds
+	}
+	if apiPolicy.AllowSameWorker != nil {
+		policy.AllowSameWorker = *apiPolicy.AllowSameWorker
+	}
+}
+
 // ListJobTypes returns the list of available job types.
 func (s *Service) ListJobTypes() api.AvailableJobTypes {
 	jobTypes := make([]api.AvailableJobType, 0)
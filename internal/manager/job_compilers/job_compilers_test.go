@@ -139,6 +139,89 @@ func TestSimpleBlenderRenderHappy(t *testing.T) {
 	assert.Equal(t, expectDeps, tVideo.Dependencies)
 }
 
+func TestSimpleBlenderRenderMinMemory(t *testing.T) {
+	c := mockedClock(t)
+
+	s, err := Load(c)
+	require.NoError(t, err)
+
+	// Compiling a job should be really fast.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	sj := exampleSubmittedJob()
+
+	{ // Without the setting, there should be no requirement.
+		aj, err := s.Compile(ctx, sj)
+		require.NoError(t, err)
+		for _, task := range aj.Tasks {
+			assert.Zero(t, task.MinMemoryGB, "task %s", task.Name)
+			assert.Zero(t, task.MinCPUCount, "task %s", task.Name)
+		}
+	}
+
+	{ // With the setting, only render tasks should get the requirement.
+		sj.Settings.AdditionalProperties["min_memory_gb"] = 32
+		aj, err := s.Compile(ctx, sj)
+		require.NoError(t, err)
+		require.Len(t, aj.Tasks, 5)
+		for _, task := range aj.Tasks[:4] {
+			assert.Equal(t, 32, task.MinMemoryGB, "task %s", task.Name)
+		}
+		assert.Equal(t, "preview-video", aj.Tasks[4].Name)
+		assert.Zero(t, aj.Tasks[4].MinMemoryGB)
+	}
+}
+
+func TestJobRetryPolicy(t *testing.T) {
+	c := mockedClock(t)
+
+	s, err := Load(c)
+	require.NoError(t, err)
+
+	// Compiling a job should be really fast.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	sj := exampleSubmittedJob()
+
+	{ // Without a retry policy, the job should use the defaults.
+		aj, err := s.Compile(ctx, sj)
+		require.NoError(t, err)
+		assert.Zero(t, aj.RetryPolicy)
+	}
+
+	{ // Only the submitted properties should be overridden.
+		sj.RetryPolicy = &api.JobRetryPolicy{
+			DelaySeconds:    ptr(30),
+			AllowSameWorker: ptr(true),
+		}
+		aj, err := s.Compile(ctx, sj)
+		require.NoError(t, err)
+		assert.Equal(t, RetryPolicy{DelaySeconds: 30, AllowSameWorker: true}, aj.RetryPolicy)
+	}
+}
+
+func TestJobRetryPolicyFromJobType(t *testing.T) {
+	s, err := Load(mockedClock(t))
+	require.NoError(t, err)
+
+	vm := VM{runtime: newGojaVM(s.registry)}
+	_, err = vm.runtime.RunString(`
+function compileJob(job) {
+	job.retryPolicy.maxFailures = 5;
+	job.retryPolicy.delaySeconds = 60;
+}`)
+	require.NoError(t, err)
+
+	compiler, err := vm.getCompileJob()
+	require.NoError(t, err)
+
+	aj := AuthoredJob{}
+	require.NoError(t, compiler(&aj))
+	assert.Equal(t, RetryPolicy{MaxFailures: 5, DelaySeconds: 60}, aj.RetryPolicy)
+}
+
 func TestJobWithoutTag(t *testing.T) {
 	c := mockedClock(t)
 
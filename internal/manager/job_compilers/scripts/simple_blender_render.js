@@ -13,6 +13,8 @@ const JOB_TYPE = {
           description: "Frame range to render. Examples: '47', '1-30', '3, 5-10, 47-327'" },
         { key: "chunk_size", type: "int32", default: 1, description: "Number of frames to render in one Blender render task",
           visible: "submission" },
+        { key: "min_memory_gb", type: "int32", default: 0, propargs: {min: 0}, visible: "submission",
+          description: "Minimum amount of RAM, in GB, of the Workers that render this job. Use 0 for no minimum" },
 
         // render_output_root + add_path_components determine the value of render_output_path.
         { key: "render_output_root", type: "string", subtype: "dir_path", required: true, visible: "submission",
@@ -101,6 +103,7 @@ function authorRenderTasks(settings, renderDir, renderOutput) {
     let chunks = frameChunker(settings.frames, settings.chunk_size);
     for (let chunk of chunks) {
         const task = author.Task(`render-${chunk}`, "blender");
+        task.minMemoryGB = settings.min_memory_gb || 0;
         const command = author.Command("blender-render", {
             exe: "{blender}",
             exeArgs: "{blenderArgs}",
@@ -0,0 +1,117 @@
+package last_rendered
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	// DefaultContactSheetColumns is the number of thumbnails per row, when not
+	// specified otherwise.
+	DefaultContactSheetColumns = 8
+
+	contactSheetPadding     = 4
+	contactSheetLabelHeight = 16
+)
+
+var (
+	ErrNoHistory = errors.New("job has no last-rendered history")
+
+	contactSheetBackground = color.RGBA{0x20, 0x20, 0x20, 0xff}
+	contactSheetLabel      = color.RGBA{0xdd, 0xdd, 0xdd, 0xff}
+)
+
+// ContactSheet combines the history of last-rendered images of the job into a
+// single image, with the thumbnails ordered by frame number and labelled with
+// that number. Returns `ErrNoHistory` if the job has no history.
+func (lrp *LastRenderedProcessor) ContactSheet(jobUUID string, columns int) (image.Image, error) {
+	if columns < 1 {
+		columns = DefaultContactSheetColumns
+	}
+
+	history, err := lrp.JobHistory(jobUUID)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, ErrNoHistory
+	}
+	if len(history) < columns {
+		columns = len(history)
+	}
+	rows := (len(history) + columns - 1) / columns
+
+	spec := historyThumbspec()
+	cellWidth := spec.MaxWidth + 2*contactSheetPadding
+	cellHeight := spec.MaxHeight + 2*contactSheetPadding + contactSheetLabelHeight
+
+	sheet := image.NewRGBA(image.Rect(0, 0, columns*cellWidth, rows*cellHeight))
+	draw.Draw(sheet, sheet.Bounds(), image.NewUniform(contactSheetBackground), image.Point{}, draw.Src)
+
+	historyDir := lrp.HistoryPathForJob(jobUUID)
+	for idx, item := range history {
+		cell := image.Rect(0, 0, cellWidth, cellHeight).Add(image.Pt(
+			(idx%columns)*cellWidth,
+			(idx/columns)*cellHeight,
+		))
+
+		thumb, err := loadImage(filepath.Join(historyDir, item.Filename))
+		if err != nil {
+			return nil, err
+		}
+
+		// Center the thumbnail in the space above the label.
+		thumbBounds := thumb.Bounds()
+		offset := image.Pt(
+			cell.Min.X+(cellWidth-thumbBounds.Dx())/2,
+			cell.Min.Y+contactSheetPadding+(spec.MaxHeight-thumbBounds.Dy())/2,
+		)
+		draw.Draw(sheet, thumbBounds.Sub(thumbBounds.Min).Add(offset), thumb, thumbBounds.Min, draw.Src)
+
+		drawLabel(sheet, cell, strconv.Itoa(item.Frame))
+	}
+
+	return sheet, nil
+}
+
+// drawLabel writes the text centered at the bottom of the cell.
+func drawLabel(img draw.Image, cell image.Rectangle, text string) {
+	face := basicfont.Face7x13
+	drawer := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(contactSheetLabel),
+		Face: face,
+	}
+	textWidth := drawer.MeasureString(text).Ceil()
+	drawer.Dot = fixed.P(
+		cell.Min.X+(cell.Dx()-textWidth)/2,
+		cell.Max.Y-contactSheetPadding-face.Descent,
+	)
+	drawer.DrawString(text)
+}
+
+func loadImage(imgpath string) (image.Image, error) {
+	file, err := os.Open(imgpath)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", imgpath, err)
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", imgpath, err)
+	}
+	return img, nil
+}
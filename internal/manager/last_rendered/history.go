@@ -0,0 +1,149 @@
+package last_rendered
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// HistoryDirname is the name of the directory, inside the job directory,
+	// that contains the thumbnails of previously rendered frames.
+	HistoryDirname = "last-rendered-history"
+
+	// maxHistoryImages is the maximum number of frames kept in the history of
+	// a job. When more frames are rendered, the oldest ones are removed.
+	maxHistoryImages = 500
+)
+
+var (
+	// framePattern finds the frame number in a filename, which is assumed to be
+	// the last group of digits.
+	framePattern = regexp.MustCompile(`(\d+)\D*$`)
+
+	// historyFilenamePattern matches the filenames written by historyFilename().
+	historyFilenamePattern = regexp.MustCompile(`^frame-(\d+)\.jpg$`)
+)
+
+// HistoryImage is a thumbnail of a previously rendered frame.
+type HistoryImage struct {
+	Frame      int
+	Filename   string // Filename relative to the history directory.
+	RenderedAt time.Time
+}
+
+// FrameFromFilename returns the frame number of a rendered image, based on its
+// filename. For example, "shot_010-0047.exr" is frame 47. Returns false if the
+// filename has no frame number.
+func FrameFromFilename(filename string) (int, bool) {
+	basename := filepath.Base(filename)
+	basename = basename[:len(basename)-len(filepath.Ext(basename))]
+
+	match := framePattern.FindStringSubmatch(basename)
+	if match == nil {
+		return 0, false
+	}
+	frame, err := strconv.Atoi(match[1])
+	if err != nil {
+		// Too many digits to fit in an int.
+		return 0, false
+	}
+	return frame, true
+}
+
+// historyThumbspec returns the size of the history thumbnails. This is the
+// smallest of the last-rendered thumbnails.
+func historyThumbspec() Thumbspec {
+	return thumbnails[len(thumbnails)-1]
+}
+
+func historyFilename(frame int) string {
+	return fmt.Sprintf("frame-%06d.jpg", frame)
+}
+
+// HistoryPathForJob returns the directory that contains the history of
+// last-rendered images of this job.
+func (lrp *LastRenderedProcessor) HistoryPathForJob(jobUUID string) string {
+	return filepath.Join(lrp.PathForJob(jobUUID), HistoryDirname)
+}
+
+// JobHistory returns the thumbnails of previously rendered frames of the job,
+// ordered by frame number.
+func (lrp *LastRenderedProcessor) JobHistory(jobUUID string) ([]HistoryImage, error) {
+	historyDir := lrp.HistoryPathForJob(jobUUID)
+	entries, err := os.ReadDir(historyDir)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return []HistoryImage{}, nil
+	case err != nil:
+		return nil, fmt.Errorf("reading history directory %s: %w", historyDir, err)
+	}
+
+	history := []HistoryImage{}
+	for _, entry := range entries {
+		match := historyFilenamePattern.FindStringSubmatch(entry.Name())
+		if match == nil || !entry.Type().IsRegular() {
+			continue
+		}
+		frame, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			// The file may have been removed in the mean time.
+			continue
+		}
+		history = append(history, HistoryImage{
+			Frame:      frame,
+			Filename:   entry.Name(),
+			RenderedAt: info.ModTime(),
+		})
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Frame < history[j].Frame
+	})
+	return history, nil
+}
+
+// saveHistoryImage stores the thumbnail in the history of the job, replacing
+// any previous thumbnail of the same frame, and removes the oldest thumbnails
+// when there are too many.
+func (lrp *LastRenderedProcessor) saveHistoryImage(logger zerolog.Logger, jobUUID string, frame int, img image.Image) {
+	imgpath := filepath.Join(lrp.HistoryPathForJob(jobUUID), historyFilename(frame))
+	if err := saveJPEG(imgpath, img); err != nil {
+		logger.Error().Err(err).Msg("last-rendered: error saving history thumbnail")
+		return
+	}
+
+	history, err := lrp.JobHistory(jobUUID)
+	if err != nil {
+		logger.Error().Err(err).Msg("last-rendered: error inspecting history")
+		return
+	}
+	if len(history) <= maxHistoryImages {
+		return
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].RenderedAt.Before(history[j].RenderedAt)
+	})
+	for _, old := range history[:len(history)-maxHistoryImages] {
+		oldpath := filepath.Join(lrp.HistoryPathForJob(jobUUID), old.Filename)
+		if err := os.Remove(oldpath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logger.Warn().Err(err).Str("path", oldpath).Msg("last-rendered: error removing old history thumbnail")
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package last_rendered
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"projects.blender.org/studio/flamenco/internal/manager/local_storage"
+	"projects.blender.org/studio/flamenco/pkg/exr"
+)
+
+func TestFrameFromFilename(t *testing.T) {
+	tests := []struct {
+		filename  string
+		wantFrame int
+		wantOK    bool
+	}{
+		{"frame-0047.png", 47, true},
+		{"/render/shot_010/shot_010-0003.exr", 3, true},
+		{"shot_010_v2_1001.jpg", 1001, true},
+		{"0012_final.tiff", 12, true},
+		{"frame.png", 0, false},
+		{"", 0, false},
+		{"99999999999999999999999.png", 0, false},
+	}
+	for _, test := range tests {
+		frame, ok := FrameFromFilename(test.filename)
+		assert.Equal(t, test.wantOK, ok, "filename %q", test.filename)
+		assert.Equal(t, test.wantFrame, frame, "filename %q", test.filename)
+	}
+}
+
+func TestProcessImageHistory(t *testing.T) {
+	imgBytes, err := os.ReadFile("last_rendered_test.jpg")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	storage := local_storage.NewNextToExe("lrp")
+	defer storage.MustErase()
+	lrp := New(storage, exr.Options{})
+
+	jobID := "e078438b-c9f5-43e6-9e86-52f8be91dd12"
+	payload := Payload{
+		JobUUID:  jobID,
+		MimeType: "image/jpeg",
+		Image:    imgBytes,
+	}
+
+	// Without filename, no history should be kept.
+	lrp.processImage(context.Background(), payload)
+	history, err := lrp.JobHistory(jobID)
+	assert.NoError(t, err)
+	assert.Empty(t, history)
+
+	// With frame number, the smallest thumbnail should be kept.
+	payload.Filename = "frame-0047.png"
+	lrp.processImage(context.Background(), payload)
+	payload.Filename = "frame-0012.png"
+	lrp.processImage(context.Background(), payload)
+	payload.Filename = "frame-0047.png"
+	lrp.processImage(context.Background(), payload)
+
+	history, err = lrp.JobHistory(jobID)
+	assert.NoError(t, err)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, 12, history[0].Frame)
+		assert.Equal(t, "frame-000012.jpg", history[0].Filename)
+		assert.Equal(t, 47, history[1].Frame)
+		assert.Equal(t, "frame-000047.jpg", history[1].Filename)
+	}
+
+	historyImagePath := filepath.Join(lrp.HistoryPathForJob(jobID), "frame-000047.jpg")
+	thumb, err := loadImage(historyImagePath)
+	if assert.NoError(t, err) {
+		spec := historyThumbspec()
+		assert.LessOrEqual(t, thumb.Bounds().Dx(), spec.MaxWidth)
+		assert.LessOrEqual(t, thumb.Bounds().Dy(), spec.MaxHeight)
+	}
+}
+
+func TestHistoryPruning(t *testing.T) {
+	storage := local_storage.NewNextToExe("lrp")
+	defer storage.MustErase()
+	lrp := New(storage, exr.Options{})
+
+	jobID := "e078438b-c9f5-43e6-9e86-52f8be91dd12"
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+
+	for frame := 1; frame <= maxHistoryImages; frame++ {
+		lrp.saveHistoryImage(log.Logger, jobID, frame, img)
+	}
+
+	// Make frame 1 the most recently rendered one, and frame 2 the oldest.
+	historyDir := lrp.HistoryPathForJob(jobID)
+	now := time.Now()
+	for frame := 1; frame <= maxHistoryImages; frame++ {
+		mtime := now.Add(time.Duration(frame-maxHistoryImages) * time.Second)
+		if frame == 1 {
+			mtime = now.Add(time.Second)
+		}
+		imgpath := filepath.Join(historyDir, historyFilename(frame))
+		if !assert.NoError(t, os.Chtimes(imgpath, mtime, mtime)) {
+			t.FailNow()
+		}
+	}
+
+	lrp.saveHistoryImage(log.Logger, jobID, maxHistoryImages+1, img)
+
+	history, err := lrp.JobHistory(jobID)
+	assert.NoError(t, err)
+	assert.Len(t, history, maxHistoryImages)
+	assert.FileExists(t, filepath.Join(historyDir, historyFilename(1)))
+	assert.NoFileExists(t, filepath.Join(historyDir, historyFilename(2)))
+	assert.FileExists(t, filepath.Join(historyDir, historyFilename(maxHistoryImages+1)))
+}
+
+func TestContactSheet(t *testing.T) {
+	storage := local_storage.NewNextToExe("lrp")
+	defer storage.MustErase()
+	lrp := New(storage, exr.Options{})
+
+	jobID := "e078438b-c9f5-43e6-9e86-52f8be91dd12"
+
+	_, err := lrp.ContactSheet(jobID, 3)
+	assert.ErrorIs(t, err, ErrNoHistory)
+
+	spec := historyThumbspec()
+	img := image.NewRGBA(image.Rect(0, 0, spec.MaxWidth, spec.MaxHeight/2))
+	for _, frame := range []int{1, 2, 3, 10, 11} {
+		lrp.saveHistoryImage(log.Logger, jobID, frame, img)
+	}
+
+	sheet, err := lrp.ContactSheet(jobID, 3)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	cellWidth := spec.MaxWidth + 2*contactSheetPadding
+	cellHeight := spec.MaxHeight + 2*contactSheetPadding + contactSheetLabelHeight
+	assert.Equal(t, 3*cellWidth, sheet.Bounds().Dx())
+	assert.Equal(t, 2*cellHeight, sheet.Bounds().Dy())
+
+	// Fewer images than columns should not produce empty columns.
+	sheet, err = lrp.ContactSheet(jobID, 32)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 5*cellWidth, sheet.Bounds().Dx())
+		assert.Equal(t, cellHeight, sheet.Bounds().Dy())
+	}
+}
@@ -16,12 +16,15 @@ import (
 
 	"github.com/disintegration/imaging"
 	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/exr"
 )
 
 var (
 	supportedMimeTypes = map[string]bool{
 		"image/jpeg": true,
 		"image/png":  true,
+		exr.MimeType: true,
 	}
 
 	ErrMimeTypeUnsupported = errors.New("mime type unsupported")
@@ -29,13 +32,21 @@ var (
 
 // decodeImage checks the payload mime type, and if okay, decodes the image and returns it.
 // Returns `ErrMimeTypeUnsupported` if the mime type is unsupported.
-func decodeImage(payload Payload) (image.Image, error) {
+func decodeImage(payload Payload, exrOptions exr.Options) (image.Image, error) {
 	if !supportedMimeTypes[payload.MimeType] {
 		return nil, ErrMimeTypeUnsupported
 	}
 
+	var (
+		img image.Image
+		err error
+	)
 	reader := bytes.NewReader(payload.Image)
-	img, _, err := image.Decode(reader)
+	if payload.MimeType == exr.MimeType {
+		img, err = exr.DecodeWithOptions(reader, exrOptions)
+	} else {
+		img, _, err = image.Decode(reader)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("decoding image: %w", err)
 	}
@@ -8,18 +8,22 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/exr"
 )
 
 const (
 	// MaxImageSizeBytes is the maximum size in bytes allowed for to-be-processed images.
 	MaxImageSizeBytes int64 = 25 * 1024 * 1024
 
-	// queueSize determines how many images can be queued in memory before rejecting
-	// new requests to process.
-	queueSize = 3
+	// maxQueuedJobs determines how many jobs can have an image queued in memory
+	// before rejecting new requests to process. Each job has at most one image
+	// queued, as a newer image replaces the older one.
+	maxQueuedJobs = 8
 
 	thumbnailJPEGQuality = 85
 )
@@ -44,12 +48,38 @@ type Storage interface {
 
 // LastRenderedProcessor processes "last-rendered" images and stores them with
 // the job.
+//
+// Only the newest image of each job is kept in the queue, and jobs are handled
+// round-robin. This way a job that produces lots of images cannot block the
+// queue for other jobs.
 type LastRenderedProcessor struct {
 	storage Storage
 
-	// TODO: expand this queue to be per job, so that one spammy job doesn't block
-	// the queue for other jobs.
-	queue chan Payload
+	// exrOptions determine how EXR images are decoded.
+	exrOptions exr.Options
+
+	mutex sync.Mutex
+	// pending maps job UUID to the newest to-be-processed image of that job.
+	pending map[string]Payload
+	// jobQueue contains the UUIDs of the jobs in `pending`, in the order in
+	// which they will be handled.
+	jobQueue []string
+	// wakeup is signalled whenever a new job is added to the queue.
+	wakeup chan struct{}
+
+	stats QueueStats
+}
+
+// QueueStats contains metrics of the processing queue.
+type QueueStats struct {
+	QueuedJobs    []string // UUIDs of the jobs with a queued image, in processing order.
+	MaxQueuedJobs int
+
+	// Counters since the start of the Manager:
+	ImagesQueued    int64 // Images accepted into the queue.
+	ImagesReplaced  int64 // Queued images that were replaced by a newer image of the same job.
+	ImagesRejected  int64 // Images rejected because the queue was full.
+	ImagesProcessed int64 // Images taken from the queue for processing.
 }
 
 // Payload contains the actual image to process.
@@ -59,6 +89,10 @@ type Payload struct {
 	MimeType   string
 	Image      []byte
 
+	// Filename of the rendered image, used to determine its frame number for
+	// the history of last-rendered images. Optional.
+	Filename string
+
 	// Callback is called when the image processing is finished.
 	Callback func(ctx context.Context)
 }
@@ -70,10 +104,12 @@ type Thumbspec struct {
 	MaxHeight int
 }
 
-func New(storage Storage) *LastRenderedProcessor {
+func New(storage Storage, exrOptions exr.Options) *LastRenderedProcessor {
 	return &LastRenderedProcessor{
-		storage: storage,
-		queue:   make(chan Payload, queueSize),
+		storage:    storage,
+		exrOptions: exrOptions,
+		pending:    map[string]Payload{},
+		wakeup:     make(chan struct{}, 1),
 	}
 }
 
@@ -84,27 +120,88 @@ func (lrp *LastRenderedProcessor) Run(ctx context.Context) {
 	defer log.Debug().Msg("last-rendered: queue runner shutting down")
 
 	for {
+		payload, ok := lrp.nextPayload()
+		if ok {
+			lrp.processImage(ctx, payload)
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
 		select {
 		case <-ctx.Done():
 			return
-		case payload := <-lrp.queue:
-			lrp.processImage(ctx, payload)
+		case <-lrp.wakeup:
 		}
 	}
 }
 
-// QueueImage queues an image for processing.
-// Returns `ErrQueueFull` if there is no more space in the queue for new images.
+// QueueImage queues an image for processing. If the job already has an image
+// queued, that one is replaced by this one, and the job keeps its place in
+// the queue. Returns `ErrQueueFull` if there is no more space in the queue for
+// new jobs.
 func (lrp *LastRenderedProcessor) QueueImage(payload Payload) error {
 	logger := payload.sublogger(log.Logger)
-	select {
-	case lrp.queue <- payload:
-		logger.Debug().Msg("last-rendered: queued image for processing")
+
+	lrp.mutex.Lock()
+	defer lrp.mutex.Unlock()
+
+	if _, hasPending := lrp.pending[payload.JobUUID]; hasPending {
+		lrp.pending[payload.JobUUID] = payload
+		lrp.stats.ImagesQueued++
+		lrp.stats.ImagesReplaced++
+		logger.Debug().Msg("last-rendered: replaced queued image of this job")
 		return nil
-	default:
+	}
+
+	if len(lrp.jobQueue) >= maxQueuedJobs {
+		lrp.stats.ImagesRejected++
 		logger.Debug().Msg("last-rendered: unable to queue image for processing")
 		return ErrQueueFull
 	}
+
+	lrp.pending[payload.JobUUID] = payload
+	lrp.jobQueue = append(lrp.jobQueue, payload.JobUUID)
+	lrp.stats.ImagesQueued++
+	logger.Debug().Msg("last-rendered: queued image for processing")
+
+	// Wake up the queue runner, if it's not already awake.
+	select {
+	case lrp.wakeup <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// QueueStats returns metrics of the processing queue.
+func (lrp *LastRenderedProcessor) QueueStats() QueueStats {
+	lrp.mutex.Lock()
+	defer lrp.mutex.Unlock()
+
+	stats := lrp.stats
+	stats.QueuedJobs = make([]string, len(lrp.jobQueue))
+	copy(stats.QueuedJobs, lrp.jobQueue)
+	stats.MaxQueuedJobs = maxQueuedJobs
+	return stats
+}
+
+// nextPayload pops the image of the first job in the queue. Returns false if
+// the queue is empty.
+func (lrp *LastRenderedProcessor) nextPayload() (Payload, bool) {
+	lrp.mutex.Lock()
+	defer lrp.mutex.Unlock()
+
+	if len(lrp.jobQueue) == 0 {
+		return Payload{}, false
+	}
+
+	jobUUID := lrp.jobQueue[0]
+	lrp.jobQueue = lrp.jobQueue[1:]
+	payload := lrp.pending[jobUUID]
+	delete(lrp.pending, jobUUID)
+	lrp.stats.ImagesProcessed++
+	return payload, true
 }
 
 // PathForJob returns the base path for this job's last-rendered images.
@@ -153,13 +250,14 @@ func (lrp *LastRenderedProcessor) processImage(ctx context.Context, payload Payl
 	logger = payload.sublogger(logger)
 
 	// Decode the image.
-	image, err := decodeImage(payload)
+	image, err := decodeImage(payload, lrp.exrOptions)
 	if err != nil {
 		logger.Error().Err(err).Msg("last-rendered: unable to decode image")
 		return
 	}
 
 	// Generate the thumbnails.
+	thumbnailsOK := true
 	for _, spec := range thumbnails {
 		thumbLogger := spec.sublogger(logger)
 		thumbLogger.Trace().Msg("last-rendered: creating thumbnail")
@@ -169,10 +267,16 @@ func (lrp *LastRenderedProcessor) processImage(ctx context.Context, payload Payl
 		imgpath := filepath.Join(jobDir, spec.Filename)
 		if err := saveJPEG(imgpath, image); err != nil {
 			thumbLogger.Error().Err(err).Msg("last-rendered: error saving thumbnail")
+			thumbnailsOK = false
 			break
 		}
 	}
 
+	// Keep the smallest thumbnail in the history of this job.
+	if frame, ok := FrameFromFilename(payload.Filename); ok && thumbnailsOK {
+		lrp.saveHistoryImage(logger.With().Int("frame", frame).Logger(), payload.JobUUID, frame, image)
+	}
+
 	// Call the callback, if provided.
 	if payload.Callback != nil {
 		payload.Callback(ctx)
@@ -184,6 +288,7 @@ func (p Payload) sublogger(logger zerolog.Logger) zerolog.Logger {
 		Str("job", p.JobUUID).
 		Str("producedByWorker", p.WorkerUUID).
 		Str("mime", p.MimeType).
+		Str("filename", p.Filename).
 		Logger()
 }
 
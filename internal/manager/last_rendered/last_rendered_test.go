@@ -4,44 +4,145 @@ package last_rendered
 
 import (
 	"context"
+	"fmt"
 	"image"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"projects.blender.org/studio/flamenco/internal/manager/local_storage"
+	"projects.blender.org/studio/flamenco/pkg/exr"
 )
 
 func TestNew(t *testing.T) {
 	storage := local_storage.NewNextToExe("lrp")
 	defer storage.MustErase()
 
-	lrp := New(storage)
+	lrp := New(storage, exr.Options{})
 	assert.Equal(t, lrp.storage, storage)
-	assert.NotNil(t, lrp.queue)
+	assert.NotNil(t, lrp.pending)
+	assert.NotNil(t, lrp.wakeup)
 }
 
 func TestQueueImage(t *testing.T) {
-	// Canary test.
-	if !assert.Equal(t, queueSize, 3) {
-		t.Fatalf("queueSize must be 3 for this test, but is %v", queueSize)
+	storage := local_storage.NewNextToExe("lrp")
+	defer storage.MustErase()
+	lrp := New(storage, exr.Options{})
+
+	payloadForJob := func(jobIndex int) Payload {
+		return Payload{
+			JobUUID:  fmt.Sprintf("2205227c-593c-46ac-a0d7-%012d", jobIndex),
+			MimeType: "image/png",
+			Image:    []byte("PNG file contents"),
+		}
 	}
 
-	payload := Payload{
-		JobUUID:  "2205227c-593c-46ac-a0d7-e115d4e80dd4",
-		MimeType: "image/png",
-		Image:    []byte("PNG file contents"),
+	// Each job gets a place in the queue.
+	for jobIndex := 0; jobIndex < maxQueuedJobs; jobIndex++ {
+		assert.NoError(t, lrp.QueueImage(payloadForJob(jobIndex)))
 	}
+	assert.ErrorIs(t, lrp.QueueImage(payloadForJob(maxQueuedJobs)), ErrQueueFull)
+
+	// Jobs that already have a place in the queue can still replace their image.
+	newerImage := payloadForJob(1)
+	newerImage.Image = []byte("newer PNG file contents")
+	assert.NoError(t, lrp.QueueImage(newerImage))
+
+	stats := lrp.QueueStats()
+	assert.Len(t, stats.QueuedJobs, maxQueuedJobs)
+	assert.Equal(t, maxQueuedJobs, stats.MaxQueuedJobs)
+	assert.Equal(t, int64(maxQueuedJobs+1), stats.ImagesQueued)
+	assert.Equal(t, int64(1), stats.ImagesReplaced)
+	assert.Equal(t, int64(1), stats.ImagesRejected)
+	assert.Equal(t, int64(0), stats.ImagesProcessed)
+
+	// The replaced image should keep the job's place in the queue.
+	payload, ok := lrp.nextPayload()
+	assert.True(t, ok)
+	assert.Equal(t, payloadForJob(0), payload)
+	payload, ok = lrp.nextPayload()
+	assert.True(t, ok)
+	assert.Equal(t, newerImage, payload)
+
+	// Popping images should make space for other jobs.
+	assert.NoError(t, lrp.QueueImage(payloadForJob(maxQueuedJobs)))
+	assert.NoError(t, lrp.QueueImage(payloadForJob(maxQueuedJobs+1)))
+	assert.ErrorIs(t, lrp.QueueImage(payloadForJob(maxQueuedJobs+2)), ErrQueueFull)
+	assert.Equal(t, int64(2), lrp.QueueStats().ImagesProcessed)
+}
 
+func TestQueueRoundRobin(t *testing.T) {
 	storage := local_storage.NewNextToExe("lrp")
 	defer storage.MustErase()
-	lrp := New(storage)
+	lrp := New(storage, exr.Options{})
+
+	const (
+		spammyJob = "8bd4e92a-2f6a-4a2b-a2a1-3d1e6b5cb1e5"
+		quietJob  = "b9ed1c0c-0f8c-4b7d-a0b0-5c5e4a3c2d1f"
+	)
+	queue := func(jobUUID string) {
+		assert.NoError(t, lrp.QueueImage(Payload{JobUUID: jobUUID, MimeType: "image/png"}))
+	}
+	next := func() string {
+		payload, ok := lrp.nextPayload()
+		if !assert.True(t, ok, "expected an image in the queue") {
+			return ""
+		}
+		return payload.JobUUID
+	}
+
+	queue(spammyJob)
+	queue(spammyJob)
+	queue(quietJob)
+	queue(spammyJob)
+
+	// The spammy job only gets one turn before the other job.
+	assert.Equal(t, spammyJob, next())
+	queue(spammyJob)
+	assert.Equal(t, quietJob, next())
+	assert.Equal(t, spammyJob, next())
+
+	_, ok := lrp.nextPayload()
+	assert.False(t, ok, "queue should be empty")
+}
+
+func TestRunProcessesQueue(t *testing.T) {
+	imgBytes, err := os.ReadFile("last_rendered_test.jpg")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	storage := local_storage.NewNextToExe("lrp")
+	defer storage.MustErase()
+	lrp := New(storage, exr.Options{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runDone := make(chan struct{})
+	go func() {
+		defer close(runDone)
+		lrp.Run(ctx)
+	}()
+
+	processed := make(chan struct{})
+	assert.NoError(t, lrp.QueueImage(Payload{
+		JobUUID:  "e078438b-c9f5-43e6-9e86-52f8be91dd12",
+		MimeType: "image/jpeg",
+		Image:    imgBytes,
+		Callback: func(context.Context) { close(processed) },
+	}))
+
+	select {
+	case <-processed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("queued image should have been processed")
+	}
 
-	assert.NoError(t, lrp.QueueImage(payload))
-	assert.NoError(t, lrp.QueueImage(payload))
-	assert.NoError(t, lrp.QueueImage(payload))
-	assert.ErrorIs(t, lrp.QueueImage(payload), ErrQueueFull)
+	cancel()
+	<-runDone
+	assert.Equal(t, int64(1), lrp.QueueStats().ImagesProcessed)
 }
 
 func TestProcessImage(t *testing.T) {
@@ -61,7 +162,7 @@ func TestProcessImage(t *testing.T) {
 
 	storage := local_storage.NewNextToExe("lrp")
 	defer storage.MustErase()
-	lrp := New(storage)
+	lrp := New(storage, exr.Options{})
 
 	callbackCount := 0
 	payload.Callback = func(context.Context) {
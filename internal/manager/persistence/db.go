@@ -10,14 +10,14 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
-
-	// sqlite "projects.blender.org/studio/flamenco/pkg/gorm-modernc-sqlite"
-	"github.com/glebarez/sqlite"
 )
 
 // DB provides the database interface.
 type DB struct {
 	gormDB *gorm.DB
+
+	// fairShare determines how ScheduleTask spreads Workers over jobs.
+	fairShare FairShare
 }
 
 // Model contains the common database fields for most model structs.
@@ -31,7 +31,7 @@ type Model struct {
 }
 
 func OpenDB(ctx context.Context, dsn string) (*DB, error) {
-	log.Info().Str("dsn", dsn).Msg("opening database")
+	log.Info().Str("dsn", RedactDSN(dsn)).Msg("opening database")
 
 	db, err := openDB(ctx, dsn)
 	if err != nil {
@@ -50,8 +50,10 @@ func OpenDB(ctx context.Context, dsn string) (*DB, error) {
 		}
 	}()
 
-	if err := setBusyTimeout(db.gormDB, 5*time.Second); err != nil {
-		return nil, err
+	if !db.isPostgres() {
+		if err := setBusyTimeout(db.gormDB, 5*time.Second); err != nil {
+			return nil, err
+		}
 	}
 
 	// Perfom some maintenance at startup, before trying to migrate the database.
@@ -88,7 +90,7 @@ func openDB(ctx context.Context, dsn string) (*DB, error) {
 }
 
 func openDBWithConfig(dsn string, config *gorm.Config) (*DB, error) {
-	dialector := sqlite.Open(dsn)
+	dialector := dialectorForDSN(dsn)
 	gormDB, err := gorm.Open(dialector, config)
 	if err != nil {
 		return nil, err
@@ -110,6 +112,13 @@ func openDBWithConfig(dsn string, config *gorm.Config) (*DB, error) {
 		}
 	}()
 
+	if db.isPostgres() {
+		// PostgreSQL handles concurrent connections and foreign keys by itself, so
+		// none of the SQLite-specific setup below is necessary.
+		closeConnOnReturn = false
+		return &db, nil
+	}
+
 	// Use the generic sql.DB interface to set some connection pool options.
 	sqlDB, err := gormDB.DB()
 	if err != nil {
@@ -147,7 +156,11 @@ func nowFunc() time.Time {
 }
 
 // vacuum executes the SQL "VACUUM" command, and logs any errors.
+// This is skipped on PostgreSQL, as that has its own autovacuum daemon.
 func (db *DB) vacuum() {
+	if db.isPostgres() {
+		return
+	}
 	tx := db.gormDB.Exec("vacuum")
 	if tx.Error != nil {
 		log.Error().Err(tx.Error).Msg("error vacuuming database")
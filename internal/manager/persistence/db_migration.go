@@ -12,10 +12,14 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-//go:embed migrations/*.sql
+//go:embed migrations/*.sql migrations/postgres/*.sql
 var embedMigrations embed.FS
 
 func (db *DB) migrate(ctx context.Context) error {
+	if db.isPostgres() {
+		return db.migratePostgres(ctx)
+	}
+
 	// Set up Goose.
 	gooseLogger := GooseLogger{}
 	goose.SetLogger(&gooseLogger)
@@ -58,6 +62,32 @@ func (db *DB) migrate(ctx context.Context) error {
 	return nil
 }
 
+// migratePostgres runs the PostgreSQL migrations. These live in their own
+// directory, as the SQL differs too much from SQLite's to share the files.
+//
+// Contrary to SQLite, PostgreSQL can alter tables in place, and foreign key
+// checks cannot be disabled, so there is no need to do that here.
+func (db *DB) migratePostgres(ctx context.Context) error {
+	gooseLogger := GooseLogger{}
+	goose.SetLogger(&gooseLogger)
+	goose.SetBaseFS(embedMigrations)
+	if err := goose.SetDialect("postgres"); err != nil {
+		log.Fatal().AnErr("cause", err).Msg("could not tell Goose to use postgres")
+	}
+
+	lowLevelDB, err := db.gormDB.DB()
+	if err != nil {
+		log.Fatal().AnErr("cause", err).Msg("GORM would not give us its low-level interface")
+	}
+
+	log.Debug().Msg("migrating PostgreSQL database with Goose")
+	if err := goose.UpContext(ctx, lowLevelDB, "migrations/postgres"); err != nil {
+		log.Fatal().AnErr("cause", err).Msg("could not migrate database to the latest version")
+	}
+
+	return nil
+}
+
 type GooseLogger struct{}
 
 func (gl *GooseLogger) Fatalf(format string, v ...interface{}) {
@@ -0,0 +1,78 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Names of the supported database engines, as reported by GORM's dialectors.
+const (
+	dialectSQLite   = "sqlite"
+	dialectPostgres = "postgres"
+)
+
+// isPostgresDSN returns whether the DSN refers to a PostgreSQL database.
+// Any other DSN is interpreted as the filename of an SQLite database.
+func isPostgresDSN(dsn string) bool {
+	return strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://")
+}
+
+// dialectorForDSN returns the GORM dialector for the database engine of the DSN.
+func dialectorForDSN(dsn string) gorm.Dialector {
+	if isPostgresDSN(dsn) {
+		return postgres.Open(dsn)
+	}
+	return sqlite.Open(dsn)
+}
+
+// RedactDSN returns the DSN with any password replaced by "xxxxx", so that it
+// can be logged.
+func RedactDSN(dsn string) string {
+	if !isPostgresDSN(dsn) {
+		return dsn
+	}
+	dsnURL, err := url.Parse(dsn)
+	if err != nil {
+		// Don't risk logging the password if the URL cannot be parsed.
+		return "postgres://(unparseable URL)"
+	}
+	return dsnURL.Redacted()
+}
+
+// isPostgres returns whether the database is PostgreSQL.
+func (db *DB) isPostgres() bool {
+	return isPostgres(db.gormDB)
+}
+
+func isPostgres(tx *gorm.DB) bool {
+	return tx.Dialector.Name() == dialectPostgres
+}
+
+// jsonExtract returns an SQL expression that extracts the value of `key` from
+// the JSON object in `column`, and the argument for its `?` placeholder.
+//
+// The key is used literally, so "user.name" refers to a key with a period in
+// it, and not to a nested object.
+func jsonExtract(tx *gorm.DB, column, key string) (string, string) {
+	if isPostgres(tx) {
+		return column + " ->> ?", key
+	}
+	// Quote the key, as metadata keys like "user.name" contain periods.
+	return "json_extract(" + column + ", ?)", fmt.Sprintf("$.%q", key)
+}
+
+// likeOperator returns the SQL operator for case-insensitive pattern matching.
+// SQLite's LIKE is case-insensitive, whereas PostgreSQL's is not.
+func likeOperator(tx *gorm.DB) string {
+	if isPostgres(tx) {
+		return "ilike"
+	}
+	return "like"
+}
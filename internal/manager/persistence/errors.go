@@ -10,9 +10,16 @@ import (
 
 var (
 	ErrJobNotFound       = PersistenceError{Message: "job not found", Err: gorm.ErrRecordNotFound}
+	ErrJobAlreadyExists  = PersistenceError{Message: "job already exists", Err: gorm.ErrDuplicatedKey}
 	ErrTaskNotFound      = PersistenceError{Message: "task not found", Err: gorm.ErrRecordNotFound}
 	ErrWorkerNotFound    = PersistenceError{Message: "worker not found", Err: gorm.ErrRecordNotFound}
 	ErrWorkerTagNotFound = PersistenceError{Message: "worker tag not found", Err: gorm.ErrRecordNotFound}
+
+	ErrJobTemplateNotFound = PersistenceError{Message: "job template not found", Err: gorm.ErrRecordNotFound}
+
+	// ErrJobHasDependents is returned when deleting a job would unblock the
+	// unfinished jobs that depend on it.
+	ErrJobHasDependents = errors.New("unfinished jobs depend on this job")
 )
 
 type PersistenceError struct {
@@ -44,6 +51,10 @@ func workerTagError(errorToWrap error, message string, msgArgs ...interface{}) e
 	return wrapError(translateGormWorkerTagError(errorToWrap), message, msgArgs...)
 }
 
+func jobTemplateError(errorToWrap error, message string, msgArgs ...interface{}) error {
+	return wrapError(translateGormJobTemplateError(errorToWrap), message, msgArgs...)
+}
+
 func wrapError(errorToWrap error, message string, format ...interface{}) error {
 	// Only format if there are arguments for formatting.
 	var formattedMsg string
@@ -94,3 +105,12 @@ func translateGormWorkerTagError(gormError error) error {
 	}
 	return gormError
 }
+
+// translateGormJobTemplateError translates a Gorm error to a persistence layer error.
+// This helps to keep Gorm as "implementation detail" of the persistence layer.
+func translateGormJobTemplateError(gormError error) error {
+	if errors.Is(gormError, gorm.ErrRecordNotFound) {
+		return ErrJobTemplateNotFound
+	}
+	return gormError
+}
@@ -38,6 +38,10 @@ func (db *DB) PeriodicIntegrityCheck(
 		log.Info().Msg("database: periodic integrity check disabled")
 		return
 	}
+	if db.isPostgres() {
+		log.Info().Msg("database: periodic integrity check is not used with PostgreSQL")
+		return
+	}
 
 	log.Info().
 		Stringer("period", period).
@@ -61,7 +65,15 @@ func (db *DB) PeriodicIntegrityCheck(
 
 // performIntegrityCheck uses a few 'pragma' SQL statements to do some integrity checking.
 // Returns true on OK, false if there was an issue. Issues are always logged.
+//
+// PostgreSQL always enforces foreign keys, and has no equivalent of SQLite's
+// integrity check, so there this just returns true.
 func (db *DB) performIntegrityCheck(ctx context.Context) (ok bool) {
+	if db.isPostgres() {
+		log.Debug().Msg("database: integrity check skipped on PostgreSQL")
+		return true
+	}
+
 	checkCtx, cancel := context.WithTimeout(ctx, integrityCheckTimeout)
 	defer cancel()
 
@@ -0,0 +1,133 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// JobTemplate is a job that is submitted periodically, according to a
+// cron-style schedule.
+type JobTemplate struct {
+	Model
+
+	UUID     string `gorm:"type:char(36);default:'';unique;index"`
+	Name     string `gorm:"type:varchar(64);default:''"`
+	Cron     string `gorm:"type:varchar(255);default:''"`
+	IsActive bool   `gorm:"default:false;index"`
+
+	// Job is what gets submitted every time the template is triggered.
+	Job SubmittedJobJSON `gorm:"type:jsonb"`
+
+	// NextRun is when the template should be triggered next.
+	NextRun time.Time `gorm:"index"`
+
+	// Info about the last time the template was triggered. LastJobUUID is empty
+	// when submitting the job failed; LastError then describes why.
+	LastRun     sql.NullTime
+	LastJobUUID string `gorm:"type:char(36);default:''"`
+	LastError   string `gorm:"type:varchar(255);default:''"`
+}
+
+// SubmittedJobJSON stores an api.SubmittedJob as JSON in the database.
+type SubmittedJobJSON api.SubmittedJob
+
+func (sj SubmittedJobJSON) Value() (driver.Value, error) {
+	return json.Marshal(sj)
+}
+func (sj *SubmittedJobJSON) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, &sj)
+}
+
+func (db *DB) CreateJobTemplate(ctx context.Context, template *JobTemplate) error {
+	template.NextRun = template.NextRun.UTC()
+	if err := db.gormDB.WithContext(ctx).Create(template).Error; err != nil {
+		return fmt.Errorf("creating new job template: %w", err)
+	}
+	return nil
+}
+
+func (db *DB) FetchJobTemplate(ctx context.Context, uuid string) (*JobTemplate, error) {
+	template := JobTemplate{}
+	tx := db.gormDB.WithContext(ctx).First(&template, "uuid = ?", uuid)
+	if tx.Error != nil {
+		return nil, jobTemplateError(tx.Error, "fetching job template")
+	}
+	return &template, nil
+}
+
+// FetchJobTemplates returns all job templates, ordered by name.
+func (db *DB) FetchJobTemplates(ctx context.Context) ([]*JobTemplate, error) {
+	templates := make([]*JobTemplate, 0)
+	tx := db.gormDB.WithContext(ctx).Order("name").Find(&templates)
+	if tx.Error != nil {
+		return nil, jobTemplateError(tx.Error, "fetching all job templates")
+	}
+	return templates, nil
+}
+
+func (db *DB) SaveJobTemplate(ctx context.Context, template *JobTemplate) error {
+	template.NextRun = template.NextRun.UTC()
+	if err := db.gormDB.WithContext(ctx).Save(template).Error; err != nil {
+		return jobTemplateError(err, "saving job template")
+	}
+	return nil
+}
+
+// SaveJobTemplateRun saves the info about the last run of the template, when
+// it should run next, and whether it is still active.
+func (db *DB) SaveJobTemplateRun(ctx context.Context, template *JobTemplate) error {
+	template.NextRun = template.NextRun.UTC()
+	if template.LastRun.Valid {
+		template.LastRun.Time = template.LastRun.Time.UTC()
+	}
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(template).
+		Select("is_active", "next_run", "last_run", "last_job_uuid", "last_error").
+		Updates(template)
+	if tx.Error != nil {
+		return jobTemplateError(tx.Error, "saving job template run")
+	}
+	return nil
+}
+
+func (db *DB) DeleteJobTemplate(ctx context.Context, uuid string) error {
+	tx := db.gormDB.WithContext(ctx).
+		Where("uuid = ?", uuid).
+		Delete(&JobTemplate{})
+	if tx.Error != nil {
+		return jobTemplateError(tx.Error, "deleting job template")
+	}
+	if tx.RowsAffected == 0 {
+		return ErrJobTemplateNotFound
+	}
+	return nil
+}
+
+// FetchJobTemplatesDue returns the active job templates that should have been
+// triggered at or before `now`.
+func (db *DB) FetchJobTemplatesDue(ctx context.Context, now time.Time) ([]*JobTemplate, error) {
+	templates := make([]*JobTemplate, 0)
+	tx := db.gormDB.WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("next_run <= ?", now.UTC()).
+		Order("next_run").
+		Find(&templates)
+	if tx.Error != nil {
+		return nil, jobTemplateError(tx.Error, "fetching job templates that are due")
+	}
+	return templates, nil
+}
@@ -0,0 +1,131 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+func TestJobTemplateCRUD(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	// Test fetching non-existent template.
+	_, err := db.FetchJobTemplate(ctx, "7ee21bc8-ff1a-42d2-a6b6-cc4b529b189f")
+	assert.ErrorIs(t, err, ErrJobTemplateNotFound)
+
+	nextRun := time.Date(2026, 10, 19, 2, 0, 0, 0, time.UTC)
+	template := JobTemplate{
+		UUID:     "4d5bc5ba-7a4f-4d5a-9bd4-3b7bbd0b2b47",
+		Name:     "Nightly dailies",
+		Cron:     "0 2 * * *",
+		IsActive: true,
+		Job: SubmittedJobJSON{
+			Name:     "Dailies",
+			Type:     "simple-blender-render",
+			Priority: 50,
+			Settings: &api.JobSettings{AdditionalProperties: map[string]interface{}{
+				"frames": "1-10",
+			}},
+		},
+		NextRun: nextRun,
+	}
+	require.NoError(t, db.CreateJobTemplate(ctx, &template))
+
+	fetched, err := db.FetchJobTemplate(ctx, template.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, template.Name, fetched.Name)
+	assert.Equal(t, template.Cron, fetched.Cron)
+	assert.True(t, fetched.IsActive)
+	assert.Equal(t, template.Job, fetched.Job)
+	assert.True(t, nextRun.Equal(fetched.NextRun))
+	assert.False(t, fetched.LastRun.Valid)
+
+	// Update the template.
+	fetched.Cron = "30 3 * * 1-5"
+	fetched.Job.Priority = 75
+	require.NoError(t, db.SaveJobTemplate(ctx, fetched))
+
+	second := JobTemplate{
+		UUID: "98a2e6e1-60a1-4d4b-9d24-f1a7c1a50e3b",
+		Name: "Cache cleanup",
+		Cron: "@weekly",
+	}
+	require.NoError(t, db.CreateJobTemplate(ctx, &second))
+
+	all, err := db.FetchJobTemplates(ctx)
+	require.NoError(t, err)
+	if assert.Len(t, all, 2) {
+		// Ordered by name.
+		assert.Equal(t, second.UUID, all[0].UUID)
+		assert.Equal(t, template.UUID, all[1].UUID)
+		assert.Equal(t, "30 3 * * 1-5", all[1].Cron)
+		assert.Equal(t, 75, all[1].Job.Priority)
+	}
+
+	// Delete the template.
+	require.NoError(t, db.DeleteJobTemplate(ctx, template.UUID))
+	_, err = db.FetchJobTemplate(ctx, template.UUID)
+	assert.ErrorIs(t, err, ErrJobTemplateNotFound)
+	assert.ErrorIs(t, db.DeleteJobTemplate(ctx, template.UUID), ErrJobTemplateNotFound)
+}
+
+func TestFetchJobTemplatesDue(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	now := time.Date(2026, 10, 19, 2, 0, 0, 0, time.UTC)
+	due := JobTemplate{
+		UUID:     "4d5bc5ba-7a4f-4d5a-9bd4-3b7bbd0b2b47",
+		Name:     "due",
+		IsActive: true,
+		NextRun:  now,
+	}
+	notDue := JobTemplate{
+		UUID:     "98a2e6e1-60a1-4d4b-9d24-f1a7c1a50e3b",
+		Name:     "not due",
+		IsActive: true,
+		NextRun:  now.Add(time.Minute),
+	}
+	inactive := JobTemplate{
+		UUID:     "0c8d1d3e-4a9b-4ac8-a8c5-7d87e3e1b4c2",
+		Name:     "inactive",
+		IsActive: false,
+		NextRun:  now.Add(-time.Hour),
+	}
+	require.NoError(t, db.CreateJobTemplate(ctx, &due))
+	require.NoError(t, db.CreateJobTemplate(ctx, &notDue))
+	require.NoError(t, db.CreateJobTemplate(ctx, &inactive))
+
+	templates, err := db.FetchJobTemplatesDue(ctx, now)
+	require.NoError(t, err)
+	if assert.Len(t, templates, 1) {
+		assert.Equal(t, due.UUID, templates[0].UUID)
+	}
+
+	// Store a run, which should move the template out of the 'due' set.
+	templates[0].NextRun = now.Add(24 * time.Hour)
+	templates[0].LastRun = sql.NullTime{Time: now, Valid: true}
+	templates[0].LastJobUUID = "7c3b3c32-0d7e-4e2f-a2e3-0ffa7e1c3d2a"
+	templates[0].Name = "this should not be saved"
+	require.NoError(t, db.SaveJobTemplateRun(ctx, templates[0]))
+
+	templates, err = db.FetchJobTemplatesDue(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, templates)
+
+	fetched, err := db.FetchJobTemplate(ctx, due.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "due", fetched.Name)
+	assert.True(t, fetched.LastRun.Valid)
+	assert.True(t, now.Equal(fetched.LastRun.Time))
+	assert.Equal(t, "7c3b3c32-0d7e-4e2f-a2e3-0ffa7e1c3d2a", fetched.LastJobUUID)
+	assert.True(t, now.Add(24*time.Hour).Equal(fetched.NextRun))
+}
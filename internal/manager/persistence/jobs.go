@@ -8,7 +8,9 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -29,15 +31,28 @@ type Job struct {
 	Status   api.JobStatus `gorm:"type:varchar(32);default:''"`
 	Activity string        `gorm:"type:varchar(255);default:''"`
 
+	// MaxWorkers is the maximum number of tasks of this job that can be active
+	// at the same time. Zero means unlimited.
+	MaxWorkers int `gorm:"type:smallint;default:0"`
+
+	RetryPolicy JobRetryPolicy `gorm:"embedded;embeddedPrefix:retry_"`
+
 	Settings StringInterfaceMap `gorm:"type:jsonb"`
 	Metadata StringStringMap    `gorm:"type:jsonb"`
 
 	DeleteRequestedAt sql.NullTime
 
+	// NotBefore is the timestamp before which this job should not start. Until
+	// then it is kept in 'waiting' status.
+	NotBefore sql.NullTime
+
 	Storage JobStorageInfo `gorm:"embedded;embeddedPrefix:storage_"`
 
 	WorkerTagID *uint
 	WorkerTag   *WorkerTag `gorm:"foreignkey:WorkerTagID;references:ID;constraint:OnDelete:SET NULL"`
+
+	// Dependencies are jobs that need to be completed before this one can run.
+	Dependencies []*Job `gorm:"many2many:job_dependencies;constraint:OnDelete:CASCADE"`
 }
 
 type StringInterfaceMap map[string]interface{}
@@ -48,6 +63,15 @@ func (j *Job) DeleteRequested() bool {
 	return j.DeleteRequestedAt.Valid
 }
 
+// JobRetryPolicy determines how failed tasks of the job are retried. See
+// job_compilers.RetryPolicy for the meaning of the fields.
+type JobRetryPolicy struct {
+	MaxFailures     int  `gorm:"type:smallint;default:0"`
+	DelaySeconds    int  `gorm:"default:0"`
+	MaxDelaySeconds int  `gorm:"default:0"`
+	AllowSameWorker bool `gorm:"default:false"`
+}
+
 // JobStorageInfo contains info about where the job files are stored. It is
 // intended to be used when removing a job, which may include the removal of its
 // files.
@@ -77,6 +101,17 @@ type Task struct {
 
 	Commands Commands `gorm:"type:jsonb"`
 	Activity string   `gorm:"type:varchar(255);default:''"`
+	Progress int      `gorm:"type:smallint;default:0"` // Percentage, 0-100.
+
+	// Minimum resources a Worker needs to get this task. Zero means 'no requirement'.
+	MinCPUCount int `gorm:"type:smallint;default:0"`
+	MinMemoryGB int `gorm:"type:smallint;default:0"` // 1 GB = 10^9 bytes.
+
+	// FailureCount is the number of times this task failed since it was last
+	// (re)queued by a user. RetryAfter is the time before which the task, when
+	// soft-failed, will not be scheduled.
+	FailureCount int `gorm:"type:smallint;default:0"`
+	RetryAfter   sql.NullTime
 }
 
 type Commands []Command
@@ -136,17 +171,28 @@ func (db *DB) StoreAuthoredJob(ctx context.Context, authoredJob job_compilers.Au
 	return db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// TODO: separate conversion of struct types from storing things in the database.
 		dbJob := Job{
-			UUID:     authoredJob.JobID,
-			Name:     authoredJob.Name,
-			JobType:  authoredJob.JobType,
-			Status:   authoredJob.Status,
-			Priority: authoredJob.Priority,
+			UUID:       authoredJob.JobID,
+			Name:       authoredJob.Name,
+			JobType:    authoredJob.JobType,
+			Status:     authoredJob.Status,
+			Priority:   authoredJob.Priority,
+			MaxWorkers: authoredJob.MaxWorkers,
+			RetryPolicy: JobRetryPolicy{
+				MaxFailures:     authoredJob.RetryPolicy.MaxFailures,
+				DelaySeconds:    authoredJob.RetryPolicy.DelaySeconds,
+				MaxDelaySeconds: authoredJob.RetryPolicy.MaxDelaySeconds,
+				AllowSameWorker: authoredJob.RetryPolicy.AllowSameWorker,
+			},
 			Settings: StringInterfaceMap(authoredJob.Settings),
 			Metadata: StringStringMap(authoredJob.Metadata),
 			Storage: JobStorageInfo{
 				ShamanCheckoutID: authoredJob.Storage.ShamanCheckoutID,
 			},
 		}
+		if !authoredJob.NotBefore.IsZero() {
+			// Only store timestamps in UTC.
+			dbJob.NotBefore = sql.NullTime{Time: authoredJob.NotBefore.UTC(), Valid: true}
+		}
 
 		// Find and assign the worker tag.
 		if authoredJob.WorkerTagUUID != "" {
@@ -158,6 +204,15 @@ func (db *DB) StoreAuthoredJob(ctx context.Context, authoredJob job_compilers.Au
 			dbJob.WorkerTag = dbTag
 		}
 
+		// Find the jobs this job depends on.
+		for _, depUUID := range authoredJob.DependsOn {
+			depJob, err := fetchJob(tx, depUUID)
+			if err != nil {
+				return err
+			}
+			dbJob.Dependencies = append(dbJob.Dependencies, depJob)
+		}
+
 		if err := tx.Create(&dbJob).Error; err != nil {
 			return jobError(err, "storing job")
 		}
@@ -202,6 +257,9 @@ func (db *DB) storeAuthoredJobTaks(
 			Priority: authoredTask.Priority,
 			Status:   api.TaskStatusQueued,
 			Commands: commands,
+
+			MinCPUCount: authoredTask.MinCPUCount,
+			MinMemoryGB: authoredTask.MinMemoryGB,
 			// dependencies are stored below.
 		}
 		if err := tx.Create(&dbTask).Error; err != nil {
@@ -251,10 +309,15 @@ func (db *DB) storeAuthoredJobTaks(
 
 // FetchJob fetches a single job, without fetching its tasks.
 func (db *DB) FetchJob(ctx context.Context, jobUUID string) (*Job, error) {
+	return fetchJob(db.gormDB.WithContext(ctx), jobUUID)
+}
+
+func fetchJob(tx *gorm.DB, jobUUID string) (*Job, error) {
 	dbJob := Job{}
-	findResult := db.gormDB.WithContext(ctx).
+	findResult := tx.
 		Limit(1).
 		Preload("WorkerTag").
+		Preload("Dependencies").
 		Find(&dbJob, "uuid = ?", jobUUID)
 	if findResult.Error != nil {
 		return nil, jobError(findResult.Error, "fetching job")
@@ -263,37 +326,118 @@ func (db *DB) FetchJob(ctx context.Context, jobUUID string) (*Job, error) {
 		return nil, ErrJobNotFound
 	}
 
+	// Preloading always produces a non-nil slice, but a job without dependencies
+	// should look the same as when it was fetched without preloading.
+	if len(dbJob.Dependencies) == 0 {
+		dbJob.Dependencies = nil
+	}
+
 	return &dbJob, nil
 }
 
-// DeleteJob deletes a job from the database.
-// The deletion cascades to its tasks and other job-related tables.
-func (db *DB) DeleteJob(ctx context.Context, jobUUID string) error {
+// FetchDependentJobs returns the jobs that depend on the given job.
+func (db *DB) FetchDependentJobs(ctx context.Context, job *Job) ([]*Job, error) {
+	var jobs []*Job
 	tx := db.gormDB.WithContext(ctx).
-		Where("uuid = ?", jobUUID).
-		Delete(&Job{})
+		Model(&Job{}).
+		Joins("inner join job_dependencies jd on jd.job_id = jobs.id").
+		Where("jd.dependency_id = ?", job.ID).
+		Scan(&jobs)
 	if tx.Error != nil {
-		return jobError(tx.Error, "deleting job")
+		return nil, jobError(tx.Error, "fetching jobs depending on job %s", job.UUID)
+	}
+	return jobs, nil
+}
+
+// jobStatusesFinished are the statuses of jobs that are not going to run any
+// more, and thus are no longer waiting for the jobs they depend on.
+var jobStatusesFinished = []api.JobStatus{
+	api.JobStatusCompleted,
+	api.JobStatusCanceled,
+	api.JobStatusCancelRequested,
+	api.JobStatusFailed,
+}
+
+// checkNoBlockedDependents returns ErrJobHasDependents when there are unfinished
+// jobs waiting for the given job. Deleting the job would remove their
+// dependency on it, and thus make them run without it having completed.
+func checkNoBlockedDependents(tx *gorm.DB, jobUUID string) error {
+	var uuids []string
+	result := tx.Table("jobs as dependent").
+		Joins("inner join job_dependencies jd on jd.job_id = dependent.id").
+		Joins("inner join jobs depjob on depjob.id = jd.dependency_id").
+		Where("depjob.uuid = ?", jobUUID).
+		Where("depjob.status != ?", api.JobStatusCompleted).
+		Where("dependent.status not in ?", jobStatusesFinished).
+		Order("dependent.id").
+		Pluck("dependent.uuid", &uuids)
+	if result.Error != nil {
+		return jobError(result.Error, "fetching jobs depending on job %s", jobUUID)
+	}
+	if len(uuids) > 0 {
+		return fmt.Errorf("%w: %s", ErrJobHasDependents, strings.Join(uuids, ", "))
 	}
 	return nil
 }
 
+// DeleteJob deletes a job from the database.
+// The deletion cascades to its tasks and other job-related tables.
+//
+// Returns ErrJobHasDependents when unfinished jobs are still waiting for this
+// job to complete.
+func (db *DB) DeleteJob(ctx context.Context, jobUUID string) error {
+	return db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkNoBlockedDependents(tx, jobUUID); err != nil {
+			return err
+		}
+
+		result := tx.
+			Where("uuid = ?", jobUUID).
+			Delete(&Job{})
+		if result.Error != nil {
+			return jobError(result.Error, "deleting job")
+		}
+		return nil
+	})
+}
+
 // RequestJobDeletion sets the job's "DeletionRequestedAt" field to "now".
+//
+// Returns ErrJobHasDependents when unfinished jobs are still waiting for this
+// job to complete.
 func (db *DB) RequestJobDeletion(ctx context.Context, j *Job) error {
-	j.DeleteRequestedAt.Time = db.gormDB.NowFunc()
-	j.DeleteRequestedAt.Valid = true
-	tx := db.gormDB.WithContext(ctx).
-		Model(j).
-		Updates(Job{DeleteRequestedAt: j.DeleteRequestedAt})
-	if tx.Error != nil {
-		return jobError(tx.Error, "queueing job for deletion")
-	}
-	return nil
+	return db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkNoBlockedDependents(tx, j.UUID); err != nil {
+			return err
+		}
+
+		j.DeleteRequestedAt.Time = db.gormDB.NowFunc()
+		j.DeleteRequestedAt.Valid = true
+		result := tx.
+			Model(j).
+			Updates(Job{DeleteRequestedAt: j.DeleteRequestedAt})
+		if result.Error != nil {
+			return jobError(result.Error, "queueing job for deletion")
+		}
+		return nil
+	})
 }
 
 // RequestJobMassDeletion sets multiple job's "DeletionRequestedAt" field to "now".
 // The list of affected job UUIDs is returned.
+//
+// Jobs that unfinished jobs are still waiting for are skipped, as deleting
+// those would make the waiting jobs run without them having completed.
 func (db *DB) RequestJobMassDeletion(ctx context.Context, lastUpdatedMax time.Time) ([]string, error) {
+	// Produce a row when any unfinished job depends on the job.
+	// `jobs.id` is the job ID from the outer query.
+	blockedDependentsQuery := db.gormDB.
+		Table("job_dependencies as jd").
+		Select("jd.job_id").
+		Joins("inner join jobs dependent on dependent.id = jd.job_id").
+		Where("jd.dependency_id = jobs.id").
+		Where("dependent.status not in ?", jobStatusesFinished)
+
 	// In order to be able to report which jobs were affected, first fetch the
 	// list of jobs, then update them.
 	var jobs []*Job
@@ -301,6 +445,7 @@ func (db *DB) RequestJobMassDeletion(ctx context.Context, lastUpdatedMax time.Ti
 		Model(&Job{}).
 		Select("uuid").
 		Where("updated_at <= ?", lastUpdatedMax).
+		Where("jobs.status = ? or not exists (?)", api.JobStatusCompleted, blockedDependentsQuery).
 		Scan(&jobs)
 	if selectResult.Error != nil {
 		return nil, jobError(selectResult.Error, "fetching jobs by last-modified timestamp")
@@ -368,6 +513,42 @@ func (db *DB) FetchJobsInStatus(ctx context.Context, jobStatuses ...api.JobStatu
 	return jobs, nil
 }
 
+// FetchJobsActiveBetween returns the jobs that existed and were updated within
+// the given time range, i.e. that were created at or before `until`, and last
+// updated at or after `since`. The jobs are ordered by creation time.
+func (db *DB) FetchJobsActiveBetween(ctx context.Context, since, until time.Time) ([]*Job, error) {
+	var jobs []*Job
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Job{}).
+		Where("created_at <= ?", until.UTC()).
+		Where("updated_at >= ?", since.UTC()).
+		Order("created_at").
+		Scan(&jobs)
+
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching jobs active between %s and %s", since.String(), until.String())
+	}
+	return jobs, nil
+}
+
+// FetchWaitingJobsDue returns the jobs in 'waiting' status whose 'not before'
+// timestamp is at or before `now`.
+func (db *DB) FetchWaitingJobsDue(ctx context.Context, now time.Time) ([]*Job, error) {
+	var jobs []*Job
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Job{}).
+		Where("status = ?", api.JobStatusWaiting).
+		Where("not_before is NULL or not_before <= ?", now.UTC()).
+		Scan(&jobs)
+
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching waiting jobs due at %s", now.String())
+	}
+	return jobs, nil
+}
+
 // SaveJobStatus saves the job's Status and Activity fields.
 func (db *DB) SaveJobStatus(ctx context.Context, j *Job) error {
 	tx := db.gormDB.WithContext(ctx).
@@ -430,9 +611,17 @@ func (db *DB) SaveTask(ctx context.Context, t *Task) error {
 	return nil
 }
 
+// SaveTaskStatus saves the task status. Queueing or completing the task also
+// resets its progress.
 func (db *DB) SaveTaskStatus(ctx context.Context, t *Task) error {
+	columns := []string{"Status"}
+	if progress, ok := taskProgressForStatus(t.Status); ok {
+		t.Progress = progress
+		columns = append(columns, "Progress")
+	}
+
 	tx := db.gormDB.WithContext(ctx).
-		Select("Status").
+		Select(columns).
 		Save(t)
 	if tx.Error != nil {
 		return taskError(tx.Error, "saving task")
@@ -450,6 +639,52 @@ func (db *DB) SaveTaskActivity(ctx context.Context, t *Task) error {
 	return nil
 }
 
+func (db *DB) SaveTaskProgress(ctx context.Context, t *Task) error {
+	if err := db.gormDB.WithContext(ctx).
+		Model(t).
+		Select("Progress").
+		Updates(Task{Progress: t.Progress}).Error; err != nil {
+		return taskError(err, "saving task progress")
+	}
+	return nil
+}
+
+// taskProgressForStatus returns the progress a task should get when it goes to
+// the given status. The boolean is false when the progress should not change.
+func taskProgressForStatus(status api.TaskStatus) (int, bool) {
+	switch status {
+	case api.TaskStatusQueued, api.TaskStatusSoftFailed:
+		return 0, true
+	case api.TaskStatusCompleted:
+		return 100, true
+	}
+	return 0, false
+}
+
+// FetchJobProgress returns the average progress of the job's tasks, where
+// completed tasks always count as 100%.
+func (db *DB) FetchJobProgress(ctx context.Context, job *Job) (int, error) {
+	var result struct {
+		NumTasks      int
+		TotalProgress int
+	}
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Task{}).
+		Select("count(*) as num_tasks, coalesce(sum(CASE WHEN status = ? THEN 100 ELSE progress END), 0) as total_progress",
+			api.TaskStatusCompleted).
+		Where("job_id", job.ID).
+		Scan(&result)
+	if tx.Error != nil {
+		return 0, jobError(tx.Error, "fetching progress of job %s", job.UUID)
+	}
+
+	if result.NumTasks == 0 {
+		return 0, nil
+	}
+	return result.TotalProgress / result.NumTasks, nil
+}
+
 func (db *DB) TaskAssignToWorker(ctx context.Context, t *Task, w *Worker) error {
 	tx := db.gormDB.WithContext(ctx).
 		Model(t).
@@ -487,7 +722,7 @@ func (db *DB) FetchTasksOfWorkerInStatusOfJob(ctx context.Context, worker *Worke
 		Joins("Job").
 		Where("tasks.worker_id = ?", worker.ID).
 		Where("tasks.status = ?", taskStatus).
-		Where("job.id = ?", job.ID).
+		Where(clause.Eq{Column: clause.Column{Table: "Job", Name: "id"}, Value: job.ID}).
 		Scan(&result)
 	if tx.Error != nil {
 		return nil, taskError(tx.Error, "finding tasks of worker %s in status %q and job %s", worker.UUID, taskStatus, job.UUID)
@@ -592,10 +827,21 @@ func (db *DB) UpdateJobsTaskStatuses(ctx context.Context, job *Job,
 		return taskError(nil, "empty status not allowed")
 	}
 
+	// Select the columns explicitly, as Updates() skips zero values otherwise.
+	columns := []string{"Status"}
+	if activity != "" {
+		columns = append(columns, "Activity")
+	}
+	progress, updateProgress := taskProgressForStatus(taskStatus)
+	if updateProgress {
+		columns = append(columns, "Progress")
+	}
+
 	tx := db.gormDB.WithContext(ctx).
 		Model(Task{}).
+		Select(columns).
 		Where("job_Id = ?", job.ID).
-		Updates(Task{Status: taskStatus, Activity: activity})
+		Updates(Task{Status: taskStatus, Activity: activity, Progress: progress})
 
 	if tx.Error != nil {
 		return taskError(tx.Error, "updating status of all tasks of job %s", job.UUID)
@@ -612,11 +858,22 @@ func (db *DB) UpdateJobsTaskStatusesConditional(ctx context.Context, job *Job,
 		return taskError(nil, "empty status not allowed")
 	}
 
+	// Select the columns explicitly, as Updates() skips zero values otherwise.
+	columns := []string{"Status"}
+	if activity != "" {
+		columns = append(columns, "Activity")
+	}
+	progress, updateProgress := taskProgressForStatus(taskStatus)
+	if updateProgress {
+		columns = append(columns, "Progress")
+	}
+
 	tx := db.gormDB.WithContext(ctx).
 		Model(Task{}).
+		Select(columns).
 		Where("job_Id = ?", job.ID).
 		Where("status in ?", statusesToUpdate).
-		Updates(Task{Status: taskStatus, Activity: activity})
+		Updates(Task{Status: taskStatus, Activity: activity, Progress: progress})
 	if tx.Error != nil {
 		return taskError(tx.Error, "updating status of all tasks in status %v of job %s", statusesToUpdate, job.UUID)
 	}
@@ -669,16 +926,37 @@ func (db *DB) AddWorkerToTaskFailedList(ctx context.Context, t *Task, w *Worker)
 	return int(numFailed64), tx.Error
 }
 
+// SaveTaskRetryState stores the task's failure count and the time after which
+// it can be retried.
+func (db *DB) SaveTaskRetryState(ctx context.Context, t *Task) error {
+	tx := db.gormDB.WithContext(ctx).
+		Model(t).
+		Select("failure_count", "retry_after").
+		Updates(Task{FailureCount: t.FailureCount, RetryAfter: t.RetryAfter})
+	if tx.Error != nil {
+		return taskError(tx.Error, "saving task retry state")
+	}
+	return nil
+}
+
 // ClearFailureListOfTask clears the list of workers that failed this task.
+// This also resets its failure count and retry delay.
 func (db *DB) ClearFailureListOfTask(ctx context.Context, t *Task) error {
 	tx := db.gormDB.WithContext(ctx).
 		Where("task_id = ?", t.ID).
 		Delete(&TaskFailure{})
-	return tx.Error
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	t.FailureCount = 0
+	t.RetryAfter = sql.NullTime{}
+	return db.SaveTaskRetryState(ctx, t)
 }
 
 // ClearFailureListOfJob en-mass, for all tasks of this job, clears the list of
-// workers that failed those tasks.
+// workers that failed those tasks. This also resets their failure count and
+// retry delay.
 func (db *DB) ClearFailureListOfJob(ctx context.Context, j *Job) error {
 
 	// SQLite doesn't support JOIN in DELETE queries, so use a sub-query instead.
@@ -689,6 +967,14 @@ func (db *DB) ClearFailureListOfJob(ctx context.Context, j *Job) error {
 	tx := db.gormDB.WithContext(ctx).
 		Where("task_id in (?)", jobTasksQuery).
 		Delete(&TaskFailure{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	tx = db.gormDB.WithContext(ctx).
+		Model(&Task{}).
+		Where("job_id = ?", j.ID).
+		Updates(map[string]interface{}{"failure_count": 0, "retry_after": nil})
 	return tx.Error
 }
 
@@ -0,0 +1,298 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// JobArchiveData contains everything from the database that is needed to
+// recreate a job on another Manager.
+//
+// Workers, worker tags, and dependencies are referenced by UUID via their
+// respective sub-structs; their database IDs are ignored when storing.
+type JobArchiveData struct {
+	Job          *Job
+	Tasks        []*Task
+	TaskFailures []*TaskFailure
+	Blocklist    []*JobBlock
+}
+
+// FetchJobArchiveData fetches the job, its tasks, their failure lists, and the
+// job's blocklist.
+func (db *DB) FetchJobArchiveData(ctx context.Context, jobUUID string) (*JobArchiveData, error) {
+	tx := db.gormDB.WithContext(ctx)
+
+	dbJob, err := fetchJob(tx, jobUUID)
+	if err != nil {
+		return nil, err
+	}
+	data := JobArchiveData{Job: dbJob}
+
+	result := tx.Model(&Task{}).
+		Where("job_id = ?", dbJob.ID).
+		Preload("Worker").
+		Preload("Dependencies").
+		Order("id").
+		Find(&data.Tasks)
+	if result.Error != nil {
+		return nil, taskError(result.Error, "fetching tasks of job %s", jobUUID)
+	}
+
+	if len(data.Tasks) > 0 {
+		result = tx.Model(&TaskFailure{}).
+			Joins("inner join tasks on tasks.id = task_failures.task_id").
+			Where("tasks.job_id = ?", dbJob.ID).
+			Preload("Task").
+			Preload("Worker").
+			Order("task_failures.task_id, task_failures.worker_id").
+			Find(&data.TaskFailures)
+		if result.Error != nil {
+			return nil, taskError(result.Error, "fetching task failures of job %s", jobUUID)
+		}
+	}
+
+	result = tx.Model(&JobBlock{}).
+		Where("job_id = ?", dbJob.ID).
+		Preload("Worker").
+		Order("id").
+		Find(&data.Blocklist)
+	if result.Error != nil {
+		return nil, jobError(result.Error, "fetching blocklist of job %s", jobUUID)
+	}
+
+	return &data, nil
+}
+
+// StoreJobArchiveData stores a job that was exported from another Manager.
+//
+// References to workers, worker tags, and dependency jobs that do not exist in
+// this database are dropped, as those only make sense on the Manager the job
+// was exported from. Tasks that were active are requeued, as no Worker of this
+// Manager is working on them. Jobs that were being canceled or requeued are
+// stored as canceled or paused, as nothing on this Manager would finish those
+// status changes. Returns ErrJobAlreadyExists when a job with the same UUID
+// already exists.
+//
+// When `beforeCommit` is not nil, it is called after everything has been
+// stored, but before the transaction is committed. When it returns an error,
+// nothing is stored and that error is returned.
+func (db *DB) StoreJobArchiveData(ctx context.Context, data *JobArchiveData, beforeCommit func() error) error {
+	return db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		srcJob := data.Job
+		logger := log.With().Str("job", srcJob.UUID).Logger()
+
+		var count int64
+		if err := tx.Model(&Job{}).Where("uuid = ?", srcJob.UUID).Count(&count).Error; err != nil {
+			return jobError(err, "checking for existing job")
+		}
+		if count > 0 {
+			return ErrJobAlreadyExists
+		}
+
+		dbJob := Job{
+			Model: Model{
+				CreatedAt: srcJob.CreatedAt,
+				UpdatedAt: srcJob.UpdatedAt,
+			},
+			UUID:              srcJob.UUID,
+			Name:              srcJob.Name,
+			JobType:           srcJob.JobType,
+			Priority:          srcJob.Priority,
+			Status:            srcJob.Status,
+			Activity:          srcJob.Activity,
+			MaxWorkers:        srcJob.MaxWorkers,
+			RetryPolicy:       srcJob.RetryPolicy,
+			Settings:          srcJob.Settings,
+			Metadata:          srcJob.Metadata,
+			DeleteRequestedAt: srcJob.DeleteRequestedAt,
+			NotBefore:         srcJob.NotBefore,
+			Storage:           srcJob.Storage,
+		}
+
+		wasCanceling := false
+		switch dbJob.Status {
+		case api.JobStatusCancelRequested:
+			wasCanceling = true
+			dbJob.Status = api.JobStatusCanceled
+			dbJob.Activity = "Job was canceled by Manager because it was being canceled when it was exported"
+		case api.JobStatusRequeueing:
+			dbJob.Status = api.JobStatusPaused
+			dbJob.Activity = "Job was paused by Manager because it was being requeued when it was exported"
+		}
+
+		if srcJob.WorkerTag != nil {
+			dbTag, err := fetchWorkerTag(tx, srcJob.WorkerTag.UUID)
+			switch {
+			case errors.Is(err, ErrWorkerTagNotFound):
+				logger.Warn().Str("workerTag", srcJob.WorkerTag.UUID).Msg("job archive: worker tag does not exist, importing job without tag")
+			case err != nil:
+				return err
+			default:
+				dbJob.WorkerTagID = &dbTag.ID
+				dbJob.WorkerTag = dbTag
+			}
+		}
+
+		for _, srcDep := range srcJob.Dependencies {
+			depJob, err := fetchJob(tx, srcDep.UUID)
+			switch {
+			case errors.Is(err, ErrJobNotFound):
+				logger.Warn().Str("dependency", srcDep.UUID).Msg("job archive: dependency job does not exist, ignoring")
+			case err != nil:
+				return err
+			default:
+				dbJob.Dependencies = append(dbJob.Dependencies, depJob)
+			}
+		}
+
+		if err := tx.Create(&dbJob).Error; err != nil {
+			return jobError(err, "storing job")
+		}
+
+		// Workers are looked up by UUID, and cached as they are typically
+		// referenced by many tasks.
+		workers := map[string]*Worker{}
+		findWorker := func(srcWorker *Worker) (*Worker, error) {
+			if srcWorker == nil {
+				return nil, nil
+			}
+			if w, ok := workers[srcWorker.UUID]; ok {
+				return w, nil
+			}
+			w := Worker{}
+			result := tx.Limit(1).Find(&w, "uuid = ?", srcWorker.UUID)
+			if result.Error != nil {
+				return nil, workerError(result.Error, "fetching worker %s", srcWorker.UUID)
+			}
+			if w.ID == 0 {
+				logger.Debug().Str("worker", srcWorker.UUID).Msg("job archive: worker does not exist, ignoring")
+				workers[srcWorker.UUID] = nil
+				return nil, nil
+			}
+			workers[srcWorker.UUID] = &w
+			return &w, nil
+		}
+
+		uuidToTask := map[string]*Task{}
+		for _, srcTask := range data.Tasks {
+			dbTask := Task{
+				Model: Model{
+					CreatedAt: srcTask.CreatedAt,
+					UpdatedAt: srcTask.UpdatedAt,
+				},
+				UUID:          srcTask.UUID,
+				Name:          srcTask.Name,
+				Type:          srcTask.Type,
+				JobID:         dbJob.ID,
+				Priority:      srcTask.Priority,
+				Status:        srcTask.Status,
+				LastTouchedAt: srcTask.LastTouchedAt,
+				Commands:      srcTask.Commands,
+				Activity:      srcTask.Activity,
+				Progress:      srcTask.Progress,
+				MinCPUCount:   srcTask.MinCPUCount,
+				MinMemoryGB:   srcTask.MinMemoryGB,
+				FailureCount:  srcTask.FailureCount,
+				RetryAfter:    srcTask.RetryAfter,
+			}
+			switch {
+			case wasCanceling && (dbTask.Status == api.TaskStatusActive ||
+				dbTask.Status == api.TaskStatusQueued ||
+				dbTask.Status == api.TaskStatusSoftFailed):
+				dbTask.Status = api.TaskStatusCanceled
+				dbTask.Activity = "Task was canceled by Manager because its job was being canceled when it was exported"
+			case dbTask.Status == api.TaskStatusActive:
+				dbTask.Status = api.TaskStatusQueued
+				dbTask.Activity = "Task was requeued by Manager because it was active when its job was exported"
+				dbTask.Progress, _ = taskProgressForStatus(dbTask.Status)
+			}
+			worker, err := findWorker(srcTask.Worker)
+			if err != nil {
+				return err
+			}
+			if worker != nil {
+				dbTask.WorkerID = &worker.ID
+			}
+
+			if err := tx.Omit("Job", "Worker", "Dependencies").Create(&dbTask).Error; err != nil {
+				return taskError(err, "storing task %s", srcTask.UUID)
+			}
+			uuidToTask[srcTask.UUID] = &dbTask
+		}
+
+		// Store the dependencies between tasks.
+		for _, srcTask := range data.Tasks {
+			if len(srcTask.Dependencies) == 0 {
+				continue
+			}
+			dbTask := uuidToTask[srcTask.UUID]
+			deps := make([]*Task, 0, len(srcTask.Dependencies))
+			for _, srcDep := range srcTask.Dependencies {
+				depTask, ok := uuidToTask[srcDep.UUID]
+				if !ok {
+					return taskError(nil, "task %s depends on task %s, which is not part of this job", srcTask.UUID, srcDep.UUID)
+				}
+				deps = append(deps, depTask)
+			}
+			if err := tx.Model(dbTask).Association("Dependencies").Append(deps); err != nil {
+				return taskError(err, "storing dependencies of task %s", srcTask.UUID)
+			}
+		}
+
+		for _, srcFailure := range data.TaskFailures {
+			if srcFailure.Task == nil {
+				continue
+			}
+			dbTask, ok := uuidToTask[srcFailure.Task.UUID]
+			if !ok {
+				return taskError(nil, "task failure refers to task %s, which is not part of this job", srcFailure.Task.UUID)
+			}
+			worker, err := findWorker(srcFailure.Worker)
+			if err != nil {
+				return err
+			}
+			if worker == nil {
+				continue
+			}
+			dbFailure := TaskFailure{
+				CreatedAt: srcFailure.CreatedAt,
+				TaskID:    dbTask.ID,
+				WorkerID:  worker.ID,
+			}
+			if err := tx.Omit("Task", "Worker").Create(&dbFailure).Error; err != nil {
+				return taskError(err, "storing failure list of task %s", dbTask.UUID)
+			}
+		}
+
+		for _, srcBlock := range data.Blocklist {
+			worker, err := findWorker(srcBlock.Worker)
+			if err != nil {
+				return err
+			}
+			if worker == nil {
+				continue
+			}
+			dbBlock := JobBlock{
+				CreatedAt: srcBlock.CreatedAt,
+				JobID:     dbJob.ID,
+				WorkerID:  worker.ID,
+				TaskType:  srcBlock.TaskType,
+			}
+			if err := tx.Omit("Job", "Worker").Create(&dbBlock).Error; err != nil {
+				return jobError(err, "storing blocklist")
+			}
+		}
+
+		if beforeCommit != nil {
+			return beforeCommit()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,186 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+func TestJobArchiveRoundTrip(t *testing.T) {
+	ctx, close, db, job, authoredJob := jobTasksTestFixtures(t)
+	defer close()
+
+	worker := createWorker(ctx, t, db)
+	task1, err := db.FetchTask(ctx, authoredJob.Tasks[1].UUID)
+	require.NoError(t, err)
+	require.NoError(t, db.TaskAssignToWorker(ctx, task1, worker))
+	task1.Progress = 47
+	require.NoError(t, db.SaveTaskProgress(ctx, task1))
+	_, err = db.AddWorkerToTaskFailedList(ctx, task1, worker)
+	require.NoError(t, err)
+	require.NoError(t, db.AddWorkerToJobBlocklist(ctx, job, worker, "blender"))
+
+	data, err := db.FetchJobArchiveData(ctx, job.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, job.UUID, data.Job.UUID)
+	require.Len(t, data.Tasks, 3)
+	require.Len(t, data.TaskFailures, 1)
+	require.Len(t, data.Blocklist, 1)
+	assert.Equal(t, worker.UUID, data.TaskFailures[0].Worker.UUID)
+	assert.Equal(t, task1.UUID, data.TaskFailures[0].Task.UUID)
+	assert.Equal(t, worker.UUID, data.Blocklist[0].Worker.UUID)
+
+	// Importing while the job still exists should fail.
+	err = db.StoreJobArchiveData(ctx, data, nil)
+	assert.ErrorIs(t, err, ErrJobAlreadyExists)
+
+	// Importing after the job has been deleted should recreate it.
+	require.NoError(t, db.DeleteJob(ctx, job.UUID))
+	require.NoError(t, db.StoreJobArchiveData(ctx, data, nil))
+
+	imported, err := db.FetchJobArchiveData(ctx, job.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, data.Job.Name, imported.Job.Name)
+	assert.Equal(t, data.Job.Status, imported.Job.Status)
+	assert.Equal(t, data.Job.Settings, imported.Job.Settings)
+	assert.Equal(t, data.Job.Metadata, imported.Job.Metadata)
+	assert.True(t, data.Job.CreatedAt.Equal(imported.Job.CreatedAt))
+
+	require.Len(t, imported.Tasks, 3)
+	for idx := range data.Tasks {
+		expect, actual := data.Tasks[idx], imported.Tasks[idx]
+		assert.Equal(t, expect.UUID, actual.UUID)
+		assert.Equal(t, expect.Status, actual.Status)
+		assert.Equal(t, expect.Commands, actual.Commands)
+		assert.Equal(t, expect.Progress, actual.Progress)
+		assert.Len(t, actual.Dependencies, len(expect.Dependencies))
+	}
+	assert.Equal(t, 47, imported.Tasks[1].Progress)
+	if assert.NotNil(t, imported.Tasks[1].Worker) {
+		assert.Equal(t, worker.UUID, imported.Tasks[1].Worker.UUID)
+	}
+
+	require.Len(t, imported.TaskFailures, 1)
+	assert.Equal(t, task1.UUID, imported.TaskFailures[0].Task.UUID)
+	require.Len(t, imported.Blocklist, 1)
+	assert.Equal(t, "blender", imported.Blocklist[0].TaskType)
+}
+
+func TestJobArchiveUnknownWorker(t *testing.T) {
+	ctx, close, db, job, authoredJob := jobTasksTestFixtures(t)
+	defer close()
+
+	worker := createWorker(ctx, t, db)
+	task1, err := db.FetchTask(ctx, authoredJob.Tasks[1].UUID)
+	require.NoError(t, err)
+	require.NoError(t, db.TaskAssignToWorker(ctx, task1, worker))
+	require.NoError(t, db.AddWorkerToJobBlocklist(ctx, job, worker, "blender"))
+
+	data, err := db.FetchJobArchiveData(ctx, job.UUID)
+	require.NoError(t, err)
+
+	// Simulate importing into a Manager that doesn't know this worker.
+	require.NoError(t, db.DeleteJob(ctx, job.UUID))
+	require.NoError(t, db.DeleteWorker(ctx, worker.UUID))
+	require.NoError(t, db.StoreJobArchiveData(ctx, data, nil))
+
+	imported, err := db.FetchJobArchiveData(ctx, job.UUID)
+	require.NoError(t, err)
+	require.Len(t, imported.Tasks, 3)
+	assert.Nil(t, imported.Tasks[1].Worker)
+	assert.Empty(t, imported.Blocklist)
+}
+
+func TestJobArchiveRequeueActiveTasks(t *testing.T) {
+	ctx, close, db, job, authoredJob := jobTasksTestFixtures(t)
+	defer close()
+
+	task1, err := db.FetchTask(ctx, authoredJob.Tasks[1].UUID)
+	require.NoError(t, err)
+	task1.Status = api.TaskStatusActive
+	task1.Progress = 47
+	require.NoError(t, db.SaveTask(ctx, task1))
+
+	data, err := db.FetchJobArchiveData(ctx, job.UUID)
+	require.NoError(t, err)
+	require.NoError(t, db.DeleteJob(ctx, job.UUID))
+	require.NoError(t, db.StoreJobArchiveData(ctx, data, nil))
+
+	imported, err := db.FetchTask(ctx, task1.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, api.TaskStatusQueued, imported.Status)
+	assert.Zero(t, imported.Progress)
+	assert.Contains(t, imported.Activity, "requeued")
+}
+
+func TestJobArchiveIntermediateStatus(t *testing.T) {
+	ctx, close, db, job, authoredJob := jobTasksTestFixtures(t)
+	defer close()
+
+	task1, err := db.FetchTask(ctx, authoredJob.Tasks[1].UUID)
+	require.NoError(t, err)
+	task1.Status = api.TaskStatusActive
+	require.NoError(t, db.SaveTask(ctx, task1))
+	task2, err := db.FetchTask(ctx, authoredJob.Tasks[2].UUID)
+	require.NoError(t, err)
+	task2.Status = api.TaskStatusCompleted
+	require.NoError(t, db.SaveTask(ctx, task2))
+
+	data, err := db.FetchJobArchiveData(ctx, job.UUID)
+	require.NoError(t, err)
+	require.NoError(t, db.DeleteJob(ctx, job.UUID))
+
+	{ // A job that was being canceled should be canceled, along with its tasks.
+		data.Job.Status = api.JobStatusCancelRequested
+		require.NoError(t, db.StoreJobArchiveData(ctx, data, nil))
+
+		imported, err := db.FetchJob(ctx, job.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, api.JobStatusCanceled, imported.Status)
+
+		importedTask, err := db.FetchTask(ctx, task1.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, api.TaskStatusCanceled, importedTask.Status)
+		importedTask, err = db.FetchTask(ctx, task2.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, api.TaskStatusCompleted, importedTask.Status)
+
+		require.NoError(t, db.DeleteJob(ctx, job.UUID))
+	}
+
+	{ // A job that was being requeued should be paused.
+		data.Job.Status = api.JobStatusRequeueing
+		require.NoError(t, db.StoreJobArchiveData(ctx, data, nil))
+
+		imported, err := db.FetchJob(ctx, job.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, api.JobStatusPaused, imported.Status)
+
+		importedTask, err := db.FetchTask(ctx, task1.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, api.TaskStatusQueued, importedTask.Status)
+	}
+}
+
+func TestJobArchiveBeforeCommitError(t *testing.T) {
+	ctx, close, db, job, _ := jobTasksTestFixtures(t)
+	defer close()
+
+	data, err := db.FetchJobArchiveData(ctx, job.UUID)
+	require.NoError(t, err)
+	require.NoError(t, db.DeleteJob(ctx, job.UUID))
+
+	// An error from the callback should roll back the import.
+	errCallback := errors.New("disk is full")
+	err = db.StoreJobArchiveData(ctx, data, func() error { return errCallback })
+	assert.ErrorIs(t, err, errCallback)
+
+	_, err = db.FetchJob(ctx, job.UUID)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
@@ -22,15 +22,17 @@ func (db *DB) QueryJobs(ctx context.Context, apiQ api.JobsQuery) ([]*Job, error)
 	}
 	if apiQ.Settings != nil {
 		for setting, value := range apiQ.Settings.AdditionalProperties {
-			q = q.Where("json_extract(metadata, ?) = ?", "$."+setting, value)
+			expr, path := jsonExtract(q, "metadata", setting)
+			q = q.Where(expr+" = ?", path, value)
 		}
 	}
 	if apiQ.Metadata != nil {
 		for setting, value := range apiQ.Metadata.AdditionalProperties {
+			expr, path := jsonExtract(q, "metadata", setting)
 			if strings.ContainsRune(value, '%') {
-				q = q.Where("json_extract(metadata, ?) like ?", "$."+setting, value)
+				q = q.Where(expr+" "+likeOperator(q)+" ?", path, value)
 			} else {
-				q = q.Where("json_extract(metadata, ?) = ?", "$."+setting, value)
+				q = q.Where(expr+" = ?", path, value)
 			}
 		}
 	}
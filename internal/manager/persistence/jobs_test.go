@@ -4,6 +4,7 @@ package persistence
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"database/sql"
 	"fmt"
 	"math"
 	"testing"
@@ -75,6 +76,134 @@ func TestStoreAuthoredJobWithShamanCheckoutID(t *testing.T) {
 	assert.Equal(t, job.Storage.ShamanCheckoutID, fetchedJob.Storage.ShamanCheckoutID)
 }
 
+func TestStoreAuthoredJobWithDependencies(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	depJob := createTestAuthoredJobWithTasks()
+	require.NoError(t, db.StoreAuthoredJob(ctx, depJob))
+
+	job := duplicateJobAndTasks(depJob)
+	job.DependsOn = []string{depJob.JobID}
+	require.NoError(t, db.StoreAuthoredJob(ctx, job))
+
+	fetchedJob, err := db.FetchJob(ctx, job.JobID)
+	require.NoError(t, err)
+	require.Len(t, fetchedJob.Dependencies, 1)
+	assert.Equal(t, depJob.JobID, fetchedJob.Dependencies[0].UUID)
+
+	// Depending on an unknown job should fail.
+	unknownDepJob := duplicateJobAndTasks(depJob)
+	unknownDepJob.DependsOn = []string{"aee71b6f-b7d5-4f9e-a69b-1e36e87a45aa"}
+	err = db.StoreAuthoredJob(ctx, unknownDepJob)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestFetchWaitingJobsDue(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	now := time.Date(2023, time.October, 17, 20, 0, 0, 0, time.UTC)
+
+	dueJob := createTestAuthoredJobWithTasks()
+	dueJob.Status = api.JobStatusWaiting
+	dueJob.NotBefore = now.Add(-time.Minute)
+	require.NoError(t, db.StoreAuthoredJob(ctx, dueJob))
+
+	notDueJob := duplicateJobAndTasks(dueJob)
+	notDueJob.NotBefore = now.Add(time.Hour)
+	require.NoError(t, db.StoreAuthoredJob(ctx, notDueJob))
+
+	// Jobs that are not waiting should never be returned.
+	queuedJob := duplicateJobAndTasks(dueJob)
+	queuedJob.Status = api.JobStatusQueued
+	require.NoError(t, db.StoreAuthoredJob(ctx, queuedJob))
+
+	jobs, err := db.FetchWaitingJobsDue(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, dueJob.JobID, jobs[0].UUID)
+	assert.True(t, jobs[0].NotBefore.Valid)
+	assert.True(t, dueJob.NotBefore.Equal(jobs[0].NotBefore.Time))
+
+	jobs, err = db.FetchWaitingJobsDue(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+}
+
+func TestFetchWaitingJobsDueNonUTC(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	// Submitted with a non-UTC 'not before' timestamp: 18:00 UTC.
+	plusTwo := time.FixedZone("UTC+2", 2*60*60)
+	job := createTestAuthoredJobWithTasks()
+	job.Status = api.JobStatusWaiting
+	job.NotBefore = time.Date(2023, time.October, 17, 20, 0, 0, 0, plusTwo)
+	require.NoError(t, db.StoreAuthoredJob(ctx, job))
+
+	dbJob, err := db.FetchJob(ctx, job.JobID)
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, dbJob.NotBefore.Time.Location(), "timestamps should be stored in UTC")
+
+	// Query with yet another timezone: 17:30 UTC, so not due yet.
+	minusFive := time.FixedZone("UTC-5", -5*60*60)
+	jobs, err := db.FetchWaitingJobsDue(ctx, time.Date(2023, time.October, 17, 12, 30, 0, 0, minusFive))
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	// 19:00 UTC, so it is due.
+	jobs, err = db.FetchWaitingJobsDue(ctx, time.Date(2023, time.October, 17, 14, 0, 0, 0, minusFive))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, job.JobID, jobs[0].UUID)
+	assert.True(t, job.NotBefore.Equal(jobs[0].NotBefore.Time))
+}
+
+func TestFetchJobsActiveBetween(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	startTime := time.Date(2023, time.October, 17, 20, 0, 0, 0, time.UTC)
+	mockNow := startTime
+	db.gormDB.NowFunc = func() time.Time { return mockNow }
+
+	oldJob := createTestAuthoredJobWithTasks()
+	require.NoError(t, db.StoreAuthoredJob(ctx, oldJob))
+
+	mockNow = startTime.Add(2 * time.Hour)
+	newJob := duplicateJobAndTasks(oldJob)
+	require.NoError(t, db.StoreAuthoredJob(ctx, newJob))
+
+	// Range that only overlaps with the old job.
+	jobs, err := db.FetchJobsActiveBetween(ctx, startTime.Add(-time.Minute), startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, oldJob.JobID, jobs[0].UUID)
+
+	// Range that only overlaps with the new job.
+	jobs, err = db.FetchJobsActiveBetween(ctx, startTime.Add(time.Hour), startTime.Add(3*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, newJob.JobID, jobs[0].UUID)
+
+	// Range that overlaps with both.
+	jobs, err = db.FetchJobsActiveBetween(ctx, startTime, startTime.Add(3*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, oldJob.JobID, jobs[0].UUID)
+	assert.Equal(t, newJob.JobID, jobs[1].UUID)
+
+	// Range in another timezone, 19:30-21:00 UTC, that only overlaps with the old job.
+	minusFive := time.FixedZone("UTC-5", -5*60*60)
+	jobs, err = db.FetchJobsActiveBetween(ctx,
+		time.Date(2023, time.October, 17, 14, 30, 0, 0, minusFive),
+		time.Date(2023, time.October, 17, 16, 0, 0, 0, minusFive))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, oldJob.JobID, jobs[0].UUID)
+}
+
 func TestSaveJobStorageInfo(t *testing.T) {
 	// Test that saving job storage info doesn't count as "update".
 	// This is necessary for `cmd/shaman-checkout-id-setter` to do its work quietly.
@@ -155,6 +284,44 @@ func TestDeleteJob(t *testing.T) {
 		"all remaining tasks should belong to the other job")
 }
 
+func TestDeleteJobWithDependents(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	depAuthoredJob := createTestAuthoredJobWithTasks()
+	depAuthoredJob.Status = api.JobStatusQueued
+	depJob := persistAuthoredJob(t, ctx, db, depAuthoredJob)
+
+	authoredJob := duplicateJobAndTasks(depAuthoredJob)
+	authoredJob.DependsOn = []string{depJob.UUID}
+	job := persistAuthoredJob(t, ctx, db, authoredJob)
+
+	// Deleting the job would make the dependent job run without it.
+	err := db.RequestJobDeletion(ctx, depJob)
+	require.ErrorIs(t, err, ErrJobHasDependents)
+	assert.ErrorContains(t, err, job.UUID)
+	assert.ErrorIs(t, db.DeleteJob(ctx, depJob.UUID), ErrJobHasDependents)
+
+	uuids, err := db.RequestJobMassDeletion(ctx, db.gormDB.NowFunc().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{job.UUID}, uuids, "only the dependent job should be marked for deletion")
+
+	// The dependency should still be there.
+	dbDepJob, err := db.FetchJob(ctx, depJob.UUID)
+	require.NoError(t, err)
+	assert.False(t, dbDepJob.DeleteRequested())
+	dbJob, err := db.FetchJob(ctx, job.UUID)
+	require.NoError(t, err)
+	require.Len(t, dbJob.Dependencies, 1)
+	assert.Equal(t, depJob.UUID, dbJob.Dependencies[0].UUID)
+
+	// Once the dependent job is no longer waiting, the job can be deleted.
+	dbJob.Status = api.JobStatusCanceled
+	require.NoError(t, db.SaveJobStatus(ctx, dbJob))
+	require.NoError(t, db.RequestJobDeletion(ctx, dbDepJob))
+	require.NoError(t, db.DeleteJob(ctx, depJob.UUID))
+}
+
 func TestRequestJobDeletion(t *testing.T) {
 	ctx, close, db, job1, authoredJob1 := jobTasksTestFixtures(t)
 	defer close()
@@ -343,6 +510,55 @@ func TestCountTasksOfJobInStatus(t *testing.T) {
 	assert.Equal(t, 3, numTotal)
 }
 
+func TestTaskProgress(t *testing.T) {
+	ctx, close, db, job, authoredJob := jobTasksTestFixtures(t)
+	defer close()
+
+	progress, err := db.FetchJobProgress(ctx, job)
+	require.NoError(t, err)
+	assert.Equal(t, 0, progress)
+
+	task1, err := db.FetchTask(ctx, authoredJob.Tasks[0].UUID)
+	require.NoError(t, err)
+	task1.Progress = 50
+	require.NoError(t, db.SaveTaskProgress(ctx, task1))
+
+	// Completed tasks count as 100%, regardless of the last-reported progress.
+	task2, err := db.FetchTask(ctx, authoredJob.Tasks[1].UUID)
+	require.NoError(t, err)
+	task2.Status = api.TaskStatusCompleted
+	require.NoError(t, db.SaveTaskStatus(ctx, task2))
+	assert.Equal(t, 100, task2.Progress)
+
+	progress, err = db.FetchJobProgress(ctx, job)
+	require.NoError(t, err)
+	assert.Equal(t, 50, progress) // (50 + 100 + 0) / 3
+
+	// Soft-failing should reset the progress, as the task will run again.
+	task1.Status = api.TaskStatusSoftFailed
+	require.NoError(t, db.SaveTaskStatus(ctx, task1))
+	assert.Equal(t, 0, task1.Progress)
+	task1.Progress = 50
+	require.NoError(t, db.SaveTaskProgress(ctx, task1))
+
+	// Requeueing should reset the progress.
+	task1.Status = api.TaskStatusQueued
+	require.NoError(t, db.SaveTaskStatus(ctx, task1))
+	dbTask1, err := db.FetchTask(ctx, task1.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, dbTask1.Progress)
+
+	require.NoError(t, db.UpdateJobsTaskStatuses(ctx, job, api.TaskStatusQueued, ""))
+	progress, err = db.FetchJobProgress(ctx, job)
+	require.NoError(t, err)
+	assert.Equal(t, 0, progress)
+
+	// The activity should not be touched when not given.
+	dbTask2, err := db.FetchTask(ctx, task2.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, task2.Activity, dbTask2.Activity)
+}
+
 func TestCheckIfJobsHoldLargeNumOfTasks(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping test in short mode")
@@ -559,6 +775,9 @@ func TestClearFailureListOfTask(t *testing.T) {
 	_, _ = db.AddWorkerToTaskFailedList(ctx, task1, worker1)
 	_, _ = db.AddWorkerToTaskFailedList(ctx, task1, worker2)
 	_, _ = db.AddWorkerToTaskFailedList(ctx, task2, worker1)
+	task1.FailureCount = 2
+	task1.RetryAfter = sql.NullTime{Time: db.gormDB.NowFunc().Add(time.Minute), Valid: true}
+	assert.NoError(t, db.SaveTaskRetryState(ctx, task1))
 
 	// Clearing should just update this one task.
 	assert.NoError(t, db.ClearFailureListOfTask(ctx, task1))
@@ -569,6 +788,12 @@ func TestClearFailureListOfTask(t *testing.T) {
 		assert.Equal(t, task2.ID, failures[0].TaskID)
 		assert.Equal(t, worker1.ID, failures[0].WorkerID)
 	}
+
+	// The retry state should have been reset too.
+	dbTask1, err := db.FetchTask(ctx, task1.UUID)
+	require.NoError(t, err)
+	assert.Zero(t, dbTask1.FailureCount)
+	assert.False(t, dbTask1.RetryAfter.Valid)
 }
 
 func TestClearFailureListOfJob(t *testing.T) {
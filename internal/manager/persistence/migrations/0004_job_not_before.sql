@@ -0,0 +1,8 @@
+-- Jobs can be submitted with a 'not before' timestamp. Until that time, the
+-- job stays in 'waiting' status and will not be scheduled.
+--
+-- +goose Up
+ALTER TABLE `jobs` ADD COLUMN `not_before` datetime;
+
+-- +goose Down
+ALTER TABLE `jobs` DROP COLUMN `not_before`;
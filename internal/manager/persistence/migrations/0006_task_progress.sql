@@ -0,0 +1,7 @@
+-- Tasks have a progress percentage, reported by the Worker.
+--
+-- +goose Up
+ALTER TABLE `tasks` ADD COLUMN `progress` smallint DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE `tasks` DROP COLUMN `progress`;
@@ -0,0 +1,25 @@
+-- Job templates are jobs that are submitted periodically, according to a
+-- cron-style schedule.
+--
+-- +goose Up
+CREATE TABLE `job_templates` (
+  `id` integer,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `uuid` char(36) UNIQUE DEFAULT "",
+  `name` varchar(64) DEFAULT "",
+  `cron` varchar(255) DEFAULT "",
+  `is_active` numeric DEFAULT false,
+  `job` jsonb,
+  `next_run` datetime,
+  `last_run` datetime,
+  `last_job_uuid` char(36) DEFAULT "",
+  `last_error` varchar(255) DEFAULT "",
+  PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_job_templates_uuid` ON `job_templates`(`uuid`);
+CREATE INDEX `idx_job_templates_is_active` ON `job_templates`(`is_active`);
+CREATE INDEX `idx_job_templates_next_run` ON `job_templates`(`next_run`);
+
+-- +goose Down
+DROP TABLE `job_templates`;
@@ -0,0 +1,10 @@
+-- Sleep schedules can have multiple time windows, and dates on which they do
+-- not apply.
+--
+-- +goose Up
+ALTER TABLE `sleep_schedules` ADD COLUMN `extra_windows` text DEFAULT "";
+ALTER TABLE `sleep_schedules` ADD COLUMN `exception_dates` text DEFAULT "";
+
+-- +goose Down
+ALTER TABLE `sleep_schedules` DROP COLUMN `extra_windows`;
+ALTER TABLE `sleep_schedules` DROP COLUMN `exception_dates`;
@@ -0,0 +1,58 @@
+-- Sleep schedules can belong to a worker tag instead of a worker. This makes
+-- `worker_id` nullable, which requires recreating the table.
+--
+-- +goose Up
+CREATE TABLE `temp_sleep_schedules` (
+  `id` integer,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `worker_id` integer UNIQUE,
+  `worker_tag_id` integer UNIQUE,
+  `is_active` numeric DEFAULT false,
+  `days_of_week` text DEFAULT "",
+  `start_time` text DEFAULT "",
+  `end_time` text DEFAULT "",
+  `extra_windows` text DEFAULT "",
+  `exception_dates` text DEFAULT "",
+  `next_check` datetime,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_sleep_schedules_worker` FOREIGN KEY (`worker_id`) REFERENCES `workers`(`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_sleep_schedules_worker_tag` FOREIGN KEY (`worker_tag_id`) REFERENCES `worker_tags`(`id`) ON DELETE CASCADE
+);
+INSERT INTO `temp_sleep_schedules`
+  (`id`, `created_at`, `updated_at`, `worker_id`, `is_active`, `days_of_week`,
+   `start_time`, `end_time`, `extra_windows`, `exception_dates`, `next_check`)
+  SELECT `id`, `created_at`, `updated_at`, `worker_id`, `is_active`, `days_of_week`,
+   `start_time`, `end_time`, `extra_windows`, `exception_dates`, `next_check`
+  FROM `sleep_schedules`;
+DROP TABLE `sleep_schedules`;
+ALTER TABLE `temp_sleep_schedules` RENAME TO `sleep_schedules`;
+CREATE INDEX `idx_sleep_schedules_is_active` ON `sleep_schedules`(`is_active`);
+CREATE INDEX `idx_sleep_schedules_worker_id` ON `sleep_schedules`(`worker_id`);
+CREATE INDEX `idx_sleep_schedules_worker_tag_id` ON `sleep_schedules`(`worker_tag_id`);
+
+-- +goose Down
+CREATE TABLE `temp_sleep_schedules` (
+  `id` integer,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `worker_id` integer UNIQUE DEFAULT 0,
+  `is_active` numeric DEFAULT false,
+  `days_of_week` text DEFAULT "",
+  `start_time` text DEFAULT "",
+  `end_time` text DEFAULT "",
+  `extra_windows` text DEFAULT "",
+  `exception_dates` text DEFAULT "",
+  `next_check` datetime,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_sleep_schedules_worker` FOREIGN KEY (`worker_id`) REFERENCES `workers`(`id`) ON DELETE CASCADE
+);
+INSERT INTO `temp_sleep_schedules`
+  SELECT `id`, `created_at`, `updated_at`, `worker_id`, `is_active`, `days_of_week`,
+   `start_time`, `end_time`, `extra_windows`, `exception_dates`, `next_check`
+  FROM `sleep_schedules`
+  WHERE `worker_id` IS NOT NULL;
+DROP TABLE `sleep_schedules`;
+ALTER TABLE `temp_sleep_schedules` RENAME TO `sleep_schedules`;
+CREATE INDEX `idx_sleep_schedules_is_active` ON `sleep_schedules`(`is_active`);
+CREATE INDEX `idx_sleep_schedules_worker_id` ON `sleep_schedules`(`worker_id`);
@@ -0,0 +1,10 @@
+-- Tasks can require a minimum number of CPUs and amount of RAM from the Worker
+-- that executes them.
+--
+-- +goose Up
+ALTER TABLE `tasks` ADD COLUMN `min_cpu_count` smallint DEFAULT 0;
+ALTER TABLE `tasks` ADD COLUMN `min_memory_gb` smallint DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE `tasks` DROP COLUMN `min_cpu_count`;
+ALTER TABLE `tasks` DROP COLUMN `min_memory_gb`;
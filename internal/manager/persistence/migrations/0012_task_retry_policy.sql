@@ -0,0 +1,18 @@
+-- Jobs have a retry policy, which determines how often and after what delay
+-- their failed tasks are retried.
+--
+-- +goose Up
+ALTER TABLE `jobs` ADD COLUMN `retry_max_failures` smallint DEFAULT 0;
+ALTER TABLE `jobs` ADD COLUMN `retry_delay_seconds` integer DEFAULT 0;
+ALTER TABLE `jobs` ADD COLUMN `retry_max_delay_seconds` integer DEFAULT 0;
+ALTER TABLE `jobs` ADD COLUMN `retry_allow_same_worker` numeric DEFAULT false;
+ALTER TABLE `tasks` ADD COLUMN `failure_count` smallint DEFAULT 0;
+ALTER TABLE `tasks` ADD COLUMN `retry_after` datetime;
+
+-- +goose Down
+ALTER TABLE `jobs` DROP COLUMN `retry_max_failures`;
+ALTER TABLE `jobs` DROP COLUMN `retry_delay_seconds`;
+ALTER TABLE `jobs` DROP COLUMN `retry_max_delay_seconds`;
+ALTER TABLE `jobs` DROP COLUMN `retry_allow_same_worker`;
+ALTER TABLE `tasks` DROP COLUMN `failure_count`;
+ALTER TABLE `tasks` DROP COLUMN `retry_after`;
@@ -0,0 +1,10 @@
+-- Sleep schedules that should be checked as soon as possible have a NULL
+-- `next_check`, instead of an empty string or a zero timestamp. This way the
+-- check for such schedules works the same on SQLite and PostgreSQL.
+--
+-- +goose Up
+UPDATE `sleep_schedules` SET `next_check` = NULL
+  WHERE `next_check` = '' OR `next_check` LIKE '0001-01-01%';
+
+-- +goose Down
+-- Not undoing this, as NULL is also handled by older versions of Flamenco.
@@ -0,0 +1,145 @@
+-- This is the initial Goose migration for Flamenco Manager on PostgreSQL. It
+-- creates the same schema as the SQLite migrations 0001 and 0002 combined.
+--
+-- WARNING: the 'Down' step will erase the entire database.
+--
+-- +goose Up
+CREATE TABLE IF NOT EXISTS worker_tags (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  uuid char(36) UNIQUE DEFAULT '',
+  name text UNIQUE DEFAULT '',
+  description text DEFAULT '',
+  PRIMARY KEY (id)
+);
+CREATE TABLE IF NOT EXISTS workers (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  deleted_at timestamptz,
+  uuid char(36) UNIQUE DEFAULT '',
+  secret text DEFAULT '',
+  name text DEFAULT '',
+  address text DEFAULT '',
+  platform text DEFAULT '',
+  software text DEFAULT '',
+  status text DEFAULT '',
+  last_seen_at timestamptz,
+  status_requested text DEFAULT '',
+  lazy_status_request boolean DEFAULT false,
+  supported_task_types text DEFAULT '',
+  can_restart boolean DEFAULT false,
+  PRIMARY KEY (id)
+);
+CREATE TABLE IF NOT EXISTS jobs (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  uuid char(36) UNIQUE DEFAULT '',
+  name text DEFAULT '',
+  job_type text DEFAULT '',
+  priority smallint DEFAULT 0,
+  status text DEFAULT '',
+  activity text DEFAULT '',
+  settings jsonb,
+  metadata jsonb,
+  delete_requested_at timestamptz,
+  storage_shaman_checkout_id text DEFAULT '',
+  worker_tag_id bigint,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_jobs_worker_tag FOREIGN KEY (worker_tag_id) REFERENCES worker_tags(id) ON DELETE SET NULL
+);
+CREATE TABLE IF NOT EXISTS tasks (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  uuid char(36) UNIQUE DEFAULT '',
+  name text DEFAULT '',
+  type text DEFAULT '',
+  job_id bigint DEFAULT 0,
+  priority smallint DEFAULT 50,
+  status text DEFAULT '',
+  worker_id bigint,
+  last_touched_at timestamptz,
+  commands jsonb,
+  activity text DEFAULT '',
+  PRIMARY KEY (id),
+  CONSTRAINT fk_tasks_job FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_tasks_worker FOREIGN KEY (worker_id) REFERENCES workers(id) ON DELETE SET NULL
+);
+CREATE TABLE IF NOT EXISTS last_rendereds (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  job_id bigint DEFAULT 0,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_last_rendereds_job FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS task_dependencies (
+  task_id bigint,
+  dependency_id bigint,
+  PRIMARY KEY (task_id, dependency_id),
+  CONSTRAINT fk_task_dependencies_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_dependencies_dependencies FOREIGN KEY (dependency_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS task_failures (
+  created_at timestamptz,
+  task_id bigint,
+  worker_id bigint,
+  PRIMARY KEY (task_id, worker_id),
+  CONSTRAINT fk_task_failures_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_failures_worker FOREIGN KEY (worker_id) REFERENCES workers(id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS worker_tag_membership (
+  worker_tag_id bigint,
+  worker_id bigint,
+  PRIMARY KEY (worker_tag_id, worker_id),
+  CONSTRAINT fk_worker_tag_membership_worker_tag FOREIGN KEY (worker_tag_id) REFERENCES worker_tags(id) ON DELETE CASCADE,
+  CONSTRAINT fk_worker_tag_membership_worker FOREIGN KEY (worker_id) REFERENCES workers(id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS job_blocks (
+  id bigserial,
+  created_at timestamptz,
+  job_id bigint DEFAULT 0,
+  worker_id bigint DEFAULT 0,
+  task_type text,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_job_blocks_job FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_blocks_worker FOREIGN KEY (worker_id) REFERENCES workers(id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS sleep_schedules (
+  id bigserial,
+  created_at timestamptz,
+  updated_at timestamptz,
+  worker_id bigint UNIQUE DEFAULT 0,
+  is_active boolean DEFAULT false,
+  days_of_week text DEFAULT '',
+  start_time text DEFAULT '',
+  end_time text DEFAULT '',
+  next_check timestamptz,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_sleep_schedules_worker FOREIGN KEY (worker_id) REFERENCES workers(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_worker_tags_uuid ON worker_tags(uuid);
+CREATE INDEX IF NOT EXISTS idx_jobs_uuid ON jobs(uuid);
+CREATE INDEX IF NOT EXISTS idx_workers_address ON workers(address);
+CREATE INDEX IF NOT EXISTS idx_workers_last_seen_at ON workers(last_seen_at);
+CREATE INDEX IF NOT EXISTS idx_workers_deleted_at ON workers(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_workers_uuid ON workers(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS job_worker_tasktype ON job_blocks(job_id, worker_id, task_type);
+CREATE INDEX IF NOT EXISTS idx_sleep_schedules_is_active ON sleep_schedules(is_active);
+CREATE INDEX IF NOT EXISTS idx_sleep_schedules_worker_id ON sleep_schedules(worker_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_uuid ON tasks(uuid);
+CREATE INDEX IF NOT EXISTS idx_tasks_last_touched_at ON tasks(last_touched_at);
+-- +goose Down
+DROP TABLE sleep_schedules;
+DROP TABLE job_blocks;
+DROP TABLE worker_tag_membership;
+DROP TABLE task_failures;
+DROP TABLE task_dependencies;
+DROP TABLE last_rendereds;
+DROP TABLE tasks;
+DROP TABLE jobs;
+DROP TABLE workers;
+DROP TABLE worker_tags;
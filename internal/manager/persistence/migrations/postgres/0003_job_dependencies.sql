@@ -0,0 +1,17 @@
+-- Job dependencies, i.e. jobs that have to be completed before another job can
+-- start. This is the job-level equivalent of the `task_dependencies` table.
+--
+-- Migration 0002 only exists for SQLite, as it cleans up a table that was never
+-- created on PostgreSQL.
+--
+-- +goose Up
+CREATE TABLE job_dependencies (
+  job_id bigint,
+  dependency_id bigint,
+  PRIMARY KEY (job_id, dependency_id),
+  CONSTRAINT fk_job_dependencies_job FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_dependencies_dependencies FOREIGN KEY (dependency_id) REFERENCES jobs(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE job_dependencies;
@@ -0,0 +1,7 @@
+-- Jobs can have a maximum number of Workers that work on them concurrently.
+--
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN max_workers smallint DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN max_workers;
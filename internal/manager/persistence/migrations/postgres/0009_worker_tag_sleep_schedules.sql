@@ -0,0 +1,14 @@
+-- Sleep schedules can belong to a worker tag instead of a worker.
+--
+-- +goose Up
+ALTER TABLE sleep_schedules ALTER COLUMN worker_id DROP DEFAULT;
+ALTER TABLE sleep_schedules ADD COLUMN worker_tag_id bigint UNIQUE;
+ALTER TABLE sleep_schedules ADD CONSTRAINT fk_sleep_schedules_worker_tag
+  FOREIGN KEY (worker_tag_id) REFERENCES worker_tags(id) ON DELETE CASCADE;
+CREATE INDEX idx_sleep_schedules_worker_tag_id ON sleep_schedules(worker_tag_id);
+
+-- +goose Down
+DELETE FROM sleep_schedules WHERE worker_id IS NULL;
+DROP INDEX idx_sleep_schedules_worker_tag_id;
+ALTER TABLE sleep_schedules DROP COLUMN worker_tag_id;
+ALTER TABLE sleep_schedules ALTER COLUMN worker_id SET DEFAULT 0;
@@ -0,0 +1,20 @@
+-- Workers report their hardware when signing on, and their resource usage in
+-- periodic heartbeats.
+--
+-- +goose Up
+ALTER TABLE workers ADD COLUMN cpu_count smallint DEFAULT 0;
+ALTER TABLE workers ADD COLUMN memory_total bigint DEFAULT 0;
+ALTER TABLE workers ADD COLUMN os_description text DEFAULT '';
+ALTER TABLE workers ADD COLUMN temp_disk_free bigint DEFAULT 0;
+ALTER TABLE workers ADD COLUMN memory_used bigint DEFAULT 0;
+ALTER TABLE workers ADD COLUMN load_average double precision;
+ALTER TABLE workers ADD COLUMN resources_sampled_at timestamptz;
+
+-- +goose Down
+ALTER TABLE workers DROP COLUMN cpu_count;
+ALTER TABLE workers DROP COLUMN memory_total;
+ALTER TABLE workers DROP COLUMN os_description;
+ALTER TABLE workers DROP COLUMN temp_disk_free;
+ALTER TABLE workers DROP COLUMN memory_used;
+ALTER TABLE workers DROP COLUMN load_average;
+ALTER TABLE workers DROP COLUMN resources_sampled_at;
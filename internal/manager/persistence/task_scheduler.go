@@ -15,10 +15,30 @@ import (
 var (
 	// Note that active tasks are not schedulable, because they're already dunning on some worker.
 	schedulableTaskStatuses = []api.TaskStatus{api.TaskStatusQueued, api.TaskStatusSoftFailed}
-	schedulableJobStatuses  = []api.JobStatus{api.JobStatusActive, api.JobStatusQueued}
+	// Paused jobs are deliberately not schedulable; their active tasks can finish,
+	// but no new tasks are handed out.
+	schedulableJobStatuses = []api.JobStatus{api.JobStatusActive, api.JobStatusQueued}
 	// completedTaskStatuses   = []api.TaskStatus{api.TaskStatusCompleted}
 )
 
+// FairShare configures fair-share scheduling in ScheduleTask. When enabled,
+// tasks of jobs of the same priority are handed out in order of how many active
+// tasks those jobs already have, so that one big job cannot starve the others.
+type FairShare struct {
+	Enabled bool
+
+	// SubmitterMetadataKey is the job metadata key that identifies who submitted
+	// the job. When non-empty, the active tasks of all jobs of the same submitter
+	// are counted first, and only then the active tasks of the job itself.
+	SubmitterMetadataKey string
+}
+
+// SetFairShare configures fair-share scheduling for ScheduleTask.
+// This is not thread-safe, and should be called before tasks are scheduled.
+func (db *DB) SetFairShare(fairShare FairShare) {
+	db.fairShare = fairShare
+}
+
 // ScheduleTask finds a task to execute by the given worker.
 // If no task is available, (nil, nil) is returned, as this is not an error situation.
 // NOTE: this does not also fetch returnedTask.Worker, but returnedTask.WorkerID is set.
@@ -37,7 +57,7 @@ func (db *DB) ScheduleTask(ctx context.Context, w *Worker) (*Task, error) {
 	var task *Task
 	txErr := db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var err error
-		task, err = findTaskForWorker(tx, w, hasWorkerTags)
+		task, err = findTaskForWorker(tx, w, hasWorkerTags, db.fairShare)
 		if err != nil {
 			if isDatabaseBusyError(err) {
 				logger.Trace().Err(err).Msg("database busy while finding task for worker")
@@ -84,7 +104,7 @@ func (db *DB) ScheduleTask(ctx context.Context, w *Worker) (*Task, error) {
 	return task, nil
 }
 
-func findTaskForWorker(tx *gorm.DB, w *Worker, checkWorkerTags bool) (*Task, error) {
+func findTaskForWorker(tx *gorm.DB, w *Worker, checkWorkerTags bool, fairShare FairShare) (*Task, error) {
 	task := Task{}
 
 	// If a task is alreay active & assigned to this worker, return just that.
@@ -110,6 +130,22 @@ func findTaskForWorker(tx *gorm.DB, w *Worker, checkWorkerTags bool) (*Task, err
 		Where("tasks2.id = tasks.id").
 		Where("dep.status is not NULL and dep.status != ?", api.TaskStatusCompleted)
 
+	// Produce the 'current job ID' when any of the jobs it depends on has not
+	// been completed yet. `jobs.id` is the job ID from the outer query.
+	incompleteJobDepsQuery := tx.Table("jobs as jobs2").
+		Select("jobs2.id").
+		Joins("left join job_dependencies jd on jobs2.id = jd.job_id").
+		Joins("left join jobs depjob on depjob.id = jd.dependency_id").
+		Where("jobs2.id = jobs.id").
+		Where("depjob.status is not NULL and depjob.status != ?", api.JobStatusCompleted)
+
+	// Count the active tasks of the job, to limit the number of concurrent
+	// workers. `jobs.id` is the job ID from the outer query.
+	jobActiveTasksQuery := tx.Table("tasks as tasks3").
+		Select("count(*)").
+		Where("tasks3.job_id = jobs.id").
+		Where("tasks3.status = ?", api.TaskStatusActive)
+
 	blockedTaskTypesQuery := tx.Model(&JobBlock{}).
 		Select("job_blocks.task_type").
 		Where("job_blocks.worker_id = ?", w.ID).
@@ -126,9 +162,25 @@ func findTaskForWorker(tx *gorm.DB, w *Worker, checkWorkerTags bool) (*Task, err
 		Where("jobs.status in ?", schedulableJobStatuses).    // Schedulable job statuses
 		Where("tasks.type in ?", w.TaskTypes()).              // Supported task types
 		Where("tasks.id not in (?)", incompleteDepsQuery).    // Dependencies completed
-		Where("TF.worker_id is NULL").                        // Not failed before
+		Where("jobs.id not in (?)", incompleteJobDepsQuery).  // Job dependencies completed
 		Where("tasks.type not in (?)", blockedTaskTypesQuery) // Non-blocklisted
 
+	// Workers should not retry tasks they failed before, unless the job allows
+	// this. Failed tasks may have to wait before they can be retried.
+	findTaskQuery = findTaskQuery.
+		Where("TF.worker_id is NULL or jobs.retry_allow_same_worker = ?", true).
+		Where("tasks.retry_after is NULL or tasks.retry_after <= ?", tx.NowFunc())
+
+	// Jobs with a maximum number of workers should not get more active tasks than that.
+	findTaskQuery = findTaskQuery.
+		Where("jobs.max_workers = 0 or jobs.max_workers > (?)", jobActiveTasksQuery)
+
+	// Workers should have the resources required by the task. Workers that did
+	// not report their resources only get tasks without requirements.
+	findTaskQuery = findTaskQuery.
+		Where("tasks.min_cpu_count <= ?", w.CPUCount).
+		Where("tasks.min_memory_gb <= ?", w.MemoryTotalGB())
+
 	if checkWorkerTags {
 		// The system has one or more tags, so limit the available jobs to those
 		// that have no tag, or overlap with the Worker's tags.
@@ -147,8 +199,12 @@ func findTaskForWorker(tx *gorm.DB, w *Worker, checkWorkerTags bool) (*Task, err
 		}
 	}
 
+	findTaskQuery = findTaskQuery.Order("jobs.priority desc") // Highest job priority
+	if fairShare.Enabled {
+		findTaskQuery = orderByFairShare(tx, findTaskQuery, fairShare)
+	}
+
 	findTaskResult := findTaskQuery.
-		Order("jobs.priority desc").  // Highest job priority
 		Order("tasks.priority desc"). // Highest task priority
 		Limit(1).
 		Preload("Job").
@@ -165,6 +221,36 @@ func findTaskForWorker(tx *gorm.DB, w *Worker, checkWorkerTags bool) (*Task, err
 	return &task, nil
 }
 
+// orderByFairShare orders the task query such that jobs (and optionally
+// submitters) with the fewest active tasks come first.
+func orderByFairShare(tx *gorm.DB, findTaskQuery *gorm.DB, fairShare FairShare) *gorm.DB {
+	// `jobs.id` is the job ID from the outer query.
+	jobActiveTasksQuery := tx.Table("tasks as job_active_tasks").
+		Select("count(*)").
+		Where("job_active_tasks.job_id = jobs.id").
+		Where("job_active_tasks.status = ?", api.TaskStatusActive)
+
+	if fairShare.SubmitterMetadataKey == "" {
+		return findTaskQuery.
+			Select("tasks.*, (?) as job_num_active", jobActiveTasksQuery).
+			Order("job_num_active asc") // Fewest workers on the job
+	}
+
+	submitterExpr, jsonPath := jsonExtract(tx, "submitter_jobs.metadata", fairShare.SubmitterMetadataKey)
+	jobExpr, _ := jsonExtract(tx, "jobs.metadata", fairShare.SubmitterMetadataKey)
+	submitterActiveTasksQuery := tx.Table("tasks as submitter_active_tasks").
+		Select("count(*)").
+		Joins("left join jobs as submitter_jobs on submitter_active_tasks.job_id = submitter_jobs.id").
+		Where("submitter_active_tasks.status = ?", api.TaskStatusActive).
+		Where(submitterExpr+" = "+jobExpr, jsonPath, jsonPath)
+
+	selectQuery := "tasks.*, (?) as submitter_num_active, (?) as job_num_active"
+	return findTaskQuery.
+		Select(selectQuery, submitterActiveTasksQuery, jobActiveTasksQuery).
+		Order("submitter_num_active asc"). // Fewest workers on the submitter's jobs
+		Order("job_num_active asc")        // Fewest workers on the job
+}
+
 func assignTaskToWorker(tx *gorm.DB, w *Worker, t *Task) error {
 	return tx.Model(t).
 		Select("WorkerID", "LastTouchedAt").
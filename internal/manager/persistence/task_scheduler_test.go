@@ -4,11 +4,13 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 
 	"projects.blender.org/studio/flamenco/internal/manager/job_compilers"
 	"projects.blender.org/studio/flamenco/internal/uuid"
@@ -291,6 +293,70 @@ func TestPreviouslyFailed(t *testing.T) {
 	assert.Equal(t, att2.Name, task.Name, "the second task should have been chosen")
 }
 
+func TestPreviouslyFailedSameWorkerAllowed(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	att1 := authorTestTask("1 failed task", "blender")
+	att2 := authorTestTask("2 other task", "blender")
+	atj := authorTestJob(
+		"1295757b-e668-4c49-8b89-f73db8270e42",
+		"simple-blender-render",
+		att1, att2)
+	atj.RetryPolicy.AllowSameWorker = true
+	job := constructTestJob(ctx, t, db, atj)
+
+	// Mimick that this worker already failed the first task.
+	tasks, err := db.FetchTasksOfJob(ctx, job)
+	require.NoError(t, err)
+	_, err = db.AddWorkerToTaskFailedList(ctx, tasks[0], &w)
+	require.NoError(t, err)
+
+	// As the job allows retrying on the same worker, this should still assign
+	// the 1st task.
+	task, err := db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att1.Name, task.Name)
+}
+
+func TestTaskRetryAfter(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	att1 := authorTestTask("1 delayed task", "blender")
+	att2 := authorTestTask("2 other task", "blender")
+	atj := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1, att2)
+	job := constructTestJob(ctx, t, db, atj)
+
+	// Mimick that the first task failed, and shouldn't be retried for a minute.
+	now := db.gormDB.NowFunc()
+	tasks, err := db.FetchTasksOfJob(ctx, job)
+	require.NoError(t, err)
+	tasks[0].FailureCount = 1
+	tasks[0].RetryAfter = sql.NullTime{Time: now.Add(time.Minute), Valid: true}
+	require.NoError(t, db.SaveTaskRetryState(ctx, tasks[0]))
+
+	task, err := findTaskForWorkerInTx(ctx, db, &w, FairShare{})
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att2.Name, task.Name, "the delayed task should be skipped")
+
+	// After the delay, the first task can be scheduled again.
+	origGormNow := db.gormDB.NowFunc
+	defer func() { db.gormDB.NowFunc = origGormNow }()
+	db.gormDB.NowFunc = func() time.Time { return now.Add(2 * time.Minute) }
+
+	task, err = findTaskForWorkerInTx(ctx, db, &w, FairShare{})
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att1.Name, task.Name)
+}
+
 func TestWorkerTagJobWithTag(t *testing.T) {
 	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
 	defer cancel()
@@ -402,6 +468,225 @@ func TestBlocklisted(t *testing.T) {
 	assert.Equal(t, att2.Name, task.Name, "the second task should have been chosen")
 }
 
+func TestJobDependencies(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	att1 := authorTestTask("1 bake task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	att2 := authorTestTask("2 render task", "blender")
+	att2.Priority = 100
+	atj2 := authorTestJob("7180617b-da70-411c-8b38-b972ab2bae8d", "simple-blender-render", att2)
+	atj2.Priority = 100
+	atj2.DependsOn = []string{atj1.JobID}
+
+	job1 := constructTestJob(ctx, t, db, atj1)
+	job2 := constructTestJob(ctx, t, db, atj2)
+
+	// Job 2 has the higher priority, but has to wait for job 1.
+	task, err := db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att1.Name, task.Name)
+
+	dependentJobs, err := db.FetchDependentJobs(ctx, job1)
+	require.NoError(t, err)
+	require.Len(t, dependentJobs, 1)
+	assert.Equal(t, job2.UUID, dependentJobs[0].UUID)
+
+	// Once job 1 is completed, job 2 can run.
+	setTaskStatus(t, db, att1.UUID, api.TaskStatusCompleted)
+	job1.Status = api.JobStatusCompleted
+	require.NoError(t, db.SaveJobStatus(ctx, job1))
+
+	task, err = db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att2.Name, task.Name)
+}
+
+func TestPausedJob(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	att1 := authorTestTask("1 task", "blender")
+	att2 := authorTestTask("2 task", "blender")
+	atj := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1, att2)
+	job := constructTestJob(ctx, t, db, atj)
+
+	job.Status = api.JobStatusPaused
+	require.NoError(t, db.SaveJobStatus(ctx, job))
+
+	// Tasks of paused jobs should stay queued, but not be scheduled.
+	task, err := db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	assert.Nil(t, task)
+
+	job.Status = api.JobStatusQueued
+	require.NoError(t, db.SaveJobStatus(ctx, job))
+
+	task, err = db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att1.Name, task.Name)
+}
+
+func TestJobMaxWorkers(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w1 := linuxWorker(t, db)
+	w2 := windowsWorker(t, db)
+
+	att1 := authorTestTask("1 task", "blender")
+	att2 := authorTestTask("2 task", "blender")
+	atj := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1, att2)
+	atj.MaxWorkers = 1
+	constructTestJob(ctx, t, db, atj)
+
+	task, err := db.ScheduleTask(ctx, &w1)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att1.Name, task.Name)
+	setTaskStatus(t, db, att1.UUID, api.TaskStatusActive)
+
+	// The job already has its maximum number of active tasks.
+	task, err = db.ScheduleTask(ctx, &w2)
+	require.NoError(t, err)
+	assert.Nil(t, task)
+
+	// Once the active task is done, the next one can be scheduled.
+	setTaskStatus(t, db, att1.UUID, api.TaskStatusCompleted)
+	task, err = db.ScheduleTask(ctx, &w2)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att2.Name, task.Name)
+}
+
+func TestTaskResourceRequirements(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	// A machine with "16 GB" of RAM reports a bit less than 16 GiB.
+	w := linuxWorker(t, db, func(w *Worker) {
+		w.CPUCount = 8
+		w.MemoryTotal = 16_663_019_520
+	})
+
+	attBig := authorTestTask("big task", "blender")
+	attBig.MinMemoryGB = 32
+	attManyCPUs := authorTestTask("many CPUs task", "blender")
+	attManyCPUs.MinCPUCount = 16
+	attFits := authorTestTask("fitting task", "blender")
+	attFits.MinMemoryGB = 16
+	attFits.MinCPUCount = 8
+	atj := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", attBig, attManyCPUs, attFits)
+	constructTestJob(ctx, t, db, atj)
+
+	task, err := db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, attFits.Name, task.Name)
+	setTaskStatus(t, db, attFits.UUID, api.TaskStatusCompleted)
+
+	// The remaining tasks need more resources than the worker has.
+	task, err = db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	assert.Nil(t, task)
+
+	// A worker that did not report its resources should not get them either.
+	w2 := windowsWorker(t, db)
+	task, err = db.ScheduleTask(ctx, &w2)
+	require.NoError(t, err)
+	assert.Nil(t, task)
+}
+
+func TestFairShareByJob(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	att1_1 := authorTestTask("1.1 task", "blender")
+	att1_2 := authorTestTask("1.2 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1_1, att1_2)
+	att2_1 := authorTestTask("2.1 task", "blender")
+	atj2 := authorTestJob("7180617b-da70-411c-8b38-b972ab2bae8d", "simple-blender-render", att2_1)
+
+	constructTestJob(ctx, t, db, atj1)
+	job2 := constructTestJob(ctx, t, db, atj2)
+
+	// Pretend another worker is already working on job 1.
+	setTaskStatus(t, db, att1_1.UUID, api.TaskStatusActive)
+
+	// Without fair-share scheduling, the first job is drained first.
+	task, err := findTaskForWorkerInTx(ctx, db, &w, FairShare{})
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, att1_2.Name, task.Name)
+
+	// With fair-share scheduling, the job without active tasks should go first.
+	task, err = findTaskForWorkerInTx(ctx, db, &w, FairShare{Enabled: true})
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, job2.ID, task.JobID)
+	assert.Equal(t, att2_1.Name, task.Name)
+
+	// Job priority is still more important than fair-share.
+	db.SetFairShare(FairShare{Enabled: true})
+	atj3 := authorTestJob("cb1c7a14-e5f7-4a1e-8e11-4d5c8f3bea07", "simple-blender-render", authorTestTask("3.1 task", "blender"))
+	atj3.Priority = 100
+	job3 := constructTestJob(ctx, t, db, atj3)
+
+	task, err = db.ScheduleTask(ctx, &w)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, job3.ID, task.JobID)
+}
+
+func TestFairShareBySubmitter(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	// Two jobs by Agent, one of which already has a worker.
+	att1_1 := authorTestTask("1.1 task", "blender")
+	att1_2 := authorTestTask("1.2 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1_1, att1_2)
+	atj1.Metadata = map[string]string{"user.name": "Agent"}
+	att2_1 := authorTestTask("2.1 task", "blender")
+	atj2 := authorTestJob("7180617b-da70-411c-8b38-b972ab2bae8d", "simple-blender-render", att2_1)
+	atj2.Metadata = map[string]string{"user.name": "Agent"}
+
+	// One job by Ariane, without any workers.
+	att3_1 := authorTestTask("3.1 task", "blender")
+	atj3 := authorTestJob("cb1c7a14-e5f7-4a1e-8e11-4d5c8f3bea07", "simple-blender-render", att3_1)
+	atj3.Metadata = map[string]string{"user.name": "Ariane"}
+
+	constructTestJob(ctx, t, db, atj1)
+	job2 := constructTestJob(ctx, t, db, atj2)
+	job3 := constructTestJob(ctx, t, db, atj3)
+	setTaskStatus(t, db, att1_1.UUID, api.TaskStatusActive)
+
+	// Only considering jobs, job 2 and 3 are equally good candidates.
+	task, err := findTaskForWorkerInTx(ctx, db, &w, FairShare{Enabled: true})
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Contains(t, []uint{job2.ID, job3.ID}, task.JobID)
+
+	// Considering submitters, Ariane's job should go first.
+	fairShare := FairShare{Enabled: true, SubmitterMetadataKey: "user.name"}
+	task, err = findTaskForWorkerInTx(ctx, db, &w, fairShare)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, job3.ID, task.JobID)
+}
+
 // To test: blocklists
 
 // To test: variable replacement
@@ -454,6 +739,17 @@ func authorTestTask(name, taskType string, dependencies ...*job_compilers.Author
 	return task
 }
 
+// findTaskForWorkerInTx finds a task for the worker, without assigning it.
+func findTaskForWorkerInTx(ctx context.Context, db *DB, w *Worker, fairShare FairShare) (*Task, error) {
+	var task *Task
+	err := db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		task, err = findTaskForWorker(tx, w, false, fairShare)
+		return err
+	})
+	return task, err
+}
+
 func setTaskStatus(t *testing.T, db *DB, taskUUID string, status api.TaskStatus) {
 	ctx := context.Background()
 	task, err := db.FetchTask(ctx, taskUUID)
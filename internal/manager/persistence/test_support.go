@@ -23,7 +23,16 @@ import (
 // resulting database.
 const TestDSN = "file::memory:"
 
+// TestPostgresDSNEnvVar names the environment variable that makes the tests
+// run on PostgreSQL instead of SQLite. It should contain a `postgres://` URL
+// of an otherwise unused database, as its contents are erased by every test.
+const TestPostgresDSNEnvVar = "FLAMENCO_TEST_POSTGRES_DSN"
+
 func CreateTestDB(t *testing.T) (db *DB, closer func()) {
+	if postgresDSN := os.Getenv(TestPostgresDSNEnvVar); postgresDSN != "" {
+		return createPostgresTestDB(t, postgresDSN)
+	}
+
 	// Delete the SQLite file if it exists on disk.
 	if _, err := os.Stat(TestDSN); err == nil {
 		if err := os.Remove(TestDSN); err != nil {
@@ -69,6 +78,55 @@ func CreateTestDB(t *testing.T) (db *DB, closer func()) {
 	return db, closer
 }
 
+// createPostgresTestDB erases the PostgreSQL database and migrates it to the
+// current schema.
+func createPostgresTestDB(t *testing.T, dsn string) (db *DB, closer func()) {
+	if !isPostgresDSN(dsn) {
+		t.Fatalf("%s should be a postgres:// URL, not %q", TestPostgresDSNEnvVar, dsn)
+	}
+
+	dblogger := NewDBLogger(log.Level(zerolog.TraceLevel).Output(os.Stdout))
+	config := gorm.Config{
+		Logger: dblogger,
+		// PostgreSQL stores timestamps with microsecond precision. Truncating
+		// them here makes them compare equal after a round-trip to the database.
+		NowFunc: func() time.Time { return nowFunc().Truncate(time.Microsecond) },
+	}
+
+	db, err := openDBWithConfig(dsn, &config)
+	if err != nil {
+		t.Fatalf("opening DB: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Start every test with an empty database.
+	for _, query := range []string{"DROP SCHEMA public CASCADE", "CREATE SCHEMA public"} {
+		if err := db.gormDB.WithContext(ctx).Exec(query).Error; err != nil {
+			t.Fatalf("erasing DB: %v", err)
+		}
+	}
+	if err := db.migrate(ctx); err != nil {
+		t.Fatalf("migrating DB: %v", err)
+	}
+
+	closer = func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("closing DB: %v", err)
+		}
+	}
+
+	return db, closer
+}
+
+// skipOnPostgres skips the test when it runs on PostgreSQL.
+func skipOnPostgres(t *testing.T, db *DB, reason string) {
+	if db.isPostgres() {
+		t.Skipf("skipping on PostgreSQL: %s", reason)
+	}
+}
+
 // persistenceTestFixtures creates a test database and returns it and a context.
 // Tests should call the returned cancel function when they're done.
 func persistenceTestFixtures(t *testing.T, testContextTimeout time.Duration) (context.Context, context.CancelFunc, *DB) {
@@ -5,6 +5,7 @@ package persistence
 import (
 	"database/sql/driver"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -107,3 +108,72 @@ func (ot *TimeOfDay) setString(value string) error {
 	*ot = scanned
 	return nil
 }
+
+// TimeWindow is a period of time on any day. An empty start time means "start
+// of the day", and an empty end time means "end of the day".
+type TimeWindow struct {
+	Start TimeOfDay
+	End   TimeOfDay
+}
+
+// Contains returns True iff the time of day is in the window. The start time is
+// included, and the end time is excluded.
+func (tw TimeWindow) Contains(tod TimeOfDay) bool {
+	beforeStart := tw.Start.HasValue() && tod.IsBefore(tw.Start)
+	afterEnd := tw.End.HasValue() && !tod.IsBefore(tw.End)
+	return !beforeStart && !afterEnd
+}
+
+func (tw TimeWindow) String() string {
+	return tw.Start.String() + "-" + tw.End.String()
+}
+
+// TimeWindows is a list of time windows, stored in the database as
+// space-separated "HH:MM-HH:MM" strings.
+type TimeWindows []TimeWindow
+
+// Value converts TimeWindows to a value usable by SQL databases.
+func (tws TimeWindows) Value() (driver.Value, error) {
+	return tws.String(), nil
+}
+
+// Scan updates these TimeWindows from the value stored in a database.
+func (tws *TimeWindows) Scan(value interface{}) error {
+	var asString string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		asString = v
+	case []byte:
+		asString = string(v)
+	default:
+		return fmt.Errorf("expected string, received %T", value)
+	}
+
+	var windows TimeWindows
+	for _, windowString := range strings.Fields(asString) {
+		startString, endString, found := strings.Cut(windowString, "-")
+		if !found {
+			return fmt.Errorf("time window %q should be in the format HH:MM-HH:MM", windowString)
+		}
+
+		var window TimeWindow
+		if err := window.Start.setString(startString); err != nil {
+			return fmt.Errorf("invalid start of time window %q: %w", windowString, err)
+		}
+		if err := window.End.setString(endString); err != nil {
+			return fmt.Errorf("invalid end of time window %q: %w", windowString, err)
+		}
+		windows = append(windows, window)
+	}
+	*tws = windows
+	return nil
+}
+
+func (tws TimeWindows) String() string {
+	windowStrings := make([]string, len(tws))
+	for idx, window := range tws {
+		windowStrings[idx] = window.String()
+	}
+	return strings.Join(windowStrings, " ")
+}
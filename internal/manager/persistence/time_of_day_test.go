@@ -127,3 +127,46 @@ func TestHasValue(t *testing.T) {
 	onlyHourValue := TimeOfDay{22, timeOfDayNoValue}
 	assert.False(t, onlyHourValue.HasValue())
 }
+
+func TestTimeWindowContains(t *testing.T) {
+	window := TimeWindow{Start: TimeOfDay{9, 0}, End: TimeOfDay{18, 0}}
+	assert.False(t, window.Contains(TimeOfDay{8, 59}))
+	assert.True(t, window.Contains(TimeOfDay{9, 0}))
+	assert.True(t, window.Contains(TimeOfDay{17, 59}))
+	assert.False(t, window.Contains(TimeOfDay{18, 0}))
+
+	// Empty start & end mean "start of day" and "end of day".
+	window = TimeWindow{Start: EmptyTimeOfDay(), End: TimeOfDay{9, 0}}
+	assert.True(t, window.Contains(TimeOfDay{0, 0}))
+	assert.False(t, window.Contains(TimeOfDay{9, 0}))
+	window = TimeWindow{Start: TimeOfDay{18, 0}, End: EmptyTimeOfDay()}
+	assert.False(t, window.Contains(TimeOfDay{17, 59}))
+	assert.True(t, window.Contains(TimeOfDay{23, 59}))
+}
+
+func TestTimeWindowsValueScan(t *testing.T) {
+	windows := TimeWindows{
+		{Start: TimeOfDay{12, 0}, End: TimeOfDay{13, 30}},
+		{Start: EmptyTimeOfDay(), End: TimeOfDay{7, 0}},
+		{Start: TimeOfDay{22, 0}, End: EmptyTimeOfDay()},
+	}
+
+	value, err := windows.Value()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "12:00-13:30 -07:00 22:00-", value)
+
+	var scanned TimeWindows
+	if assert.NoError(t, scanned.Scan(value)) {
+		assert.Equal(t, windows, scanned)
+	}
+
+	// Empty string should result in no windows.
+	if assert.NoError(t, scanned.Scan("")) {
+		assert.Empty(t, scanned)
+	}
+
+	assert.Error(t, scanned.Scan("12:00"))
+	assert.Error(t, scanned.Scan("12:00-noon"))
+}
@@ -4,6 +4,7 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -11,14 +12,19 @@ import (
 	"gorm.io/gorm/clause"
 )
 
-// SleepSchedule belongs to a Worker, and determines when it's automatically
-// sent to the 'asleep' and 'awake' states.
+// SleepSchedule belongs to a Worker or a WorkerTag, and determines when it's
+// automatically sent to the 'asleep' and 'awake' states. A schedule of a
+// WorkerTag applies to the Workers in that tag that do not have an active
+// schedule of their own.
 type SleepSchedule struct {
 	Model
 
-	WorkerID uint    `gorm:"default:0;unique;index"`
+	WorkerID *uint   `gorm:"unique;index"`
 	Worker   *Worker `gorm:"foreignkey:WorkerID;references:ID;constraint:OnDelete:CASCADE"`
 
+	WorkerTagID *uint      `gorm:"unique;index"`
+	WorkerTag   *WorkerTag `gorm:"foreignkey:WorkerTagID;references:ID;constraint:OnDelete:CASCADE"`
+
 	IsActive bool `gorm:"default:false;index"`
 
 	// Space-separated two-letter strings indicating days of week the schedule is
@@ -27,7 +33,17 @@ type SleepSchedule struct {
 	StartTime  TimeOfDay `gorm:"default:''"`
 	EndTime    TimeOfDay `gorm:"default:''"`
 
-	NextCheck time.Time
+	// Sleeping time in addition to StartTime-EndTime, for example to have the
+	// Worker sleep both in the morning and in the afternoon.
+	ExtraWindows TimeWindows `gorm:"default:''"`
+
+	// Space-separated dates ("2006-01-02") on which the schedule does not apply,
+	// and the Worker stays awake all day. Empty means "no exceptions".
+	ExceptionDates string `gorm:"default:''"`
+
+	// NextCheck is when the schedule should be checked again. NULL means "as
+	// soon as possible".
+	NextCheck sql.NullTime
 }
 
 // FetchWorkerSleepSchedule fetches the worker's sleep schedule.
@@ -60,12 +76,14 @@ func (db *DB) SetWorkerSleepSchedule(ctx context.Context, workerUUID string, sch
 	if err != nil {
 		return fmt.Errorf("fetching worker %q: %w", workerUUID, err)
 	}
-	schedule.WorkerID = worker.ID
+	schedule.WorkerID = &worker.ID
 	schedule.Worker = worker
+	schedule.WorkerTagID = nil
+	schedule.WorkerTag = nil
 
 	// Only store timestamps in UTC.
-	if schedule.NextCheck.Location() != time.UTC {
-		schedule.NextCheck = schedule.NextCheck.UTC()
+	if schedule.NextCheck.Valid && schedule.NextCheck.Time.Location() != time.UTC {
+		schedule.NextCheck.Time = schedule.NextCheck.Time.UTC()
 	}
 
 	tx := db.gormDB.WithContext(ctx).
@@ -79,8 +97,8 @@ func (db *DB) SetWorkerSleepSchedule(ctx context.Context, workerUUID string, sch
 
 func (db *DB) SetWorkerSleepScheduleNextCheck(ctx context.Context, schedule *SleepSchedule) error {
 	// Only store timestamps in UTC.
-	if schedule.NextCheck.Location() != time.UTC {
-		schedule.NextCheck = schedule.NextCheck.UTC()
+	if schedule.NextCheck.Valid && schedule.NextCheck.Time.Location() != time.UTC {
+		schedule.NextCheck.Time = schedule.NextCheck.Time.UTC()
 	}
 
 	tx := db.gormDB.WithContext(ctx).
@@ -89,10 +107,115 @@ func (db *DB) SetWorkerSleepScheduleNextCheck(ctx context.Context, schedule *Sle
 	return tx.Error
 }
 
+// FetchWorkerTagSleepSchedule fetches the sleep schedule of the worker tag.
+// Returns nil when the tag has no sleep schedule.
+func (db *DB) FetchWorkerTagSleepSchedule(ctx context.Context, tagUUID string) (*SleepSchedule, error) {
+	logger := log.With().Str("tag", tagUUID).Logger()
+	logger.Trace().Msg("fetching worker tag sleep schedule")
+
+	var sched SleepSchedule
+	tx := db.gormDB.WithContext(ctx).
+		Joins("inner join worker_tags on worker_tags.id = sleep_schedules.worker_tag_id").
+		Where("worker_tags.uuid = ?", tagUUID).
+		Limit(1).Find(&sched)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	if sched.ID == 0 {
+		return nil, nil
+	}
+	return &sched, nil
+}
+
+func (db *DB) SetWorkerTagSleepSchedule(ctx context.Context, tagUUID string, schedule *SleepSchedule) error {
+	logger := log.With().Str("tag", tagUUID).Logger()
+	logger.Trace().Msg("setting worker tag sleep schedule")
+
+	tag, err := db.FetchWorkerTag(ctx, tagUUID)
+	if err != nil {
+		return fmt.Errorf("fetching worker tag %q: %w", tagUUID, err)
+	}
+	schedule.WorkerTagID = &tag.ID
+	schedule.WorkerTag = tag
+	schedule.WorkerID = nil
+	schedule.Worker = nil
+
+	// Only store timestamps in UTC.
+	if schedule.NextCheck.Valid && schedule.NextCheck.Time.Location() != time.UTC {
+		schedule.NextCheck.Time = schedule.NextCheck.Time.UTC()
+	}
+
+	tx := db.gormDB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "worker_tag_id"}},
+			UpdateAll: true,
+		}).
+		Create(&schedule)
+	return tx.Error
+}
+
+// FetchEffectiveSleepSchedule returns the sleep schedule that applies to the
+// worker. This is the worker's own schedule if it is active. Otherwise it is
+// the active schedule of one of the worker's tags; when there are multiple,
+// the schedule of the tag that sorts first by name is used. When there is no
+// active schedule at all, the worker's own inactive schedule is returned, or
+// nil if it doesn't have one.
+//
+// The returned schedule's `Worker` and `WorkerTag` pointers are not set.
+func (db *DB) FetchEffectiveSleepSchedule(ctx context.Context, workerUUID string) (*SleepSchedule, error) {
+	workerSchedule, err := db.FetchWorkerSleepSchedule(ctx, workerUUID)
+	if err != nil {
+		return nil, err
+	}
+	if workerSchedule != nil && workerSchedule.IsActive {
+		return workerSchedule, nil
+	}
+
+	var tagSchedule SleepSchedule
+	tx := db.gormDB.WithContext(ctx).
+		Joins("inner join worker_tags on worker_tags.id = sleep_schedules.worker_tag_id").
+		Joins("inner join worker_tag_membership on worker_tag_membership.worker_tag_id = worker_tags.id").
+		Joins("inner join workers on workers.id = worker_tag_membership.worker_id").
+		Where("workers.uuid = ?", workerUUID).
+		Where("sleep_schedules.is_active = ?", true).
+		Order("worker_tags.name").
+		Limit(1).Find(&tagSchedule)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	if tagSchedule.ID == 0 {
+		return workerSchedule, nil
+	}
+	return &tagSchedule, nil
+}
+
+// FetchSleepScheduleTagWorkers returns the workers in the schedule's tag.
+func (db *DB) FetchSleepScheduleTagWorkers(ctx context.Context, schedule *SleepSchedule) ([]*Worker, error) {
+	workers := []*Worker{}
+	if schedule.WorkerTagID == nil {
+		return workers, nil
+	}
+
+	tx := db.gormDB.WithContext(ctx).
+		Joins("inner join worker_tag_membership on worker_tag_membership.worker_id = workers.id").
+		Where("worker_tag_membership.worker_tag_id = ?", *schedule.WorkerTagID).
+		Order("workers.id").
+		Find(&workers)
+	if tx.Error != nil {
+		return nil, workerError(tx.Error, "fetching workers of sleep schedule tag")
+	}
+	return workers, nil
+}
+
 // FetchSleepScheduleWorker sets the given schedule's `Worker` pointer.
 func (db *DB) FetchSleepScheduleWorker(ctx context.Context, schedule *SleepSchedule) error {
+	if schedule.WorkerID == nil {
+		schedule.Worker = nil
+		return ErrWorkerNotFound
+	}
+
 	var worker Worker
-	tx := db.gormDB.WithContext(ctx).Limit(1).Find(&worker, schedule.WorkerID)
+	tx := db.gormDB.WithContext(ctx).Limit(1).Find(&worker, *schedule.WorkerID)
 	if tx.Error != nil {
 		return workerError(tx.Error, "finding worker by their sleep schedule")
 	}
@@ -116,10 +239,10 @@ func (db *DB) FetchSleepSchedulesToCheck(ctx context.Context) ([]*SleepSchedule,
 
 	schedules := []*SleepSchedule{}
 	tx := db.gormDB.WithContext(ctx).
-		Model(&SleepSchedule{}).
+		Preload("WorkerTag").
 		Where("is_active = ?", true).
-		Where("next_check <= ? or next_check is NULL or next_check = ''", now).
-		Scan(&schedules)
+		Where("next_check <= ? or next_check is NULL", now).
+		Find(&schedules)
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
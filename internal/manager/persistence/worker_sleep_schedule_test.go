@@ -3,6 +3,7 @@ package persistence
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
@@ -42,13 +43,18 @@ func TestFetchWorkerSleepSchedule(t *testing.T) {
 
 	// Create a sleep schedule.
 	created := SleepSchedule{
-		WorkerID: linuxWorker.ID,
+		WorkerID: &linuxWorker.ID,
 		Worker:   &linuxWorker,
 
 		IsActive:   true,
 		DaysOfWeek: "mo,tu,th,fr",
 		StartTime:  TimeOfDay{18, 0},
 		EndTime:    TimeOfDay{9, 0},
+		ExtraWindows: TimeWindows{
+			{Start: TimeOfDay{12, 0}, End: TimeOfDay{13, 30}},
+			{Start: EmptyTimeOfDay(), End: TimeOfDay{7, 0}},
+		},
+		ExceptionDates: "2026-12-24 2026-12-25",
 	}
 	tx := db.gormDB.Create(&created)
 	if !assert.NoError(t, tx.Error) {
@@ -80,7 +86,7 @@ func TestFetchSleepScheduleWorker(t *testing.T) {
 
 	// Create a sleep schedule.
 	created := SleepSchedule{
-		WorkerID: linuxWorker.ID,
+		WorkerID: &linuxWorker.ID,
 		Worker:   &linuxWorker,
 
 		IsActive:   true,
@@ -130,7 +136,7 @@ func TestSetWorkerSleepSchedule(t *testing.T) {
 	}
 
 	schedule := SleepSchedule{
-		WorkerID: linuxWorker.ID,
+		WorkerID: &linuxWorker.ID,
 		Worker:   &linuxWorker,
 
 		IsActive:   true,
@@ -172,7 +178,7 @@ func TestSetWorkerSleepSchedule(t *testing.T) {
 
 	// Overwrite the schedule with a freshly constructed one.
 	newerSchedule := SleepSchedule{
-		WorkerID: linuxWorker.ID,
+		WorkerID: &linuxWorker.ID,
 		Worker:   &linuxWorker,
 
 		IsActive:   true,
@@ -192,7 +198,7 @@ func TestSetWorkerSleepSchedule(t *testing.T) {
 
 	// Clear the sleep schedule.
 	emptySchedule := SleepSchedule{
-		WorkerID: linuxWorker.ID,
+		WorkerID: &linuxWorker.ID,
 		Worker:   &linuxWorker,
 
 		IsActive:   false,
@@ -212,6 +218,129 @@ func TestSetWorkerSleepSchedule(t *testing.T) {
 
 }
 
+func TestWorkerTagSleepSchedule(t *testing.T) {
+	ctx, finish, db := persistenceTestFixtures(t, 1*time.Second)
+	defer finish()
+
+	tag := WorkerTag{UUID: "7e8b9d1c-2f6c-4f4c-9a0e-3c1d6e5b2a17", Name: "workstations"}
+	require.NoError(t, db.CreateWorkerTag(ctx, &tag))
+
+	// No sleep schedule.
+	fetched, err := db.FetchWorkerTagSleepSchedule(ctx, tag.UUID)
+	assert.NoError(t, err)
+	assert.Nil(t, fetched)
+
+	// Not an existing tag.
+	schedule := SleepSchedule{
+		IsActive:  true,
+		StartTime: TimeOfDay{9, 0},
+		EndTime:   TimeOfDay{18, 0},
+	}
+	err = db.SetWorkerTagSleepSchedule(ctx, "2cf6153a-3d4e-49f4-a5c0-1c9fc176e155", &schedule)
+	assert.ErrorIs(t, err, ErrWorkerTagNotFound)
+
+	// Create the sleep schedule.
+	require.NoError(t, db.SetWorkerTagSleepSchedule(ctx, tag.UUID, &schedule))
+	fetched, err = db.FetchWorkerTagSleepSchedule(ctx, tag.UUID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched)
+	assert.Nil(t, fetched.WorkerID)
+	if assert.NotNil(t, fetched.WorkerTagID) {
+		assert.Equal(t, tag.ID, *fetched.WorkerTagID)
+	}
+	assert.Equal(t, schedule.StartTime, fetched.StartTime)
+	assert.Equal(t, schedule.EndTime, fetched.EndTime)
+
+	// Overwrite the schedule with a freshly constructed one.
+	newSchedule := SleepSchedule{
+		IsActive:  true,
+		StartTime: TimeOfDay{8, 0},
+		EndTime:   TimeOfDay{17, 0},
+	}
+	require.NoError(t, db.SetWorkerTagSleepSchedule(ctx, tag.UUID, &newSchedule))
+	fetched, err = db.FetchWorkerTagSleepSchedule(ctx, tag.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, newSchedule.StartTime, fetched.StartTime)
+	assert.Equal(t, newSchedule.EndTime, fetched.EndTime)
+
+	// Schedules to check should include the tag, so that it can be logged.
+	toCheck, err := db.FetchSleepSchedulesToCheck(ctx)
+	require.NoError(t, err)
+	if assert.Len(t, toCheck, 1) && assert.NotNil(t, toCheck[0].WorkerTag) {
+		assert.Equal(t, tag.Name, toCheck[0].WorkerTag.Name)
+	}
+
+	// Deleting the tag should delete its schedule.
+	require.NoError(t, db.DeleteWorkerTag(ctx, tag.UUID))
+	var count int64
+	db.gormDB.Model(&SleepSchedule{}).Count(&count)
+	assert.Zero(t, count)
+}
+
+func TestFetchEffectiveSleepSchedule(t *testing.T) {
+	ctx, finish, db := persistenceTestFixtures(t, 1*time.Second)
+	defer finish()
+
+	worker := Worker{
+		UUID:     uuid.New(),
+		Name:     "дрон",
+		Platform: "linux",
+		Status:   api.WorkerStatusAwake,
+	}
+	require.NoError(t, db.CreateWorker(ctx, &worker))
+
+	tagA := WorkerTag{UUID: "7e8b9d1c-2f6c-4f4c-9a0e-3c1d6e5b2a17", Name: "A: workstations"}
+	tagB := WorkerTag{UUID: "1d0b9a9c-7a3e-4e8e-b1a4-0e7b6f6d8c3f", Name: "B: lighting"}
+	require.NoError(t, db.CreateWorkerTag(ctx, &tagA))
+	require.NoError(t, db.CreateWorkerTag(ctx, &tagB))
+	require.NoError(t, db.WorkerSetTags(ctx, &worker, []string{tagA.UUID, tagB.UUID}))
+
+	// No schedules at all.
+	effective, err := db.FetchEffectiveSleepSchedule(ctx, worker.UUID)
+	assert.NoError(t, err)
+	assert.Nil(t, effective)
+
+	// Only tag B has an active schedule.
+	scheduleA := SleepSchedule{IsActive: false, StartTime: TimeOfDay{9, 0}, EndTime: TimeOfDay{17, 0}}
+	scheduleB := SleepSchedule{IsActive: true, StartTime: TimeOfDay{10, 0}, EndTime: TimeOfDay{18, 0}}
+	require.NoError(t, db.SetWorkerTagSleepSchedule(ctx, tagA.UUID, &scheduleA))
+	require.NoError(t, db.SetWorkerTagSleepSchedule(ctx, tagB.UUID, &scheduleB))
+	effective, err = db.FetchEffectiveSleepSchedule(ctx, worker.UUID)
+	if assert.NoError(t, err) && assert.NotNil(t, effective) {
+		assert.Equal(t, scheduleB.ID, effective.ID)
+	}
+
+	// Both tags have an active schedule, the first tag by name should win.
+	scheduleA.IsActive = true
+	require.NoError(t, db.SetWorkerTagSleepSchedule(ctx, tagA.UUID, &scheduleA))
+	effective, err = db.FetchEffectiveSleepSchedule(ctx, worker.UUID)
+	if assert.NoError(t, err) && assert.NotNil(t, effective) {
+		assert.Equal(t, scheduleA.ID, effective.ID)
+	}
+
+	// An inactive schedule of the worker itself should not override the tags.
+	workerSchedule := SleepSchedule{IsActive: false, StartTime: TimeOfDay{1, 0}, EndTime: TimeOfDay{2, 0}}
+	require.NoError(t, db.SetWorkerSleepSchedule(ctx, worker.UUID, &workerSchedule))
+	effective, err = db.FetchEffectiveSleepSchedule(ctx, worker.UUID)
+	if assert.NoError(t, err) && assert.NotNil(t, effective) {
+		assert.Equal(t, scheduleA.ID, effective.ID)
+	}
+
+	// An active schedule of the worker itself should override the tags.
+	workerSchedule.IsActive = true
+	require.NoError(t, db.SetWorkerSleepSchedule(ctx, worker.UUID, &workerSchedule))
+	effective, err = db.FetchEffectiveSleepSchedule(ctx, worker.UUID)
+	if assert.NoError(t, err) && assert.NotNil(t, effective) {
+		assert.Equal(t, workerSchedule.ID, effective.ID)
+	}
+
+	// The tag's workers should be found via its schedule.
+	tagWorkers, err := db.FetchSleepScheduleTagWorkers(ctx, &scheduleA)
+	if assert.NoError(t, err) && assert.Len(t, tagWorkers, 1) {
+		assert.Equal(t, worker.UUID, tagWorkers[0].UUID)
+	}
+}
+
 func TestSetWorkerSleepScheduleNextCheck(t *testing.T) {
 	ctx, finish, db := persistenceTestFixtures(t, 1*time.Second)
 	defer finish()
@@ -233,7 +362,7 @@ func TestSetWorkerSleepScheduleNextCheck(t *testing.T) {
 	}
 
 	future := db.gormDB.NowFunc().Add(5 * time.Hour)
-	schedule.NextCheck = future
+	schedule.NextCheck = sql.NullTime{Time: future, Valid: true}
 
 	err := db.SetWorkerSleepScheduleNextCheck(ctx, &schedule)
 	if !assert.NoError(t, err) {
@@ -268,7 +397,7 @@ func TestFetchSleepSchedulesToCheck(t *testing.T) {
 		StartTime:  TimeOfDay{18, 0},
 		EndTime:    TimeOfDay{9, 0},
 
-		NextCheck: mockedPast,
+		NextCheck: sql.NullTime{Time: mockedPast, Valid: true},
 	}
 
 	schedule1 := SleepSchedule{ // Next check in future -> should not be checked.
@@ -282,10 +411,10 @@ func TestFetchSleepSchedulesToCheck(t *testing.T) {
 		StartTime:  TimeOfDay{18, 0},
 		EndTime:    TimeOfDay{9, 0},
 
-		NextCheck: mockedFuture,
+		NextCheck: sql.NullTime{Time: mockedFuture, Valid: true},
 	}
 
-	schedule2 := SleepSchedule{ // Next check is zero value -> should be checked.
+	schedule2 := SleepSchedule{ // Next check is NULL -> should be checked.
 		Worker: &Worker{
 			UUID:   "dc251817-6a11-4548-a36a-07b0d50b4c21",
 			Name:   "дрон 3",
@@ -296,7 +425,7 @@ func TestFetchSleepSchedulesToCheck(t *testing.T) {
 		StartTime:  TimeOfDay{18, 0},
 		EndTime:    TimeOfDay{9, 0},
 
-		NextCheck: time.Time{}, // zero value for time.
+		NextCheck: sql.NullTime{}, // NULL means "check as soon as possible".
 	}
 
 	schedule3 := SleepSchedule{ // Schedule inactive -> should not be checked.
@@ -310,7 +439,7 @@ func TestFetchSleepSchedulesToCheck(t *testing.T) {
 		StartTime:  TimeOfDay{18, 0},
 		EndTime:    TimeOfDay{9, 0},
 
-		NextCheck: mockedPast, // next check in the past, so if active it would be checked.
+		NextCheck: sql.NullTime{Time: mockedPast, Valid: true}, // next check in the past, so if active it would be checked.
 	}
 
 	// Use GORM to create the workers and sleep schedules in one go.
@@ -327,16 +456,21 @@ func TestFetchSleepSchedulesToCheck(t *testing.T) {
 		assert.Nil(t, toCheck[0].Worker, "the Worker should NOT be fetched")
 		assertEqualSleepSchedule(t, schedule2.Worker.ID, schedule1, *toCheck[1])
 		assert.Nil(t, toCheck[1].Worker, "the Worker should NOT be fetched")
+		assert.False(t, toCheck[1].NextCheck.Valid, "NextCheck should be stored as NULL")
 	}
 }
 
 func assertEqualSleepSchedule(t *testing.T, workerID uint, expect, actual SleepSchedule) {
-	assert.Equal(t, workerID, actual.WorkerID, "sleep schedule is assigned to different worker")
+	if assert.NotNil(t, actual.WorkerID, "sleep schedule is not assigned to a worker") {
+		assert.Equal(t, workerID, *actual.WorkerID, "sleep schedule is assigned to different worker")
+	}
 	assert.Nil(t, actual.Worker, "the Worker itself should not be fetched")
 	assert.Equal(t, expect.IsActive, actual.IsActive, "IsActive does not match")
 	assert.Equal(t, expect.DaysOfWeek, actual.DaysOfWeek, "DaysOfWeek does not match")
 	assert.Equal(t, expect.StartTime, actual.StartTime, "StartTime does not match")
 	assert.Equal(t, expect.EndTime, actual.EndTime, "EndTime does not match")
+	assert.Equal(t, expect.ExtraWindows, actual.ExtraWindows, "ExtraWindows does not match")
+	assert.Equal(t, expect.ExceptionDates, actual.ExceptionDates, "ExceptionDates does not match")
 }
 
 func mustParseTime(timeString string) time.Time {
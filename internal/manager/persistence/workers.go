@@ -4,6 +4,7 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -32,6 +33,19 @@ type Worker struct {
 
 	SupportedTaskTypes string `gorm:"type:varchar(255);default:''"` // comma-separated list of task types.
 
+	// Resources, as reported by the Worker when signing on. Zero values mean
+	// 'unknown'.
+	CPUCount      int    `gorm:"type:smallint;default:0"`
+	MemoryTotal   int64  `gorm:"type:bigint;default:0"` // Bytes.
+	OSDescription string `gorm:"type:varchar(255);default:''"`
+
+	// Resource usage, as reported by the Worker in its last heartbeat.
+	// TempDiskFree is also reported when signing on.
+	TempDiskFree       int64 `gorm:"type:bigint;default:0"` // Bytes.
+	MemoryUsed         int64 `gorm:"type:bigint;default:0"` // Bytes.
+	LoadAverage        sql.NullFloat64
+	ResourcesSampledAt sql.NullTime // Should contain UTC timestamps.
+
 	Tags []*WorkerTag `gorm:"many2many:worker_tag_membership;constraint:OnDelete:CASCADE"`
 }
 
@@ -48,6 +62,13 @@ func (w *Worker) TaskTypes() []string {
 	return strings.Split(w.SupportedTaskTypes, ",")
 }
 
+// MemoryTotalGB returns the Worker's total RAM in whole GB, where 1 GB = 10^9
+// bytes. This makes a machine with "16 GB" of RAM count as 16 GB, even though
+// the operating system reports a bit less than 16 GiB.
+func (w *Worker) MemoryTotalGB() int {
+	return int(w.MemoryTotal / 1_000_000_000)
+}
+
 // StatusChangeRequest stores a requested status change on the Worker.
 // This just updates the Worker instance, but doesn't store the change in the
 // database.
@@ -162,6 +183,24 @@ func (db *DB) SaveWorker(ctx context.Context, w *Worker) error {
 	return nil
 }
 
+// SaveWorkerResourceUsage stores the resource usage reported in the Worker's
+// heartbeat.
+func (db *DB) SaveWorkerResourceUsage(ctx context.Context, w *Worker) error {
+	err := db.gormDB.WithContext(ctx).
+		Model(w).
+		Select("temp_disk_free", "memory_used", "load_average", "resources_sampled_at").
+		Updates(Worker{
+			TempDiskFree:       w.TempDiskFree,
+			MemoryUsed:         w.MemoryUsed,
+			LoadAverage:        w.LoadAverage,
+			ResourcesSampledAt: w.ResourcesSampledAt,
+		}).Error
+	if err != nil {
+		return workerError(err, "saving worker resource usage")
+	}
+	return nil
+}
+
 // WorkerSeen marks the worker as 'seen' by this Manager. This is used for timeout detection.
 func (db *DB) WorkerSeen(ctx context.Context, w *Worker) error {
 	tx := db.gormDB.WithContext(ctx).
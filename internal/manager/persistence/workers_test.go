@@ -4,6 +4,7 @@ package persistence
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
@@ -192,6 +193,43 @@ func TestSaveWorker(t *testing.T) {
 	assert.Equal(t, updatedWorker.Software, fetchedWorker.Software, "non-status fields should also have been updated")
 }
 
+func TestSaveWorkerResourceUsage(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	w := Worker{
+		UUID:          uuid.New(),
+		Name:          "дрон",
+		Status:        api.WorkerStatusAwake,
+		CPUCount:      16,
+		MemoryTotal:   64 * 1024 * 1024 * 1024,
+		OSDescription: "Ubuntu 24.04 LTS (6.8.0-45-generic)",
+		TempDiskFree:  100 * 1024 * 1024 * 1024,
+	}
+	require.NoError(t, db.CreateWorker(ctx, &w))
+
+	sampledAt := time.Date(2026, 10, 18, 11, 47, 3, 0, time.UTC)
+	updatedWorker := w
+	updatedWorker.Name = "7 မှ 9"
+	updatedWorker.TempDiskFree = 50 * 1024 * 1024 * 1024
+	updatedWorker.MemoryUsed = 12 * 1024 * 1024 * 1024
+	updatedWorker.LoadAverage = sql.NullFloat64{Float64: 3.25, Valid: true}
+	updatedWorker.ResourcesSampledAt = sql.NullTime{Time: sampledAt, Valid: true}
+	require.NoError(t, db.SaveWorkerResourceUsage(ctx, &updatedWorker))
+
+	fetchedWorker, err := db.FetchWorker(ctx, w.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, w.Name, fetchedWorker.Name, "saving resource usage should not touch the name")
+	assert.Equal(t, w.CPUCount, fetchedWorker.CPUCount)
+	assert.Equal(t, w.MemoryTotal, fetchedWorker.MemoryTotal)
+	assert.Equal(t, w.OSDescription, fetchedWorker.OSDescription)
+	assert.Equal(t, updatedWorker.TempDiskFree, fetchedWorker.TempDiskFree)
+	assert.Equal(t, updatedWorker.MemoryUsed, fetchedWorker.MemoryUsed)
+	assert.Equal(t, updatedWorker.LoadAverage, fetchedWorker.LoadAverage)
+	assert.True(t, fetchedWorker.ResourcesSampledAt.Valid)
+	assert.True(t, sampledAt.Equal(fetchedWorker.ResourcesSampledAt.Time))
+}
+
 func TestFetchWorkers(t *testing.T) {
 	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
 	defer cancel()
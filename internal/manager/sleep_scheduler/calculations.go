@@ -3,6 +3,7 @@ package sleep_scheduler
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"sort"
 	"strings"
 	"time"
 
@@ -10,6 +11,9 @@ import (
 	"projects.blender.org/studio/flamenco/pkg/api"
 )
 
+// dateFormat is the format of the exception dates of sleep schedules.
+const dateFormat = time.DateOnly
+
 // scheduledWorkerStatus returns the expected worker status at the given date/time.
 func scheduledWorkerStatus(now time.Time, sched *persistence.SleepSchedule) api.WorkerStatus {
 	if sched == nil {
@@ -17,12 +21,15 @@ func scheduledWorkerStatus(now time.Time, sched *persistence.SleepSchedule) api.
 		return api.WorkerStatusAwake
 	}
 
-	tod := persistence.MakeTimeOfDay(now)
-
 	if !sched.IsActive {
 		return api.WorkerStatusAwake
 	}
 
+	if isExceptionDate(now, sched.ExceptionDates) {
+		// The schedule does not apply today.
+		return api.WorkerStatusAwake
+	}
+
 	if sched.DaysOfWeek != "" {
 		weekdayName := strings.ToLower(now.Weekday().String()[:2])
 		if !strings.Contains(sched.DaysOfWeek, weekdayName) {
@@ -31,15 +38,46 @@ func scheduledWorkerStatus(now time.Time, sched *persistence.SleepSchedule) api.
 		}
 	}
 
-	beforeStart := sched.StartTime.HasValue() && tod.IsBefore(sched.StartTime)
-	afterEnd := sched.EndTime.HasValue() && !tod.IsBefore(sched.EndTime)
+	tod := persistence.MakeTimeOfDay(now)
+	for _, window := range scheduleWindows(sched) {
+		if window.Contains(tod) {
+			return api.WorkerStatusAsleep
+		}
+	}
 
-	if beforeStart || afterEnd {
-		// Outside sleeping time.
-		return api.WorkerStatusAwake
+	// Outside sleeping time.
+	return api.WorkerStatusAwake
+}
+
+// scheduleWindows returns all the time windows in which the schedule has the
+// Worker sleep.
+func scheduleWindows(sched *persistence.SleepSchedule) []persistence.TimeWindow {
+	primary := persistence.TimeWindow{Start: sched.StartTime, End: sched.EndTime}
+	if len(sched.ExtraWindows) == 0 {
+		// Without extra windows, empty start & end times mean "all day".
+		return []persistence.TimeWindow{primary}
+	}
+	if primary.Start == primary.End {
+		// The primary window is not used, and should not put the Worker to sleep
+		// all day.
+		return sched.ExtraWindows
 	}
+	return append([]persistence.TimeWindow{primary}, sched.ExtraWindows...)
+}
 
-	return api.WorkerStatusAsleep
+// isExceptionDate returns whether the date of `now` is one of the
+// space-separated exception dates.
+func isExceptionDate(now time.Time, exceptionDates string) bool {
+	if exceptionDates == "" {
+		return false
+	}
+	today := now.Format(dateFormat)
+	for _, date := range strings.Fields(exceptionDates) {
+		if date == today {
+			return true
+		}
+	}
+	return false
 }
 
 func cleanupDaysOfWeek(daysOfWeek string) string {
@@ -55,6 +93,25 @@ func cleanupDaysOfWeek(daysOfWeek string) string {
 	return strings.Join(daynames, " ")
 }
 
+// cleanupExceptionDates sorts the space-separated dates and removes duplicates
+// and dates that cannot be parsed.
+func cleanupExceptionDates(exceptionDates string) string {
+	dates := strings.Fields(exceptionDates)
+	sort.Strings(dates)
+
+	cleaned := []string{}
+	for _, date := range dates {
+		if _, err := time.Parse(dateFormat, date); err != nil {
+			continue
+		}
+		if len(cleaned) > 0 && cleaned[len(cleaned)-1] == date {
+			continue
+		}
+		cleaned = append(cleaned, date)
+	}
+	return strings.Join(cleaned, " ")
+}
+
 // Return a timestamp when the next scheck for this schedule is due.
 func calculateNextCheck(now time.Time, schedule *persistence.SleepSchedule) time.Time {
 	// calcNext returns the given time of day on "today" if that hasn't passed
@@ -68,19 +125,21 @@ func calculateNextCheck(now time.Time, schedule *persistence.SleepSchedule) time
 	}
 
 	nextChecks := []time.Time{
-		// Always check at the end of the day.
+		// Always check at the end of the day. This also covers exception dates.
 		endOfDay(now),
 	}
 
-	// No start time means "start of the day", which is already covered by
-	// yesterday's "end of the day" check.
-	if schedule.StartTime.HasValue() {
-		nextChecks = append(nextChecks, calcNext(schedule.StartTime))
-	}
-	// No end time means "end of the day", which is already covered by today's
-	// "end of the day" check.
-	if schedule.EndTime.HasValue() {
-		nextChecks = append(nextChecks, calcNext(schedule.EndTime))
+	for _, window := range scheduleWindows(schedule) {
+		// No start time means "start of the day", which is already covered by
+		// yesterday's "end of the day" check.
+		if window.Start.HasValue() {
+			nextChecks = append(nextChecks, calcNext(window.Start))
+		}
+		// No end time means "end of the day", which is already covered by today's
+		// "end of the day" check.
+		if window.End.HasValue() {
+			nextChecks = append(nextChecks, calcNext(window.End))
+		}
 	}
 
 	next := earliestTime(nextChecks)
@@ -109,6 +109,97 @@ func TestScheduledWorkerStatus(t *testing.T) {
 	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(8, 47), &sched))
 }
 
+func TestCalculateNextCheckExtraWindows(t *testing.T) {
+	_, mocks, _ := testFixtures(t)
+
+	sched := persistence.SleepSchedule{
+		StartTime: mkToD(9, 0),
+		EndTime:   mkToD(12, 0),
+		ExtraWindows: persistence.TimeWindows{
+			{Start: mkToD(13, 0), End: mkToD(18, 0)},
+			{Start: mkToD(22, 30), End: persistence.EmptyTimeOfDay()},
+		},
+	}
+
+	assert.Equal(t, mocks.todayAt(12, 0), calculateNextCheck(mocks.todayAt(11, 16), &sched))
+	assert.Equal(t, mocks.todayAt(13, 0), calculateNextCheck(mocks.todayAt(12, 16), &sched))
+	assert.Equal(t, mocks.todayAt(18, 0), calculateNextCheck(mocks.todayAt(13, 16), &sched))
+	assert.Equal(t, mocks.todayAt(22, 30), calculateNextCheck(mocks.todayAt(19, 16), &sched))
+	assert.Equal(t, mocks.endOfDay(), calculateNextCheck(mocks.todayAt(22, 47), &sched))
+}
+
+func TestScheduledWorkerStatusExtraWindows(t *testing.T) {
+	_, mocks, _ := testFixtures(t)
+
+	// Sleep during office hours, but join the farm over lunch.
+	sched := persistence.SleepSchedule{
+		IsActive:  true,
+		StartTime: mkToD(9, 0),
+		EndTime:   mkToD(12, 0),
+		ExtraWindows: persistence.TimeWindows{
+			{Start: mkToD(13, 0), End: mkToD(18, 0)},
+		},
+	}
+
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(8, 47), &sched))
+	assert.Equal(t, api.WorkerStatusAsleep, scheduledWorkerStatus(mocks.todayAt(11, 16), &sched))
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(12, 30), &sched))
+	assert.Equal(t, api.WorkerStatusAsleep, scheduledWorkerStatus(mocks.todayAt(13, 0), &sched))
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(18, 0), &sched))
+}
+
+func TestScheduledWorkerStatusOnlyExtraWindows(t *testing.T) {
+	_, mocks, _ := testFixtures(t)
+
+	// Without primary window, only the extra windows should count.
+	sched := persistence.SleepSchedule{
+		IsActive:  true,
+		StartTime: persistence.EmptyTimeOfDay(),
+		EndTime:   persistence.EmptyTimeOfDay(),
+		ExtraWindows: persistence.TimeWindows{
+			{Start: mkToD(13, 0), End: mkToD(18, 0)},
+		},
+	}
+
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(8, 47), &sched))
+	assert.Equal(t, api.WorkerStatusAsleep, scheduledWorkerStatus(mocks.todayAt(13, 16), &sched))
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(18, 0), &sched))
+	assert.Equal(t, mocks.todayAt(13, 0), calculateNextCheck(mocks.todayAt(8, 47), &sched))
+
+	// The same goes for a primary window with equal start & end times.
+	sched.StartTime = mkToD(9, 0)
+	sched.EndTime = mkToD(9, 0)
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(8, 47), &sched))
+	assert.Equal(t, api.WorkerStatusAsleep, scheduledWorkerStatus(mocks.todayAt(13, 16), &sched))
+	assert.Equal(t, mocks.todayAt(13, 0), calculateNextCheck(mocks.todayAt(8, 47), &sched))
+}
+
+func TestScheduledWorkerStatusExceptionDates(t *testing.T) {
+	_, mocks, _ := testFixtures(t)
+
+	// The mocked "today" is Tuesday 2022-06-07.
+	sched := persistence.SleepSchedule{
+		IsActive:       true,
+		StartTime:      mkToD(9, 0),
+		EndTime:        mkToD(18, 0),
+		ExceptionDates: "2022-06-06 2022-06-08",
+	}
+	assert.Equal(t, api.WorkerStatusAsleep, scheduledWorkerStatus(mocks.todayAt(11, 16), &sched))
+
+	// Today is an exception, so the Worker should stay awake all day.
+	sched.ExceptionDates = "2022-06-06 2022-06-07"
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(11, 16), &sched))
+	assert.Equal(t, api.WorkerStatusAwake, scheduledWorkerStatus(mocks.todayAt(0, 0), &sched))
+}
+
+func TestCleanupExceptionDates(t *testing.T) {
+	assert.Equal(t, "", cleanupExceptionDates(""))
+	assert.Equal(t, "2022-06-07", cleanupExceptionDates("  2022-06-07\n"))
+	assert.Equal(t, "2021-12-25 2022-06-07 2022-06-08",
+		cleanupExceptionDates("2022-06-08 2021-12-25 2022-06-07 2022-06-08"))
+	assert.Equal(t, "2022-06-07", cleanupExceptionDates("2022-06-07 tomorrow 2022-13-01"))
+}
+
 func TestCleanupDaysOfWeek(t *testing.T) {
 	assert.Equal(t, "", cleanupDaysOfWeek(""))
 	assert.Equal(t, "mo tu we", cleanupDaysOfWeek("mo tu we"))
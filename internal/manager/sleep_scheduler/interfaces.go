@@ -20,6 +20,14 @@ type PersistenceService interface {
 	FetchSleepScheduleWorker(ctx context.Context, schedule *persistence.SleepSchedule) error
 	FetchSleepSchedulesToCheck(ctx context.Context) ([]*persistence.SleepSchedule, error)
 
+	FetchWorkerTagSleepSchedule(ctx context.Context, tagUUID string) (*persistence.SleepSchedule, error)
+	SetWorkerTagSleepSchedule(ctx context.Context, tagUUID string, schedule *persistence.SleepSchedule) error
+	// FetchSleepScheduleTagWorkers returns the workers in the schedule's tag.
+	FetchSleepScheduleTagWorkers(ctx context.Context, schedule *persistence.SleepSchedule) ([]*persistence.Worker, error)
+	// FetchEffectiveSleepSchedule returns the sleep schedule that applies to the
+	// worker, which can be its own or one of its tags.
+	FetchEffectiveSleepSchedule(ctx context.Context, workerUUID string) (*persistence.SleepSchedule, error)
+
 	SetWorkerSleepScheduleNextCheck(ctx context.Context, schedule *persistence.SleepSchedule) error
 
 	SaveWorkerStatus(ctx context.Context, w *persistence.Worker) error
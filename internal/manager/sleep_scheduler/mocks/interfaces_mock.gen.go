@@ -36,6 +36,36 @@ func (m *MockPersistenceService) EXPECT() *MockPersistenceServiceMockRecorder {
 	return m.recorder
 }
 
+// FetchEffectiveSleepSchedule mocks base method.
+func (m *MockPersistenceService) FetchEffectiveSleepSchedule(arg0 context.Context, arg1 string) (*persistence.SleepSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEffectiveSleepSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.SleepSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEffectiveSleepSchedule indicates an expected call of FetchEffectiveSleepSchedule.
+func (mr *MockPersistenceServiceMockRecorder) FetchEffectiveSleepSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEffectiveSleepSchedule", reflect.TypeOf((*MockPersistenceService)(nil).FetchEffectiveSleepSchedule), arg0, arg1)
+}
+
+// FetchSleepScheduleTagWorkers mocks base method.
+func (m *MockPersistenceService) FetchSleepScheduleTagWorkers(arg0 context.Context, arg1 *persistence.SleepSchedule) ([]*persistence.Worker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSleepScheduleTagWorkers", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.Worker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSleepScheduleTagWorkers indicates an expected call of FetchSleepScheduleTagWorkers.
+func (mr *MockPersistenceServiceMockRecorder) FetchSleepScheduleTagWorkers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSleepScheduleTagWorkers", reflect.TypeOf((*MockPersistenceService)(nil).FetchSleepScheduleTagWorkers), arg0, arg1)
+}
+
 // FetchSleepScheduleWorker mocks base method.
 func (m *MockPersistenceService) FetchSleepScheduleWorker(arg0 context.Context, arg1 *persistence.SleepSchedule) error {
 	m.ctrl.T.Helper()
@@ -80,6 +110,21 @@ func (mr *MockPersistenceServiceMockRecorder) FetchWorkerSleepSchedule(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerSleepSchedule", reflect.TypeOf((*MockPersistenceService)(nil).FetchWorkerSleepSchedule), arg0, arg1)
 }
 
+// FetchWorkerTagSleepSchedule mocks base method.
+func (m *MockPersistenceService) FetchWorkerTagSleepSchedule(arg0 context.Context, arg1 string) (*persistence.SleepSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchWorkerTagSleepSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.SleepSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWorkerTagSleepSchedule indicates an expected call of FetchWorkerTagSleepSchedule.
+func (mr *MockPersistenceServiceMockRecorder) FetchWorkerTagSleepSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerTagSleepSchedule", reflect.TypeOf((*MockPersistenceService)(nil).FetchWorkerTagSleepSchedule), arg0, arg1)
+}
+
 // SaveWorkerStatus mocks base method.
 func (m *MockPersistenceService) SaveWorkerStatus(arg0 context.Context, arg1 *persistence.Worker) error {
 	m.ctrl.T.Helper()
@@ -122,6 +167,20 @@ func (mr *MockPersistenceServiceMockRecorder) SetWorkerSleepScheduleNextCheck(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerSleepScheduleNextCheck", reflect.TypeOf((*MockPersistenceService)(nil).SetWorkerSleepScheduleNextCheck), arg0, arg1)
 }
 
+// SetWorkerTagSleepSchedule mocks base method.
+func (m *MockPersistenceService) SetWorkerTagSleepSchedule(arg0 context.Context, arg1 string, arg2 *persistence.SleepSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWorkerTagSleepSchedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWorkerTagSleepSchedule indicates an expected call of SetWorkerTagSleepSchedule.
+func (mr *MockPersistenceServiceMockRecorder) SetWorkerTagSleepSchedule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerTagSleepSchedule", reflect.TypeOf((*MockPersistenceService)(nil).SetWorkerTagSleepSchedule), arg0, arg1, arg2)
+}
+
 // MockChangeBroadcaster is a mock of ChangeBroadcaster interface.
 type MockChangeBroadcaster struct {
 	ctrl     *gomock.Controller
@@ -4,6 +4,7 @@ package sleep_scheduler
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -67,15 +68,7 @@ func (ss *SleepScheduler) FetchSchedule(ctx context.Context, workerUUID string)
 // SetSleepSchedule stores the given schedule as the worker's new sleep schedule.
 // The new schedule is immediately applied to the Worker.
 func (ss *SleepScheduler) SetSchedule(ctx context.Context, workerUUID string, schedule *persistence.SleepSchedule) error {
-	// Ensure 'start' actually preceeds 'end'.
-	if schedule.StartTime.HasValue() &&
-		schedule.EndTime.HasValue() &&
-		schedule.EndTime.IsBefore(schedule.StartTime) {
-		schedule.StartTime, schedule.EndTime = schedule.EndTime, schedule.StartTime
-	}
-
-	schedule.DaysOfWeek = cleanupDaysOfWeek(schedule.DaysOfWeek)
-	schedule.NextCheck = ss.calculateNextCheck(schedule)
+	ss.prepareSchedule(schedule)
 
 	if err := ss.persist.SetWorkerSleepSchedule(ctx, workerUUID, schedule); err != nil {
 		return fmt.Errorf("persisting sleep schedule of worker %s: %w", workerUUID, err)
@@ -89,10 +82,49 @@ func (ss *SleepScheduler) SetSchedule(ctx context.Context, workerUUID string, sc
 	return ss.ApplySleepSchedule(ctx, schedule)
 }
 
-// WorkerStatus returns the status the worker should be in right now, according to its schedule.
-// If the worker has no schedule active, returns 'awake'.
+func (ss *SleepScheduler) FetchTagSchedule(ctx context.Context, tagUUID string) (*persistence.SleepSchedule, error) {
+	return ss.persist.FetchWorkerTagSleepSchedule(ctx, tagUUID)
+}
+
+// SetTagSchedule stores the given schedule as the worker tag's new sleep
+// schedule. The new schedule is immediately applied to the Workers in the tag.
+func (ss *SleepScheduler) SetTagSchedule(ctx context.Context, tagUUID string, schedule *persistence.SleepSchedule) error {
+	ss.prepareSchedule(schedule)
+
+	if err := ss.persist.SetWorkerTagSleepSchedule(ctx, tagUUID, schedule); err != nil {
+		return fmt.Errorf("persisting sleep schedule of worker tag %s: %w", tagUUID, err)
+	}
+
+	logger := addLoggerFields(zerolog.Ctx(ctx), schedule)
+	logger.Info().Msg("sleep scheduler: new schedule for worker tag")
+
+	return ss.ApplySleepSchedule(ctx, schedule)
+}
+
+// prepareSchedule cleans up the schedule and computes its next check.
+func (ss *SleepScheduler) prepareSchedule(schedule *persistence.SleepSchedule) {
+	// Ensure 'start' actually preceeds 'end'.
+	if schedule.StartTime.HasValue() &&
+		schedule.EndTime.HasValue() &&
+		schedule.EndTime.IsBefore(schedule.StartTime) {
+		schedule.StartTime, schedule.EndTime = schedule.EndTime, schedule.StartTime
+	}
+	for idx, window := range schedule.ExtraWindows {
+		if window.Start.HasValue() && window.End.HasValue() && window.End.IsBefore(window.Start) {
+			schedule.ExtraWindows[idx].Start, schedule.ExtraWindows[idx].End = window.End, window.Start
+		}
+	}
+
+	schedule.DaysOfWeek = cleanupDaysOfWeek(schedule.DaysOfWeek)
+	schedule.ExceptionDates = cleanupExceptionDates(schedule.ExceptionDates)
+	schedule.NextCheck = ss.calculateNextCheck(schedule)
+}
+
+// WorkerStatus returns the status the worker should be in right now, according
+// to its own schedule or the schedule of its tags. If the worker has no schedule
+// active, returns 'awake'.
 func (ss *SleepScheduler) WorkerStatus(ctx context.Context, workerUUID string) (api.WorkerStatus, error) {
-	schedule, err := ss.persist.FetchWorkerSleepSchedule(ctx, workerUUID)
+	schedule, err := ss.persist.FetchEffectiveSleepSchedule(ctx, workerUUID)
 	if err != nil {
 		return "", err
 	}
@@ -106,13 +138,18 @@ func (ss *SleepScheduler) scheduledWorkerStatus(sched *persistence.SleepSchedule
 }
 
 // Return a timestamp when the next scheck for this schedule is due.
-func (ss *SleepScheduler) calculateNextCheck(schedule *persistence.SleepSchedule) time.Time {
+func (ss *SleepScheduler) calculateNextCheck(schedule *persistence.SleepSchedule) sql.NullTime {
 	now := ss.clock.Now()
-	return calculateNextCheck(now, schedule)
+	return sql.NullTime{Time: calculateNextCheck(now, schedule), Valid: true}
 }
 
 // ApplySleepSchedule sets worker.StatusRequested if the scheduler demands a status change.
+// A schedule of a worker tag is applied to all the Workers in that tag.
 func (ss *SleepScheduler) ApplySleepSchedule(ctx context.Context, schedule *persistence.SleepSchedule) error {
+	if schedule.WorkerTagID != nil {
+		return ss.applyTagSleepSchedule(ctx, schedule)
+	}
+
 	// Find the Worker managed by this schedule.
 	worker := schedule.Worker
 	if worker == nil {
@@ -123,6 +160,48 @@ func (ss *SleepScheduler) ApplySleepSchedule(ctx context.Context, schedule *pers
 		worker = schedule.Worker
 	}
 
+	if !schedule.IsActive {
+		// The Worker may follow the schedule of one of its tags instead.
+		return ss.applyEffectiveSleepSchedule(ctx, worker)
+	}
+	return ss.applyToWorker(ctx, schedule, worker)
+}
+
+// applyTagSleepSchedule applies the effective sleep schedule to each Worker in
+// the schedule's tag. For most of them this will be the tag's schedule, but
+// Workers can have their own schedule, or be part of multiple tags.
+func (ss *SleepScheduler) applyTagSleepSchedule(ctx context.Context, schedule *persistence.SleepSchedule) error {
+	workers, err := ss.persist.FetchSleepScheduleTagWorkers(ctx, schedule)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, worker := range workers {
+		if err := ss.applyEffectiveSleepSchedule(ctx, worker); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyEffectiveSleepSchedule applies the schedule that is in effect for the
+// Worker, which can be its own or one of its tags.
+func (ss *SleepScheduler) applyEffectiveSleepSchedule(ctx context.Context, worker *persistence.Worker) error {
+	schedule, err := ss.persist.FetchEffectiveSleepSchedule(ctx, worker.UUID)
+	if err != nil {
+		return fmt.Errorf("fetching effective sleep schedule of worker %s: %w", worker.Identifier(), err)
+	}
+	return ss.applyToWorker(ctx, schedule, worker)
+}
+
+// applyToWorker sets worker.StatusRequested if the schedule demands a status
+// change. A nil schedule means the worker should be awake.
+func (ss *SleepScheduler) applyToWorker(
+	ctx context.Context,
+	schedule *persistence.SleepSchedule,
+	worker *persistence.Worker,
+) error {
 	if !ss.mayUpdateWorker(worker) {
 		return nil
 	}
@@ -213,10 +292,10 @@ func (ss *SleepScheduler) checkSchedule(ctx context.Context, schedule *persisten
 		// Manager is shutting down, this is fine.
 		return
 	case err != nil:
-		log.Error().
+		logger := scheduleOwnerLogger(schedule)
+		logger.Error().
 			Err(err).
-			Str("worker", schedule.Worker.Identifier()).
-			Msg("sleep scheduler: error refreshing worker's sleep schedule")
+			Msg("sleep scheduler: error refreshing sleep schedule")
 		return
 	}
 
@@ -230,16 +309,31 @@ func (ss *SleepScheduler) checkSchedule(ctx context.Context, schedule *persisten
 		// soft-deleted (and thus foreign key constraints don't trigger deletion of
 		// the sleep schedule).
 		log.Debug().
-			Uint("worker", schedule.WorkerID).
+			Interface("worker", schedule.WorkerID).
 			Msg("sleep scheduler: sleep schedule's owning worker cannot be found; not applying the schedule")
 	case err != nil:
-		log.Error().
+		logger := scheduleOwnerLogger(schedule)
+		logger.Error().
 			Err(err).
-			Str("worker", schedule.Worker.Identifier()).
-			Msg("sleep scheduler: error applying worker's sleep schedule")
+			Msg("sleep scheduler: error applying sleep schedule")
 	}
 }
 
+// scheduleOwnerLogger returns a logger that identifies the Worker or the worker
+// tag that owns the schedule.
+func scheduleOwnerLogger(schedule *persistence.SleepSchedule) zerolog.Logger {
+	logCtx := log.With()
+	switch {
+	case schedule.WorkerTag != nil:
+		logCtx = logCtx.Str("tag", schedule.WorkerTag.Name)
+	case schedule.WorkerTagID != nil:
+		logCtx = logCtx.Uint("tagID", *schedule.WorkerTagID)
+	default:
+		logCtx = logCtx.Str("worker", schedule.Worker.Identifier())
+	}
+	return logCtx.Logger()
+}
+
 // mayUpdateWorker determines whether the sleep scheduler is allowed to update this Worker.
 func (ss *SleepScheduler) mayUpdateWorker(worker *persistence.Worker) bool {
 	shouldSkip := skipWorkersInStatus[worker.Status]
@@ -252,12 +346,17 @@ func addLoggerFields(logger *zerolog.Logger, schedule *persistence.SleepSchedule
 	if schedule.Worker != nil {
 		logCtx = logCtx.Str("worker", schedule.Worker.Identifier())
 	}
+	if schedule.WorkerTag != nil {
+		logCtx = logCtx.Str("tag", schedule.WorkerTag.Name)
+	}
 
 	logCtx = logCtx.
 		Bool("isActive", schedule.IsActive).
 		Str("daysOfWeek", schedule.DaysOfWeek).
 		Stringer("startTime", schedule.StartTime).
-		Stringer("endTime", schedule.EndTime)
+		Stringer("endTime", schedule.EndTime).
+		Stringer("extraWindows", schedule.ExtraWindows).
+		Str("exceptionDates", schedule.ExceptionDates)
 
 	return logCtx.Logger()
 }
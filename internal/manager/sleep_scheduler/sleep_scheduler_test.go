@@ -4,6 +4,7 @@ package sleep_scheduler
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -48,7 +49,7 @@ func TestSetSchedule(t *testing.T) {
 	expectSavedSchedule := sched
 	expectSavedSchedule.DaysOfWeek = "mo tu we" // Expect a cleanup
 	expectNextCheck := mocks.todayAt(18, 0)     // "now" is at 11:14:47, expect a check at the end time.
-	expectSavedSchedule.NextCheck = expectNextCheck
+	expectSavedSchedule.NextCheck = sql.NullTime{Time: expectNextCheck, Valid: true}
 
 	// Expect the new schedule to be saved.
 	mocks.persist.EXPECT().SetWorkerSleepSchedule(ctx, workerUUID, &expectSavedSchedule)
@@ -85,7 +86,7 @@ func TestSetScheduleSwappedStartEnd(t *testing.T) {
 		DaysOfWeek: "mo tu we",
 		StartTime:  mkToD(9, 0), // Expect start and end time to be corrected.
 		EndTime:    mkToD(18, 0),
-		NextCheck:  mocks.todayAt(18, 0), // "now" is at 11:14:47, expect a check at the end time.
+		NextCheck:  sql.NullTime{Time: mocks.todayAt(18, 0), Valid: true}, // "now" is at 11:14:47, expect a check at the end time.
 		Worker:     sched.Worker,
 	}
 
@@ -95,6 +96,149 @@ func TestSetScheduleSwappedStartEnd(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSetScheduleExtraWindowsAndExceptions(t *testing.T) {
+	ss, mocks, ctx := testFixtures(t)
+
+	workerUUID := "aeb49d8a-6903-41b3-b545-77b7a1c0ca19"
+
+	sched := persistence.SleepSchedule{
+		IsActive:  true,
+		StartTime: mkToD(7, 0),
+		EndTime:   mkToD(9, 0),
+		ExtraWindows: persistence.TimeWindows{
+			{Start: mkToD(18, 0), End: mkToD(13, 0)},
+		},
+		ExceptionDates: "2022-12-25 2022-12-24 2022-12-25",
+
+		// Worker already in the right state, so no saving/broadcasting expected.
+		Worker: &persistence.Worker{
+			UUID:   workerUUID,
+			Status: api.WorkerStatusAwake,
+		},
+	}
+
+	expectSavedSchedule := persistence.SleepSchedule{
+		IsActive:  true,
+		StartTime: mkToD(7, 0),
+		EndTime:   mkToD(9, 0),
+		ExtraWindows: persistence.TimeWindows{
+			{Start: mkToD(13, 0), End: mkToD(18, 0)}, // Expect start and end time to be corrected.
+		},
+		ExceptionDates: "2022-12-24 2022-12-25",
+		NextCheck:      sql.NullTime{Time: mocks.todayAt(13, 0), Valid: true}, // Expect a check at the start of the extra window.
+		Worker:         sched.Worker,
+	}
+
+	mocks.persist.EXPECT().SetWorkerSleepSchedule(ctx, workerUUID, &expectSavedSchedule)
+
+	err := ss.SetSchedule(ctx, workerUUID, &sched)
+	assert.NoError(t, err)
+}
+
+func TestSetTagSchedule(t *testing.T) {
+	ss, mocks, ctx := testFixtures(t)
+
+	tagUUID := "7e8b9d1c-2f6c-4f4c-9a0e-3c1d6e5b2a17"
+	tagID := uint(47)
+	mocks.clock.Set(mocks.todayAt(10, 47))
+
+	sched := persistence.SleepSchedule{
+		IsActive:   true,
+		DaysOfWeek: " mo  tu  we",
+		StartTime:  mkToD(18, 0),
+		EndTime:    mkToD(9, 0),
+	}
+	expectSavedSchedule := sched
+	expectSavedSchedule.DaysOfWeek = "mo tu we"
+	expectSavedSchedule.StartTime = mkToD(9, 0)
+	expectSavedSchedule.EndTime = mkToD(18, 0)
+	expectSavedSchedule.NextCheck = sql.NullTime{Time: mocks.todayAt(18, 0), Valid: true}
+	mocks.persist.EXPECT().SetWorkerTagSleepSchedule(ctx, tagUUID, &expectSavedSchedule).DoAndReturn(
+		func(ctx context.Context, tagUUID string, schedule *persistence.SleepSchedule) error {
+			schedule.WorkerTagID = &tagID
+			return nil
+		})
+
+	// Expect the schedule to be applied to the workers in the tag, by way of
+	// their effective schedule.
+	followingWorker := persistence.Worker{
+		UUID:   "74997de4-c530-4913-b89f-c489f14f7634",
+		Status: api.WorkerStatusAwake,
+	}
+	ownScheduleWorker := persistence.Worker{
+		UUID:   "aeb49d8a-6903-41b3-b545-77b7a1c0ca19",
+		Status: api.WorkerStatusAwake,
+	}
+	ownSchedule := persistence.SleepSchedule{
+		IsActive:  true,
+		StartTime: mkToD(20, 0),
+		EndTime:   mkToD(23, 0),
+	}
+	mocks.persist.EXPECT().FetchSleepScheduleTagWorkers(ctx, &sched).
+		Return([]*persistence.Worker{&followingWorker, &ownScheduleWorker}, nil)
+	mocks.persist.EXPECT().FetchEffectiveSleepSchedule(ctx, followingWorker.UUID).Return(&sched, nil)
+	mocks.persist.EXPECT().FetchEffectiveSleepSchedule(ctx, ownScheduleWorker.UUID).Return(&ownSchedule, nil)
+
+	// Only the worker following the tag schedule should be sent to sleep.
+	mocks.persist.EXPECT().SaveWorkerStatus(ctx, &followingWorker)
+	mocks.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+
+	err := ss.SetTagSchedule(ctx, tagUUID, &sched)
+	assert.NoError(t, err)
+
+	assert.Equal(t, api.WorkerStatusAsleep, followingWorker.StatusRequested)
+	assert.Equal(t, api.WorkerStatus(""), ownScheduleWorker.StatusRequested)
+}
+
+func TestApplyInactiveWorkerSchedule(t *testing.T) {
+	ss, mocks, ctx := testFixtures(t)
+
+	worker := persistence.Worker{
+		UUID:   "74997de4-c530-4913-b89f-c489f14f7634",
+		Status: api.WorkerStatusAwake,
+	}
+	workerSched := persistence.SleepSchedule{
+		IsActive:  false,
+		StartTime: mkToD(20, 0),
+		EndTime:   mkToD(23, 0),
+		Worker:    &worker,
+	}
+
+	// The inactive worker schedule should defer to the schedule of its tag.
+	tagID := uint(47)
+	tagSched := persistence.SleepSchedule{
+		IsActive:    true,
+		StartTime:   mkToD(9, 0),
+		EndTime:     mkToD(18, 0),
+		WorkerTagID: &tagID,
+	}
+	mocks.persist.EXPECT().FetchEffectiveSleepSchedule(ctx, worker.UUID).Return(&tagSched, nil)
+	mocks.persist.EXPECT().SaveWorkerStatus(ctx, &worker)
+	mocks.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+
+	mocks.clock.Set(mocks.todayAt(10, 47))
+	err := ss.ApplySleepSchedule(ctx, &workerSched)
+	assert.NoError(t, err)
+	assert.Equal(t, api.WorkerStatusAsleep, worker.StatusRequested)
+}
+
+func TestWorkerStatusEffectiveSchedule(t *testing.T) {
+	ss, mocks, ctx := testFixtures(t)
+
+	workerUUID := "74997de4-c530-4913-b89f-c489f14f7634"
+	tagSched := persistence.SleepSchedule{
+		IsActive:  true,
+		StartTime: mkToD(9, 0),
+		EndTime:   mkToD(18, 0),
+	}
+	mocks.persist.EXPECT().FetchEffectiveSleepSchedule(ctx, workerUUID).Return(&tagSched, nil)
+
+	mocks.clock.Set(mocks.todayAt(10, 47))
+	status, err := ss.WorkerStatus(ctx, workerUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, api.WorkerStatusAsleep, status)
+}
+
 // Test that a sleep check that happens at shutdown of the Manager doesn't cause any panics.
 func TestCheckSleepScheduleAtShutdown(t *testing.T) {
 	ss, mocks, _ := testFixtures(t)
@@ -3,11 +3,15 @@ package task_logs
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -227,6 +231,65 @@ func (s *Storage) Tail(jobID, taskID string) (string, error) {
 	return string(buffer), nil
 }
 
+// SearchMatch is a line of a task log that matched a search.
+type SearchMatch struct {
+	LineNumber int // Line number, starting at 1.
+	Line       string
+}
+
+// Search reads the task log line by line, and calls `found` for every line for
+// which `matches` returns true. Line endings are stripped before matching.
+//
+// Searching stops when `found` returns an error; that error is then returned.
+// A task without log on disk is not considered an error.
+//
+// The task is intentionally not locked while searching, as that would block
+// incoming logs for the duration of the search. Lines that are written while
+// the search is running may or may not be seen.
+func (s *Storage) Search(
+	ctx context.Context,
+	jobID, taskID string,
+	matches func(line string) bool,
+	found func(match SearchMatch) error,
+) error {
+	filepath := s.Filepath(jobID, taskID)
+
+	file, err := os.Open(filepath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return fmt.Errorf("unable to open log file for reading: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		line, readErr := reader.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return fmt.Errorf("error reading log file: %w", readErr)
+		}
+		if readErr == io.EOF && line == "" {
+			return nil
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if matches(line) {
+			if err := found(SearchMatch{LineNumber: lineNumber, Line: line}); err != nil {
+				return err
+			}
+		}
+
+		if readErr == io.EOF {
+			return nil
+		}
+	}
+}
+
 func (s *Storage) taskLock(taskID string) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -3,6 +3,7 @@ package task_logs
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -154,6 +155,53 @@ func TestLogTailAndSize(t *testing.T) {
 	)
 }
 
+func TestLogSearch(t *testing.T) {
+	s, finish, mocks := taskLogsTestFixtures(t)
+	defer finish()
+
+	jobID := "25c5a51c-e0dd-44f7-9f87-74f3d1fbbd8c"
+	taskID := "20ff9d06-53ec-4019-9e2e-1774f05f170a"
+	jobDir := filepath.Join(mocks.temppath, "job-25c5", jobID)
+
+	mocks.broadcaster.EXPECT().BroadcastTaskLogUpdate(gomock.Any())
+	mocks.localStorage.EXPECT().ForJob(jobID).Return(jobDir).AnyTimes()
+
+	ctx := context.Background()
+	containsWarning := func(line string) bool { return strings.Contains(line, "Warning") }
+
+	var matches []SearchMatch
+	collect := func(match SearchMatch) error {
+		matches = append(matches, match)
+		return nil
+	}
+
+	// Searching a non-existent log file should just not find anything.
+	err := s.Search(ctx, jobID, taskID, containsWarning, collect)
+	assert.NoError(t, err)
+	assert.Empty(t, matches)
+
+	err = s.Write(zerolog.Nop(), jobID, taskID,
+		"Fra:1 Mem:2.3M\nWarning: Unable to open /textures/wood.png\nFra:2 Mem:2.3M\r\nWarning: Unable to open /textures/brick.png")
+	assert.NoError(t, err)
+
+	err = s.Search(ctx, jobID, taskID, containsWarning, collect)
+	assert.NoError(t, err)
+	assert.Equal(t, []SearchMatch{
+		{LineNumber: 2, Line: "Warning: Unable to open /textures/wood.png"},
+		{LineNumber: 4, Line: "Warning: Unable to open /textures/brick.png"},
+	}, matches)
+
+	// Returning an error from the callback should stop the search.
+	stopErr := errors.New("stop searching")
+	numCalls := 0
+	err = s.Search(ctx, jobID, taskID, containsWarning, func(match SearchMatch) error {
+		numCalls++
+		return stopErr
+	})
+	assert.ErrorIs(t, err, stopErr)
+	assert.Equal(t, 1, numCalls)
+}
+
 func TestLogWritingParallel(t *testing.T) {
 	s, finish, mocks := taskLogsTestFixtures(t)
 	defer finish()
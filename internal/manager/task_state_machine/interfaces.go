@@ -34,6 +34,8 @@ type PersistenceService interface {
 		statusesToUpdate []api.TaskStatus, taskStatus api.TaskStatus, activity string) error
 
 	FetchJobsInStatus(ctx context.Context, jobStatuses ...api.JobStatus) ([]*persistence.Job, error)
+	// FetchDependentJobs returns the jobs that depend on the given job.
+	FetchDependentJobs(ctx context.Context, job *persistence.Job) ([]*persistence.Job, error)
 	FetchTasksOfWorkerInStatus(context.Context, *persistence.Worker, api.TaskStatus) ([]*persistence.Task, error)
 	FetchTasksOfWorkerInStatusOfJob(context.Context, *persistence.Worker, api.TaskStatus, *persistence.Job) ([]*persistence.Task, error)
 }
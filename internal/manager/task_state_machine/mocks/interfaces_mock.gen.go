@@ -58,6 +58,21 @@ func (mr *MockPersistenceServiceMockRecorder) CountTasksOfJobInStatus(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTasksOfJobInStatus", reflect.TypeOf((*MockPersistenceService)(nil).CountTasksOfJobInStatus), varargs...)
 }
 
+// FetchDependentJobs mocks base method.
+func (m *MockPersistenceService) FetchDependentJobs(arg0 context.Context, arg1 *persistence.Job) ([]*persistence.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDependentJobs", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDependentJobs indicates an expected call of FetchDependentJobs.
+func (mr *MockPersistenceServiceMockRecorder) FetchDependentJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDependentJobs", reflect.TypeOf((*MockPersistenceService)(nil).FetchDependentJobs), arg0, arg1)
+}
+
 // FetchJobsInStatus mocks base method.
 func (m *MockPersistenceService) FetchJobsInStatus(arg0 context.Context, arg1 ...api.JobStatus) ([]*persistence.Job, error) {
 	m.ctrl.T.Helper()
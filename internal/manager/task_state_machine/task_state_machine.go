@@ -130,6 +130,10 @@ func (sm *StateMachine) updateJobAfterTaskStatusChange(
 		case api.JobStatusActive, api.JobStatusCancelRequested:
 			// Do nothing, job is already in the desired status.
 			return nil
+		case api.JobStatusPaused:
+			// Do nothing, tasks that were already running when the job got paused
+			// are allowed to finish without un-pausing the job.
+			return nil
 		default:
 			logger.Info().Msg("job became active because one of its task changed status")
 			reason := fmt.Sprintf("task became %s", task.Status)
@@ -336,9 +340,55 @@ func (sm *StateMachine) jobStatusSet(ctx context.Context,
 	jobUpdate.RefreshTasks = result.massTaskUpdate
 	sm.broadcaster.BroadcastJobUpdate(jobUpdate)
 
+	if err := sm.updateDependentJobs(ctx, logger, job); err != nil {
+		return "", fmt.Errorf("updating dependent jobs after job status change: %w", err)
+	}
+
 	return result.followingJobStatus, nil
 }
 
+// updateDependentJobs fails or cancels the jobs that depend on this job, when
+// this job failed or was canceled. Otherwise those would wait forever.
+func (sm *StateMachine) updateDependentJobs(
+	ctx context.Context,
+	logger zerolog.Logger,
+	job *persistence.Job,
+) error {
+	var newJobStatus api.JobStatus
+	switch job.Status {
+	case api.JobStatusFailed:
+		newJobStatus = api.JobStatusFailed
+	case api.JobStatusCanceled:
+		newJobStatus = api.JobStatusCancelRequested
+	default:
+		return nil
+	}
+
+	dependentJobs, err := sm.persist.FetchDependentJobs(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	reason := fmt.Sprintf("job %s it depends on got status %q", job.UUID, job.Status)
+	for _, dependentJob := range dependentJobs {
+		switch dependentJob.Status {
+		case api.JobStatusCompleted, api.JobStatusCanceled, api.JobStatusCancelRequested, api.JobStatusFailed:
+			// Nothing to do, the job is not going to run anyway.
+			continue
+		}
+
+		logger.Info().
+			Str("dependentJob", dependentJob.UUID).
+			Str("dependentJobStatusNew", string(newJobStatus)).
+			Msg("job changes status of job that depends on it")
+		if err := sm.JobStatusChange(ctx, dependentJob, newJobStatus, reason); err != nil {
+			return fmt.Errorf("changing status of job %s: %w", dependentJob.UUID, err)
+		}
+	}
+
+	return nil
+}
+
 // tasksUpdateResult is returned by `updateTasksAfterJobStatusChange`.
 type tasksUpdateResult struct {
 	// FollowingJobStatus is set when the task updates should trigger another job status update.
@@ -375,6 +425,16 @@ func (sm *StateMachine) updateTasksAfterJobStatusChange(
 		// do with other tasks in the job.
 		return tasksUpdateResult{}, nil
 
+	case api.JobStatusPaused:
+		// Nothing to do; active tasks are allowed to run to completion, and queued
+		// tasks stay queued. The scheduler skips paused jobs.
+		return tasksUpdateResult{}, nil
+
+	case api.JobStatusWaiting:
+		// Nothing to do; the tasks stay queued, and the scheduler skips jobs that
+		// are waiting for their start time.
+		return tasksUpdateResult{}, nil
+
 	case api.JobStatusCancelRequested, api.JobStatusFailed:
 		jobStatus, err := sm.cancelTasks(ctx, logger, job)
 		return tasksUpdateResult{
@@ -143,6 +143,7 @@ func TestTaskStatusChangeActiveToFailedFailJob(t *testing.T) {
 	mocks.persist.EXPECT().UpdateJobsTaskStatusesConditional(ctx, task1.Job, taskStatusesToCancel, api.TaskStatusCanceled,
 		"Manager cancelled this task because the job got status \"failed\".",
 	)
+	mocks.persist.EXPECT().FetchDependentJobs(ctx, task1.Job)
 
 	assert.NoError(t, sm.TaskStatusChange(ctx, task1, api.TaskStatusFailed))
 }
@@ -197,6 +198,7 @@ func TestTaskStatusChangeCancelSingleTask(t *testing.T) {
 		Return(0, 2, nil)
 	mocks.expectSaveJobWithStatus(t, job, api.JobStatusCanceled)
 	mocks.expectBroadcastJobChange(task.Job, api.JobStatusCancelRequested, api.JobStatusCanceled)
+	mocks.persist.EXPECT().FetchDependentJobs(ctx, job)
 
 	assert.NoError(t, sm.TaskStatusChange(ctx, task2, api.TaskStatusCanceled))
 }
@@ -219,6 +221,7 @@ func TestTaskStatusChangeCancelSingleTaskWithOtherFailed(t *testing.T) {
 		Return(0, 3, nil)
 	mocks.expectSaveJobWithStatus(t, job, api.JobStatusCanceled)
 	mocks.expectBroadcastJobChange(task1.Job, api.JobStatusCancelRequested, api.JobStatusCanceled)
+	mocks.persist.EXPECT().FetchDependentJobs(ctx, job)
 
 	// The paused task just stays paused, so don't expectBroadcastTaskChange(task3).
 
@@ -331,10 +334,87 @@ func TestJobCancelWithSomeCompletedTasks(t *testing.T) {
 
 	mocks.expectBroadcastJobChangeWithTaskRefresh(job, api.JobStatusActive, api.JobStatusCancelRequested)
 	mocks.expectBroadcastJobChange(job, api.JobStatusCancelRequested, api.JobStatusCanceled)
+	mocks.persist.EXPECT().FetchDependentJobs(ctx, job)
 
 	assert.NoError(t, sm.JobStatusChange(ctx, job, api.JobStatusCancelRequested, "someone wrote a unittest"))
 }
 
+func TestJobPauseAndResume(t *testing.T) {
+	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
+	defer mockCtrl.Finish()
+
+	task1 := taskWithStatus(api.JobStatusActive, api.TaskStatusActive)
+	task2 := taskOfSameJob(task1, api.TaskStatusActive)
+	job := task1.Job
+
+	// Pausing the job should not touch its tasks.
+	mocks.expectSaveJobWithStatus(t, job, api.JobStatusPaused)
+	mocks.expectBroadcastJobChange(job, api.JobStatusActive, api.JobStatusPaused)
+	assert.NoError(t, sm.JobStatusChange(ctx, job, api.JobStatusPaused, "someone wrote a unittest"))
+
+	// Active tasks can finish, without un-pausing the job.
+	mocks.expectSaveTaskWithStatus(t, task1, api.TaskStatusSoftFailed)
+	mocks.expectWriteTaskLogTimestamped(t, task1, "task changed status active -> soft-failed")
+	mocks.expectBroadcastTaskChange(task1, api.TaskStatusActive, api.TaskStatusSoftFailed)
+	assert.NoError(t, sm.TaskStatusChange(ctx, task1, api.TaskStatusSoftFailed))
+
+	mocks.expectSaveTaskWithStatus(t, task2, api.TaskStatusCompleted)
+	mocks.expectWriteTaskLogTimestamped(t, task2, "task changed status active -> completed")
+	mocks.expectBroadcastTaskChange(task2, api.TaskStatusActive, api.TaskStatusCompleted)
+	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, job, api.TaskStatusCompleted).Return(1, 3, nil)
+	assert.NoError(t, sm.TaskStatusChange(ctx, task2, api.TaskStatusCompleted))
+	assert.Equal(t, api.JobStatusPaused, job.Status)
+
+	// Resuming the job just queues it again.
+	mocks.expectSaveJobWithStatus(t, job, api.JobStatusQueued)
+	mocks.expectBroadcastJobChangeWithTaskRefresh(job, api.JobStatusPaused, api.JobStatusQueued)
+	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, job, api.TaskStatusCompleted).Return(1, 3, nil)
+	assert.NoError(t, sm.JobStatusChange(ctx, job, api.JobStatusQueued, "someone wrote a unittest"))
+}
+
+func TestJobFailPropagatesToDependentJobs(t *testing.T) {
+	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
+	defer mockCtrl.Finish()
+
+	job := taskWithStatus(api.JobStatusActive, api.TaskStatusFailed).Job
+	dependentJob := &persistence.Job{
+		Model:  persistence.Model{ID: 48},
+		UUID:   "dependent-f3f5-4cef-9cd7-e67eb28eaf3e",
+		Status: api.JobStatusQueued,
+	}
+	completedJob := &persistence.Job{
+		Model:  persistence.Model{ID: 49},
+		UUID:   "completed-f3f5-4cef-9cd7-e67eb28eaf3e",
+		Status: api.JobStatusCompleted,
+	}
+
+	taskStatusesToCancel := []api.TaskStatus{
+		api.TaskStatusActive,
+		api.TaskStatusQueued,
+		api.TaskStatusSoftFailed,
+	}
+
+	// J: active > failed
+	mocks.expectSaveJobWithStatus(t, job, api.JobStatusFailed)
+	mocks.persist.EXPECT().UpdateJobsTaskStatusesConditional(ctx, job, taskStatusesToCancel, api.TaskStatusCanceled,
+		"Manager cancelled this task because the job got status \"failed\".",
+	)
+	mocks.expectBroadcastJobChangeWithTaskRefresh(job, api.JobStatusActive, api.JobStatusFailed)
+	mocks.persist.EXPECT().FetchDependentJobs(ctx, job).
+		Return([]*persistence.Job{dependentJob, completedJob}, nil)
+
+	// Dependent J: queued > failed; the completed job should be left alone.
+	mocks.expectSaveJobWithStatus(t, dependentJob, api.JobStatusFailed)
+	mocks.persist.EXPECT().UpdateJobsTaskStatusesConditional(ctx, dependentJob, taskStatusesToCancel, api.TaskStatusCanceled,
+		"Manager cancelled this task because the job got status \"failed\".",
+	)
+	mocks.expectBroadcastJobChangeWithTaskRefresh(dependentJob, api.JobStatusQueued, api.JobStatusFailed)
+	mocks.persist.EXPECT().FetchDependentJobs(ctx, dependentJob)
+
+	assert.NoError(t, sm.JobStatusChange(ctx, job, api.JobStatusFailed, "someone wrote a unittest"))
+	assert.Equal(t, api.JobStatusCompleted, completedJob.Status)
+}
+
 func TestCheckStuck(t *testing.T) {
 	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
 	defer mockCtrl.Finish()
@@ -0,0 +1,240 @@
+package template_scheduler
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears limits how far into the future CronSchedule.Next() looks for
+// a matching time. This avoids endless searches for schedules like "0 0 31 2 *"
+// that never match.
+const maxSearchYears = 5
+
+var ErrCronNeverTriggers = errors.New("cron expression never triggers")
+
+// CronSchedule is a parsed cron expression. The expression has five fields,
+// "minute hour day-of-month month day-of-week", which support `*`, lists
+// (`1,15`), ranges (`1-5`), and steps (`*/15`, `0-30/10`). Months and days of
+// the week can also be given by their three-letter English name, and Sunday
+// can be given as either 0 or 7.
+//
+// The macros `@yearly`, `@annually`, `@monthly`, `@weekly`, `@daily`,
+// `@midnight`, and `@hourly` are supported as well.
+type CronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64 // Bit masks of matching values.
+
+	// When both day-of-month and day-of-week are restricted, a day matches when
+	// either of them matches. This is the traditional cron behaviour.
+	dayOfMonthStar, dayOfWeekStar bool
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // Names of the values, starting at `min`.
+}
+
+var (
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: []string{
+		"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	// Day of week allows 7 as Sunday, which is folded into 0 after parsing.
+	cronDayOfWeek = cronField{name: "day of week", min: 0, max: 7, names: []string{
+		"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+// ParseCron parses a cron expression.
+func ParseCron(expression string) (*CronSchedule, error) {
+	expression = strings.TrimSpace(expression)
+	if macro, ok := cronMacros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q should have 5 fields, not %d", expression, len(fields))
+	}
+
+	var (
+		schedule CronSchedule
+		err      error
+	)
+	if schedule.minute, err = cronMinute.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if schedule.hour, err = cronHour.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfMonth, err = cronDayOfMonth.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if schedule.month, err = cronMonth.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfWeek, err = cronDayOfWeek.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek = schedule.dayOfWeek&^(1<<7) | 1
+	}
+	schedule.dayOfMonthStar = strings.HasPrefix(fields[2], "*")
+	schedule.dayOfWeekStar = strings.HasPrefix(fields[4], "*")
+
+	return &schedule, nil
+}
+
+// parse returns the bit mask of the values matched by the field expression.
+func (f cronField) parse(expression string) (uint64, error) {
+	var mask uint64
+	for _, part := range strings.Split(expression, ",") {
+		partMask, err := f.parsePart(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q: %w", f.name, expression, err)
+		}
+		mask |= partMask
+	}
+	return mask, nil
+}
+
+// parsePart parses a single element of a list, like "*", "*/5", "3", "1-5", or "1-30/2".
+func (f cronField) parsePart(part string) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step < 1 {
+			return 0, fmt.Errorf("invalid step %q", stepPart)
+		}
+	}
+
+	var low, high int
+	switch {
+	case rangePart == "*":
+		low, high = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		lowPart, highPart, _ := strings.Cut(rangePart, "-")
+		var err error
+		if low, err = f.parseValue(lowPart); err != nil {
+			return 0, err
+		}
+		if high, err = f.parseValue(highPart); err != nil {
+			return 0, err
+		}
+		if high < low {
+			return 0, fmt.Errorf("range %q ends before it starts", rangePart)
+		}
+	default:
+		value, err := f.parseValue(rangePart)
+		if err != nil {
+			return 0, err
+		}
+		low, high = value, value
+		if hasStep {
+			// "5/15" means "starting at 5, every 15".
+			high = f.max
+		}
+	}
+
+	var mask uint64
+	for value := low; value <= high; value += step {
+		mask |= 1 << value
+	}
+	return mask, nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	for idx, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + idx, nil
+		}
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if number < f.min || number > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", number, f.min, f.max)
+	}
+	return number, nil
+}
+
+// Next returns the first time after `after` that matches the schedule, in the
+// timezone of `after`. Returns `ErrCronNeverTriggers` when there is no such time
+// in the foreseeable future.
+func (cs *CronSchedule) Next(after time.Time) (time.Time, error) {
+	loc := after.Location()
+
+	// Start at the next whole minute.
+	t := time.Date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute()+1, 0, 0, loc)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case !matches(cs.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !cs.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !matches(cs.hour, t.Hour()):
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			if cs.skippedHourMatches(t.Hour()+1, next.Hour()) {
+				// The matching hour does not exist on this day because of a daylight
+				// saving time change. Just like traditional cron, trigger directly
+				// after the change instead.
+				return next, nil
+			}
+			t = next
+		case !matches(cs.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrCronNeverTriggers
+}
+
+// skippedHourMatches returns whether any hour in [fromHour, toHour) matches the
+// schedule. These hours are skipped by daylight saving time changes.
+func (cs *CronSchedule) skippedHourMatches(fromHour, toHour int) bool {
+	for hour := fromHour; hour%24 != toHour && hour < fromHour+24; hour++ {
+		if matches(cs.hour, hour%24) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cs *CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := matches(cs.dayOfMonth, t.Day())
+	dowMatch := matches(cs.dayOfWeek, int(t.Weekday()))
+
+	switch {
+	case cs.dayOfMonthStar || cs.dayOfWeekStar:
+		return domMatch && dowMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+func matches(mask uint64, value int) bool {
+	return mask&(1<<value) != 0
+}
@@ -0,0 +1,103 @@
+package template_scheduler
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronInvalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"* * * foo *",
+		"@fortnightly",
+	}
+	for _, expression := range invalid {
+		_, err := ParseCron(expression)
+		assert.Error(t, err, "expression %q should be invalid", expression)
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// Saturday 17 October 2026, 14:29:30.
+	now := time.Date(2026, 10, 17, 14, 29, 30, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		expect     time.Time
+	}{
+		{"* * * * *", time.Date(2026, 10, 17, 14, 30, 0, 0, time.UTC)},
+		{"29 * * * *", time.Date(2026, 10, 17, 15, 29, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 10, 17, 14, 30, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, 10, 17, 14, 45, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 10, 17, 15, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 3 * * 1-5", time.Date(2026, 10, 19, 3, 30, 0, 0, time.UTC)},
+		{"30 3 * * mon-fri", time.Date(2026, 10, 19, 3, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 9 * feb *", time.Date(2027, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Both day-of-month and day-of-week restricted: either matches.
+		{"0 0 1 * mon", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		schedule, err := ParseCron(test.expression)
+		require.NoError(t, err, "expression %q", test.expression)
+		next, err := schedule.Next(now)
+		require.NoError(t, err, "expression %q", test.expression)
+		assert.Equal(t, test.expect, next, "expression %q", test.expression)
+	}
+}
+
+func TestCronNextNeverTriggers(t *testing.T) {
+	schedule, err := ParseCron("0 0 31 2 *")
+	require.NoError(t, err)
+
+	_, err = schedule.Next(time.Date(2026, 10, 17, 14, 29, 30, 0, time.UTC))
+	assert.ErrorIs(t, err, ErrCronNeverTriggers)
+}
+
+func TestCronNextTimezone(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Skipf("timezone database not available: %v", err)
+	}
+
+	schedule, err := ParseCron("30 2 * * *")
+	require.NoError(t, err)
+
+	// Daylight saving time ends on 25 October 2026, 03:00 → 02:00.
+	next, err := schedule.Next(time.Date(2026, 10, 24, 12, 0, 0, 0, amsterdam))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 25, 2, 30, 0, 0, amsterdam), next)
+
+	// Daylight saving time starts on 29 March 2026, 02:00 → 03:00. The
+	// non-existent 02:30 should trigger directly after the change.
+	next, err = schedule.Next(time.Date(2026, 3, 28, 12, 0, 0, 0, amsterdam))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 29, 3, 0, 0, 0, amsterdam), next)
+
+	// The day after, it should be back to normal.
+	next, err = schedule.Next(next)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 30, 2, 30, 0, 0, amsterdam), next)
+}
@@ -0,0 +1,27 @@
+package template_scheduler
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"time"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// Generate mock implementations of these interfaces.
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks projects.blender.org/studio/flamenco/internal/manager/template_scheduler PersistenceService,JobSubmitter
+
+type PersistenceService interface {
+	FetchJobTemplatesDue(ctx context.Context, now time.Time) ([]*persistence.JobTemplate, error)
+	SaveJobTemplateRun(ctx context.Context, template *persistence.JobTemplate) error
+}
+
+var _ PersistenceService = (*persistence.DB)(nil)
+
+// JobSubmitter submits jobs, in the same way as jobs submitted via the API.
+// This is implemented by api_impl.Flamenco.
+type JobSubmitter interface {
+	SubmitScheduledJob(ctx context.Context, submittedJob api.SubmittedJob) (*persistence.Job, error)
+}
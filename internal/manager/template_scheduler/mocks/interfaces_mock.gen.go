@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: projects.blender.org/studio/flamenco/internal/manager/template_scheduler (interfaces: PersistenceService,JobSubmitter)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	persistence "projects.blender.org/studio/flamenco/internal/manager/persistence"
+	api "projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// MockPersistenceService is a mock of PersistenceService interface.
+type MockPersistenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersistenceServiceMockRecorder
+}
+
+// MockPersistenceServiceMockRecorder is the mock recorder for MockPersistenceService.
+type MockPersistenceServiceMockRecorder struct {
+	mock *MockPersistenceService
+}
+
+// NewMockPersistenceService creates a new mock instance.
+func NewMockPersistenceService(ctrl *gomock.Controller) *MockPersistenceService {
+	mock := &MockPersistenceService{ctrl: ctrl}
+	mock.recorder = &MockPersistenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersistenceService) EXPECT() *MockPersistenceServiceMockRecorder {
+	return m.recorder
+}
+
+// FetchJobTemplatesDue mocks base method.
+func (m *MockPersistenceService) FetchJobTemplatesDue(arg0 context.Context, arg1 time.Time) ([]*persistence.JobTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobTemplatesDue", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.JobTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobTemplatesDue indicates an expected call of FetchJobTemplatesDue.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobTemplatesDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobTemplatesDue", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobTemplatesDue), arg0, arg1)
+}
+
+// SaveJobTemplateRun mocks base method.
+func (m *MockPersistenceService) SaveJobTemplateRun(arg0 context.Context, arg1 *persistence.JobTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJobTemplateRun", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJobTemplateRun indicates an expected call of SaveJobTemplateRun.
+func (mr *MockPersistenceServiceMockRecorder) SaveJobTemplateRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJobTemplateRun", reflect.TypeOf((*MockPersistenceService)(nil).SaveJobTemplateRun), arg0, arg1)
+}
+
+// MockJobSubmitter is a mock of JobSubmitter interface.
+type MockJobSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobSubmitterMockRecorder
+}
+
+// MockJobSubmitterMockRecorder is the mock recorder for MockJobSubmitter.
+type MockJobSubmitterMockRecorder struct {
+	mock *MockJobSubmitter
+}
+
+// NewMockJobSubmitter creates a new mock instance.
+func NewMockJobSubmitter(ctrl *gomock.Controller) *MockJobSubmitter {
+	mock := &MockJobSubmitter{ctrl: ctrl}
+	mock.recorder = &MockJobSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobSubmitter) EXPECT() *MockJobSubmitterMockRecorder {
+	return m.recorder
+}
+
+// SubmitScheduledJob mocks base method.
+func (m *MockJobSubmitter) SubmitScheduledJob(arg0 context.Context, arg1 api.SubmittedJob) (*persistence.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitScheduledJob", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitScheduledJob indicates an expected call of SubmitScheduledJob.
+func (mr *MockJobSubmitterMockRecorder) SubmitScheduledJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitScheduledJob", reflect.TypeOf((*MockJobSubmitter)(nil).SubmitScheduledJob), arg0, arg1)
+}
@@ -0,0 +1,141 @@
+package template_scheduler
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+// maxErrorLength is the maximum length of the error stored with a template,
+// and matches the size of its database column.
+const maxErrorLength = 255
+
+// TemplateScheduler periodically submits jobs from job templates, according to
+// their cron schedule.
+type TemplateScheduler struct {
+	clock     clock.Clock
+	persist   PersistenceService
+	submitter JobSubmitter
+}
+
+// New creates a new TemplateScheduler.
+func New(clock clock.Clock, persist PersistenceService, submitter JobSubmitter) *TemplateScheduler {
+	return &TemplateScheduler{
+		clock:     clock,
+		persist:   persist,
+		submitter: submitter,
+	}
+}
+
+// NextRun returns the first time after `now` at which a template with the
+// given cron expression should be triggered.
+func NextRun(cronExpression string, now time.Time) (time.Time, error) {
+	schedule, err := ParseCron(cronExpression)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(now)
+}
+
+// Run checks for job templates that are due at the start of every minute.
+// It stops running when the context closes.
+func (ts *TemplateScheduler) Run(ctx context.Context) {
+	log.Info().Msg("job template scheduler starting")
+	defer log.Info().Msg("job template scheduler shutting down")
+
+	waitDuration := 2 * time.Second // First check should be quickly after startup.
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ts.clock.After(waitDuration):
+			ts.CheckTemplates(ctx)
+
+			// Cron schedules have a resolution of one minute, so wake up just
+			// after the start of the next minute.
+			now := ts.clock.Now()
+			waitDuration = now.Truncate(time.Minute).Add(time.Minute + time.Second).Sub(now)
+		}
+	}
+}
+
+// CheckTemplates submits a job for every job template that is due.
+func (ts *TemplateScheduler) CheckTemplates(ctx context.Context) {
+	now := ts.clock.Now()
+	templates, err := ts.persist.FetchJobTemplatesDue(ctx, now)
+	if err != nil {
+		log.Error().Err(err).Msg("job template scheduler: unable to fetch job templates")
+		return
+	}
+	if len(templates) == 0 {
+		log.Trace().Msg("job template scheduler: no job templates are due")
+		return
+	}
+
+	for _, template := range templates {
+		if ctx.Err() != nil {
+			return
+		}
+		ts.runTemplate(ctx, now, template)
+	}
+}
+
+// runTemplate submits the template's job, and schedules the template's next run.
+func (ts *TemplateScheduler) runTemplate(ctx context.Context, now time.Time, template *persistence.JobTemplate) {
+	logger := log.With().
+		Str("template", template.UUID).
+		Str("templateName", template.Name).
+		Str("cron", template.Cron).
+		Logger()
+
+	template.LastRun = sql.NullTime{Time: now, Valid: true}
+
+	job, err := ts.submitter.SubmitScheduledJob(ctx, api.SubmittedJob(template.Job))
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		// Manager is shutting down. Don't record anything, so that the template
+		// is retried at the next startup.
+		return
+	case err != nil:
+		logger.Error().Err(err).Msg("job template scheduler: unable to submit job")
+		template.LastJobUUID = ""
+		template.LastError = truncate(err.Error(), maxErrorLength)
+	default:
+		logger.Info().Str("job", job.UUID).Msg("job template scheduler: submitted job")
+		template.LastJobUUID = job.UUID
+		template.LastError = ""
+	}
+
+	// Scheduling is done relative to 'now' and not to the previous NextRun, so
+	// that runs missed while the Manager was down are not all done at once.
+	nextRun, err := NextRun(template.Cron, now)
+	if err != nil {
+		logger.Error().Err(err).Msg("job template scheduler: unable to schedule next run, deactivating template")
+		template.IsActive = false
+		template.LastError = truncate(err.Error(), maxErrorLength)
+	} else {
+		template.NextRun = nextRun
+	}
+
+	if err := ts.persist.SaveJobTemplateRun(ctx, template); err != nil {
+		logger.Error().Err(err).Msg("job template scheduler: unable to save job template")
+	}
+}
+
+// truncate limits the message to `maxLength` characters.
+func truncate(message string, maxLength int) string {
+	runes := []rune(message)
+	if len(runes) <= maxLength {
+		return message
+	}
+	return string(runes[:maxLength-3]) + "..."
+}
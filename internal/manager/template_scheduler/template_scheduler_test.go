@@ -0,0 +1,148 @@
+package template_scheduler
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"projects.blender.org/studio/flamenco/internal/manager/persistence"
+	"projects.blender.org/studio/flamenco/internal/manager/template_scheduler/mocks"
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+type TemplateSchedulerMocks struct {
+	clock     *clock.Mock
+	persist   *mocks.MockPersistenceService
+	submitter *mocks.MockJobSubmitter
+}
+
+func TestCheckTemplates(t *testing.T) {
+	ts, finish, mocks := templateSchedulerTestFixtures(t)
+	defer finish()
+
+	now := mocks.clock.Now()
+	submittedJob := api.SubmittedJob{
+		Name:     "Dailies",
+		Type:     "simple-blender-render",
+		Priority: 50,
+	}
+	template1 := persistence.JobTemplate{
+		UUID:     "4d5bc5ba-7a4f-4d5a-9bd4-3b7bbd0b2b47",
+		Name:     "Nightly dailies",
+		Cron:     "0 2 * * *",
+		IsActive: true,
+		Job:      persistence.SubmittedJobJSON(submittedJob),
+		NextRun:  now.Add(-time.Minute),
+	}
+	template2 := persistence.JobTemplate{
+		UUID:        "98a2e6e1-60a1-4d4b-9d24-f1a7c1a50e3b",
+		Name:        "Cache cleanup",
+		Cron:        "*/30 * * * *",
+		IsActive:    true,
+		NextRun:     now,
+		LastJobUUID: "4a2b8a3e-c8fa-46a6-9b8b-e8f0c7d6f2e1",
+	}
+
+	ctx := context.Background()
+	mocks.persist.EXPECT().FetchJobTemplatesDue(ctx, now).
+		Return([]*persistence.JobTemplate{&template1, &template2}, nil)
+
+	mocks.submitter.EXPECT().SubmitScheduledJob(ctx, submittedJob).
+		Return(&persistence.Job{UUID: "7c3b3c32-0d7e-4e2f-a2e3-0ffa7e1c3d2a"}, nil)
+	// An error submitting one job should not stop the others from being submitted.
+	mocks.submitter.EXPECT().SubmitScheduledJob(ctx, api.SubmittedJob{}).
+		Return(nil, errors.New("this is an unit test error"))
+
+	mocks.persist.EXPECT().SaveJobTemplateRun(ctx, &template1)
+	mocks.persist.EXPECT().SaveJobTemplateRun(ctx, &template2)
+
+	ts.CheckTemplates(ctx)
+
+	// 2023-10-17T20:00:00+02:00 → the next day at 02:00.
+	assert.Equal(t, time.Date(2023, 10, 18, 2, 0, 0, 0, now.Location()), template1.NextRun)
+	assert.Equal(t, sql.NullTime{Time: now, Valid: true}, template1.LastRun)
+	assert.Equal(t, "7c3b3c32-0d7e-4e2f-a2e3-0ffa7e1c3d2a", template1.LastJobUUID)
+	assert.Empty(t, template1.LastError)
+
+	assert.Equal(t, now.Add(30*time.Minute), template2.NextRun)
+	assert.Equal(t, sql.NullTime{Time: now, Valid: true}, template2.LastRun)
+	assert.Empty(t, template2.LastJobUUID)
+	assert.Equal(t, "this is an unit test error", template2.LastError)
+	assert.True(t, template2.IsActive)
+}
+
+func TestCheckTemplatesInvalidCron(t *testing.T) {
+	ts, finish, mocks := templateSchedulerTestFixtures(t)
+	defer finish()
+
+	now := mocks.clock.Now()
+	template := persistence.JobTemplate{
+		UUID:     "4d5bc5ba-7a4f-4d5a-9bd4-3b7bbd0b2b47",
+		Cron:     "0 0 31 2 *",
+		IsActive: true,
+		NextRun:  now,
+	}
+
+	ctx := context.Background()
+	mocks.persist.EXPECT().FetchJobTemplatesDue(ctx, now).Return([]*persistence.JobTemplate{&template}, nil)
+	mocks.submitter.EXPECT().SubmitScheduledJob(ctx, gomock.Any()).
+		Return(&persistence.Job{UUID: "7c3b3c32-0d7e-4e2f-a2e3-0ffa7e1c3d2a"}, nil)
+	mocks.persist.EXPECT().SaveJobTemplateRun(ctx, &template)
+
+	ts.CheckTemplates(ctx)
+
+	// A template that will never run again should be deactivated.
+	assert.False(t, template.IsActive)
+	assert.Equal(t, ErrCronNeverTriggers.Error(), template.LastError)
+}
+
+func TestCheckTemplatesNoneDue(t *testing.T) {
+	ts, finish, mocks := templateSchedulerTestFixtures(t)
+	defer finish()
+
+	ctx := context.Background()
+	mocks.persist.EXPECT().FetchJobTemplatesDue(ctx, mocks.clock.Now()).Return([]*persistence.JobTemplate{}, nil)
+
+	ts.CheckTemplates(ctx)
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", truncate("short", 10))
+	assert.Equal(t, "exactly 10", truncate("exactly 10", 10))
+	assert.Equal(t, "too lon...", truncate("too long for this", 10))
+	assert.Equal(t, "ünïcö...", truncate("ünïcödé characters", 8))
+	assert.Len(t, []rune(truncate(strings.Repeat("é", 300), maxErrorLength)), maxErrorLength)
+}
+
+func templateSchedulerTestFixtures(t *testing.T) (*TemplateScheduler, func(), *TemplateSchedulerMocks) {
+	mockCtrl := gomock.NewController(t)
+
+	mocks := &TemplateSchedulerMocks{
+		clock:     clock.NewMock(),
+		persist:   mocks.NewMockPersistenceService(mockCtrl),
+		submitter: mocks.NewMockJobSubmitter(mockCtrl),
+	}
+
+	mockedNow, err := time.Parse(time.RFC3339, "2023-10-17T20:00:00+02:00")
+	if err != nil {
+		panic(err)
+	}
+	mocks.clock.Set(mockedNow)
+
+	// This should be called at the end of each unit test.
+	finish := func() {
+		mockCtrl.Finish()
+	}
+
+	ts := New(mocks.clock, mocks.persist, mocks.submitter)
+	return ts, finish, mocks
+}
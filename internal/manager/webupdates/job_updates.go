@@ -43,6 +43,7 @@ func NewTaskUpdate(task *persistence.Task) api.SocketIOTaskUpdate {
 		Updated:  task.UpdatedAt,
 		Status:   task.Status,
 		Activity: task.Activity,
+		Progress: task.Progress,
 	}
 	return taskUpdate
 }
@@ -0,0 +1,258 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+/* This file contains the parser for Blender's render output. */
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+const (
+	// blenderProgressInterval is the minimum duration between sending render
+	// progress to the Manager. Blender can output a line per rendered sample, so
+	// sending each of those would be too much. Saved frames and missing files
+	// are always sent immediately.
+	blenderProgressInterval = 5 * time.Second
+
+	// blenderMaxMissingFiles limits the number of reported missing files, to
+	// keep the task updates small.
+	blenderMaxMissingFiles = 50
+)
+
+var (
+	// "Fra:1 Mem:182.73M (Peak 183.81M) | Time:00:01.23 | ..." (Blender 3.0+)
+	// "Fra:1 Mem:12.00M (0.00M, Peak 13.00M) | Time:00:00.48 | ..." (Blender 2.x)
+	regexpBlenderFrame = regexp.MustCompile(`^Fra:(-?\d+) Mem:\S+ \((?:\S+, )?Peak ([\d.]+)M\)`)
+	// Cycles: "... | Sample 12/128"
+	regexpBlenderSamplesCycles = regexp.MustCompile(`\| Sample (\d+)/(\d+)`)
+	// EEVEE: "... | Rendering 12 / 64 samples"
+	regexpBlenderSamplesEEVEE = regexp.MustCompile(`\| Rendering (\d+) / (\d+) samples`)
+	// " Time: 00:05.63 (Saving: 00:00.03)"
+	regexpBlenderFrameTime = regexp.MustCompile(`^\s*Time: ([\d:.]+) \(Saving: [\d:.]+\)`)
+	// "Warning: Unable to open '/path/to/texture.png'"
+	regexpBlenderUnableToOpen = regexp.MustCompile(`Unable to open\b(.*)$`)
+	regexpQuoted              = regexp.MustCompile(`'([^']+)'|"([^"]+)"`)
+)
+
+// blenderLineKind indicates how relevant a line of Blender output is for the
+// render progress.
+type blenderLineKind int
+
+const (
+	blenderLineIrrelevant blenderLineKind = iota
+	blenderLineProgress                   // Progress changed, can be throttled.
+	blenderLineImportant                  // Progress changed, should be sent immediately.
+)
+
+// blenderProgress keeps track of the render progress of a single Blender
+// command, by parsing its output.
+type blenderProgress struct {
+	frame        int
+	framesDone   int
+	framesTotal  int // Zero when unknown.
+	samplesDone  int
+	samplesTotal int // Zero when unknown.
+
+	memoryPeakMB  float64
+	lastFrameTime time.Duration // Zero when no frame has been saved yet.
+
+	missingFiles      []string
+	knownMissingFiles map[string]bool
+
+	// lastSent is the time at which progress was last sent to the Manager.
+	lastSent time.Time
+}
+
+// newBlenderProgress creates a progress tracker for a Blender command with the
+// given CLI arguments. These are used to determine the number of frames to render.
+func newBlenderProgress(cliArgs []string) *blenderProgress {
+	return &blenderProgress{
+		framesTotal:       blenderFrameCount(cliArgs),
+		knownMissingFiles: map[string]bool{},
+	}
+}
+
+// parseLine updates the progress from a line of Blender output.
+func (bp *blenderProgress) parseLine(line string) blenderLineKind {
+	if match := regexpBlenderFrame.FindStringSubmatch(line); match != nil {
+		return bp.parseFrameLine(line, match)
+	}
+
+	if match := regexpBlenderFrameTime.FindStringSubmatch(line); match != nil {
+		duration, ok := parseBlenderTimecode(match[1])
+		if !ok {
+			return blenderLineIrrelevant
+		}
+		bp.lastFrameTime = duration
+		bp.framesDone++
+		bp.samplesDone = 0
+		bp.samplesTotal = 0
+		return blenderLineImportant
+	}
+
+	if match := regexpBlenderUnableToOpen.FindStringSubmatch(line); match != nil {
+		filename := strings.TrimSpace(match[1])
+		if quoted := regexpQuoted.FindStringSubmatch(filename); quoted != nil {
+			filename = quoted[1] + quoted[2]
+		}
+		if filename == "" {
+			filename = strings.TrimSpace(line)
+		}
+
+		if bp.knownMissingFiles[filename] || len(bp.missingFiles) >= blenderMaxMissingFiles {
+			return blenderLineIrrelevant
+		}
+		bp.knownMissingFiles[filename] = true
+		bp.missingFiles = append(bp.missingFiles, filename)
+		return blenderLineImportant
+	}
+
+	return blenderLineIrrelevant
+}
+
+func (bp *blenderProgress) parseFrameLine(line string, match []string) blenderLineKind {
+	frame, err := strconv.Atoi(match[1])
+	if err != nil {
+		return blenderLineIrrelevant
+	}
+	if frame != bp.frame {
+		bp.samplesDone = 0
+		bp.samplesTotal = 0
+	}
+	bp.frame = frame
+
+	if peak, err := strconv.ParseFloat(match[2], 64); err == nil && peak > bp.memoryPeakMB {
+		bp.memoryPeakMB = peak
+	}
+
+	samplesMatch := regexpBlenderSamplesCycles.FindStringSubmatch(line)
+	if samplesMatch == nil {
+		samplesMatch = regexpBlenderSamplesEEVEE.FindStringSubmatch(line)
+	}
+	if samplesMatch != nil {
+		done, errDone := strconv.Atoi(samplesMatch[1])
+		total, errTotal := strconv.Atoi(samplesMatch[2])
+		if errDone == nil && errTotal == nil {
+			bp.samplesDone = done
+			bp.samplesTotal = total
+		}
+	}
+
+	return blenderLineProgress
+}
+
+// activity returns a one-line description of the current progress.
+func (bp *blenderProgress) activity() string {
+	var activity string
+	switch {
+	case bp.samplesTotal > 0:
+		activity = fmt.Sprintf("Rendering frame %d, sample %d/%d", bp.frame, bp.samplesDone, bp.samplesTotal)
+	case bp.lastFrameTime > 0 && bp.framesDone > 0:
+		activity = fmt.Sprintf("Rendered frame %d in %v", bp.frame, bp.lastFrameTime.Round(10*time.Millisecond))
+	default:
+		activity = fmt.Sprintf("Rendering frame %d", bp.frame)
+	}
+
+	if bp.framesTotal > 0 {
+		activity += fmt.Sprintf(" (%d of %d frames done)", bp.framesDone, bp.framesTotal)
+	}
+
+	switch numMissing := len(bp.missingFiles); numMissing {
+	case 0:
+	case 1:
+		activity += ", 1 missing file"
+	default:
+		activity += fmt.Sprintf(", %d missing files", numMissing)
+	}
+	return activity
+}
+
+// toAPI returns the progress for sending to the Manager. The returned struct
+// does not share any memory with `bp`.
+func (bp *blenderProgress) toAPI() api.TaskRenderProgress {
+	progress := api.TaskRenderProgress{
+		Frame:      ptr(bp.frame),
+		FramesDone: ptr(bp.framesDone),
+	}
+	if bp.framesTotal > 0 {
+		progress.FramesTotal = ptr(bp.framesTotal)
+	}
+	if bp.samplesTotal > 0 {
+		progress.SamplesDone = ptr(bp.samplesDone)
+		progress.SamplesTotal = ptr(bp.samplesTotal)
+	}
+	if bp.memoryPeakMB > 0 {
+		progress.MemoryPeakMb = ptr(float32(bp.memoryPeakMB))
+	}
+	if bp.lastFrameTime > 0 {
+		progress.LastFrameTime = ptr(float32(bp.lastFrameTime.Seconds()))
+	}
+	if len(bp.missingFiles) > 0 {
+		missingFiles := make([]string, len(bp.missingFiles))
+		copy(missingFiles, bp.missingFiles)
+		progress.MissingFiles = &missingFiles
+	}
+	return progress
+}
+
+// parseBlenderTimecode parses "MM:SS.ss" or "HH:MM:SS.ss" into a duration.
+func parseBlenderTimecode(timecode string) (time.Duration, bool) {
+	parts := strings.Split(timecode, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil {
+		return 0, false
+	}
+	duration := time.Duration(seconds * float64(time.Second))
+
+	multiplier := time.Minute
+	for idx := len(parts) - 2; idx >= 0; idx-- {
+		value, err := strconv.Atoi(parts[idx])
+		if err != nil {
+			return 0, false
+		}
+		duration += time.Duration(value) * multiplier
+		multiplier *= 60
+	}
+	return duration, true
+}
+
+// blenderFrameCount returns the number of frames rendered by the
+// `--render-frame` CLI argument, or 0 if this cannot be determined.
+func blenderFrameCount(cliArgs []string) int {
+	for idx, arg := range cliArgs {
+		if arg != "--render-frame" && arg != "-f" {
+			continue
+		}
+		if idx+1 >= len(cliArgs) {
+			return 0
+		}
+
+		// Blender frame notation: "1", "1..10", or comma-separated combinations.
+		count := 0
+		for _, part := range strings.Split(cliArgs[idx+1], ",") {
+			start, end, isRange := strings.Cut(part, "..")
+			if !isRange {
+				end = start
+			}
+			startFrame, errStart := strconv.Atoi(strings.TrimSpace(start))
+			endFrame, errEnd := strconv.Atoi(strings.TrimSpace(end))
+			if errStart != nil || errEnd != nil || endFrame < startFrame {
+				return 0
+			}
+			count += endFrame - startFrame + 1
+		}
+		return count
+	}
+	return 0
+}
@@ -0,0 +1,61 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlenderProgressEEVEE(t *testing.T) {
+	progress := newBlenderProgress(nil)
+
+	assert.Equal(t, blenderLineProgress, progress.parseLine(
+		"Fra:47 Mem:12.00M (0.00M, Peak 13.00M) | Time:00:00.48 | Scene, ViewLayer | Rendering 8 / 64 samples"))
+	assert.Equal(t, 47, progress.frame)
+	assert.Equal(t, 8, progress.samplesDone)
+	assert.Equal(t, 64, progress.samplesTotal)
+	assert.Equal(t, 13.0, progress.memoryPeakMB)
+	assert.Equal(t, "Rendering frame 47, sample 8/64", progress.activity())
+
+	// A new frame resets the sample counts.
+	assert.Equal(t, blenderLineProgress, progress.parseLine("Fra:48 Mem:12.00M (0.00M, Peak 12.50M) | Time:00:00.01 | Syncing Cube"))
+	assert.Equal(t, 0, progress.samplesTotal)
+	assert.Equal(t, 13.0, progress.memoryPeakMB, "memory peak should never go down")
+	assert.Equal(t, "Rendering frame 48", progress.activity())
+}
+
+func TestBlenderProgressIrrelevant(t *testing.T) {
+	progress := newBlenderProgress(nil)
+
+	assert.Equal(t, blenderLineIrrelevant, progress.parseLine("Blender 3.6.0 (hash 5d7a4be8bb60 built 2023-06-27 00:33:54)"))
+	assert.Equal(t, blenderLineIrrelevant, progress.parseLine("Read blend: /path/to/file.blend"))
+	assert.Equal(t, blenderLineIrrelevant, progress.parseLine("Saved: '/render/0001.png'"))
+}
+
+func TestParseBlenderTimecode(t *testing.T) {
+	test := func(timecode string, expect time.Duration, expectOK bool) {
+		duration, ok := parseBlenderTimecode(timecode)
+		assert.Equal(t, expectOK, ok, timecode)
+		assert.Equal(t, expect, duration, timecode)
+	}
+
+	test("00:05.63", 5630*time.Millisecond, true)
+	test("12:05.50", 12*time.Minute+5500*time.Millisecond, true)
+	test("01:02:03.25", time.Hour+2*time.Minute+3250*time.Millisecond, true)
+	test("5.63", 0, false)
+	test("a:05.63", 0, false)
+}
+
+func TestBlenderFrameCount(t *testing.T) {
+	assert.Equal(t, 0, blenderFrameCount(nil))
+	assert.Equal(t, 0, blenderFrameCount([]string{"--render-anim"}))
+	assert.Equal(t, 0, blenderFrameCount([]string{"--render-frame"}))
+	assert.Equal(t, 1, blenderFrameCount([]string{"--render-frame", "47"}))
+	assert.Equal(t, 10, blenderFrameCount([]string{"--render-output", "/render/###", "--render-frame", "1..10"}))
+	assert.Equal(t, 13, blenderFrameCount([]string{"-f", "1..10,15,20..21"}))
+	assert.Equal(t, 0, blenderFrameCount([]string{"-f", "10..1"}))
+	assert.Equal(t, 0, blenderFrameCount([]string{"-f", "first"}))
+}
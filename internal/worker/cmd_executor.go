@@ -39,6 +39,8 @@ type CommandListener interface {
 	LogProduced(ctx context.Context, taskID string, logLines ...string) error
 	// OutputProduced tells the Manager there has been some output (most commonly a rendered frame or video).
 	OutputProduced(ctx context.Context, taskID string, outputLocation string) error
+	// ProgressProduced tells the Manager what the command is doing, and how far along it is.
+	ProgressProduced(ctx context.Context, taskID string, activity string, progress api.TaskRenderProgress) error
 }
 
 // TimeService is a service that operates on time.
@@ -40,6 +40,7 @@ func (ce *CommandExecutor) cmdBlenderRender(ctx context.Context, logger zerolog.
 
 	logChunker := NewLogChunker(taskID, ce.listener, ce.timeService)
 	lineChannel := make(chan string)
+	progress := newBlenderProgress(execCmd.Args)
 
 	// Process the output of Blender in its own goroutine.
 	wg := sync.WaitGroup{}
@@ -47,7 +48,7 @@ func (ce *CommandExecutor) cmdBlenderRender(ctx context.Context, logger zerolog.
 	go func() {
 		defer wg.Done()
 		for line := range lineChannel {
-			ce.processLineBlender(ctx, logger, taskID, line)
+			ce.processLineBlender(ctx, logger, taskID, progress, line)
 		}
 	}()
 
@@ -157,9 +158,14 @@ func cmdBlenderRenderParams(logger zerolog.Logger, cmd api.Command) (BlenderPara
 	return parameters, nil
 }
 
-func (ce *CommandExecutor) processLineBlender(ctx context.Context, logger zerolog.Logger, taskID string, line string) {
-	// TODO: check for "Warning: Unable to open" and other indicators of missing
-	// files. Flamenco v2 updated the task.Activity field for such situations.
+func (ce *CommandExecutor) processLineBlender(
+	ctx context.Context,
+	logger zerolog.Logger,
+	taskID string,
+	progress *blenderProgress,
+	line string,
+) {
+	ce.processProgressBlender(ctx, logger, taskID, progress, line)
 
 	match := regexpFileSaved.FindStringSubmatch(line)
 	if len(match) < 2 {
@@ -175,3 +181,30 @@ func (ce *CommandExecutor) processLineBlender(ctx context.Context, logger zerolo
 		logger.Warn().Err(err).Msg("error submitting produced output to listener")
 	}
 }
+
+// processProgressBlender parses the line for render progress, and sends it to
+// the Manager. Regular progress updates are throttled, but finished frames and
+// missing files are sent immediately.
+func (ce *CommandExecutor) processProgressBlender(
+	ctx context.Context,
+	logger zerolog.Logger,
+	taskID string,
+	progress *blenderProgress,
+	line string,
+) {
+	now := ce.timeService.Now()
+	switch progress.parseLine(line) {
+	case blenderLineIrrelevant:
+		return
+	case blenderLineProgress:
+		if now.Sub(progress.lastSent) < blenderProgressInterval {
+			return
+		}
+	}
+	progress.lastSent = now
+
+	err := ce.listener.ProgressProduced(ctx, taskID, progress.activity(), progress.toAPI())
+	if err != nil {
+		logger.Warn().Err(err).Msg("error submitting render progress to listener")
+	}
+}
@@ -87,10 +87,60 @@ func TestProcessLineBlender(t *testing.T) {
 	ce, mocks := testCommandExecutor(t, mockCtrl)
 	taskID := "c194ea21-1fda-46f6-bc9a-34bd302cfb19"
 
+	progress := newBlenderProgress(nil)
+
 	// This shouldn't call anything on the mocks.
-	ce.processLineBlender(ctx, log.Logger, taskID, "starting Blender")
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, "starting Blender")
 
 	// This should be recognised as produced output.
 	mocks.listener.EXPECT().OutputProduced(ctx, taskID, "/path/to/file.exr")
-	ce.processLineBlender(ctx, log.Logger, taskID, "Saved: '/path/to/file.exr'")
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, "Saved: '/path/to/file.exr'")
+}
+
+func TestProcessLineBlenderProgress(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ce, mocks := testCommandExecutor(t, mockCtrl)
+	taskID := "c194ea21-1fda-46f6-bc9a-34bd302cfb19"
+	progress := newBlenderProgress([]string{"--render-output", "/render/###", "--render-frame", "1..4"})
+
+	// The first progress line should be sent.
+	mocks.listener.EXPECT().ProgressProduced(ctx, taskID, "Rendering frame 1, sample 1/64 (0 of 4 frames done)",
+		api.TaskRenderProgress{
+			Frame:        ptr(1),
+			FramesDone:   ptr(0),
+			FramesTotal:  ptr(4),
+			SamplesDone:  ptr(1),
+			SamplesTotal: ptr(64),
+			MemoryPeakMb: ptr(float32(183.81)),
+		})
+	ce.processLineBlender(ctx, log.Logger, taskID, progress,
+		"Fra:1 Mem:182.73M (Peak 183.81M) | Time:00:01.23 | Remaining:00:13.21 | Mem:97.23M, Peak:97.23M | Scene, ViewLayer | Sample 1/64")
+
+	// Subsequent progress lines should be throttled.
+	mocks.clock.Add(blenderProgressInterval / 2)
+	ce.processLineBlender(ctx, log.Logger, taskID, progress,
+		"Fra:1 Mem:182.73M (Peak 190.00M) | Time:00:02.23 | Remaining:00:10.21 | Mem:97.23M, Peak:97.23M | Scene, ViewLayer | Sample 16/64")
+
+	// Missing files should be sent immediately, but only once.
+	mocks.listener.EXPECT().ProgressProduced(ctx, taskID,
+		"Rendering frame 1, sample 16/64 (0 of 4 frames done), 1 missing file", gomock.Any())
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, "Warning: Unable to open '/textures/wood.png'")
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, "Warning: Unable to open '/textures/wood.png'")
+
+	// A finished frame should be sent immediately.
+	mocks.listener.EXPECT().OutputProduced(ctx, taskID, "/render/0001.png")
+	mocks.listener.EXPECT().ProgressProduced(ctx, taskID, "Rendered frame 1 in 5.63s (1 of 4 frames done), 1 missing file",
+		api.TaskRenderProgress{
+			Frame:         ptr(1),
+			FramesDone:    ptr(1),
+			FramesTotal:   ptr(4),
+			MemoryPeakMb:  ptr(float32(190.0)),
+			LastFrameTime: ptr(float32(5.63)),
+			MissingFiles:  &[]string{"/textures/wood.png"},
+		})
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, "Saved: '/render/0001.png'")
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, " Time: 00:05.63 (Saving: 00:00.03)")
 }
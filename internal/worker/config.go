@@ -50,6 +50,11 @@ type WorkerConfig struct {
 
 	TaskTypes       []string `yaml:"task_types"`
 	RestartExitCode int      `yaml:"restart_exit_code"`
+
+	// EXRLayer is the layer of rendered EXR images that is sent to the Manager
+	// as last-rendered image, like "ViewLayer.Combined". When empty, the layer
+	// is chosen automatically.
+	EXRLayer string `yaml:"exr_layer,omitempty"`
 }
 
 type WorkerCredentials struct {
@@ -0,0 +1,117 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/sysinfo"
+)
+
+// heartbeatInterval determines how often the Worker reports its resource usage.
+const heartbeatInterval = 1 * time.Minute
+
+// workerResources returns the hardware and OS info to send when signing on.
+// Anything that cannot be determined is logged and left out.
+func workerResources() api.WorkerResources {
+	cpuCount := sysinfo.CPUCount()
+	resources := api.WorkerResources{
+		CpuCount:     &cpuCount,
+		TempDiskFree: tempDiskFree(),
+	}
+
+	if description, err := sysinfo.Description(); err != nil {
+		log.Debug().Err(err).Msg("unable to determine OS description")
+	} else {
+		resources.OsDescription = &description
+	}
+
+	if total, err := sysinfo.MemoryTotal(); err != nil {
+		log.Debug().Err(err).Msg("unable to determine total memory")
+	} else {
+		memoryTotal := int64(total)
+		resources.MemoryTotal = &memoryTotal
+	}
+
+	return resources
+}
+
+// workerResourceUsage returns the current resource usage to send in a
+// heartbeat. Anything that cannot be determined is logged and left out.
+func workerResourceUsage() api.WorkerResourceUsage {
+	usage := api.WorkerResourceUsage{
+		TempDiskFree: tempDiskFree(),
+	}
+
+	if load, err := sysinfo.LoadAverage(); err == nil {
+		usage.LoadAverage = &load
+	} else if !errors.Is(err, sysinfo.ErrNotSupported) {
+		log.Debug().Err(err).Msg("unable to determine load average")
+	}
+
+	if used, err := sysinfo.MemoryUsed(); err == nil {
+		memoryUsed := int64(used)
+		usage.MemoryUsed = &memoryUsed
+	} else if !errors.Is(err, sysinfo.ErrNotSupported) {
+		log.Debug().Err(err).Msg("unable to determine used memory")
+	}
+
+	return usage
+}
+
+// tempDiskFree returns the free disk space of the temporary directory, or nil
+// if it cannot be determined.
+func tempDiskFree() *int64 {
+	tempDir := os.TempDir()
+	free, err := sysinfo.FreeDiskSpace(tempDir)
+	if err != nil {
+		log.Debug().Err(err).Str("path", tempDir).Msg("unable to determine free disk space")
+		return nil
+	}
+	diskFree := int64(free)
+	return &diskFree
+}
+
+// runHeartbeat periodically sends the resource usage to the Manager, until the
+// context is closed or the Worker shuts down.
+func (w *Worker) runHeartbeat(ctx context.Context) {
+	defer w.doneWg.Done()
+	defer log.Debug().Msg("stopping heartbeat")
+
+	for {
+		w.sendHeartbeat(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.doneChan:
+			return
+		case <-time.After(heartbeatInterval):
+		}
+	}
+}
+
+// sendHeartbeat sends the current resource usage to the Manager.
+// Any error communicating with the Manager is logged but otherwise ignored.
+func (w *Worker) sendHeartbeat(ctx context.Context) {
+	resp, err := w.client.WorkerHeartbeatWithResponse(ctx, api.WorkerHeartbeatJSONRequestBody(workerResourceUsage()))
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			log.Warn().Err(err).Msg("error sending heartbeat to Manager")
+		}
+		return
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		log.Warn().
+			Int("code", resp.StatusCode()).
+			Str("error", string(resp.Body)).
+			Msg("unable to send heartbeat to Manager")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/exr"
 )
 
 var _ CommandListener = (*Listener)(nil)
@@ -33,11 +34,13 @@ type UpstreamBuffer interface {
 }
 
 // NewListener creates a new Listener that will send updates to the API client.
-func NewListener(client FlamencoClient, buffer UpstreamBuffer) *Listener {
+// The EXR options determine how rendered EXR images are decoded before they
+// are sent to the Manager.
+func NewListener(client FlamencoClient, buffer UpstreamBuffer, exrOptions exr.Options) *Listener {
 	l := &Listener{
 		client:         client,
 		buffer:         buffer,
-		outputUploader: NewOutputUploader(client),
+		outputUploader: NewOutputUploader(client, exrOptions),
 	}
 	return l
 }
@@ -74,6 +77,7 @@ func (l *Listener) TaskFailed(ctx context.Context, taskID string, reason string)
 func (l *Listener) TaskCompleted(ctx context.Context, taskID string) error {
 	return l.sendTaskUpdate(ctx, taskID, api.TaskUpdateJSONRequestBody{
 		Activity:   ptr("Completed"),
+		Progress:   ptr(100),
 		TaskStatus: ptr(api.TaskStatusCompleted),
 	})
 }
@@ -91,6 +95,37 @@ func (l *Listener) OutputProduced(ctx context.Context, taskID string, outputLoca
 	return nil
 }
 
+// ProgressProduced tells the Manager what the command is doing, and how far along it is.
+func (l *Listener) ProgressProduced(ctx context.Context, taskID string, activity string, progress api.TaskRenderProgress) error {
+	return l.sendTaskUpdate(ctx, taskID, api.TaskUpdateJSONRequestBody{
+		Activity:       &activity,
+		Progress:       renderPercentage(progress),
+		RenderProgress: &progress,
+	})
+}
+
+// renderPercentage returns the percentage of frames rendered, taking the
+// samples of the current frame into account. Returns nil when unknown.
+func renderPercentage(progress api.TaskRenderProgress) *int {
+	if progress.FramesTotal == nil || *progress.FramesTotal <= 0 {
+		return nil
+	}
+
+	framesDone := 0.0
+	if progress.FramesDone != nil {
+		framesDone = float64(*progress.FramesDone)
+	}
+	if progress.SamplesDone != nil && progress.SamplesTotal != nil && *progress.SamplesTotal > 0 {
+		framesDone += float64(*progress.SamplesDone) / float64(*progress.SamplesTotal)
+	}
+
+	percentage := int(100 * framesDone / float64(*progress.FramesTotal))
+	if percentage > 100 {
+		percentage = 100
+	}
+	return &percentage
+}
+
 func (l *Listener) sendTaskUpdate(ctx context.Context, taskID string, update api.TaskUpdateJSONRequestBody) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
@@ -0,0 +1,35 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+)
+
+func TestRenderPercentage(t *testing.T) {
+	assert.Nil(t, renderPercentage(api.TaskRenderProgress{}))
+	assert.Nil(t, renderPercentage(api.TaskRenderProgress{FramesDone: ptr(3)}))
+
+	assert.Equal(t, ptr(30), renderPercentage(api.TaskRenderProgress{
+		FramesDone:  ptr(3),
+		FramesTotal: ptr(10),
+	}))
+
+	// Samples of the current frame count towards the progress.
+	assert.Equal(t, ptr(35), renderPercentage(api.TaskRenderProgress{
+		FramesDone:   ptr(3),
+		FramesTotal:  ptr(10),
+		SamplesDone:  ptr(32),
+		SamplesTotal: ptr(64),
+	}))
+
+	// More frames than expected should not go beyond 100%.
+	assert.Equal(t, ptr(100), renderPercentage(api.TaskRenderProgress{
+		FramesDone:  ptr(12),
+		FramesTotal: ptr(10),
+	}))
+}
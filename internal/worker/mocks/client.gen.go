@@ -116,6 +116,46 @@ func (mr *MockFlamencoClientMockRecorder) CheckSharedStoragePathWithResponse(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSharedStoragePathWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CheckSharedStoragePathWithResponse), varargs...)
 }
 
+// CreateJobTemplateWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) CreateJobTemplateWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.CreateJobTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateJobTemplateWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.CreateJobTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobTemplateWithBodyWithResponse indicates an expected call of CreateJobTemplateWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) CreateJobTemplateWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobTemplateWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CreateJobTemplateWithBodyWithResponse), varargs...)
+}
+
+// CreateJobTemplateWithResponse mocks base method.
+func (m *MockFlamencoClient) CreateJobTemplateWithResponse(arg0 context.Context, arg1 api.CreateJobTemplateJSONRequestBody, arg2 ...api.RequestEditorFn) (*api.CreateJobTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateJobTemplateWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.CreateJobTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobTemplateWithResponse indicates an expected call of CreateJobTemplateWithResponse.
+func (mr *MockFlamencoClientMockRecorder) CreateJobTemplateWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobTemplateWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CreateJobTemplateWithResponse), varargs...)
+}
+
 // CreateWorkerTagWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) CreateWorkerTagWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.CreateWorkerTagResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +236,26 @@ func (mr *MockFlamencoClientMockRecorder) DeleteJobMassWithResponse(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobMassWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteJobMassWithResponse), varargs...)
 }
 
+// DeleteJobTemplateWithResponse mocks base method.
+func (m *MockFlamencoClient) DeleteJobTemplateWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.DeleteJobTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteJobTemplateWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.DeleteJobTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteJobTemplateWithResponse indicates an expected call of DeleteJobTemplateWithResponse.
+func (mr *MockFlamencoClientMockRecorder) DeleteJobTemplateWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobTemplateWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteJobTemplateWithResponse), varargs...)
+}
+
 // DeleteJobWhatWouldItDoWithResponse mocks base method.
 func (m *MockFlamencoClient) DeleteJobWhatWouldItDoWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.DeleteJobWhatWouldItDoResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +336,26 @@ func (mr *MockFlamencoClientMockRecorder) DeleteWorkerWithResponse(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkerWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteWorkerWithResponse), varargs...)
 }
 
+// ExportJobArchiveWithResponse mocks base method.
+func (m *MockFlamencoClient) ExportJobArchiveWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.ExportJobArchiveResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportJobArchiveWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.ExportJobArchiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportJobArchiveWithResponse indicates an expected call of ExportJobArchiveWithResponse.
+func (mr *MockFlamencoClientMockRecorder) ExportJobArchiveWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportJobArchiveWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).ExportJobArchiveWithResponse), varargs...)
+}
+
 // FetchGlobalLastRenderedInfoWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchGlobalLastRenderedInfoWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.FetchGlobalLastRenderedInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +396,46 @@ func (mr *MockFlamencoClientMockRecorder) FetchJobBlocklistWithResponse(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobBlocklistWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobBlocklistWithResponse), varargs...)
 }
 
+// FetchJobLastRenderedContactSheetWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchJobLastRenderedContactSheetWithResponse(arg0 context.Context, arg1 string, arg2 *api.FetchJobLastRenderedContactSheetParams, arg3 ...api.RequestEditorFn) (*api.FetchJobLastRenderedContactSheetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchJobLastRenderedContactSheetWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchJobLastRenderedContactSheetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobLastRenderedContactSheetWithResponse indicates an expected call of FetchJobLastRenderedContactSheetWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchJobLastRenderedContactSheetWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobLastRenderedContactSheetWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobLastRenderedContactSheetWithResponse), varargs...)
+}
+
+// FetchJobLastRenderedHistoryWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchJobLastRenderedHistoryWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchJobLastRenderedHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchJobLastRenderedHistoryWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchJobLastRenderedHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobLastRenderedHistoryWithResponse indicates an expected call of FetchJobLastRenderedHistoryWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchJobLastRenderedHistoryWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobLastRenderedHistoryWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobLastRenderedHistoryWithResponse), varargs...)
+}
+
 // FetchJobLastRenderedInfoWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchJobLastRenderedInfoWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchJobLastRenderedInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +476,46 @@ func (mr *MockFlamencoClientMockRecorder) FetchJobTasksWithResponse(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobTasksWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobTasksWithResponse), varargs...)
 }
 
+// FetchJobTemplateWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchJobTemplateWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchJobTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchJobTemplateWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchJobTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobTemplateWithResponse indicates an expected call of FetchJobTemplateWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchJobTemplateWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobTemplateWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobTemplateWithResponse), varargs...)
+}
+
+// FetchJobTemplatesWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchJobTemplatesWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.FetchJobTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchJobTemplatesWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchJobTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobTemplatesWithResponse indicates an expected call of FetchJobTemplatesWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchJobTemplatesWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobTemplatesWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobTemplatesWithResponse), varargs...)
+}
+
 // FetchJobWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchJobWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -376,6 +536,26 @@ func (mr *MockFlamencoClientMockRecorder) FetchJobWithResponse(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobWithResponse), varargs...)
 }
 
+// FetchLastRenderedQueueInfoWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchLastRenderedQueueInfoWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.FetchLastRenderedQueueInfoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchLastRenderedQueueInfoWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchLastRenderedQueueInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchLastRenderedQueueInfoWithResponse indicates an expected call of FetchLastRenderedQueueInfoWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchLastRenderedQueueInfoWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchLastRenderedQueueInfoWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchLastRenderedQueueInfoWithResponse), varargs...)
+}
+
 // FetchTaskLogInfoWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchTaskLogInfoWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchTaskLogInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -456,6 +636,26 @@ func (mr *MockFlamencoClientMockRecorder) FetchWorkerSleepScheduleWithResponse(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerSleepScheduleWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchWorkerSleepScheduleWithResponse), varargs...)
 }
 
+// FetchWorkerTagSleepScheduleWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchWorkerTagSleepScheduleWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchWorkerTagSleepScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchWorkerTagSleepScheduleWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchWorkerTagSleepScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWorkerTagSleepScheduleWithResponse indicates an expected call of FetchWorkerTagSleepScheduleWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchWorkerTagSleepScheduleWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerTagSleepScheduleWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchWorkerTagSleepScheduleWithResponse), varargs...)
+}
+
 // FetchWorkerTagWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchWorkerTagWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchWorkerTagResponse, error) {
 	m.ctrl.T.Helper()
@@ -636,6 +836,46 @@ func (mr *MockFlamencoClientMockRecorder) GetJobTypesWithResponse(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobTypesWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).GetJobTypesWithResponse), varargs...)
 }
 
+// GetShamanScrubStatusWithResponse mocks base method.
+func (m *MockFlamencoClient) GetShamanScrubStatusWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.GetShamanScrubStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetShamanScrubStatusWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.GetShamanScrubStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShamanScrubStatusWithResponse indicates an expected call of GetShamanScrubStatusWithResponse.
+func (mr *MockFlamencoClientMockRecorder) GetShamanScrubStatusWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShamanScrubStatusWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).GetShamanScrubStatusWithResponse), varargs...)
+}
+
+// GetShamanStatusWithResponse mocks base method.
+func (m *MockFlamencoClient) GetShamanStatusWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.GetShamanStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetShamanStatusWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.GetShamanStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShamanStatusWithResponse indicates an expected call of GetShamanStatusWithResponse.
+func (mr *MockFlamencoClientMockRecorder) GetShamanStatusWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShamanStatusWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).GetShamanStatusWithResponse), varargs...)
+}
+
 // GetSharedStorageWithResponse mocks base method.
 func (m *MockFlamencoClient) GetSharedStorageWithResponse(arg0 context.Context, arg1 api.ManagerVariableAudience, arg2 string, arg3 ...api.RequestEditorFn) (*api.GetSharedStorageResponse, error) {
 	m.ctrl.T.Helper()
@@ -696,6 +936,26 @@ func (mr *MockFlamencoClientMockRecorder) GetVersionWithResponse(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).GetVersionWithResponse), varargs...)
 }
 
+// ImportJobArchiveWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) ImportJobArchiveWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.ImportJobArchiveResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportJobArchiveWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.ImportJobArchiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportJobArchiveWithBodyWithResponse indicates an expected call of ImportJobArchiveWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) ImportJobArchiveWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJobArchiveWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).ImportJobArchiveWithBodyWithResponse), varargs...)
+}
+
 // MayWorkerRunWithResponse mocks base method.
 func (m *MockFlamencoClient) MayWorkerRunWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.MayWorkerRunResponse, error) {
 	m.ctrl.T.Helper()
@@ -936,6 +1196,46 @@ func (mr *MockFlamencoClientMockRecorder) ScheduleTaskWithResponse(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTaskWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).ScheduleTaskWithResponse), varargs...)
 }
 
+// SearchTaskLogsWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) SearchTaskLogsWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.SearchTaskLogsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchTaskLogsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SearchTaskLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTaskLogsWithBodyWithResponse indicates an expected call of SearchTaskLogsWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SearchTaskLogsWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTaskLogsWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SearchTaskLogsWithBodyWithResponse), varargs...)
+}
+
+// SearchTaskLogsWithResponse mocks base method.
+func (m *MockFlamencoClient) SearchTaskLogsWithResponse(arg0 context.Context, arg1 api.SearchTaskLogsJSONRequestBody, arg2 ...api.RequestEditorFn) (*api.SearchTaskLogsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchTaskLogsWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SearchTaskLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTaskLogsWithResponse indicates an expected call of SearchTaskLogsWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SearchTaskLogsWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTaskLogsWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SearchTaskLogsWithResponse), varargs...)
+}
+
 // SetJobPriorityWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) SetJobPriorityWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SetJobPriorityResponse, error) {
 	m.ctrl.T.Helper()
@@ -1096,6 +1396,46 @@ func (mr *MockFlamencoClientMockRecorder) SetWorkerSleepScheduleWithResponse(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerSleepScheduleWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetWorkerSleepScheduleWithResponse), varargs...)
 }
 
+// SetWorkerTagSleepScheduleWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) SetWorkerTagSleepScheduleWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SetWorkerTagSleepScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetWorkerTagSleepScheduleWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetWorkerTagSleepScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkerTagSleepScheduleWithBodyWithResponse indicates an expected call of SetWorkerTagSleepScheduleWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetWorkerTagSleepScheduleWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerTagSleepScheduleWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetWorkerTagSleepScheduleWithBodyWithResponse), varargs...)
+}
+
+// SetWorkerTagSleepScheduleWithResponse mocks base method.
+func (m *MockFlamencoClient) SetWorkerTagSleepScheduleWithResponse(arg0 context.Context, arg1 string, arg2 api.SetWorkerTagSleepScheduleJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.SetWorkerTagSleepScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetWorkerTagSleepScheduleWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetWorkerTagSleepScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkerTagSleepScheduleWithResponse indicates an expected call of SetWorkerTagSleepScheduleWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetWorkerTagSleepScheduleWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerTagSleepScheduleWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetWorkerTagSleepScheduleWithResponse), varargs...)
+}
+
 // SetWorkerTagsWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) SetWorkerTagsWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SetWorkerTagsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1316,6 +1656,26 @@ func (mr *MockFlamencoClientMockRecorder) SignOnWithResponse(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOnWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SignOnWithResponse), varargs...)
 }
 
+// StartShamanScrubWithResponse mocks base method.
+func (m *MockFlamencoClient) StartShamanScrubWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.StartShamanScrubResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartShamanScrubWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.StartShamanScrubResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartShamanScrubWithResponse indicates an expected call of StartShamanScrubWithResponse.
+func (mr *MockFlamencoClientMockRecorder) StartShamanScrubWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShamanScrubWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).StartShamanScrubWithResponse), varargs...)
+}
+
 // SubmitJobCheckWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) SubmitJobCheckWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.SubmitJobCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -1397,10 +1757,10 @@ func (mr *MockFlamencoClientMockRecorder) SubmitJobWithResponse(arg0, arg1 inter
 }
 
 // TaskOutputProducedWithBodyWithResponse mocks base method.
-func (m *MockFlamencoClient) TaskOutputProducedWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.TaskOutputProducedResponse, error) {
+func (m *MockFlamencoClient) TaskOutputProducedWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 *api.TaskOutputProducedParams, arg3 string, arg4 io.Reader, arg5 ...api.RequestEditorFn) (*api.TaskOutputProducedResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1, arg2, arg3}
-	for _, a := range arg4 {
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "TaskOutputProducedWithBodyWithResponse", varargs...)
@@ -1410,9 +1770,9 @@ func (m *MockFlamencoClient) TaskOutputProducedWithBodyWithResponse(arg0 context
 }
 
 // TaskOutputProducedWithBodyWithResponse indicates an expected call of TaskOutputProducedWithBodyWithResponse.
-func (mr *MockFlamencoClientMockRecorder) TaskOutputProducedWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+func (mr *MockFlamencoClientMockRecorder) TaskOutputProducedWithBodyWithResponse(arg0, arg1, arg2, arg3, arg4 interface{}, arg5 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskOutputProducedWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).TaskOutputProducedWithBodyWithResponse), varargs...)
 }
 
@@ -1456,6 +1816,46 @@ func (mr *MockFlamencoClientMockRecorder) TaskUpdateWithResponse(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskUpdateWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).TaskUpdateWithResponse), varargs...)
 }
 
+// UpdateJobTemplateWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) UpdateJobTemplateWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.UpdateJobTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateJobTemplateWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.UpdateJobTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobTemplateWithBodyWithResponse indicates an expected call of UpdateJobTemplateWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) UpdateJobTemplateWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobTemplateWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).UpdateJobTemplateWithBodyWithResponse), varargs...)
+}
+
+// UpdateJobTemplateWithResponse mocks base method.
+func (m *MockFlamencoClient) UpdateJobTemplateWithResponse(arg0 context.Context, arg1 string, arg2 api.UpdateJobTemplateJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.UpdateJobTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateJobTemplateWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.UpdateJobTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobTemplateWithResponse indicates an expected call of UpdateJobTemplateWithResponse.
+func (mr *MockFlamencoClientMockRecorder) UpdateJobTemplateWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobTemplateWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).UpdateJobTemplateWithResponse), varargs...)
+}
+
 // UpdateWorkerTagWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) UpdateWorkerTagWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.UpdateWorkerTagResponse, error) {
 	m.ctrl.T.Helper()
@@ -1496,6 +1896,46 @@ func (mr *MockFlamencoClientMockRecorder) UpdateWorkerTagWithResponse(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerTagWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).UpdateWorkerTagWithResponse), varargs...)
 }
 
+// WorkerHeartbeatWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) WorkerHeartbeatWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.WorkerHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WorkerHeartbeatWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.WorkerHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkerHeartbeatWithBodyWithResponse indicates an expected call of WorkerHeartbeatWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) WorkerHeartbeatWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerHeartbeatWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).WorkerHeartbeatWithBodyWithResponse), varargs...)
+}
+
+// WorkerHeartbeatWithResponse mocks base method.
+func (m *MockFlamencoClient) WorkerHeartbeatWithResponse(arg0 context.Context, arg1 api.WorkerHeartbeatJSONRequestBody, arg2 ...api.RequestEditorFn) (*api.WorkerHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WorkerHeartbeatWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.WorkerHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkerHeartbeatWithResponse indicates an expected call of WorkerHeartbeatWithResponse.
+func (mr *MockFlamencoClientMockRecorder) WorkerHeartbeatWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerHeartbeatWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).WorkerHeartbeatWithResponse), varargs...)
+}
+
 // WorkerStateChangedWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) WorkerStateChangedWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.WorkerStateChangedResponse, error) {
 	m.ctrl.T.Helper()
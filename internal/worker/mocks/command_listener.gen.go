@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	api "projects.blender.org/studio/flamenco/pkg/api"
 )
 
 // MockCommandListener is a mock of CommandListener interface.
@@ -66,3 +67,17 @@ func (mr *MockCommandListenerMockRecorder) OutputProduced(arg0, arg1, arg2 inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutputProduced", reflect.TypeOf((*MockCommandListener)(nil).OutputProduced), arg0, arg1, arg2)
 }
+
+// ProgressProduced mocks base method.
+func (m *MockCommandListener) ProgressProduced(arg0 context.Context, arg1, arg2 string, arg3 api.TaskRenderProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProgressProduced", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProgressProduced indicates an expected call of ProgressProduced.
+func (mr *MockCommandListenerMockRecorder) ProgressProduced(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProgressProduced", reflect.TypeOf((*MockCommandListener)(nil).ProgressProduced), arg0, arg1, arg2, arg3)
+}
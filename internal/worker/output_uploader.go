@@ -9,24 +9,41 @@ import (
 	"image"
 	"image/jpeg"
 	_ "image/png"
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/rs/zerolog/log"
 	_ "golang.org/x/image/tiff"
 
+	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/exr"
 	"projects.blender.org/studio/flamenco/pkg/last_in_one_out_queue"
 )
 
 const thumbnailJPEGQuality = 85
 
+// supportedOutputExtensions are the file extensions of images that can be
+// decoded by loadAsJPEG(). They should match the registered image decoders.
+var supportedOutputExtensions = map[string]bool{
+	".exr":  true,
+	".jpeg": true,
+	".jpg":  true,
+	".png":  true,
+	".tif":  true,
+	".tiff": true,
+}
+
 // OutputUploader sends (downscaled versions of) rendered images to Flamenco
 // Manager. Only one image is sent at a time. A queue of a single image is kept,
 // where newly queued images replace older ones.
 type OutputUploader struct {
-	client FlamencoClient
-	queue  *last_in_one_out_queue.LastInOneOutQueue[TaskOutput]
+	client     FlamencoClient
+	queue      *last_in_one_out_queue.LastInOneOutQueue[TaskOutput]
+	exrOptions exr.Options
 }
 
 type TaskOutput struct {
@@ -34,19 +51,26 @@ type TaskOutput struct {
 	Filename string
 }
 
-func NewOutputUploader(client FlamencoClient) *OutputUploader {
+func NewOutputUploader(client FlamencoClient, exrOptions exr.Options) *OutputUploader {
 	return &OutputUploader{
-		client: client,
-		queue:  last_in_one_out_queue.New[TaskOutput](),
+		client:     client,
+		queue:      last_in_one_out_queue.New[TaskOutput](),
+		exrOptions: exrOptions,
 	}
 }
 
 // OutputProduced enqueues the given filename for processing.
+//
+// Files that cannot be handled are ignored, so that they do not replace any
+// previously queued, perfectly-good image.
 func (ou *OutputUploader) OutputProduced(taskID, filename string) {
-	// TODO: Before enqueueing (and thus overwriting any previously queued item),
-	// check that this file can actually be handled by the Last Rendered system of
-	// Flamenco. It would be a shame if a perfectly-good JPEG file is kicked off
-	// the queue by an EXR file we can't handle.
+	if !isSupportedOutput(filename) {
+		log.Debug().
+			Str("image", filename).
+			Str("task", taskID).
+			Msg("output uploader: file type not supported, ignoring it")
+		return
+	}
 	item := TaskOutput{taskID, filename}
 	ou.queue.Enqueue(item)
 }
@@ -84,15 +108,18 @@ func (ou *OutputUploader) process(ctx context.Context, item TaskOutput) {
 		Logger()
 	logger.Info().Msg("output uploader: processing file before uploading to Manager")
 
-	jpegBytes := loadAsJPEG(item.Filename)
+	jpegBytes := loadAsJPEG(item.Filename, ou.exrOptions)
 	if len(jpegBytes) == 0 {
 		return // loadAsJPEG() already logged the error.
 	}
 
 	// Upload to Manager.
 	jpegReader := bytes.NewReader(jpegBytes)
+	params := api.TaskOutputProducedParams{
+		XFlamencoOutputFilename: ptr(filepath.Base(item.Filename)),
+	}
 	resp, err := ou.client.TaskOutputProducedWithBodyWithResponse(
-		ctx, item.TaskID, "image/jpeg", jpegReader)
+		ctx, item.TaskID, &params, "image/jpeg", jpegReader)
 	if err != nil {
 		logger.Error().Err(err).Msg("output uploader: unable to send image to Manager")
 		return
@@ -129,7 +156,15 @@ func (ou *OutputUploader) process(ctx context.Context, item TaskOutput) {
 	}
 }
 
-func loadAsJPEG(imagePath string) []byte {
+// isSupportedOutput returns whether the file looks like an image that
+// loadAsJPEG() can handle. Only the extension is checked, as the file may not
+// even have been fully written yet.
+func isSupportedOutput(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	return supportedOutputExtensions[ext]
+}
+
+func loadAsJPEG(imagePath string, exrOptions exr.Options) []byte {
 	logger := log.With().Str("image", imagePath).Logger()
 
 	// Open the output file.
@@ -141,11 +176,11 @@ func loadAsJPEG(imagePath string) []byte {
 	defer file.Close()
 
 	// Try to decode the file as image.
-	img, fileType, err := image.Decode(file)
+	img, fileType, err := decodeImage(imagePath, file, exrOptions)
 	switch {
 	case errors.Is(err, image.ErrFormat):
 		// Blender writing formats not supported by this Go code will happen, for
-		// example EXR files. This is fine, and shouldn't even trigger a warning.
+		// example JPEG 2000 files. This is fine, and shouldn't even trigger a warning.
 		logger.Info().Msg("output uploader: file a format I cannot decode, ignoring it")
 		return nil
 	case err != nil:
@@ -169,3 +204,13 @@ func loadAsJPEG(imagePath string) []byte {
 
 	return jpegBuffer.Bytes()
 }
+
+// decodeImage decodes the image file. EXR files are decoded with the given
+// options, all other formats with the registered image decoders.
+func decodeImage(imagePath string, file io.Reader, exrOptions exr.Options) (image.Image, string, error) {
+	if strings.ToLower(filepath.Ext(imagePath)) != ".exr" {
+		return image.Decode(file)
+	}
+	img, err := exr.DecodeWithOptions(file, exrOptions)
+	return img, "exr", err
+}
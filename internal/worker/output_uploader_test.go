@@ -4,7 +4,12 @@ package worker
 
 import (
 	"context"
+	"image"
+	"image/color"
+	"image/png"
 	"net/http"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -14,6 +19,7 @@ import (
 
 	"projects.blender.org/studio/flamenco/internal/worker/mocks"
 	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/exr"
 )
 
 func TestQueueOutput(t *testing.T) {
@@ -45,6 +51,36 @@ func TestQueueOutput(t *testing.T) {
 	wg.Wait()
 }
 
+func TestIsSupportedOutput(t *testing.T) {
+	assert.True(t, isSupportedOutput("/render/frame-0001.png"))
+	assert.True(t, isSupportedOutput("/render/frame-0001.JPG"))
+	assert.True(t, isSupportedOutput("/render/frame-0001.tif"))
+	assert.True(t, isSupportedOutput("/render/frame-0001.exr"))
+	assert.False(t, isSupportedOutput("/render/frame-0001.jp2"))
+	assert.False(t, isSupportedOutput("/render/frame-0001.mkv"))
+	assert.False(t, isSupportedOutput("/render/frame-0001"))
+}
+
+func TestLoadAsJPEG16BitPNG(t *testing.T) {
+	img := image.NewNRGBA64(image.Rect(0, 0, 32, 16))
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 32; x++ {
+			img.SetNRGBA64(x, y, color.NRGBA64{R: uint16(x * 2000), G: uint16(y * 4000), B: 0x8000, A: 0xffff})
+		}
+	}
+
+	filename := filepath.Join(t.TempDir(), "frame-16bit.png")
+	file, err := os.Create(filename)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.NoError(t, png.Encode(file, img))
+	assert.NoError(t, file.Close())
+
+	jpegBytes := loadAsJPEG(filename, exr.Options{})
+	assert.NotEmpty(t, jpegBytes)
+}
+
 func TestProcess(t *testing.T) {
 	ou, mocks, finish := mockedOutputUploader(t)
 	defer finish()
@@ -65,8 +101,11 @@ func TestProcess(t *testing.T) {
 				StatusCode: http.StatusAccepted,
 			},
 		}
+		expectParams := api.TaskOutputProducedParams{
+			XFlamencoOutputFilename: ptr("frame-1.png"),
+		}
 		mocks.client.EXPECT().TaskOutputProducedWithBodyWithResponse(
-			mocks.ctx, taskID, "image/jpeg", gomock.Any()).
+			mocks.ctx, taskID, &expectParams, "image/jpeg", gomock.Any()).
 			Return(&response, nil)
 
 		ou.process(mocks.ctx, item)
@@ -85,7 +124,7 @@ func TestProcess(t *testing.T) {
 			},
 		}
 		mocks.client.EXPECT().TaskOutputProducedWithBodyWithResponse(
-			mocks.ctx, taskID, "image/jpeg", gomock.Any()).
+			mocks.ctx, taskID, gomock.Any(), "image/jpeg", gomock.Any()).
 			Return(&response, nil)
 
 		ou.process(mocks.ctx, item)
@@ -114,7 +153,7 @@ func TestProcessTiff(t *testing.T) {
 			},
 		}
 		mocks.client.EXPECT().TaskOutputProducedWithBodyWithResponse(
-			mocks.ctx, taskID, "image/jpeg", gomock.Any()).
+			mocks.ctx, taskID, gomock.Any(), "image/jpeg", gomock.Any()).
 			Return(&response, nil)
 
 		ou.process(mocks.ctx, item)
@@ -136,7 +175,7 @@ func mockedOutputUploader(t *testing.T) (*OutputUploader, *outputUploaderTestMoc
 		ctx:       ctx,
 		ctxCancel: cancel,
 	}
-	ou := NewOutputUploader(mocks.client)
+	ou := NewOutputUploader(mocks.client, exr.Options{})
 
 	finish := func() {
 		cancel()
@@ -151,11 +151,13 @@ func signOn(ctx context.Context, cfg WorkerConfig, client FlamencoClient) (api.W
 	logger := log.With().Str("manager", cfg.ManagerURL).Logger()
 
 	canRestart := cfg.RestartExitCode != 0
+	resources := workerResources()
 	req := api.SignOnJSONRequestBody{
 		Name:               workerName(cfg),
 		SupportedTaskTypes: cfg.TaskTypes,
 		SoftwareVersion:    appinfo.ExtendedVersion(),
 		CanRestart:         &canRestart,
+		Resources:          &resources,
 	}
 
 	logger.Info().
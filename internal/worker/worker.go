@@ -60,6 +60,9 @@ func NewWorker(
 
 // Start starts the worker by sending it to the given state.
 func (w *Worker) Start(ctx context.Context, state api.WorkerStatus) {
+	w.doneWg.Add(1)
+	go w.runHeartbeat(ctx)
+
 	w.changeState(ctx, state)
 }
 
@@ -122,6 +122,25 @@ type ClientInterface interface {
 	// GetVariables request
 	GetVariables(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FetchJobTemplates request
+	FetchJobTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateJobTemplate request with any body
+	CreateJobTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateJobTemplate(ctx context.Context, body CreateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteJobTemplate request
+	DeleteJobTemplate(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FetchJobTemplate request
+	FetchJobTemplate(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateJobTemplate request with any body
+	UpdateJobTemplateWithBody(ctx context.Context, templateId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateJobTemplate(ctx context.Context, templateId string, body UpdateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SubmitJob request with any body
 	SubmitJobWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -132,9 +151,15 @@ type ClientInterface interface {
 
 	SubmitJobCheck(ctx context.Context, body SubmitJobCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ImportJobArchive request with any body
+	ImportJobArchiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchGlobalLastRenderedInfo request
 	FetchGlobalLastRenderedInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FetchLastRenderedQueueInfo request
+	FetchLastRenderedQueueInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteJobMass request with any body
 	DeleteJobMassWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -157,6 +182,9 @@ type ClientInterface interface {
 	// FetchJob request
 	FetchJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExportJobArchive request
+	ExportJobArchive(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RemoveJobBlocklist request with any body
 	RemoveJobBlocklistWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -168,6 +196,12 @@ type ClientInterface interface {
 	// FetchJobLastRenderedInfo request
 	FetchJobLastRenderedInfo(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FetchJobLastRenderedContactSheet request
+	FetchJobLastRenderedContactSheet(ctx context.Context, jobId string, params *FetchJobLastRenderedContactSheetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FetchJobLastRenderedHistory request
+	FetchJobLastRenderedHistory(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SetJobPriority request with any body
 	SetJobPriorityWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -200,6 +234,20 @@ type ClientInterface interface {
 	// ShamanFileStore request with any body
 	ShamanFileStoreWithBody(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetShamanScrubStatus request
+	GetShamanScrubStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartShamanScrub request
+	StartShamanScrub(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetShamanStatus request
+	GetShamanStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchTaskLogs request with any body
+	SearchTaskLogsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchTaskLogs(ctx context.Context, body SearchTaskLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchTask request
 	FetchTask(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -228,6 +276,14 @@ type ClientInterface interface {
 
 	UpdateWorkerTag(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FetchWorkerTagSleepSchedule request
+	FetchWorkerTagSleepSchedule(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetWorkerTagSleepSchedule request with any body
+	SetWorkerTagSleepScheduleWithBody(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetWorkerTagSleepSchedule(ctx context.Context, tagId string, body SetWorkerTagSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchWorkerTags request
 	FetchWorkerTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -263,6 +319,11 @@ type ClientInterface interface {
 
 	SetWorkerSleepSchedule(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkerHeartbeat request with any body
+	WorkerHeartbeatWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WorkerHeartbeat(ctx context.Context, body WorkerHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RegisterWorker request with any body
 	RegisterWorkerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -296,7 +357,7 @@ type ClientInterface interface {
 	MayWorkerRun(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// TaskOutputProduced request with any body
-	TaskOutputProducedWithBody(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	TaskOutputProducedWithBody(ctx context.Context, taskId string, params *TaskOutputProducedParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -431,6 +492,90 @@ func (c *Client) GetVariables(ctx context.Context, audience ManagerVariableAudie
 	return c.Client.Do(req)
 }
 
+func (c *Client) FetchJobTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchJobTemplatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateJobTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJobTemplateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateJobTemplate(ctx context.Context, body CreateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJobTemplateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteJobTemplate(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteJobTemplateRequest(c.Server, templateId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FetchJobTemplate(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchJobTemplateRequest(c.Server, templateId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateJobTemplateWithBody(ctx context.Context, templateId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateJobTemplateRequestWithBody(c.Server, templateId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateJobTemplate(ctx context.Context, templateId string, body UpdateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateJobTemplateRequest(c.Server, templateId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SubmitJobWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSubmitJobRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -479,6 +624,18 @@ func (c *Client) SubmitJobCheck(ctx context.Context, body SubmitJobCheckJSONRequ
 	return c.Client.Do(req)
 }
 
+func (c *Client) ImportJobArchiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportJobArchiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FetchGlobalLastRenderedInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFetchGlobalLastRenderedInfoRequest(c.Server)
 	if err != nil {
@@ -491,6 +648,18 @@ func (c *Client) FetchGlobalLastRenderedInfo(ctx context.Context, reqEditors ...
 	return c.Client.Do(req)
 }
 
+func (c *Client) FetchLastRenderedQueueInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchLastRenderedQueueInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteJobMassWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteJobMassRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -587,6 +756,18 @@ func (c *Client) FetchJob(ctx context.Context, jobId string, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
+func (c *Client) ExportJobArchive(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportJobArchiveRequest(c.Server, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) RemoveJobBlocklistWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewRemoveJobBlocklistRequestWithBody(c.Server, jobId, contentType, body)
 	if err != nil {
@@ -635,6 +816,30 @@ func (c *Client) FetchJobLastRenderedInfo(ctx context.Context, jobId string, req
 	return c.Client.Do(req)
 }
 
+func (c *Client) FetchJobLastRenderedContactSheet(ctx context.Context, jobId string, params *FetchJobLastRenderedContactSheetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchJobLastRenderedContactSheetRequest(c.Server, jobId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FetchJobLastRenderedHistory(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchJobLastRenderedHistoryRequest(c.Server, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SetJobPriorityWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSetJobPriorityRequestWithBody(c.Server, jobId, contentType, body)
 	if err != nil {
@@ -779,6 +984,66 @@ func (c *Client) ShamanFileStoreWithBody(ctx context.Context, checksum string, f
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetShamanScrubStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetShamanScrubStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartShamanScrub(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartShamanScrubRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetShamanStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetShamanStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchTaskLogsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchTaskLogsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchTaskLogs(ctx context.Context, body SearchTaskLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchTaskLogsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FetchTask(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFetchTaskRequest(c.Server, taskId)
 	if err != nil {
@@ -899,6 +1164,42 @@ func (c *Client) UpdateWorkerTag(ctx context.Context, tagId string, body UpdateW
 	return c.Client.Do(req)
 }
 
+func (c *Client) FetchWorkerTagSleepSchedule(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchWorkerTagSleepScheduleRequest(c.Server, tagId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetWorkerTagSleepScheduleWithBody(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerTagSleepScheduleRequestWithBody(c.Server, tagId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetWorkerTagSleepSchedule(ctx context.Context, tagId string, body SetWorkerTagSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerTagSleepScheduleRequest(c.Server, tagId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FetchWorkerTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFetchWorkerTagsRequest(c.Server)
 	if err != nil {
@@ -1055,6 +1356,30 @@ func (c *Client) SetWorkerSleepSchedule(ctx context.Context, workerId string, bo
 	return c.Client.Do(req)
 }
 
+func (c *Client) WorkerHeartbeatWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerHeartbeatRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkerHeartbeat(ctx context.Context, body WorkerHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerHeartbeatRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) RegisterWorkerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewRegisterWorkerRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -1199,8 +1524,8 @@ func (c *Client) MayWorkerRun(ctx context.Context, taskId string, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) TaskOutputProducedWithBody(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTaskOutputProducedRequestWithBody(c.Server, taskId, contentType, body)
+func (c *Client) TaskOutputProducedWithBody(ctx context.Context, taskId string, params *TaskOutputProducedParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTaskOutputProducedRequestWithBody(c.Server, taskId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1494,19 +1819,8 @@ func NewGetVariablesRequest(server string, audience ManagerVariableAudience, pla
 	return req, nil
 }
 
-// NewSubmitJobRequest calls the generic SubmitJob builder with application/json body
-func NewSubmitJobRequest(server string, body SubmitJobJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSubmitJobRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewSubmitJobRequestWithBody generates requests for SubmitJob with any type of body
-func NewSubmitJobRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewFetchJobTemplatesRequest generates requests for FetchJobTemplates
+func NewFetchJobTemplatesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1514,7 +1828,7 @@ func NewSubmitJobRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs")
+	operationPath := fmt.Sprintf("/api/v3/job-templates")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1524,29 +1838,27 @@ func NewSubmitJobRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewSubmitJobCheckRequest calls the generic SubmitJobCheck builder with application/json body
-func NewSubmitJobCheckRequest(server string, body SubmitJobCheckJSONRequestBody) (*http.Request, error) {
+// NewCreateJobTemplateRequest calls the generic CreateJobTemplate builder with application/json body
+func NewCreateJobTemplateRequest(server string, body CreateJobTemplateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSubmitJobCheckRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateJobTemplateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSubmitJobCheckRequestWithBody generates requests for SubmitJobCheck with any type of body
-func NewSubmitJobCheckRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateJobTemplateRequestWithBody generates requests for CreateJobTemplate with any type of body
+func NewCreateJobTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1554,7 +1866,7 @@ func NewSubmitJobCheckRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/check")
+	operationPath := fmt.Sprintf("/api/v3/job-templates")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1574,16 +1886,57 @@ func NewSubmitJobCheckRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewFetchGlobalLastRenderedInfoRequest generates requests for FetchGlobalLastRenderedInfo
-func NewFetchGlobalLastRenderedInfoRequest(server string) (*http.Request, error) {
+// NewDeleteJobTemplateRequest generates requests for DeleteJobTemplate
+func NewDeleteJobTemplateRequest(server string, templateId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "template_id", runtime.ParamLocationPath, templateId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/last-rendered")
+	operationPath := fmt.Sprintf("/api/v3/job-templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFetchJobTemplateRequest generates requests for FetchJobTemplate
+func NewFetchJobTemplateRequest(server string, templateId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "template_id", runtime.ParamLocationPath, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/job-templates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1601,27 +1954,34 @@ func NewFetchGlobalLastRenderedInfoRequest(server string) (*http.Request, error)
 	return req, nil
 }
 
-// NewDeleteJobMassRequest calls the generic DeleteJobMass builder with application/json body
-func NewDeleteJobMassRequest(server string, body DeleteJobMassJSONRequestBody) (*http.Request, error) {
+// NewUpdateJobTemplateRequest calls the generic UpdateJobTemplate builder with application/json body
+func NewUpdateJobTemplateRequest(server string, templateId string, body UpdateJobTemplateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDeleteJobMassRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateJobTemplateRequestWithBody(server, templateId, "application/json", bodyReader)
 }
 
-// NewDeleteJobMassRequestWithBody generates requests for DeleteJobMass with any type of body
-func NewDeleteJobMassRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateJobTemplateRequestWithBody generates requests for UpdateJobTemplate with any type of body
+func NewUpdateJobTemplateRequestWithBody(server string, templateId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "template_id", runtime.ParamLocationPath, templateId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/mass-delete")
+	operationPath := fmt.Sprintf("/api/v3/job-templates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1631,7 +1991,7 @@ func NewDeleteJobMassRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1641,19 +2001,19 @@ func NewDeleteJobMassRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewQueryJobsRequest calls the generic QueryJobs builder with application/json body
-func NewQueryJobsRequest(server string, body QueryJobsJSONRequestBody) (*http.Request, error) {
+// NewSubmitJobRequest calls the generic SubmitJob builder with application/json body
+func NewSubmitJobRequest(server string, body SubmitJobJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewQueryJobsRequestWithBody(server, "application/json", bodyReader)
+	return NewSubmitJobRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewQueryJobsRequestWithBody generates requests for QueryJobs with any type of body
-func NewQueryJobsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSubmitJobRequestWithBody generates requests for SubmitJob with any type of body
+func NewSubmitJobRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1661,7 +2021,7 @@ func NewQueryJobsRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/query")
+	operationPath := fmt.Sprintf("/api/v3/jobs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1681,23 +2041,27 @@ func NewQueryJobsRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-// NewGetJobTypeRequest generates requests for GetJobType
-func NewGetJobTypeRequest(server string, typeName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "typeName", runtime.ParamLocationPath, typeName)
+// NewSubmitJobCheckRequest calls the generic SubmitJobCheck builder with application/json body
+func NewSubmitJobCheckRequest(server string, body SubmitJobCheckJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitJobCheckRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitJobCheckRequestWithBody generates requests for SubmitJobCheck with any type of body
+func NewSubmitJobCheckRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/type/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/check")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1707,16 +2071,18 @@ func NewGetJobTypeRequest(server string, typeName string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetJobTypesRequest generates requests for GetJobTypes
-func NewGetJobTypesRequest(server string) (*http.Request, error) {
+// NewImportJobArchiveRequestWithBody generates requests for ImportJobArchive with any type of body
+func NewImportJobArchiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1724,7 +2090,7 @@ func NewGetJobTypesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/types")
+	operationPath := fmt.Sprintf("/api/v3/jobs/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1734,31 +2100,26 @@ func NewGetJobTypesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteJobRequest generates requests for DeleteJob
-func NewDeleteJobRequest(server string, jobId string) (*http.Request, error) {
+// NewFetchGlobalLastRenderedInfoRequest generates requests for FetchGlobalLastRenderedInfo
+func NewFetchGlobalLastRenderedInfoRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/last-rendered")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1768,7 +2129,7 @@ func NewDeleteJobRequest(server string, jobId string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1776,23 +2137,16 @@ func NewDeleteJobRequest(server string, jobId string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewFetchJobRequest generates requests for FetchJob
-func NewFetchJobRequest(server string, jobId string) (*http.Request, error) {
+// NewFetchLastRenderedQueueInfoRequest generates requests for FetchLastRenderedQueueInfo
+func NewFetchLastRenderedQueueInfoRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/last-rendered-queue")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1810,34 +2164,27 @@ func NewFetchJobRequest(server string, jobId string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewRemoveJobBlocklistRequest calls the generic RemoveJobBlocklist builder with application/json body
-func NewRemoveJobBlocklistRequest(server string, jobId string, body RemoveJobBlocklistJSONRequestBody) (*http.Request, error) {
+// NewDeleteJobMassRequest calls the generic DeleteJobMass builder with application/json body
+func NewDeleteJobMassRequest(server string, body DeleteJobMassJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRemoveJobBlocklistRequestWithBody(server, jobId, "application/json", bodyReader)
+	return NewDeleteJobMassRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewRemoveJobBlocklistRequestWithBody generates requests for RemoveJobBlocklist with any type of body
-func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteJobMassRequestWithBody generates requests for DeleteJobMass with any type of body
+func NewDeleteJobMassRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/mass-delete")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1857,23 +2204,27 @@ func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentTy
 	return req, nil
 }
 
-// NewFetchJobBlocklistRequest generates requests for FetchJobBlocklist
-func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+// NewQueryJobsRequest calls the generic QueryJobs builder with application/json body
+func NewQueryJobsRequest(server string, body QueryJobsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryJobsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQueryJobsRequestWithBody generates requests for QueryJobs with any type of body
+func NewQueryJobsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/query")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1883,21 +2234,23 @@ func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFetchJobLastRenderedInfoRequest generates requests for FetchJobLastRenderedInfo
-func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Request, error) {
+// NewGetJobTypeRequest generates requests for GetJobType
+func NewGetJobTypeRequest(server string, typeName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "typeName", runtime.ParamLocationPath, typeName)
 	if err != nil {
 		return nil, err
 	}
@@ -1907,7 +2260,7 @@ func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/last-rendered", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/type/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1925,34 +2278,16 @@ func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Requ
 	return req, nil
 }
 
-// NewSetJobPriorityRequest calls the generic SetJobPriority builder with application/json body
-func NewSetJobPriorityRequest(server string, jobId string, body SetJobPriorityJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetJobPriorityRequestWithBody(server, jobId, "application/json", bodyReader)
-}
-
-// NewSetJobPriorityRequestWithBody generates requests for SetJobPriority with any type of body
-func NewSetJobPriorityRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetJobTypesRequest generates requests for GetJobTypes
+func NewGetJobTypesRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setpriority", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/types")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1962,29 +2297,16 @@ func NewSetJobPriorityRequestWithBody(server string, jobId string, contentType s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewSetJobStatusRequest calls the generic SetJobStatus builder with application/json body
-func NewSetJobStatusRequest(server string, jobId string, body SetJobStatusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetJobStatusRequestWithBody(server, jobId, "application/json", bodyReader)
-}
-
-// NewSetJobStatusRequestWithBody generates requests for SetJobStatus with any type of body
-func NewSetJobStatusRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteJobRequest generates requests for DeleteJob
+func NewDeleteJobRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1999,7 +2321,7 @@ func NewSetJobStatusRequestWithBody(server string, jobId string, contentType str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setstatus", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2009,18 +2331,16 @@ func NewSetJobStatusRequestWithBody(server string, jobId string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewFetchJobTasksRequest generates requests for FetchJobTasks
-func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error) {
+// NewFetchJobRequest generates requests for FetchJob
+func NewFetchJobRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2035,7 +2355,7 @@ func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/tasks", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2053,8 +2373,8 @@ func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error)
 	return req, nil
 }
 
-// NewDeleteJobWhatWouldItDoRequest generates requests for DeleteJobWhatWouldItDo
-func NewDeleteJobWhatWouldItDoRequest(server string, jobId string) (*http.Request, error) {
+// NewExportJobArchiveRequest generates requests for ExportJobArchive
+func NewExportJobArchiveRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2069,7 +2389,7 @@ func NewDeleteJobWhatWouldItDoRequest(server string, jobId string) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/what-would-delete-do", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/archive", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2087,67 +2407,34 @@ func NewDeleteJobWhatWouldItDoRequest(server string, jobId string) (*http.Reques
 	return req, nil
 }
 
-// NewShamanCheckoutRequest calls the generic ShamanCheckout builder with application/json body
-func NewShamanCheckoutRequest(server string, body ShamanCheckoutJSONRequestBody) (*http.Request, error) {
+// NewRemoveJobBlocklistRequest calls the generic RemoveJobBlocklist builder with application/json body
+func NewRemoveJobBlocklistRequest(server string, jobId string, body RemoveJobBlocklistJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewShamanCheckoutRequestWithBody(server, "application/json", bodyReader)
+	return NewRemoveJobBlocklistRequestWithBody(server, jobId, "application/json", bodyReader)
 }
 
-// NewShamanCheckoutRequestWithBody generates requests for ShamanCheckout with any type of body
-func NewShamanCheckoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewRemoveJobBlocklistRequestWithBody generates requests for RemoveJobBlocklist with any type of body
+func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/create")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewShamanCheckoutRequirementsRequest calls the generic ShamanCheckoutRequirements builder with application/json body
-func NewShamanCheckoutRequirementsRequest(server string, body ShamanCheckoutRequirementsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewShamanCheckoutRequirementsRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewShamanCheckoutRequirementsRequestWithBody generates requests for ShamanCheckoutRequirements with any type of body
-func NewShamanCheckoutRequirementsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/requirements")
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2157,7 +2444,7 @@ func NewShamanCheckoutRequirementsRequestWithBody(server string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2167,20 +2454,13 @@ func NewShamanCheckoutRequirementsRequestWithBody(server string, contentType str
 	return req, nil
 }
 
-// NewShamanFileStoreCheckRequest generates requests for ShamanFileStoreCheck
-func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int) (*http.Request, error) {
+// NewFetchJobBlocklistRequest generates requests for FetchJobBlocklist
+func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2190,7 +2470,7 @@ func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2208,20 +2488,13 @@ func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int
 	return req, nil
 }
 
-// NewShamanFileStoreRequestWithBody generates requests for ShamanFileStore with any type of body
-func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewFetchJobLastRenderedInfoRequest generates requests for FetchJobLastRenderedInfo
+func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2231,7 +2504,7 @@ func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/last-rendered", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2241,45 +2514,21 @@ func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params.XShamanCanDeferUpload != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Can-Defer-Upload", runtime.ParamLocationHeader, *params.XShamanCanDeferUpload)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Shaman-Can-Defer-Upload", headerParam0)
-	}
-
-	if params.XShamanOriginalFilename != nil {
-		var headerParam1 string
-
-		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Original-Filename", runtime.ParamLocationHeader, *params.XShamanOriginalFilename)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Shaman-Original-Filename", headerParam1)
-	}
-
 	return req, nil
 }
 
-// NewFetchTaskRequest generates requests for FetchTask
-func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
+// NewFetchJobLastRenderedContactSheetRequest generates requests for FetchJobLastRenderedContactSheet
+func NewFetchJobLastRenderedContactSheetRequest(server string, jobId string, params *FetchJobLastRenderedContactSheetParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2289,7 +2538,7 @@ func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/last-rendered/contact-sheet", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2299,6 +2548,26 @@ func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Columns != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "columns", runtime.ParamLocationQuery, *params.Columns); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2307,13 +2576,13 @@ func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewFetchTaskLogInfoRequest generates requests for FetchTaskLogInfo
-func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, error) {
+// NewFetchJobLastRenderedHistoryRequest generates requests for FetchJobLastRenderedHistory
+func NewFetchJobLastRenderedHistoryRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2323,7 +2592,7 @@ func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s/log", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/last-rendered/history", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2341,13 +2610,24 @@ func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, er
 	return req, nil
 }
 
-// NewFetchTaskLogTailRequest generates requests for FetchTaskLogTail
-func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, error) {
+// NewSetJobPriorityRequest calls the generic SetJobPriority builder with application/json body
+func NewSetJobPriorityRequest(server string, jobId string, body SetJobPriorityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetJobPriorityRequestWithBody(server, jobId, "application/json", bodyReader)
+}
+
+// NewSetJobPriorityRequestWithBody generates requests for SetJobPriority with any type of body
+func NewSetJobPriorityRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2357,7 +2637,7 @@ func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s/logtail", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setpriority", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2367,32 +2647,34 @@ func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewSetTaskStatusRequest calls the generic SetTaskStatus builder with application/json body
-func NewSetTaskStatusRequest(server string, taskId string, body SetTaskStatusJSONRequestBody) (*http.Request, error) {
+// NewSetJobStatusRequest calls the generic SetJobStatus builder with application/json body
+func NewSetJobStatusRequest(server string, jobId string, body SetJobStatusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSetTaskStatusRequestWithBody(server, taskId, "application/json", bodyReader)
+	return NewSetJobStatusRequestWithBody(server, jobId, "application/json", bodyReader)
 }
 
-// NewSetTaskStatusRequestWithBody generates requests for SetTaskStatus with any type of body
-func NewSetTaskStatusRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetJobStatusRequestWithBody generates requests for SetJobStatus with any type of body
+func NewSetJobStatusRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2402,7 +2684,7 @@ func NewSetTaskStatusRequestWithBody(server string, taskId string, contentType s
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s/setstatus", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setstatus", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2422,16 +2704,23 @@ func NewSetTaskStatusRequestWithBody(server string, taskId string, contentType s
 	return req, nil
 }
 
-// NewGetVersionRequest generates requests for GetVersion
-func NewGetVersionRequest(server string) (*http.Request, error) {
+// NewFetchJobTasksRequest generates requests for FetchJobTasks
+func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/version")
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/tasks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2449,13 +2738,13 @@ func NewGetVersionRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeleteWorkerTagRequest generates requests for DeleteWorkerTag
-func NewDeleteWorkerTagRequest(server string, tagId string) (*http.Request, error) {
+// NewDeleteJobWhatWouldItDoRequest generates requests for DeleteJobWhatWouldItDo
+func NewDeleteJobWhatWouldItDoRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2465,7 +2754,7 @@ func NewDeleteWorkerTagRequest(server string, tagId string) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/what-would-delete-do", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2475,7 +2764,7 @@ func NewDeleteWorkerTagRequest(server string, tagId string) (*http.Request, erro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2483,23 +2772,27 @@ func NewDeleteWorkerTagRequest(server string, tagId string) (*http.Request, erro
 	return req, nil
 }
 
-// NewFetchWorkerTagRequest generates requests for FetchWorkerTag
-func NewFetchWorkerTagRequest(server string, tagId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
+// NewShamanCheckoutRequest calls the generic ShamanCheckout builder with application/json body
+func NewShamanCheckoutRequest(server string, body ShamanCheckoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewShamanCheckoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewShamanCheckoutRequestWithBody generates requests for ShamanCheckout with any type of body
+func NewShamanCheckoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/create")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2509,42 +2802,37 @@ func NewFetchWorkerTagRequest(server string, tagId string) (*http.Request, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUpdateWorkerTagRequest calls the generic UpdateWorkerTag builder with application/json body
-func NewUpdateWorkerTagRequest(server string, tagId string, body UpdateWorkerTagJSONRequestBody) (*http.Request, error) {
+// NewShamanCheckoutRequirementsRequest calls the generic ShamanCheckoutRequirements builder with application/json body
+func NewShamanCheckoutRequirementsRequest(server string, body ShamanCheckoutRequirementsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateWorkerTagRequestWithBody(server, tagId, "application/json", bodyReader)
+	return NewShamanCheckoutRequirementsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateWorkerTagRequestWithBody generates requests for UpdateWorkerTag with any type of body
-func NewUpdateWorkerTagRequestWithBody(server string, tagId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewShamanCheckoutRequirementsRequestWithBody generates requests for ShamanCheckoutRequirements with any type of body
+func NewShamanCheckoutRequirementsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/requirements")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2554,7 +2842,7 @@ func NewUpdateWorkerTagRequestWithBody(server string, tagId string, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2564,16 +2852,30 @@ func NewUpdateWorkerTagRequestWithBody(server string, tagId string, contentType
 	return req, nil
 }
 
-// NewFetchWorkerTagsRequest generates requests for FetchWorkerTags
-func NewFetchWorkerTagsRequest(server string) (*http.Request, error) {
+// NewShamanFileStoreCheckRequest generates requests for ShamanFileStoreCheck
+func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tags")
+	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2591,27 +2893,30 @@ func NewFetchWorkerTagsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateWorkerTagRequest calls the generic CreateWorkerTag builder with application/json body
-func NewCreateWorkerTagRequest(server string, body CreateWorkerTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateWorkerTagRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateWorkerTagRequestWithBody generates requests for CreateWorkerTag with any type of body
-func NewCreateWorkerTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewShamanFileStoreRequestWithBody generates requests for ShamanFileStore with any type of body
+func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tags")
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2628,11 +2933,44 @@ func NewCreateWorkerTagRequestWithBody(server string, contentType string, body i
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params.XShamanCanDeferUpload != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Can-Defer-Upload", runtime.ParamLocationHeader, *params.XShamanCanDeferUpload)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Shaman-Can-Defer-Upload", headerParam0)
+	}
+
+	if params.XShamanOriginalFilename != nil {
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Original-Filename", runtime.ParamLocationHeader, *params.XShamanOriginalFilename)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Shaman-Original-Filename", headerParam1)
+	}
+
+	if params.XShamanUploadOffset != nil {
+		var headerParam2 string
+
+		headerParam2, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Upload-Offset", runtime.ParamLocationHeader, *params.XShamanUploadOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Shaman-Upload-Offset", headerParam2)
+	}
+
 	return req, nil
 }
 
-// NewFetchWorkersRequest generates requests for FetchWorkers
-func NewFetchWorkersRequest(server string) (*http.Request, error) {
+// NewGetShamanScrubStatusRequest generates requests for GetShamanScrubStatus
+func NewGetShamanScrubStatusRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2640,7 +2978,7 @@ func NewFetchWorkersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers")
+	operationPath := fmt.Sprintf("/api/v3/shaman/scrub")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2658,23 +2996,16 @@ func NewFetchWorkersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeleteWorkerRequest generates requests for DeleteWorker
-func NewDeleteWorkerRequest(server string, workerId string) (*http.Request, error) {
+// NewStartShamanScrubRequest generates requests for StartShamanScrub
+func NewStartShamanScrubRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/shaman/scrub")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2684,7 +3015,7 @@ func NewDeleteWorkerRequest(server string, workerId string) (*http.Request, erro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2692,23 +3023,16 @@ func NewDeleteWorkerRequest(server string, workerId string) (*http.Request, erro
 	return req, nil
 }
 
-// NewFetchWorkerRequest generates requests for FetchWorker
-func NewFetchWorkerRequest(server string, workerId string) (*http.Request, error) {
+// NewGetShamanStatusRequest generates requests for GetShamanStatus
+func NewGetShamanStatusRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/shaman/status")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2726,34 +3050,27 @@ func NewFetchWorkerRequest(server string, workerId string) (*http.Request, error
 	return req, nil
 }
 
-// NewRequestWorkerStatusChangeRequest calls the generic RequestWorkerStatusChange builder with application/json body
-func NewRequestWorkerStatusChangeRequest(server string, workerId string, body RequestWorkerStatusChangeJSONRequestBody) (*http.Request, error) {
+// NewSearchTaskLogsRequest calls the generic SearchTaskLogs builder with application/json body
+func NewSearchTaskLogsRequest(server string, body SearchTaskLogsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRequestWorkerStatusChangeRequestWithBody(server, workerId, "application/json", bodyReader)
+	return NewSearchTaskLogsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewRequestWorkerStatusChangeRequestWithBody generates requests for RequestWorkerStatusChange with any type of body
-func NewRequestWorkerStatusChangeRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSearchTaskLogsRequestWithBody generates requests for SearchTaskLogs with any type of body
+func NewSearchTaskLogsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/setstatus", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/tasks/logs/search")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2773,24 +3090,13 @@ func NewRequestWorkerStatusChangeRequestWithBody(server string, workerId string,
 	return req, nil
 }
 
-// NewSetWorkerTagsRequest calls the generic SetWorkerTags builder with application/json body
-func NewSetWorkerTagsRequest(server string, workerId string, body SetWorkerTagsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetWorkerTagsRequestWithBody(server, workerId, "application/json", bodyReader)
-}
-
-// NewSetWorkerTagsRequestWithBody generates requests for SetWorkerTags with any type of body
-func NewSetWorkerTagsRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewFetchTaskRequest generates requests for FetchTask
+func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
 	if err != nil {
 		return nil, err
 	}
@@ -2800,7 +3106,7 @@ func NewSetWorkerTagsRequestWithBody(server string, workerId string, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/settags", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2810,23 +3116,21 @@ func NewSetWorkerTagsRequestWithBody(server string, workerId string, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewFetchWorkerSleepScheduleRequest generates requests for FetchWorkerSleepSchedule
-func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.Request, error) {
+// NewFetchTaskLogInfoRequest generates requests for FetchTaskLogInfo
+func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
 	if err != nil {
 		return nil, err
 	}
@@ -2836,7 +3140,7 @@ func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s/log", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2854,24 +3158,13 @@ func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.R
 	return req, nil
 }
 
-// NewSetWorkerSleepScheduleRequest calls the generic SetWorkerSleepSchedule builder with application/json body
-func NewSetWorkerSleepScheduleRequest(server string, workerId string, body SetWorkerSleepScheduleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetWorkerSleepScheduleRequestWithBody(server, workerId, "application/json", bodyReader)
-}
-
-// NewSetWorkerSleepScheduleRequestWithBody generates requests for SetWorkerSleepSchedule with any type of body
-func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewFetchTaskLogTailRequest generates requests for FetchTaskLogTail
+func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
 	if err != nil {
 		return nil, err
 	}
@@ -2881,7 +3174,7 @@ func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s/logtail", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2891,37 +3184,42 @@ func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewRegisterWorkerRequest calls the generic RegisterWorker builder with application/json body
-func NewRegisterWorkerRequest(server string, body RegisterWorkerJSONRequestBody) (*http.Request, error) {
+// NewSetTaskStatusRequest calls the generic SetTaskStatus builder with application/json body
+func NewSetTaskStatusRequest(server string, taskId string, body SetTaskStatusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRegisterWorkerRequestWithBody(server, "application/json", bodyReader)
+	return NewSetTaskStatusRequestWithBody(server, taskId, "application/json", bodyReader)
 }
 
-// NewRegisterWorkerRequestWithBody generates requests for RegisterWorker with any type of body
-func NewRegisterWorkerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetTaskStatusRequestWithBody generates requests for SetTaskStatus with any type of body
+func NewSetTaskStatusRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/register-worker")
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s/setstatus", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2941,8 +3239,8 @@ func NewRegisterWorkerRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewSignOffRequest generates requests for SignOff
-func NewSignOffRequest(server string) (*http.Request, error) {
+// NewGetVersionRequest generates requests for GetVersion
+func NewGetVersionRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2950,7 +3248,7 @@ func NewSignOffRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/sign-off")
+	operationPath := fmt.Sprintf("/api/v3/version")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2960,7 +3258,7 @@ func NewSignOffRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2968,27 +3266,23 @@ func NewSignOffRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSignOnRequest calls the generic SignOn builder with application/json body
-func NewSignOnRequest(server string, body SignOnJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteWorkerTagRequest generates requests for DeleteWorkerTag
+func NewDeleteWorkerTagRequest(server string, tagId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSignOnRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewSignOnRequestWithBody generates requests for SignOn with any type of body
-func NewSignOnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/sign-on")
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2998,26 +3292,31 @@ func NewSignOnRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWorkerStateRequest generates requests for WorkerState
-func NewWorkerStateRequest(server string) (*http.Request, error) {
+// NewFetchWorkerTagRequest generates requests for FetchWorkerTag
+func NewFetchWorkerTagRequest(server string, tagId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/state")
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3035,27 +3334,34 @@ func NewWorkerStateRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewWorkerStateChangedRequest calls the generic WorkerStateChanged builder with application/json body
-func NewWorkerStateChangedRequest(server string, body WorkerStateChangedJSONRequestBody) (*http.Request, error) {
+// NewUpdateWorkerTagRequest calls the generic UpdateWorkerTag builder with application/json body
+func NewUpdateWorkerTagRequest(server string, tagId string, body UpdateWorkerTagJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkerStateChangedRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateWorkerTagRequestWithBody(server, tagId, "application/json", bodyReader)
 }
 
-// NewWorkerStateChangedRequestWithBody generates requests for WorkerStateChanged with any type of body
-func NewWorkerStateChangedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateWorkerTagRequestWithBody generates requests for UpdateWorkerTag with any type of body
+func NewUpdateWorkerTagRequestWithBody(server string, tagId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/state-changed")
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3065,7 +3371,7 @@ func NewWorkerStateChangedRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3075,16 +3381,23 @@ func NewWorkerStateChangedRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewScheduleTaskRequest generates requests for ScheduleTask
-func NewScheduleTaskRequest(server string) (*http.Request, error) {
+// NewFetchWorkerTagSleepScheduleRequest generates requests for FetchWorkerTagSleepSchedule
+func NewFetchWorkerTagSleepScheduleRequest(server string, tagId string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task")
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s/sleep-schedule", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3094,7 +3407,7 @@ func NewScheduleTaskRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3102,24 +3415,24 @@ func NewScheduleTaskRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTaskUpdateRequest calls the generic TaskUpdate builder with application/json body
-func NewTaskUpdateRequest(server string, taskId string, body TaskUpdateJSONRequestBody) (*http.Request, error) {
+// NewSetWorkerTagSleepScheduleRequest calls the generic SetWorkerTagSleepSchedule builder with application/json body
+func NewSetWorkerTagSleepScheduleRequest(server string, tagId string, body SetWorkerTagSleepScheduleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTaskUpdateRequestWithBody(server, taskId, "application/json", bodyReader)
+	return NewSetWorkerTagSleepScheduleRequestWithBody(server, tagId, "application/json", bodyReader)
 }
 
-// NewTaskUpdateRequestWithBody generates requests for TaskUpdate with any type of body
-func NewTaskUpdateRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetWorkerTagSleepScheduleRequestWithBody generates requests for SetWorkerTagSleepSchedule with any type of body
+func NewSetWorkerTagSleepScheduleRequestWithBody(server string, tagId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -3129,7 +3442,7 @@ func NewTaskUpdateRequestWithBody(server string, taskId string, contentType stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s/sleep-schedule", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3149,23 +3462,16 @@ func NewTaskUpdateRequestWithBody(server string, taskId string, contentType stri
 	return req, nil
 }
 
-// NewMayWorkerRunRequest generates requests for MayWorkerRun
-func NewMayWorkerRunRequest(server string, taskId string) (*http.Request, error) {
+// NewFetchWorkerTagsRequest generates requests for FetchWorkerTags
+func NewFetchWorkerTagsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/may-i-run", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tags")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3183,23 +3489,27 @@ func NewMayWorkerRunRequest(server string, taskId string) (*http.Request, error)
 	return req, nil
 }
 
-// NewTaskOutputProducedRequestWithBody generates requests for TaskOutputProduced with any type of body
-func NewTaskOutputProducedRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+// NewCreateWorkerTagRequest calls the generic CreateWorkerTag builder with application/json body
+func NewCreateWorkerTagRequest(server string, body CreateWorkerTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWorkerTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWorkerTagRequestWithBody generates requests for CreateWorkerTag with any type of body
+func NewCreateWorkerTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/output-produced", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tags")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3219,264 +3529,1665 @@ func NewTaskOutputProducedRequestWithBody(server string, taskId string, contentT
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewFetchWorkersRequest generates requests for FetchWorkers
+func NewFetchWorkersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetConfiguration request
-	GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error)
+	return req, nil
+}
 
-	// FindBlenderExePath request
-	FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error)
+// NewDeleteWorkerRequest generates requests for DeleteWorker
+func NewDeleteWorkerRequest(server string, workerId string) (*http.Request, error) {
+	var err error
 
-	// CheckBlenderExePath request with any body
-	CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+	var pathParam0 string
 
-	CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
 
-	// CheckSharedStoragePath request with any body
-	CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetConfigurationFile request
-	GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SaveSetupAssistantConfig request with any body
-	SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+	return req, nil
+}
 
-	// GetSharedStorage request
-	GetSharedStorageWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetSharedStorageResponse, error)
+// NewFetchWorkerRequest generates requests for FetchWorker
+func NewFetchWorkerRequest(server string, workerId string) (*http.Request, error) {
+	var err error
 
-	// GetVariables request
-	GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error)
+	var pathParam0 string
 
-	// SubmitJob request with any body
-	SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
 
-	SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// SubmitJobCheck request with any body
-	SubmitJobCheckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	SubmitJobCheckWithResponse(ctx context.Context, body SubmitJobCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchGlobalLastRenderedInfo request
-	FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteJobMass request with any body
-	DeleteJobMassWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error)
+	return req, nil
+}
 
-	DeleteJobMassWithResponse(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error)
+// NewRequestWorkerStatusChangeRequest calls the generic RequestWorkerStatusChange builder with application/json body
+func NewRequestWorkerStatusChangeRequest(server string, workerId string, body RequestWorkerStatusChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestWorkerStatusChangeRequestWithBody(server, workerId, "application/json", bodyReader)
+}
 
-	// QueryJobs request with any body
-	QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+// NewRequestWorkerStatusChangeRequestWithBody generates requests for RequestWorkerStatusChange with any type of body
+func NewRequestWorkerStatusChangeRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+	var pathParam0 string
 
-	// GetJobType request
-	GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetJobTypes request
-	GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteJob request
-	DeleteJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/setstatus", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// FetchJob request
-	FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// RemoveJobBlocklist request with any body
-	RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// FetchJobBlocklist request
-	FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error)
+	return req, nil
+}
 
-	// FetchJobLastRenderedInfo request
-	FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error)
+// NewSetWorkerTagsRequest calls the generic SetWorkerTags builder with application/json body
+func NewSetWorkerTagsRequest(server string, workerId string, body SetWorkerTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetWorkerTagsRequestWithBody(server, workerId, "application/json", bodyReader)
+}
 
-	// SetJobPriority request with any body
-	SetJobPriorityWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error)
+// NewSetWorkerTagsRequestWithBody generates requests for SetWorkerTags with any type of body
+func NewSetWorkerTagsRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	SetJobPriorityWithResponse(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error)
+	var pathParam0 string
 
-	// SetJobStatus request with any body
-	SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
 
-	SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchJobTasks request
-	FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/settags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteJobWhatWouldItDo request
-	DeleteJobWhatWouldItDoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobWhatWouldItDoResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ShamanCheckout request with any body
-	ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ShamanCheckoutRequirements request with any body
-	ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error)
+	return req, nil
+}
+
+// NewFetchWorkerSleepScheduleRequest generates requests for FetchWorkerSleepSchedule
+func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetWorkerSleepScheduleRequest calls the generic SetWorkerSleepSchedule builder with application/json body
+func NewSetWorkerSleepScheduleRequest(server string, workerId string, body SetWorkerSleepScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetWorkerSleepScheduleRequestWithBody(server, workerId, "application/json", bodyReader)
+}
+
+// NewSetWorkerSleepScheduleRequestWithBody generates requests for SetWorkerSleepSchedule with any type of body
+func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkerHeartbeatRequest calls the generic WorkerHeartbeat builder with application/json body
+func NewWorkerHeartbeatRequest(server string, body WorkerHeartbeatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkerHeartbeatRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewWorkerHeartbeatRequestWithBody generates requests for WorkerHeartbeat with any type of body
+func NewWorkerHeartbeatRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/heartbeat")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterWorkerRequest calls the generic RegisterWorker builder with application/json body
+func NewRegisterWorkerRequest(server string, body RegisterWorkerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterWorkerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterWorkerRequestWithBody generates requests for RegisterWorker with any type of body
+func NewRegisterWorkerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/register-worker")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSignOffRequest generates requests for SignOff
+func NewSignOffRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/sign-off")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignOnRequest calls the generic SignOn builder with application/json body
+func NewSignOnRequest(server string, body SignOnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSignOnRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSignOnRequestWithBody generates requests for SignOn with any type of body
+func NewSignOnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/sign-on")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkerStateRequest generates requests for WorkerState
+func NewWorkerStateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/state")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerStateChangedRequest calls the generic WorkerStateChanged builder with application/json body
+func NewWorkerStateChangedRequest(server string, body WorkerStateChangedJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkerStateChangedRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewWorkerStateChangedRequestWithBody generates requests for WorkerStateChanged with any type of body
+func NewWorkerStateChangedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/state-changed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewScheduleTaskRequest generates requests for ScheduleTask
+func NewScheduleTaskRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTaskUpdateRequest calls the generic TaskUpdate builder with application/json body
+func NewTaskUpdateRequest(server string, taskId string, body TaskUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTaskUpdateRequestWithBody(server, taskId, "application/json", bodyReader)
+}
+
+// NewTaskUpdateRequestWithBody generates requests for TaskUpdate with any type of body
+func NewTaskUpdateRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMayWorkerRunRequest generates requests for MayWorkerRun
+func NewMayWorkerRunRequest(server string, taskId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/may-i-run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTaskOutputProducedRequestWithBody generates requests for TaskOutputProduced with any type of body
+func NewTaskOutputProducedRequestWithBody(server string, taskId string, params *TaskOutputProducedParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/output-produced", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.XFlamencoOutputFilename != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Flamenco-Output-Filename", runtime.ParamLocationHeader, *params.XFlamencoOutputFilename)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Flamenco-Output-Filename", headerParam0)
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetConfiguration request
+	GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error)
+
+	// FindBlenderExePath request
+	FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error)
+
+	// CheckBlenderExePath request with any body
+	CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+
+	CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+
+	// CheckSharedStoragePath request with any body
+	CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+
+	CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+
+	// GetConfigurationFile request
+	GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error)
+
+	// SaveSetupAssistantConfig request with any body
+	SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+
+	SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+
+	// GetSharedStorage request
+	GetSharedStorageWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetSharedStorageResponse, error)
+
+	// GetVariables request
+	GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error)
+
+	// FetchJobTemplates request
+	FetchJobTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchJobTemplatesResponse, error)
+
+	// CreateJobTemplate request with any body
+	CreateJobTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJobTemplateResponse, error)
+
+	CreateJobTemplateWithResponse(ctx context.Context, body CreateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJobTemplateResponse, error)
+
+	// DeleteJobTemplate request
+	DeleteJobTemplateWithResponse(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*DeleteJobTemplateResponse, error)
+
+	// FetchJobTemplate request
+	FetchJobTemplateWithResponse(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*FetchJobTemplateResponse, error)
+
+	// UpdateJobTemplate request with any body
+	UpdateJobTemplateWithBodyWithResponse(ctx context.Context, templateId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateJobTemplateResponse, error)
+
+	UpdateJobTemplateWithResponse(ctx context.Context, templateId string, body UpdateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateJobTemplateResponse, error)
+
+	// SubmitJob request with any body
+	SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+
+	SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+
+	// SubmitJobCheck request with any body
+	SubmitJobCheckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error)
+
+	SubmitJobCheckWithResponse(ctx context.Context, body SubmitJobCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error)
+
+	// ImportJobArchive request with any body
+	ImportJobArchiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportJobArchiveResponse, error)
+
+	// FetchGlobalLastRenderedInfo request
+	FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error)
+
+	// FetchLastRenderedQueueInfo request
+	FetchLastRenderedQueueInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchLastRenderedQueueInfoResponse, error)
+
+	// DeleteJobMass request with any body
+	DeleteJobMassWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error)
+
+	DeleteJobMassWithResponse(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error)
+
+	// QueryJobs request with any body
+	QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+
+	QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+
+	// GetJobType request
+	GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error)
+
+	// GetJobTypes request
+	GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error)
+
+	// DeleteJob request
+	DeleteJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobResponse, error)
+
+	// FetchJob request
+	FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error)
+
+	// ExportJobArchive request
+	ExportJobArchiveWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*ExportJobArchiveResponse, error)
+
+	// RemoveJobBlocklist request with any body
+	RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+
+	RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+
+	// FetchJobBlocklist request
+	FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error)
+
+	// FetchJobLastRenderedInfo request
+	FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error)
+
+	// FetchJobLastRenderedContactSheet request
+	FetchJobLastRenderedContactSheetWithResponse(ctx context.Context, jobId string, params *FetchJobLastRenderedContactSheetParams, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedContactSheetResponse, error)
+
+	// FetchJobLastRenderedHistory request
+	FetchJobLastRenderedHistoryWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedHistoryResponse, error)
+
+	// SetJobPriority request with any body
+	SetJobPriorityWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error)
+
+	SetJobPriorityWithResponse(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error)
+
+	// SetJobStatus request with any body
+	SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+
+	SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+
+	// FetchJobTasks request
+	FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error)
+
+	// DeleteJobWhatWouldItDo request
+	DeleteJobWhatWouldItDoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobWhatWouldItDoResponse, error)
+
+	// ShamanCheckout request with any body
+	ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+
+	ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+
+	// ShamanCheckoutRequirements request with any body
+	ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error)
 
 	ShamanCheckoutRequirementsWithResponse(ctx context.Context, body ShamanCheckoutRequirementsJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error)
 
-	// ShamanFileStoreCheck request
-	ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error)
+	// ShamanFileStoreCheck request
+	ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error)
+
+	// ShamanFileStore request with any body
+	ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error)
+
+	// GetShamanScrubStatus request
+	GetShamanScrubStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetShamanScrubStatusResponse, error)
+
+	// StartShamanScrub request
+	StartShamanScrubWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StartShamanScrubResponse, error)
+
+	// GetShamanStatus request
+	GetShamanStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetShamanStatusResponse, error)
+
+	// SearchTaskLogs request with any body
+	SearchTaskLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchTaskLogsResponse, error)
+
+	SearchTaskLogsWithResponse(ctx context.Context, body SearchTaskLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchTaskLogsResponse, error)
+
+	// FetchTask request
+	FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error)
+
+	// FetchTaskLogInfo request
+	FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error)
+
+	// FetchTaskLogTail request
+	FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error)
+
+	// SetTaskStatus request with any body
+	SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+
+	SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+
+	// GetVersion request
+	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+
+	// DeleteWorkerTag request
+	DeleteWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteWorkerTagResponse, error)
+
+	// FetchWorkerTag request
+	FetchWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagResponse, error)
+
+	// UpdateWorkerTag request with any body
+	UpdateWorkerTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error)
+
+	UpdateWorkerTagWithResponse(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error)
+
+	// FetchWorkerTagSleepSchedule request
+	FetchWorkerTagSleepScheduleWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagSleepScheduleResponse, error)
+
+	// SetWorkerTagSleepSchedule request with any body
+	SetWorkerTagSleepScheduleWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagSleepScheduleResponse, error)
+
+	SetWorkerTagSleepScheduleWithResponse(ctx context.Context, tagId string, body SetWorkerTagSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagSleepScheduleResponse, error)
+
+	// FetchWorkerTags request
+	FetchWorkerTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkerTagsResponse, error)
+
+	// CreateWorkerTag request with any body
+	CreateWorkerTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error)
+
+	CreateWorkerTagWithResponse(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error)
+
+	// FetchWorkers request
+	FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error)
+
+	// DeleteWorker request
+	DeleteWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*DeleteWorkerResponse, error)
+
+	// FetchWorker request
+	FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error)
+
+	// RequestWorkerStatusChange request with any body
+	RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+
+	RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+
+	// SetWorkerTags request with any body
+	SetWorkerTagsWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error)
+
+	SetWorkerTagsWithResponse(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error)
+
+	// FetchWorkerSleepSchedule request
+	FetchWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerSleepScheduleResponse, error)
+
+	// SetWorkerSleepSchedule request with any body
+	SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+
+	SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+
+	// WorkerHeartbeat request with any body
+	WorkerHeartbeatWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerHeartbeatResponse, error)
+
+	WorkerHeartbeatWithResponse(ctx context.Context, body WorkerHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerHeartbeatResponse, error)
+
+	// RegisterWorker request with any body
+	RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+
+	RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+
+	// SignOff request
+	SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error)
+
+	// SignOn request with any body
+	SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+
+	SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+
+	// WorkerState request
+	WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error)
+
+	// WorkerStateChanged request with any body
+	WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+
+	WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+
+	// ScheduleTask request
+	ScheduleTaskWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error)
+
+	// TaskUpdate request with any body
+	TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+
+	TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+
+	// MayWorkerRun request
+	MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error)
+
+	// TaskOutputProduced request with any body
+	TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, params *TaskOutputProducedParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error)
+}
+
+type GetConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ManagerConfiguration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindBlenderExePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BlenderPathFindResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FindBlenderExePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindBlenderExePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckBlenderExePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BlenderPathCheckResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckBlenderExePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckBlenderExePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckSharedStoragePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PathCheckResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckSharedStoragePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckSharedStoragePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigurationFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AdditionalProperties map[string]interface{} `json:"-"`
+	}
+	YAML200 *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigurationFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigurationFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SaveSetupAssistantConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveSetupAssistantConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveSetupAssistantConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSharedStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedStorageLocation
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSharedStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSharedStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVariablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ManagerVariables
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVariablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVariablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobTemplateList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateJobTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobTemplate
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateJobTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateJobTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteJobTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteJobTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJobTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobTemplate
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateJobTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobTemplate
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateJobTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateJobTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON412      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitJobCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON412      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitJobCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitJobCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// ShamanFileStore request with any body
-	ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error)
+type ImportJobArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON409      *Error
+	JSONDefault  *Error
+}
 
-	// FetchTask request
-	FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error)
+// Status returns HTTPResponse.Status
+func (r ImportJobArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// FetchTaskLogInfo request
-	FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportJobArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// FetchTaskLogTail request
-	FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error)
+type FetchGlobalLastRenderedInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobLastRenderedImageInfo
+}
 
-	// SetTaskStatus request with any body
-	SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+// Status returns HTTPResponse.Status
+func (r FetchGlobalLastRenderedInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchGlobalLastRenderedInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetVersion request
-	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+type FetchLastRenderedQueueInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LastRenderedQueueInfo
+}
 
-	// DeleteWorkerTag request
-	DeleteWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteWorkerTagResponse, error)
+// Status returns HTTPResponse.Status
+func (r FetchLastRenderedQueueInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// FetchWorkerTag request
-	FetchWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchLastRenderedQueueInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdateWorkerTag request with any body
-	UpdateWorkerTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error)
+type DeleteJobMassResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON416      *Error
+	JSONDefault  *Error
+}
 
-	UpdateWorkerTagWithResponse(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error)
+// Status returns HTTPResponse.Status
+func (r DeleteJobMassResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// FetchWorkerTags request
-	FetchWorkerTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkerTagsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJobMassResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CreateWorkerTag request with any body
-	CreateWorkerTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error)
+type QueryJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobsQueryResult
+	JSONDefault  *Error
+}
 
-	CreateWorkerTagWithResponse(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error)
+// Status returns HTTPResponse.Status
+func (r QueryJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// FetchWorkers request
-	FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeleteWorker request
-	DeleteWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*DeleteWorkerResponse, error)
+type GetJobTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AvailableJobType
+}
 
-	// FetchWorker request
-	FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetJobTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// RequestWorkerStatusChange request with any body
-	RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+type GetJobTypesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AvailableJobTypes
+}
 
-	// SetWorkerTags request with any body
-	SetWorkerTagsWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetJobTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	SetWorkerTagsWithResponse(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportJobArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportJobArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportJobArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveJobBlocklistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveJobBlocklistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// FetchWorkerSleepSchedule request
-	FetchWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerSleepScheduleResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveJobBlocklistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// SetWorkerSleepSchedule request with any body
-	SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+type FetchJobBlocklistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobBlocklist
+	JSONDefault  *Error
+}
 
-	SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+// Status returns HTTPResponse.Status
+func (r FetchJobBlocklistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// RegisterWorker request with any body
-	RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobBlocklistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+type FetchJobLastRenderedInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobLastRenderedImageInfo
+}
 
-	// SignOff request
-	SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error)
+// Status returns HTTPResponse.Status
+func (r FetchJobLastRenderedInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// SignOn request with any body
-	SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobLastRenderedInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+type FetchJobLastRenderedContactSheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
 
-	// WorkerState request
-	WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error)
+// Status returns HTTPResponse.Status
+func (r FetchJobLastRenderedContactSheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// WorkerStateChanged request with any body
-	WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobLastRenderedContactSheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+type FetchJobLastRenderedHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobLastRenderedHistory
+	JSONDefault  *Error
+}
 
-	// ScheduleTask request
-	ScheduleTaskWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error)
+// Status returns HTTPResponse.Status
+func (r FetchJobLastRenderedHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// TaskUpdate request with any body
-	TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobLastRenderedHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+type SetJobPriorityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
 
-	// MayWorkerRun request
-	MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error)
+// Status returns HTTPResponse.Status
+func (r SetJobPriorityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// TaskOutputProduced request with any body
-	TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetJobPriorityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetConfigurationResponse struct {
+type SetJobStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ManagerConfiguration
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetConfigurationResponse) Status() string {
+func (r SetJobStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3484,22 +5195,22 @@ func (r GetConfigurationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetConfigurationResponse) StatusCode() int {
+func (r SetJobStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindBlenderExePathResponse struct {
+type FetchJobTasksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BlenderPathFindResult
+	JSON200      *JobTasksSummary
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FindBlenderExePathResponse) Status() string {
+func (r FetchJobTasksResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3507,22 +5218,21 @@ func (r FindBlenderExePathResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindBlenderExePathResponse) StatusCode() int {
+func (r FetchJobTasksResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CheckBlenderExePathResponse struct {
+type DeleteJobWhatWouldItDoResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BlenderPathCheckResult
-	JSONDefault  *Error
+	JSON200      *JobDeletionInfo
 }
 
 // Status returns HTTPResponse.Status
-func (r CheckBlenderExePathResponse) Status() string {
+func (r DeleteJobWhatWouldItDoResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3530,22 +5240,25 @@ func (r CheckBlenderExePathResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CheckBlenderExePathResponse) StatusCode() int {
+func (r DeleteJobWhatWouldItDoResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CheckSharedStoragePathResponse struct {
+type ShamanCheckoutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PathCheckResult
+	JSON200      *ShamanCheckoutResult
+	JSON409      *Error
+	JSON424      *Error
+	JSON425      *Error
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CheckSharedStoragePathResponse) Status() string {
+func (r ShamanCheckoutResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3553,24 +5266,22 @@ func (r CheckSharedStoragePathResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CheckSharedStoragePathResponse) StatusCode() int {
+func (r ShamanCheckoutResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetConfigurationFileResponse struct {
+type ShamanCheckoutRequirementsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		AdditionalProperties map[string]interface{} `json:"-"`
-	}
-	YAML200 *string
+	JSON200      *ShamanRequirementsResponse
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetConfigurationFileResponse) Status() string {
+func (r ShamanCheckoutRequirementsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3578,21 +5289,22 @@ func (r GetConfigurationFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetConfigurationFileResponse) StatusCode() int {
+func (r ShamanCheckoutRequirementsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SaveSetupAssistantConfigResponse struct {
+type ShamanFileStoreCheckResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ShamanSingleFileStatus
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r SaveSetupAssistantConfigResponse) Status() string {
+func (r ShamanFileStoreCheckResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3600,21 +5312,24 @@ func (r SaveSetupAssistantConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SaveSetupAssistantConfigResponse) StatusCode() int {
+func (r ShamanFileStoreCheckResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSharedStorageResponse struct {
+type ShamanFileStoreResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SharedStorageLocation
+	JSON409      *Error
+	JSON416      *Error
+	JSON507      *Error
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSharedStorageResponse) Status() string {
+func (r ShamanFileStoreResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3622,21 +5337,22 @@ func (r GetSharedStorageResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSharedStorageResponse) StatusCode() int {
+func (r ShamanFileStoreResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetVariablesResponse struct {
+type GetShamanScrubStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ManagerVariables
+	JSON200      *ShamanScrubStatus
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetVariablesResponse) Status() string {
+func (r GetShamanScrubStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3644,23 +5360,22 @@ func (r GetVariablesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetVariablesResponse) StatusCode() int {
+func (r GetShamanScrubStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SubmitJobResponse struct {
+type StartShamanScrubResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Job
-	JSON412      *Error
+	JSON409      *Error
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r SubmitJobResponse) Status() string {
+func (r StartShamanScrubResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3668,22 +5383,22 @@ func (r SubmitJobResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SubmitJobResponse) StatusCode() int {
+func (r StartShamanScrubResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SubmitJobCheckResponse struct {
+type GetShamanStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON412      *Error
+	JSON200      *ShamanStatus
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r SubmitJobCheckResponse) Status() string {
+func (r GetShamanStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3691,21 +5406,21 @@ func (r SubmitJobCheckResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SubmitJobCheckResponse) StatusCode() int {
+func (r GetShamanStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchGlobalLastRenderedInfoResponse struct {
+type SearchTaskLogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobLastRenderedImageInfo
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchGlobalLastRenderedInfoResponse) Status() string {
+func (r SearchTaskLogsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3713,22 +5428,22 @@ func (r FetchGlobalLastRenderedInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchGlobalLastRenderedInfoResponse) StatusCode() int {
+func (r SearchTaskLogsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteJobMassResponse struct {
+type FetchTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON416      *Error
+	JSON200      *Task
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteJobMassResponse) Status() string {
+func (r FetchTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3736,22 +5451,22 @@ func (r DeleteJobMassResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteJobMassResponse) StatusCode() int {
+func (r FetchTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type QueryJobsResponse struct {
+type FetchTaskLogInfoResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobsQueryResult
+	JSON200      *TaskLogInfo
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r QueryJobsResponse) Status() string {
+func (r FetchTaskLogInfoResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3759,21 +5474,21 @@ func (r QueryJobsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r QueryJobsResponse) StatusCode() int {
+func (r FetchTaskLogInfoResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetJobTypeResponse struct {
+type FetchTaskLogTailResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AvailableJobType
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetJobTypeResponse) Status() string {
+func (r FetchTaskLogTailResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3781,21 +5496,21 @@ func (r GetJobTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetJobTypeResponse) StatusCode() int {
+func (r FetchTaskLogTailResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetJobTypesResponse struct {
+type SetTaskStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AvailableJobTypes
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetJobTypesResponse) Status() string {
+func (r SetTaskStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3803,21 +5518,21 @@ func (r GetJobTypesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetJobTypesResponse) StatusCode() int {
+func (r SetTaskStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteJobResponse struct {
+type GetVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSONDefault  *Error
+	JSON200      *FlamencoVersion
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteJobResponse) Status() string {
+func (r GetVersionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3825,21 +5540,21 @@ func (r DeleteJobResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteJobResponse) StatusCode() int {
+func (r GetVersionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchJobResponse struct {
+type DeleteWorkerTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Job
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchJobResponse) Status() string {
+func (r DeleteWorkerTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3847,21 +5562,21 @@ func (r FetchJobResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobResponse) StatusCode() int {
+func (r DeleteWorkerTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RemoveJobBlocklistResponse struct {
+type FetchWorkerTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSONDefault  *Error
+	JSON200      *WorkerTag
 }
 
 // Status returns HTTPResponse.Status
-func (r RemoveJobBlocklistResponse) Status() string {
+func (r FetchWorkerTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3869,22 +5584,21 @@ func (r RemoveJobBlocklistResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RemoveJobBlocklistResponse) StatusCode() int {
+func (r FetchWorkerTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchJobBlocklistResponse struct {
+type UpdateWorkerTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobBlocklist
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchJobBlocklistResponse) Status() string {
+func (r UpdateWorkerTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3892,21 +5606,22 @@ func (r FetchJobBlocklistResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobBlocklistResponse) StatusCode() int {
+func (r UpdateWorkerTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchJobLastRenderedInfoResponse struct {
+type FetchWorkerTagSleepScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobLastRenderedImageInfo
+	JSON200      *WorkerSleepSchedule
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchJobLastRenderedInfoResponse) Status() string {
+func (r FetchWorkerTagSleepScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3914,21 +5629,21 @@ func (r FetchJobLastRenderedInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobLastRenderedInfoResponse) StatusCode() int {
+func (r FetchWorkerTagSleepScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SetJobPriorityResponse struct {
+type SetWorkerTagSleepScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r SetJobPriorityResponse) Status() string {
+func (r SetWorkerTagSleepScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3936,21 +5651,21 @@ func (r SetJobPriorityResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SetJobPriorityResponse) StatusCode() int {
+func (r SetWorkerTagSleepScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SetJobStatusResponse struct {
+type FetchWorkerTagsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSONDefault  *Error
+	JSON200      *WorkerTagList
 }
 
 // Status returns HTTPResponse.Status
-func (r SetJobStatusResponse) Status() string {
+func (r FetchWorkerTagsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3958,22 +5673,22 @@ func (r SetJobStatusResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SetJobStatusResponse) StatusCode() int {
+func (r FetchWorkerTagsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchJobTasksResponse struct {
+type CreateWorkerTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobTasksSummary
+	JSON200      *WorkerTag
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchJobTasksResponse) Status() string {
+func (r CreateWorkerTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3981,21 +5696,21 @@ func (r FetchJobTasksResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobTasksResponse) StatusCode() int {
+func (r CreateWorkerTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteJobWhatWouldItDoResponse struct {
+type FetchWorkersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobDeletionInfo
+	JSON200      *WorkerList
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteJobWhatWouldItDoResponse) Status() string {
+func (r FetchWorkersResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4003,24 +5718,21 @@ func (r DeleteJobWhatWouldItDoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteJobWhatWouldItDoResponse) StatusCode() int {
+func (r FetchWorkersResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ShamanCheckoutResponse struct {
+type DeleteWorkerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ShamanCheckoutResult
-	JSON409      *Error
-	JSON424      *Error
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r ShamanCheckoutResponse) Status() string {
+func (r DeleteWorkerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4028,22 +5740,21 @@ func (r ShamanCheckoutResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ShamanCheckoutResponse) StatusCode() int {
+func (r DeleteWorkerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ShamanCheckoutRequirementsResponse struct {
+type FetchWorkerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ShamanRequirementsResponse
-	JSONDefault  *Error
+	JSON200      *Worker
 }
 
 // Status returns HTTPResponse.Status
-func (r ShamanCheckoutRequirementsResponse) Status() string {
+func (r FetchWorkerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4051,22 +5762,21 @@ func (r ShamanCheckoutRequirementsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ShamanCheckoutRequirementsResponse) StatusCode() int {
+func (r FetchWorkerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ShamanFileStoreCheckResponse struct {
+type RequestWorkerStatusChangeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ShamanSingleFileStatus
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r ShamanFileStoreCheckResponse) Status() string {
+func (r RequestWorkerStatusChangeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4074,21 +5784,21 @@ func (r ShamanFileStoreCheckResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ShamanFileStoreCheckResponse) StatusCode() int {
+func (r RequestWorkerStatusChangeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ShamanFileStoreResponse struct {
+type SetWorkerTagsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r ShamanFileStoreResponse) Status() string {
+func (r SetWorkerTagsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4096,22 +5806,22 @@ func (r ShamanFileStoreResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ShamanFileStoreResponse) StatusCode() int {
+func (r SetWorkerTagsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchTaskResponse struct {
+type FetchWorkerSleepScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Task
+	JSON200      *WorkerSleepSchedule
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchTaskResponse) Status() string {
+func (r FetchWorkerSleepScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4119,22 +5829,21 @@ func (r FetchTaskResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchTaskResponse) StatusCode() int {
+func (r FetchWorkerSleepScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchTaskLogInfoResponse struct {
+type SetWorkerSleepScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TaskLogInfo
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchTaskLogInfoResponse) Status() string {
+func (r SetWorkerSleepScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4142,21 +5851,21 @@ func (r FetchTaskLogInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchTaskLogInfoResponse) StatusCode() int {
+func (r SetWorkerSleepScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchTaskLogTailResponse struct {
+type WorkerHeartbeatResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchTaskLogTailResponse) Status() string {
+func (r WorkerHeartbeatResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4164,21 +5873,22 @@ func (r FetchTaskLogTailResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchTaskLogTailResponse) StatusCode() int {
+func (r WorkerHeartbeatResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SetTaskStatusResponse struct {
+type RegisterWorkerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *RegisteredWorker
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r SetTaskStatusResponse) Status() string {
+func (r RegisterWorkerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4186,21 +5896,21 @@ func (r SetTaskStatusResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SetTaskStatusResponse) StatusCode() int {
+func (r RegisterWorkerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetVersionResponse struct {
+type SignOffResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FlamencoVersion
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetVersionResponse) Status() string {
+func (r SignOffResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4208,21 +5918,22 @@ func (r GetVersionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetVersionResponse) StatusCode() int {
+func (r SignOffResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteWorkerTagResponse struct {
+type SignOnResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkerStateChange
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteWorkerTagResponse) Status() string {
+func (r SignOnResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4230,21 +5941,22 @@ func (r DeleteWorkerTagResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWorkerTagResponse) StatusCode() int {
+func (r SignOnResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchWorkerTagResponse struct {
+type WorkerStateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkerTag
+	JSON200      *WorkerStateChange
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchWorkerTagResponse) Status() string {
+func (r WorkerStateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4252,21 +5964,21 @@ func (r FetchWorkerTagResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkerTagResponse) StatusCode() int {
+func (r WorkerStateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateWorkerTagResponse struct {
+type WorkerStateChangedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateWorkerTagResponse) Status() string {
+func (r WorkerStateChangedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4274,21 +5986,23 @@ func (r UpdateWorkerTagResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateWorkerTagResponse) StatusCode() int {
+func (r WorkerStateChangedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchWorkerTagsResponse struct {
+type ScheduleTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkerTagList
+	JSON200      *AssignedTask
+	JSON403      *SecurityError
+	JSON423      *WorkerStateChange
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchWorkerTagsResponse) Status() string {
+func (r ScheduleTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4296,22 +6010,21 @@ func (r FetchWorkerTagsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkerTagsResponse) StatusCode() int {
+func (r ScheduleTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateWorkerTagResponse struct {
+type TaskUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkerTag
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateWorkerTagResponse) Status() string {
+func (r TaskUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4319,21 +6032,22 @@ func (r CreateWorkerTagResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateWorkerTagResponse) StatusCode() int {
+func (r TaskUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchWorkersResponse struct {
+type MayWorkerRunResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkerList
+	JSON200      *MayKeepRunning
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchWorkersResponse) Status() string {
+func (r MayWorkerRunResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4341,21 +6055,25 @@ func (r FetchWorkersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkersResponse) StatusCode() int {
+func (r MayWorkerRunResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteWorkerResponse struct {
+type TaskOutputProducedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON411      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON429      *Error
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteWorkerResponse) Status() string {
+func (r TaskOutputProducedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4363,1036 +6081,1214 @@ func (r DeleteWorkerResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWorkerResponse) StatusCode() int {
+func (r TaskOutputProducedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchWorkerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
+// GetConfigurationWithResponse request returning *GetConfigurationResponse
+func (c *ClientWithResponses) GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error) {
+	rsp, err := c.GetConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigurationResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchWorkerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindBlenderExePathWithResponse request returning *FindBlenderExePathResponse
+func (c *ClientWithResponses) FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error) {
+	rsp, err := c.FindBlenderExePath(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindBlenderExePathResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CheckBlenderExePathWithBodyWithResponse request with arbitrary body returning *CheckBlenderExePathResponse
+func (c *ClientWithResponses) CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
+	rsp, err := c.CheckBlenderExePathWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCheckBlenderExePathResponse(rsp)
 }
 
-type RequestWorkerStatusChangeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+func (c *ClientWithResponses) CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
+	rsp, err := c.CheckBlenderExePath(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckBlenderExePathResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RequestWorkerStatusChangeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CheckSharedStoragePathWithBodyWithResponse request with arbitrary body returning *CheckSharedStoragePathResponse
+func (c *ClientWithResponses) CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
+	rsp, err := c.CheckSharedStoragePathWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCheckSharedStoragePathResponse(rsp)
+}
+
+func (c *ClientWithResponses) CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
+	rsp, err := c.CheckSharedStoragePath(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckSharedStoragePathResponse(rsp)
+}
+
+// GetConfigurationFileWithResponse request returning *GetConfigurationFileResponse
+func (c *ClientWithResponses) GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error) {
+	rsp, err := c.GetConfigurationFile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigurationFileResponse(rsp)
+}
+
+// SaveSetupAssistantConfigWithBodyWithResponse request with arbitrary body returning *SaveSetupAssistantConfigResponse
+func (c *ClientWithResponses) SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
+	rsp, err := c.SaveSetupAssistantConfigWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveSetupAssistantConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
+	rsp, err := c.SaveSetupAssistantConfig(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveSetupAssistantConfigResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RequestWorkerStatusChangeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetSharedStorageWithResponse request returning *GetSharedStorageResponse
+func (c *ClientWithResponses) GetSharedStorageWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetSharedStorageResponse, error) {
+	rsp, err := c.GetSharedStorage(ctx, audience, platform, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetSharedStorageResponse(rsp)
 }
 
-type SetWorkerTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// GetVariablesWithResponse request returning *GetVariablesResponse
+func (c *ClientWithResponses) GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error) {
+	rsp, err := c.GetVariables(ctx, audience, platform, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVariablesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SetWorkerTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchJobTemplatesWithResponse request returning *FetchJobTemplatesResponse
+func (c *ClientWithResponses) FetchJobTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchJobTemplatesResponse, error) {
+	rsp, err := c.FetchJobTemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchJobTemplatesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetWorkerTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateJobTemplateWithBodyWithResponse request with arbitrary body returning *CreateJobTemplateResponse
+func (c *ClientWithResponses) CreateJobTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJobTemplateResponse, error) {
+	rsp, err := c.CreateJobTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateJobTemplateResponse(rsp)
 }
 
-type FetchWorkerSleepScheduleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerSleepSchedule
-	JSONDefault  *Error
+func (c *ClientWithResponses) CreateJobTemplateWithResponse(ctx context.Context, body CreateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJobTemplateResponse, error) {
+	rsp, err := c.CreateJobTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateJobTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchWorkerSleepScheduleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteJobTemplateWithResponse request returning *DeleteJobTemplateResponse
+func (c *ClientWithResponses) DeleteJobTemplateWithResponse(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*DeleteJobTemplateResponse, error) {
+	rsp, err := c.DeleteJobTemplate(ctx, templateId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteJobTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkerSleepScheduleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchJobTemplateWithResponse request returning *FetchJobTemplateResponse
+func (c *ClientWithResponses) FetchJobTemplateWithResponse(ctx context.Context, templateId string, reqEditors ...RequestEditorFn) (*FetchJobTemplateResponse, error) {
+	rsp, err := c.FetchJobTemplate(ctx, templateId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchJobTemplateResponse(rsp)
 }
 
-type SetWorkerSleepScheduleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// UpdateJobTemplateWithBodyWithResponse request with arbitrary body returning *UpdateJobTemplateResponse
+func (c *ClientWithResponses) UpdateJobTemplateWithBodyWithResponse(ctx context.Context, templateId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateJobTemplateResponse, error) {
+	rsp, err := c.UpdateJobTemplateWithBody(ctx, templateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateJobTemplateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SetWorkerSleepScheduleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateJobTemplateWithResponse(ctx context.Context, templateId string, body UpdateJobTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateJobTemplateResponse, error) {
+	rsp, err := c.UpdateJobTemplate(ctx, templateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateJobTemplateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetWorkerSleepScheduleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SubmitJobWithBodyWithResponse request with arbitrary body returning *SubmitJobResponse
+func (c *ClientWithResponses) SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
+	rsp, err := c.SubmitJobWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSubmitJobResponse(rsp)
 }
 
-type RegisterWorkerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RegisteredWorker
-	JSONDefault  *Error
+func (c *ClientWithResponses) SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
+	rsp, err := c.SubmitJob(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitJobResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RegisterWorkerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SubmitJobCheckWithBodyWithResponse request with arbitrary body returning *SubmitJobCheckResponse
+func (c *ClientWithResponses) SubmitJobCheckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error) {
+	rsp, err := c.SubmitJobCheckWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSubmitJobCheckResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterWorkerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) SubmitJobCheckWithResponse(ctx context.Context, body SubmitJobCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error) {
+	rsp, err := c.SubmitJobCheck(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSubmitJobCheckResponse(rsp)
 }
 
-type SignOffResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// ImportJobArchiveWithBodyWithResponse request with arbitrary body returning *ImportJobArchiveResponse
+func (c *ClientWithResponses) ImportJobArchiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportJobArchiveResponse, error) {
+	rsp, err := c.ImportJobArchiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportJobArchiveResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SignOffResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchGlobalLastRenderedInfoWithResponse request returning *FetchGlobalLastRenderedInfoResponse
+func (c *ClientWithResponses) FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error) {
+	rsp, err := c.FetchGlobalLastRenderedInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchGlobalLastRenderedInfoResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SignOffResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchLastRenderedQueueInfoWithResponse request returning *FetchLastRenderedQueueInfoResponse
+func (c *ClientWithResponses) FetchLastRenderedQueueInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchLastRenderedQueueInfoResponse, error) {
+	rsp, err := c.FetchLastRenderedQueueInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchLastRenderedQueueInfoResponse(rsp)
 }
 
-type SignOnResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerStateChange
-	JSONDefault  *Error
+// DeleteJobMassWithBodyWithResponse request with arbitrary body returning *DeleteJobMassResponse
+func (c *ClientWithResponses) DeleteJobMassWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error) {
+	rsp, err := c.DeleteJobMassWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteJobMassResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SignOnResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) DeleteJobMassWithResponse(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error) {
+	rsp, err := c.DeleteJobMass(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteJobMassResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SignOnResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// QueryJobsWithBodyWithResponse request with arbitrary body returning *QueryJobsResponse
+func (c *ClientWithResponses) QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
+	rsp, err := c.QueryJobsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseQueryJobsResponse(rsp)
 }
 
-type WorkerStateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerStateChange
-	JSONDefault  *Error
+func (c *ClientWithResponses) QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
+	rsp, err := c.QueryJobs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryJobsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerStateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetJobTypeWithResponse request returning *GetJobTypeResponse
+func (c *ClientWithResponses) GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error) {
+	rsp, err := c.GetJobType(ctx, typeName, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetJobTypeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerStateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetJobTypesWithResponse request returning *GetJobTypesResponse
+func (c *ClientWithResponses) GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error) {
+	rsp, err := c.GetJobTypes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetJobTypesResponse(rsp)
 }
 
-type WorkerStateChangedResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// DeleteJobWithResponse request returning *DeleteJobResponse
+func (c *ClientWithResponses) DeleteJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobResponse, error) {
+	rsp, err := c.DeleteJob(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteJobResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerStateChangedResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchJobWithResponse request returning *FetchJobResponse
+func (c *ClientWithResponses) FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error) {
+	rsp, err := c.FetchJob(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchJobResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerStateChangedResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ExportJobArchiveWithResponse request returning *ExportJobArchiveResponse
+func (c *ClientWithResponses) ExportJobArchiveWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*ExportJobArchiveResponse, error) {
+	rsp, err := c.ExportJobArchive(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseExportJobArchiveResponse(rsp)
 }
 
-type ScheduleTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AssignedTask
-	JSON403      *SecurityError
-	JSON423      *WorkerStateChange
+// RemoveJobBlocklistWithBodyWithResponse request with arbitrary body returning *RemoveJobBlocklistResponse
+func (c *ClientWithResponses) RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
+	rsp, err := c.RemoveJobBlocklistWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveJobBlocklistResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ScheduleTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
+	rsp, err := c.RemoveJobBlocklist(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseRemoveJobBlocklistResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ScheduleTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchJobBlocklistWithResponse request returning *FetchJobBlocklistResponse
+func (c *ClientWithResponses) FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error) {
+	rsp, err := c.FetchJobBlocklist(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchJobBlocklistResponse(rsp)
 }
 
-type TaskUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// FetchJobLastRenderedInfoWithResponse request returning *FetchJobLastRenderedInfoResponse
+func (c *ClientWithResponses) FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error) {
+	rsp, err := c.FetchJobLastRenderedInfo(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFetchJobLastRenderedInfoResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TaskUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchJobLastRenderedContactSheetWithResponse request returning *FetchJobLastRenderedContactSheetResponse
+func (c *ClientWithResponses) FetchJobLastRenderedContactSheetWithResponse(ctx context.Context, jobId string, params *FetchJobLastRenderedContactSheetParams, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedContactSheetResponse, error) {
+	rsp, err := c.FetchJobLastRenderedContactSheet(ctx, jobId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchJobLastRenderedContactSheetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TaskUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchJobLastRenderedHistoryWithResponse request returning *FetchJobLastRenderedHistoryResponse
+func (c *ClientWithResponses) FetchJobLastRenderedHistoryWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedHistoryResponse, error) {
+	rsp, err := c.FetchJobLastRenderedHistory(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchJobLastRenderedHistoryResponse(rsp)
 }
 
-type MayWorkerRunResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MayKeepRunning
-	JSONDefault  *Error
+// SetJobPriorityWithBodyWithResponse request with arbitrary body returning *SetJobPriorityResponse
+func (c *ClientWithResponses) SetJobPriorityWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error) {
+	rsp, err := c.SetJobPriorityWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetJobPriorityResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r MayWorkerRunResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) SetJobPriorityWithResponse(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error) {
+	rsp, err := c.SetJobPriority(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSetJobPriorityResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MayWorkerRunResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SetJobStatusWithBodyWithResponse request with arbitrary body returning *SetJobStatusResponse
+func (c *ClientWithResponses) SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
+	rsp, err := c.SetJobStatusWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSetJobStatusResponse(rsp)
 }
 
-type TaskOutputProducedResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON411      *Error
-	JSON413      *Error
-	JSON415      *Error
-	JSON429      *Error
-	JSONDefault  *Error
+func (c *ClientWithResponses) SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
+	rsp, err := c.SetJobStatus(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetJobStatusResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TaskOutputProducedResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchJobTasksWithResponse request returning *FetchJobTasksResponse
+func (c *ClientWithResponses) FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error) {
+	rsp, err := c.FetchJobTasks(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchJobTasksResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TaskOutputProducedResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteJobWhatWouldItDoWithResponse request returning *DeleteJobWhatWouldItDoResponse
+func (c *ClientWithResponses) DeleteJobWhatWouldItDoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobWhatWouldItDoResponse, error) {
+	rsp, err := c.DeleteJobWhatWouldItDo(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteJobWhatWouldItDoResponse(rsp)
 }
 
-// GetConfigurationWithResponse request returning *GetConfigurationResponse
-func (c *ClientWithResponses) GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error) {
-	rsp, err := c.GetConfiguration(ctx, reqEditors...)
+// ShamanCheckoutWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutResponse
+func (c *ClientWithResponses) ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
+	rsp, err := c.ShamanCheckoutWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetConfigurationResponse(rsp)
+	return ParseShamanCheckoutResponse(rsp)
 }
 
-// FindBlenderExePathWithResponse request returning *FindBlenderExePathResponse
-func (c *ClientWithResponses) FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error) {
-	rsp, err := c.FindBlenderExePath(ctx, reqEditors...)
+func (c *ClientWithResponses) ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
+	rsp, err := c.ShamanCheckout(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindBlenderExePathResponse(rsp)
+	return ParseShamanCheckoutResponse(rsp)
 }
 
-// CheckBlenderExePathWithBodyWithResponse request with arbitrary body returning *CheckBlenderExePathResponse
-func (c *ClientWithResponses) CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
-	rsp, err := c.CheckBlenderExePathWithBody(ctx, contentType, body, reqEditors...)
+// ShamanCheckoutRequirementsWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutRequirementsResponse
+func (c *ClientWithResponses) ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
+	rsp, err := c.ShamanCheckoutRequirementsWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCheckBlenderExePathResponse(rsp)
+	return ParseShamanCheckoutRequirementsResponse(rsp)
 }
 
-func (c *ClientWithResponses) CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
-	rsp, err := c.CheckBlenderExePath(ctx, body, reqEditors...)
+func (c *ClientWithResponses) ShamanCheckoutRequirementsWithResponse(ctx context.Context, body ShamanCheckoutRequirementsJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
+	rsp, err := c.ShamanCheckoutRequirements(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCheckBlenderExePathResponse(rsp)
+	return ParseShamanCheckoutRequirementsResponse(rsp)
 }
 
-// CheckSharedStoragePathWithBodyWithResponse request with arbitrary body returning *CheckSharedStoragePathResponse
-func (c *ClientWithResponses) CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
-	rsp, err := c.CheckSharedStoragePathWithBody(ctx, contentType, body, reqEditors...)
+// ShamanFileStoreCheckWithResponse request returning *ShamanFileStoreCheckResponse
+func (c *ClientWithResponses) ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error) {
+	rsp, err := c.ShamanFileStoreCheck(ctx, checksum, filesize, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCheckSharedStoragePathResponse(rsp)
+	return ParseShamanFileStoreCheckResponse(rsp)
 }
 
-func (c *ClientWithResponses) CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
-	rsp, err := c.CheckSharedStoragePath(ctx, body, reqEditors...)
+// ShamanFileStoreWithBodyWithResponse request with arbitrary body returning *ShamanFileStoreResponse
+func (c *ClientWithResponses) ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error) {
+	rsp, err := c.ShamanFileStoreWithBody(ctx, checksum, filesize, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCheckSharedStoragePathResponse(rsp)
+	return ParseShamanFileStoreResponse(rsp)
 }
 
-// GetConfigurationFileWithResponse request returning *GetConfigurationFileResponse
-func (c *ClientWithResponses) GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error) {
-	rsp, err := c.GetConfigurationFile(ctx, reqEditors...)
+// GetShamanScrubStatusWithResponse request returning *GetShamanScrubStatusResponse
+func (c *ClientWithResponses) GetShamanScrubStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetShamanScrubStatusResponse, error) {
+	rsp, err := c.GetShamanScrubStatus(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetConfigurationFileResponse(rsp)
+	return ParseGetShamanScrubStatusResponse(rsp)
 }
 
-// SaveSetupAssistantConfigWithBodyWithResponse request with arbitrary body returning *SaveSetupAssistantConfigResponse
-func (c *ClientWithResponses) SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
-	rsp, err := c.SaveSetupAssistantConfigWithBody(ctx, contentType, body, reqEditors...)
+// StartShamanScrubWithResponse request returning *StartShamanScrubResponse
+func (c *ClientWithResponses) StartShamanScrubWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StartShamanScrubResponse, error) {
+	rsp, err := c.StartShamanScrub(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSaveSetupAssistantConfigResponse(rsp)
+	return ParseStartShamanScrubResponse(rsp)
 }
 
-func (c *ClientWithResponses) SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
-	rsp, err := c.SaveSetupAssistantConfig(ctx, body, reqEditors...)
+// GetShamanStatusWithResponse request returning *GetShamanStatusResponse
+func (c *ClientWithResponses) GetShamanStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetShamanStatusResponse, error) {
+	rsp, err := c.GetShamanStatus(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSaveSetupAssistantConfigResponse(rsp)
+	return ParseGetShamanStatusResponse(rsp)
 }
 
-// GetSharedStorageWithResponse request returning *GetSharedStorageResponse
-func (c *ClientWithResponses) GetSharedStorageWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetSharedStorageResponse, error) {
-	rsp, err := c.GetSharedStorage(ctx, audience, platform, reqEditors...)
+// SearchTaskLogsWithBodyWithResponse request with arbitrary body returning *SearchTaskLogsResponse
+func (c *ClientWithResponses) SearchTaskLogsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchTaskLogsResponse, error) {
+	rsp, err := c.SearchTaskLogsWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSharedStorageResponse(rsp)
+	return ParseSearchTaskLogsResponse(rsp)
 }
 
-// GetVariablesWithResponse request returning *GetVariablesResponse
-func (c *ClientWithResponses) GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error) {
-	rsp, err := c.GetVariables(ctx, audience, platform, reqEditors...)
+func (c *ClientWithResponses) SearchTaskLogsWithResponse(ctx context.Context, body SearchTaskLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchTaskLogsResponse, error) {
+	rsp, err := c.SearchTaskLogs(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVariablesResponse(rsp)
+	return ParseSearchTaskLogsResponse(rsp)
 }
 
-// SubmitJobWithBodyWithResponse request with arbitrary body returning *SubmitJobResponse
-func (c *ClientWithResponses) SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
-	rsp, err := c.SubmitJobWithBody(ctx, contentType, body, reqEditors...)
+// FetchTaskWithResponse request returning *FetchTaskResponse
+func (c *ClientWithResponses) FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error) {
+	rsp, err := c.FetchTask(ctx, taskId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubmitJobResponse(rsp)
+	return ParseFetchTaskResponse(rsp)
 }
 
-func (c *ClientWithResponses) SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
-	rsp, err := c.SubmitJob(ctx, body, reqEditors...)
+// FetchTaskLogInfoWithResponse request returning *FetchTaskLogInfoResponse
+func (c *ClientWithResponses) FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error) {
+	rsp, err := c.FetchTaskLogInfo(ctx, taskId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubmitJobResponse(rsp)
+	return ParseFetchTaskLogInfoResponse(rsp)
 }
 
-// SubmitJobCheckWithBodyWithResponse request with arbitrary body returning *SubmitJobCheckResponse
-func (c *ClientWithResponses) SubmitJobCheckWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error) {
-	rsp, err := c.SubmitJobCheckWithBody(ctx, contentType, body, reqEditors...)
+// FetchTaskLogTailWithResponse request returning *FetchTaskLogTailResponse
+func (c *ClientWithResponses) FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error) {
+	rsp, err := c.FetchTaskLogTail(ctx, taskId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubmitJobCheckResponse(rsp)
+	return ParseFetchTaskLogTailResponse(rsp)
 }
 
-func (c *ClientWithResponses) SubmitJobCheckWithResponse(ctx context.Context, body SubmitJobCheckJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobCheckResponse, error) {
-	rsp, err := c.SubmitJobCheck(ctx, body, reqEditors...)
+// SetTaskStatusWithBodyWithResponse request with arbitrary body returning *SetTaskStatusResponse
+func (c *ClientWithResponses) SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
+	rsp, err := c.SetTaskStatusWithBody(ctx, taskId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubmitJobCheckResponse(rsp)
+	return ParseSetTaskStatusResponse(rsp)
 }
 
-// FetchGlobalLastRenderedInfoWithResponse request returning *FetchGlobalLastRenderedInfoResponse
-func (c *ClientWithResponses) FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error) {
-	rsp, err := c.FetchGlobalLastRenderedInfo(ctx, reqEditors...)
+func (c *ClientWithResponses) SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
+	rsp, err := c.SetTaskStatus(ctx, taskId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchGlobalLastRenderedInfoResponse(rsp)
+	return ParseSetTaskStatusResponse(rsp)
 }
 
-// DeleteJobMassWithBodyWithResponse request with arbitrary body returning *DeleteJobMassResponse
-func (c *ClientWithResponses) DeleteJobMassWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error) {
-	rsp, err := c.DeleteJobMassWithBody(ctx, contentType, body, reqEditors...)
+// GetVersionWithResponse request returning *GetVersionResponse
+func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
+	rsp, err := c.GetVersion(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteJobMassResponse(rsp)
+	return ParseGetVersionResponse(rsp)
 }
 
-func (c *ClientWithResponses) DeleteJobMassWithResponse(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error) {
-	rsp, err := c.DeleteJobMass(ctx, body, reqEditors...)
+// DeleteWorkerTagWithResponse request returning *DeleteWorkerTagResponse
+func (c *ClientWithResponses) DeleteWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteWorkerTagResponse, error) {
+	rsp, err := c.DeleteWorkerTag(ctx, tagId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteJobMassResponse(rsp)
+	return ParseDeleteWorkerTagResponse(rsp)
 }
 
-// QueryJobsWithBodyWithResponse request with arbitrary body returning *QueryJobsResponse
-func (c *ClientWithResponses) QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
-	rsp, err := c.QueryJobsWithBody(ctx, contentType, body, reqEditors...)
+// FetchWorkerTagWithResponse request returning *FetchWorkerTagResponse
+func (c *ClientWithResponses) FetchWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagResponse, error) {
+	rsp, err := c.FetchWorkerTag(ctx, tagId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryJobsResponse(rsp)
+	return ParseFetchWorkerTagResponse(rsp)
 }
 
-func (c *ClientWithResponses) QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
-	rsp, err := c.QueryJobs(ctx, body, reqEditors...)
+// UpdateWorkerTagWithBodyWithResponse request with arbitrary body returning *UpdateWorkerTagResponse
+func (c *ClientWithResponses) UpdateWorkerTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error) {
+	rsp, err := c.UpdateWorkerTagWithBody(ctx, tagId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseQueryJobsResponse(rsp)
+	return ParseUpdateWorkerTagResponse(rsp)
 }
 
-// GetJobTypeWithResponse request returning *GetJobTypeResponse
-func (c *ClientWithResponses) GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error) {
-	rsp, err := c.GetJobType(ctx, typeName, reqEditors...)
+func (c *ClientWithResponses) UpdateWorkerTagWithResponse(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error) {
+	rsp, err := c.UpdateWorkerTag(ctx, tagId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJobTypeResponse(rsp)
+	return ParseUpdateWorkerTagResponse(rsp)
 }
 
-// GetJobTypesWithResponse request returning *GetJobTypesResponse
-func (c *ClientWithResponses) GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error) {
-	rsp, err := c.GetJobTypes(ctx, reqEditors...)
+// FetchWorkerTagSleepScheduleWithResponse request returning *FetchWorkerTagSleepScheduleResponse
+func (c *ClientWithResponses) FetchWorkerTagSleepScheduleWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagSleepScheduleResponse, error) {
+	rsp, err := c.FetchWorkerTagSleepSchedule(ctx, tagId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJobTypesResponse(rsp)
+	return ParseFetchWorkerTagSleepScheduleResponse(rsp)
 }
 
-// DeleteJobWithResponse request returning *DeleteJobResponse
-func (c *ClientWithResponses) DeleteJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobResponse, error) {
-	rsp, err := c.DeleteJob(ctx, jobId, reqEditors...)
+// SetWorkerTagSleepScheduleWithBodyWithResponse request with arbitrary body returning *SetWorkerTagSleepScheduleResponse
+func (c *ClientWithResponses) SetWorkerTagSleepScheduleWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagSleepScheduleResponse, error) {
+	rsp, err := c.SetWorkerTagSleepScheduleWithBody(ctx, tagId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteJobResponse(rsp)
+	return ParseSetWorkerTagSleepScheduleResponse(rsp)
 }
 
-// FetchJobWithResponse request returning *FetchJobResponse
-func (c *ClientWithResponses) FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error) {
-	rsp, err := c.FetchJob(ctx, jobId, reqEditors...)
+func (c *ClientWithResponses) SetWorkerTagSleepScheduleWithResponse(ctx context.Context, tagId string, body SetWorkerTagSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagSleepScheduleResponse, error) {
+	rsp, err := c.SetWorkerTagSleepSchedule(ctx, tagId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchJobResponse(rsp)
+	return ParseSetWorkerTagSleepScheduleResponse(rsp)
 }
 
-// RemoveJobBlocklistWithBodyWithResponse request with arbitrary body returning *RemoveJobBlocklistResponse
-func (c *ClientWithResponses) RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
-	rsp, err := c.RemoveJobBlocklistWithBody(ctx, jobId, contentType, body, reqEditors...)
+// FetchWorkerTagsWithResponse request returning *FetchWorkerTagsResponse
+func (c *ClientWithResponses) FetchWorkerTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkerTagsResponse, error) {
+	rsp, err := c.FetchWorkerTags(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRemoveJobBlocklistResponse(rsp)
+	return ParseFetchWorkerTagsResponse(rsp)
 }
 
-func (c *ClientWithResponses) RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
-	rsp, err := c.RemoveJobBlocklist(ctx, jobId, body, reqEditors...)
+// CreateWorkerTagWithBodyWithResponse request with arbitrary body returning *CreateWorkerTagResponse
+func (c *ClientWithResponses) CreateWorkerTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error) {
+	rsp, err := c.CreateWorkerTagWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRemoveJobBlocklistResponse(rsp)
+	return ParseCreateWorkerTagResponse(rsp)
 }
 
-// FetchJobBlocklistWithResponse request returning *FetchJobBlocklistResponse
-func (c *ClientWithResponses) FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error) {
-	rsp, err := c.FetchJobBlocklist(ctx, jobId, reqEditors...)
+func (c *ClientWithResponses) CreateWorkerTagWithResponse(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error) {
+	rsp, err := c.CreateWorkerTag(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchJobBlocklistResponse(rsp)
+	return ParseCreateWorkerTagResponse(rsp)
 }
 
-// FetchJobLastRenderedInfoWithResponse request returning *FetchJobLastRenderedInfoResponse
-func (c *ClientWithResponses) FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error) {
-	rsp, err := c.FetchJobLastRenderedInfo(ctx, jobId, reqEditors...)
+// FetchWorkersWithResponse request returning *FetchWorkersResponse
+func (c *ClientWithResponses) FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error) {
+	rsp, err := c.FetchWorkers(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchJobLastRenderedInfoResponse(rsp)
+	return ParseFetchWorkersResponse(rsp)
 }
 
-// SetJobPriorityWithBodyWithResponse request with arbitrary body returning *SetJobPriorityResponse
-func (c *ClientWithResponses) SetJobPriorityWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error) {
-	rsp, err := c.SetJobPriorityWithBody(ctx, jobId, contentType, body, reqEditors...)
+// DeleteWorkerWithResponse request returning *DeleteWorkerResponse
+func (c *ClientWithResponses) DeleteWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*DeleteWorkerResponse, error) {
+	rsp, err := c.DeleteWorker(ctx, workerId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetJobPriorityResponse(rsp)
+	return ParseDeleteWorkerResponse(rsp)
 }
 
-func (c *ClientWithResponses) SetJobPriorityWithResponse(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error) {
-	rsp, err := c.SetJobPriority(ctx, jobId, body, reqEditors...)
+// FetchWorkerWithResponse request returning *FetchWorkerResponse
+func (c *ClientWithResponses) FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error) {
+	rsp, err := c.FetchWorker(ctx, workerId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetJobPriorityResponse(rsp)
+	return ParseFetchWorkerResponse(rsp)
 }
 
-// SetJobStatusWithBodyWithResponse request with arbitrary body returning *SetJobStatusResponse
-func (c *ClientWithResponses) SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
-	rsp, err := c.SetJobStatusWithBody(ctx, jobId, contentType, body, reqEditors...)
+// RequestWorkerStatusChangeWithBodyWithResponse request with arbitrary body returning *RequestWorkerStatusChangeResponse
+func (c *ClientWithResponses) RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
+	rsp, err := c.RequestWorkerStatusChangeWithBody(ctx, workerId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetJobStatusResponse(rsp)
+	return ParseRequestWorkerStatusChangeResponse(rsp)
 }
 
-func (c *ClientWithResponses) SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
-	rsp, err := c.SetJobStatus(ctx, jobId, body, reqEditors...)
+func (c *ClientWithResponses) RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
+	rsp, err := c.RequestWorkerStatusChange(ctx, workerId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetJobStatusResponse(rsp)
+	return ParseRequestWorkerStatusChangeResponse(rsp)
 }
 
-// FetchJobTasksWithResponse request returning *FetchJobTasksResponse
-func (c *ClientWithResponses) FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error) {
-	rsp, err := c.FetchJobTasks(ctx, jobId, reqEditors...)
+// SetWorkerTagsWithBodyWithResponse request with arbitrary body returning *SetWorkerTagsResponse
+func (c *ClientWithResponses) SetWorkerTagsWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error) {
+	rsp, err := c.SetWorkerTagsWithBody(ctx, workerId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchJobTasksResponse(rsp)
+	return ParseSetWorkerTagsResponse(rsp)
 }
 
-// DeleteJobWhatWouldItDoWithResponse request returning *DeleteJobWhatWouldItDoResponse
-func (c *ClientWithResponses) DeleteJobWhatWouldItDoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobWhatWouldItDoResponse, error) {
-	rsp, err := c.DeleteJobWhatWouldItDo(ctx, jobId, reqEditors...)
+func (c *ClientWithResponses) SetWorkerTagsWithResponse(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error) {
+	rsp, err := c.SetWorkerTags(ctx, workerId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteJobWhatWouldItDoResponse(rsp)
+	return ParseSetWorkerTagsResponse(rsp)
 }
 
-// ShamanCheckoutWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutResponse
-func (c *ClientWithResponses) ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
-	rsp, err := c.ShamanCheckoutWithBody(ctx, contentType, body, reqEditors...)
+// FetchWorkerSleepScheduleWithResponse request returning *FetchWorkerSleepScheduleResponse
+func (c *ClientWithResponses) FetchWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerSleepScheduleResponse, error) {
+	rsp, err := c.FetchWorkerSleepSchedule(ctx, workerId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanCheckoutResponse(rsp)
+	return ParseFetchWorkerSleepScheduleResponse(rsp)
 }
 
-func (c *ClientWithResponses) ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
-	rsp, err := c.ShamanCheckout(ctx, body, reqEditors...)
+// SetWorkerSleepScheduleWithBodyWithResponse request with arbitrary body returning *SetWorkerSleepScheduleResponse
+func (c *ClientWithResponses) SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
+	rsp, err := c.SetWorkerSleepScheduleWithBody(ctx, workerId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanCheckoutResponse(rsp)
+	return ParseSetWorkerSleepScheduleResponse(rsp)
 }
 
-// ShamanCheckoutRequirementsWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutRequirementsResponse
-func (c *ClientWithResponses) ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
-	rsp, err := c.ShamanCheckoutRequirementsWithBody(ctx, contentType, body, reqEditors...)
+func (c *ClientWithResponses) SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
+	rsp, err := c.SetWorkerSleepSchedule(ctx, workerId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanCheckoutRequirementsResponse(rsp)
+	return ParseSetWorkerSleepScheduleResponse(rsp)
 }
 
-func (c *ClientWithResponses) ShamanCheckoutRequirementsWithResponse(ctx context.Context, body ShamanCheckoutRequirementsJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
-	rsp, err := c.ShamanCheckoutRequirements(ctx, body, reqEditors...)
+// WorkerHeartbeatWithBodyWithResponse request with arbitrary body returning *WorkerHeartbeatResponse
+func (c *ClientWithResponses) WorkerHeartbeatWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerHeartbeatResponse, error) {
+	rsp, err := c.WorkerHeartbeatWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanCheckoutRequirementsResponse(rsp)
+	return ParseWorkerHeartbeatResponse(rsp)
 }
 
-// ShamanFileStoreCheckWithResponse request returning *ShamanFileStoreCheckResponse
-func (c *ClientWithResponses) ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error) {
-	rsp, err := c.ShamanFileStoreCheck(ctx, checksum, filesize, reqEditors...)
+func (c *ClientWithResponses) WorkerHeartbeatWithResponse(ctx context.Context, body WorkerHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerHeartbeatResponse, error) {
+	rsp, err := c.WorkerHeartbeat(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanFileStoreCheckResponse(rsp)
+	return ParseWorkerHeartbeatResponse(rsp)
 }
 
-// ShamanFileStoreWithBodyWithResponse request with arbitrary body returning *ShamanFileStoreResponse
-func (c *ClientWithResponses) ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error) {
-	rsp, err := c.ShamanFileStoreWithBody(ctx, checksum, filesize, params, contentType, body, reqEditors...)
+// RegisterWorkerWithBodyWithResponse request with arbitrary body returning *RegisterWorkerResponse
+func (c *ClientWithResponses) RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
+	rsp, err := c.RegisterWorkerWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanFileStoreResponse(rsp)
+	return ParseRegisterWorkerResponse(rsp)
 }
 
-// FetchTaskWithResponse request returning *FetchTaskResponse
-func (c *ClientWithResponses) FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error) {
-	rsp, err := c.FetchTask(ctx, taskId, reqEditors...)
+func (c *ClientWithResponses) RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
+	rsp, err := c.RegisterWorker(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchTaskResponse(rsp)
+	return ParseRegisterWorkerResponse(rsp)
 }
 
-// FetchTaskLogInfoWithResponse request returning *FetchTaskLogInfoResponse
-func (c *ClientWithResponses) FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error) {
-	rsp, err := c.FetchTaskLogInfo(ctx, taskId, reqEditors...)
+// SignOffWithResponse request returning *SignOffResponse
+func (c *ClientWithResponses) SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error) {
+	rsp, err := c.SignOff(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchTaskLogInfoResponse(rsp)
+	return ParseSignOffResponse(rsp)
 }
 
-// FetchTaskLogTailWithResponse request returning *FetchTaskLogTailResponse
-func (c *ClientWithResponses) FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error) {
-	rsp, err := c.FetchTaskLogTail(ctx, taskId, reqEditors...)
+// SignOnWithBodyWithResponse request with arbitrary body returning *SignOnResponse
+func (c *ClientWithResponses) SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
+	rsp, err := c.SignOnWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchTaskLogTailResponse(rsp)
+	return ParseSignOnResponse(rsp)
 }
 
-// SetTaskStatusWithBodyWithResponse request with arbitrary body returning *SetTaskStatusResponse
-func (c *ClientWithResponses) SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
-	rsp, err := c.SetTaskStatusWithBody(ctx, taskId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
+	rsp, err := c.SignOn(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetTaskStatusResponse(rsp)
+	return ParseSignOnResponse(rsp)
 }
 
-func (c *ClientWithResponses) SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
-	rsp, err := c.SetTaskStatus(ctx, taskId, body, reqEditors...)
+// WorkerStateWithResponse request returning *WorkerStateResponse
+func (c *ClientWithResponses) WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error) {
+	rsp, err := c.WorkerState(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetTaskStatusResponse(rsp)
+	return ParseWorkerStateResponse(rsp)
 }
 
-// GetVersionWithResponse request returning *GetVersionResponse
-func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
-	rsp, err := c.GetVersion(ctx, reqEditors...)
+// WorkerStateChangedWithBodyWithResponse request with arbitrary body returning *WorkerStateChangedResponse
+func (c *ClientWithResponses) WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
+	rsp, err := c.WorkerStateChangedWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVersionResponse(rsp)
+	return ParseWorkerStateChangedResponse(rsp)
 }
 
-// DeleteWorkerTagWithResponse request returning *DeleteWorkerTagResponse
-func (c *ClientWithResponses) DeleteWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteWorkerTagResponse, error) {
-	rsp, err := c.DeleteWorkerTag(ctx, tagId, reqEditors...)
+func (c *ClientWithResponses) WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
+	rsp, err := c.WorkerStateChanged(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteWorkerTagResponse(rsp)
+	return ParseWorkerStateChangedResponse(rsp)
 }
 
-// FetchWorkerTagWithResponse request returning *FetchWorkerTagResponse
-func (c *ClientWithResponses) FetchWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagResponse, error) {
-	rsp, err := c.FetchWorkerTag(ctx, tagId, reqEditors...)
+// ScheduleTaskWithResponse request returning *ScheduleTaskResponse
+func (c *ClientWithResponses) ScheduleTaskWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error) {
+	rsp, err := c.ScheduleTask(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchWorkerTagResponse(rsp)
+	return ParseScheduleTaskResponse(rsp)
 }
 
-// UpdateWorkerTagWithBodyWithResponse request with arbitrary body returning *UpdateWorkerTagResponse
-func (c *ClientWithResponses) UpdateWorkerTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error) {
-	rsp, err := c.UpdateWorkerTagWithBody(ctx, tagId, contentType, body, reqEditors...)
+// TaskUpdateWithBodyWithResponse request with arbitrary body returning *TaskUpdateResponse
+func (c *ClientWithResponses) TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
+	rsp, err := c.TaskUpdateWithBody(ctx, taskId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateWorkerTagResponse(rsp)
+	return ParseTaskUpdateResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateWorkerTagWithResponse(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error) {
-	rsp, err := c.UpdateWorkerTag(ctx, tagId, body, reqEditors...)
+func (c *ClientWithResponses) TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
+	rsp, err := c.TaskUpdate(ctx, taskId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateWorkerTagResponse(rsp)
+	return ParseTaskUpdateResponse(rsp)
 }
 
-// FetchWorkerTagsWithResponse request returning *FetchWorkerTagsResponse
-func (c *ClientWithResponses) FetchWorkerTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkerTagsResponse, error) {
-	rsp, err := c.FetchWorkerTags(ctx, reqEditors...)
+// MayWorkerRunWithResponse request returning *MayWorkerRunResponse
+func (c *ClientWithResponses) MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error) {
+	rsp, err := c.MayWorkerRun(ctx, taskId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchWorkerTagsResponse(rsp)
+	return ParseMayWorkerRunResponse(rsp)
 }
 
-// CreateWorkerTagWithBodyWithResponse request with arbitrary body returning *CreateWorkerTagResponse
-func (c *ClientWithResponses) CreateWorkerTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error) {
-	rsp, err := c.CreateWorkerTagWithBody(ctx, contentType, body, reqEditors...)
+// TaskOutputProducedWithBodyWithResponse request with arbitrary body returning *TaskOutputProducedResponse
+func (c *ClientWithResponses) TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, params *TaskOutputProducedParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error) {
+	rsp, err := c.TaskOutputProducedWithBody(ctx, taskId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateWorkerTagResponse(rsp)
+	return ParseTaskOutputProducedResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateWorkerTagWithResponse(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error) {
-	rsp, err := c.CreateWorkerTag(ctx, body, reqEditors...)
+// ParseGetConfigurationResponse parses an HTTP response from a GetConfigurationWithResponse call
+func ParseGetConfigurationResponse(rsp *http.Response) (*GetConfigurationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateWorkerTagResponse(rsp)
+
+	response := &GetConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ManagerConfiguration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// FetchWorkersWithResponse request returning *FetchWorkersResponse
-func (c *ClientWithResponses) FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error) {
-	rsp, err := c.FetchWorkers(ctx, reqEditors...)
+// ParseFindBlenderExePathResponse parses an HTTP response from a FindBlenderExePathWithResponse call
+func ParseFindBlenderExePathResponse(rsp *http.Response) (*FindBlenderExePathResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchWorkersResponse(rsp)
+
+	response := &FindBlenderExePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BlenderPathFindResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteWorkerWithResponse request returning *DeleteWorkerResponse
-func (c *ClientWithResponses) DeleteWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*DeleteWorkerResponse, error) {
-	rsp, err := c.DeleteWorker(ctx, workerId, reqEditors...)
+// ParseCheckBlenderExePathResponse parses an HTTP response from a CheckBlenderExePathWithResponse call
+func ParseCheckBlenderExePathResponse(rsp *http.Response) (*CheckBlenderExePathResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteWorkerResponse(rsp)
-}
 
-// FetchWorkerWithResponse request returning *FetchWorkerResponse
-func (c *ClientWithResponses) FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error) {
-	rsp, err := c.FetchWorker(ctx, workerId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CheckBlenderExePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFetchWorkerResponse(rsp)
-}
 
-// RequestWorkerStatusChangeWithBodyWithResponse request with arbitrary body returning *RequestWorkerStatusChangeResponse
-func (c *ClientWithResponses) RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
-	rsp, err := c.RequestWorkerStatusChangeWithBody(ctx, workerId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BlenderPathCheckResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseRequestWorkerStatusChangeResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
-	rsp, err := c.RequestWorkerStatusChange(ctx, workerId, body, reqEditors...)
+// ParseCheckSharedStoragePathResponse parses an HTTP response from a CheckSharedStoragePathWithResponse call
+func ParseCheckSharedStoragePathResponse(rsp *http.Response) (*CheckSharedStoragePathResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRequestWorkerStatusChangeResponse(rsp)
-}
 
-// SetWorkerTagsWithBodyWithResponse request with arbitrary body returning *SetWorkerTagsResponse
-func (c *ClientWithResponses) SetWorkerTagsWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error) {
-	rsp, err := c.SetWorkerTagsWithBody(ctx, workerId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CheckSharedStoragePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSetWorkerTagsResponse(rsp)
-}
 
-func (c *ClientWithResponses) SetWorkerTagsWithResponse(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error) {
-	rsp, err := c.SetWorkerTags(ctx, workerId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PathCheckResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseSetWorkerTagsResponse(rsp)
+
+	return response, nil
 }
 
-// FetchWorkerSleepScheduleWithResponse request returning *FetchWorkerSleepScheduleResponse
-func (c *ClientWithResponses) FetchWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerSleepScheduleResponse, error) {
-	rsp, err := c.FetchWorkerSleepSchedule(ctx, workerId, reqEditors...)
+// ParseGetConfigurationFileResponse parses an HTTP response from a GetConfigurationFileWithResponse call
+func ParseGetConfigurationFileResponse(rsp *http.Response) (*GetConfigurationFileResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchWorkerSleepScheduleResponse(rsp)
-}
 
-// SetWorkerSleepScheduleWithBodyWithResponse request with arbitrary body returning *SetWorkerSleepScheduleResponse
-func (c *ClientWithResponses) SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
-	rsp, err := c.SetWorkerSleepScheduleWithBody(ctx, workerId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetConfigurationFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSetWorkerSleepScheduleResponse(rsp)
-}
 
-func (c *ClientWithResponses) SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
-	rsp, err := c.SetWorkerSleepSchedule(ctx, workerId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AdditionalProperties map[string]interface{} `json:"-"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
 	}
-	return ParseSetWorkerSleepScheduleResponse(rsp)
+
+	return response, nil
 }
 
-// RegisterWorkerWithBodyWithResponse request with arbitrary body returning *RegisterWorkerResponse
-func (c *ClientWithResponses) RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
-	rsp, err := c.RegisterWorkerWithBody(ctx, contentType, body, reqEditors...)
+// ParseSaveSetupAssistantConfigResponse parses an HTTP response from a SaveSetupAssistantConfigWithResponse call
+func ParseSaveSetupAssistantConfigResponse(rsp *http.Response) (*SaveSetupAssistantConfigResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRegisterWorkerResponse(rsp)
-}
 
-func (c *ClientWithResponses) RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
-	rsp, err := c.RegisterWorker(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SaveSetupAssistantConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRegisterWorkerResponse(rsp)
-}
 
-// SignOffWithResponse request returning *SignOffResponse
-func (c *ClientWithResponses) SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error) {
-	rsp, err := c.SignOff(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseSignOffResponse(rsp)
+
+	return response, nil
 }
 
-// SignOnWithBodyWithResponse request with arbitrary body returning *SignOnResponse
-func (c *ClientWithResponses) SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
-	rsp, err := c.SignOnWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetSharedStorageResponse parses an HTTP response from a GetSharedStorageWithResponse call
+func ParseGetSharedStorageResponse(rsp *http.Response) (*GetSharedStorageResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSignOnResponse(rsp)
-}
 
-func (c *ClientWithResponses) SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
-	rsp, err := c.SignOn(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetSharedStorageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSignOnResponse(rsp)
-}
 
-// WorkerStateWithResponse request returning *WorkerStateResponse
-func (c *ClientWithResponses) WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error) {
-	rsp, err := c.WorkerState(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedStorageLocation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseWorkerStateResponse(rsp)
+
+	return response, nil
 }
 
-// WorkerStateChangedWithBodyWithResponse request with arbitrary body returning *WorkerStateChangedResponse
-func (c *ClientWithResponses) WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
-	rsp, err := c.WorkerStateChangedWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetVariablesResponse parses an HTTP response from a GetVariablesWithResponse call
+func ParseGetVariablesResponse(rsp *http.Response) (*GetVariablesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkerStateChangedResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
-	rsp, err := c.WorkerStateChanged(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetVariablesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkerStateChangedResponse(rsp)
-}
 
-// ScheduleTaskWithResponse request returning *ScheduleTaskResponse
-func (c *ClientWithResponses) ScheduleTaskWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error) {
-	rsp, err := c.ScheduleTask(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ManagerVariables
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseScheduleTaskResponse(rsp)
+
+	return response, nil
 }
 
-// TaskUpdateWithBodyWithResponse request with arbitrary body returning *TaskUpdateResponse
-func (c *ClientWithResponses) TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
-	rsp, err := c.TaskUpdateWithBody(ctx, taskId, contentType, body, reqEditors...)
+// ParseFetchJobTemplatesResponse parses an HTTP response from a FetchJobTemplatesWithResponse call
+func ParseFetchJobTemplatesResponse(rsp *http.Response) (*FetchJobTemplatesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTaskUpdateResponse(rsp)
-}
 
-func (c *ClientWithResponses) TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
-	rsp, err := c.TaskUpdate(ctx, taskId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FetchJobTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobTemplateList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseTaskUpdateResponse(rsp)
+
+	return response, nil
 }
 
-// MayWorkerRunWithResponse request returning *MayWorkerRunResponse
-func (c *ClientWithResponses) MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error) {
-	rsp, err := c.MayWorkerRun(ctx, taskId, reqEditors...)
+// ParseCreateJobTemplateResponse parses an HTTP response from a CreateJobTemplateWithResponse call
+func ParseCreateJobTemplateResponse(rsp *http.Response) (*CreateJobTemplateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMayWorkerRunResponse(rsp)
-}
 
-// TaskOutputProducedWithBodyWithResponse request with arbitrary body returning *TaskOutputProducedResponse
-func (c *ClientWithResponses) TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error) {
-	rsp, err := c.TaskOutputProducedWithBody(ctx, taskId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateJobTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTaskOutputProducedResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetConfigurationResponse parses an HTTP response from a GetConfigurationWithResponse call
-func ParseGetConfigurationResponse(rsp *http.Response) (*GetConfigurationResponse, error) {
+// ParseDeleteJobTemplateResponse parses an HTTP response from a DeleteJobTemplateWithResponse call
+func ParseDeleteJobTemplateResponse(rsp *http.Response) (*DeleteJobTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetConfigurationResponse{
+	response := &DeleteJobTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ManagerConfiguration
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseFindBlenderExePathResponse parses an HTTP response from a FindBlenderExePathWithResponse call
-func ParseFindBlenderExePathResponse(rsp *http.Response) (*FindBlenderExePathResponse, error) {
+// ParseFetchJobTemplateResponse parses an HTTP response from a FetchJobTemplateWithResponse call
+func ParseFetchJobTemplateResponse(rsp *http.Response) (*FetchJobTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindBlenderExePathResponse{
+	response := &FetchJobTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BlenderPathFindResult
+		var dest JobTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5410,22 +7306,22 @@ func ParseFindBlenderExePathResponse(rsp *http.Response) (*FindBlenderExePathRes
 	return response, nil
 }
 
-// ParseCheckBlenderExePathResponse parses an HTTP response from a CheckBlenderExePathWithResponse call
-func ParseCheckBlenderExePathResponse(rsp *http.Response) (*CheckBlenderExePathResponse, error) {
+// ParseUpdateJobTemplateResponse parses an HTTP response from a UpdateJobTemplateWithResponse call
+func ParseUpdateJobTemplateResponse(rsp *http.Response) (*UpdateJobTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CheckBlenderExePathResponse{
+	response := &UpdateJobTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BlenderPathCheckResult
+		var dest JobTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5443,27 +7339,34 @@ func ParseCheckBlenderExePathResponse(rsp *http.Response) (*CheckBlenderExePathR
 	return response, nil
 }
 
-// ParseCheckSharedStoragePathResponse parses an HTTP response from a CheckSharedStoragePathWithResponse call
-func ParseCheckSharedStoragePathResponse(rsp *http.Response) (*CheckSharedStoragePathResponse, error) {
+// ParseSubmitJobResponse parses an HTTP response from a SubmitJobWithResponse call
+func ParseSubmitJobResponse(rsp *http.Response) (*SubmitJobResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CheckSharedStoragePathResponse{
+	response := &SubmitJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PathCheckResult
+		var dest Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5476,55 +7379,67 @@ func ParseCheckSharedStoragePathResponse(rsp *http.Response) (*CheckSharedStorag
 	return response, nil
 }
 
-// ParseGetConfigurationFileResponse parses an HTTP response from a GetConfigurationFileWithResponse call
-func ParseGetConfigurationFileResponse(rsp *http.Response) (*GetConfigurationFileResponse, error) {
+// ParseSubmitJobCheckResponse parses an HTTP response from a SubmitJobCheckWithResponse call
+func ParseSubmitJobCheckResponse(rsp *http.Response) (*SubmitJobCheckResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetConfigurationFileResponse{
+	response := &SubmitJobCheckResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			AdditionalProperties map[string]interface{} `json:"-"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON412 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.YAML200 = &dest
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSaveSetupAssistantConfigResponse parses an HTTP response from a SaveSetupAssistantConfigWithResponse call
-func ParseSaveSetupAssistantConfigResponse(rsp *http.Response) (*SaveSetupAssistantConfigResponse, error) {
+// ParseImportJobArchiveResponse parses an HTTP response from a ImportJobArchiveWithResponse call
+func ParseImportJobArchiveResponse(rsp *http.Response) (*ImportJobArchiveResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SaveSetupAssistantConfigResponse{
+	response := &ImportJobArchiveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5537,22 +7452,22 @@ func ParseSaveSetupAssistantConfigResponse(rsp *http.Response) (*SaveSetupAssist
 	return response, nil
 }
 
-// ParseGetSharedStorageResponse parses an HTTP response from a GetSharedStorageWithResponse call
-func ParseGetSharedStorageResponse(rsp *http.Response) (*GetSharedStorageResponse, error) {
+// ParseFetchGlobalLastRenderedInfoResponse parses an HTTP response from a FetchGlobalLastRenderedInfoWithResponse call
+func ParseFetchGlobalLastRenderedInfoResponse(rsp *http.Response) (*FetchGlobalLastRenderedInfoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSharedStorageResponse{
+	response := &FetchGlobalLastRenderedInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SharedStorageLocation
+		var dest JobLastRenderedImageInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5563,22 +7478,22 @@ func ParseGetSharedStorageResponse(rsp *http.Response) (*GetSharedStorageRespons
 	return response, nil
 }
 
-// ParseGetVariablesResponse parses an HTTP response from a GetVariablesWithResponse call
-func ParseGetVariablesResponse(rsp *http.Response) (*GetVariablesResponse, error) {
+// ParseFetchLastRenderedQueueInfoResponse parses an HTTP response from a FetchLastRenderedQueueInfoWithResponse call
+func ParseFetchLastRenderedQueueInfoResponse(rsp *http.Response) (*FetchLastRenderedQueueInfoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVariablesResponse{
+	response := &FetchLastRenderedQueueInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ManagerVariables
+		var dest LastRenderedQueueInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5589,33 +7504,59 @@ func ParseGetVariablesResponse(rsp *http.Response) (*GetVariablesResponse, error
 	return response, nil
 }
 
-// ParseSubmitJobResponse parses an HTTP response from a SubmitJobWithResponse call
-func ParseSubmitJobResponse(rsp *http.Response) (*SubmitJobResponse, error) {
+// ParseDeleteJobMassResponse parses an HTTP response from a DeleteJobMassWithResponse call
+func ParseDeleteJobMassResponse(rsp *http.Response) (*DeleteJobMassResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SubmitJobResponse{
+	response := &DeleteJobMassResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Job
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 416:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON416 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryJobsResponse parses an HTTP response from a QueryJobsWithResponse call
+func ParseQueryJobsResponse(rsp *http.Response) (*QueryJobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobsQueryResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
@@ -5629,27 +7570,72 @@ func ParseSubmitJobResponse(rsp *http.Response) (*SubmitJobResponse, error) {
 	return response, nil
 }
 
-// ParseSubmitJobCheckResponse parses an HTTP response from a SubmitJobCheckWithResponse call
-func ParseSubmitJobCheckResponse(rsp *http.Response) (*SubmitJobCheckResponse, error) {
+// ParseGetJobTypeResponse parses an HTTP response from a GetJobTypeWithResponse call
+func ParseGetJobTypeResponse(rsp *http.Response) (*GetJobTypeResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SubmitJobCheckResponse{
+	response := &GetJobTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AvailableJobType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJobTypesResponse parses an HTTP response from a GetJobTypesWithResponse call
+func ParseGetJobTypesResponse(rsp *http.Response) (*GetJobTypesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AvailableJobTypes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteJobResponse parses an HTTP response from a DeleteJobWithResponse call
+func ParseDeleteJobResponse(rsp *http.Response) (*DeleteJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5662,22 +7648,22 @@ func ParseSubmitJobCheckResponse(rsp *http.Response) (*SubmitJobCheckResponse, e
 	return response, nil
 }
 
-// ParseFetchGlobalLastRenderedInfoResponse parses an HTTP response from a FetchGlobalLastRenderedInfoWithResponse call
-func ParseFetchGlobalLastRenderedInfoResponse(rsp *http.Response) (*FetchGlobalLastRenderedInfoResponse, error) {
+// ParseFetchJobResponse parses an HTTP response from a FetchJobWithResponse call
+func ParseFetchJobResponse(rsp *http.Response) (*FetchJobResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchGlobalLastRenderedInfoResponse{
+	response := &FetchJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobLastRenderedImageInfo
+		var dest Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5688,27 +7674,46 @@ func ParseFetchGlobalLastRenderedInfoResponse(rsp *http.Response) (*FetchGlobalL
 	return response, nil
 }
 
-// ParseDeleteJobMassResponse parses an HTTP response from a DeleteJobMassWithResponse call
-func ParseDeleteJobMassResponse(rsp *http.Response) (*DeleteJobMassResponse, error) {
+// ParseExportJobArchiveResponse parses an HTTP response from a ExportJobArchiveWithResponse call
+func ParseExportJobArchiveResponse(rsp *http.Response) (*ExportJobArchiveResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteJobMassResponse{
+	response := &ExportJobArchiveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 416:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON416 = &dest
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveJobBlocklistResponse parses an HTTP response from a RemoveJobBlocklistWithResponse call
+func ParseRemoveJobBlocklistResponse(rsp *http.Response) (*RemoveJobBlocklistResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveJobBlocklistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5721,22 +7726,22 @@ func ParseDeleteJobMassResponse(rsp *http.Response) (*DeleteJobMassResponse, err
 	return response, nil
 }
 
-// ParseQueryJobsResponse parses an HTTP response from a QueryJobsWithResponse call
-func ParseQueryJobsResponse(rsp *http.Response) (*QueryJobsResponse, error) {
+// ParseFetchJobBlocklistResponse parses an HTTP response from a FetchJobBlocklistWithResponse call
+func ParseFetchJobBlocklistResponse(rsp *http.Response) (*FetchJobBlocklistResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryJobsResponse{
+	response := &FetchJobBlocklistResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobsQueryResult
+		var dest JobBlocklist
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5754,22 +7759,22 @@ func ParseQueryJobsResponse(rsp *http.Response) (*QueryJobsResponse, error) {
 	return response, nil
 }
 
-// ParseGetJobTypeResponse parses an HTTP response from a GetJobTypeWithResponse call
-func ParseGetJobTypeResponse(rsp *http.Response) (*GetJobTypeResponse, error) {
+// ParseFetchJobLastRenderedInfoResponse parses an HTTP response from a FetchJobLastRenderedInfoWithResponse call
+func ParseFetchJobLastRenderedInfoResponse(rsp *http.Response) (*FetchJobLastRenderedInfoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJobTypeResponse{
+	response := &FetchJobLastRenderedInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AvailableJobType
+		var dest JobLastRenderedImageInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5780,46 +7785,53 @@ func ParseGetJobTypeResponse(rsp *http.Response) (*GetJobTypeResponse, error) {
 	return response, nil
 }
 
-// ParseGetJobTypesResponse parses an HTTP response from a GetJobTypesWithResponse call
-func ParseGetJobTypesResponse(rsp *http.Response) (*GetJobTypesResponse, error) {
+// ParseFetchJobLastRenderedContactSheetResponse parses an HTTP response from a FetchJobLastRenderedContactSheetWithResponse call
+func ParseFetchJobLastRenderedContactSheetResponse(rsp *http.Response) (*FetchJobLastRenderedContactSheetResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJobTypesResponse{
+	response := &FetchJobLastRenderedContactSheetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AvailableJobTypes
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteJobResponse parses an HTTP response from a DeleteJobWithResponse call
-func ParseDeleteJobResponse(rsp *http.Response) (*DeleteJobResponse, error) {
+// ParseFetchJobLastRenderedHistoryResponse parses an HTTP response from a FetchJobLastRenderedHistoryWithResponse call
+func ParseFetchJobLastRenderedHistoryResponse(rsp *http.Response) (*FetchJobLastRenderedHistoryResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteJobResponse{
+	response := &FetchJobLastRenderedHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobLastRenderedHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5832,41 +7844,41 @@ func ParseDeleteJobResponse(rsp *http.Response) (*DeleteJobResponse, error) {
 	return response, nil
 }
 
-// ParseFetchJobResponse parses an HTTP response from a FetchJobWithResponse call
-func ParseFetchJobResponse(rsp *http.Response) (*FetchJobResponse, error) {
+// ParseSetJobPriorityResponse parses an HTTP response from a SetJobPriorityWithResponse call
+func ParseSetJobPriorityResponse(rsp *http.Response) (*SetJobPriorityResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobResponse{
+	response := &SetJobPriorityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Job
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRemoveJobBlocklistResponse parses an HTTP response from a RemoveJobBlocklistWithResponse call
-func ParseRemoveJobBlocklistResponse(rsp *http.Response) (*RemoveJobBlocklistResponse, error) {
+// ParseSetJobStatusResponse parses an HTTP response from a SetJobStatusWithResponse call
+func ParseSetJobStatusResponse(rsp *http.Response) (*SetJobStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveJobBlocklistResponse{
+	response := &SetJobStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5884,22 +7896,22 @@ func ParseRemoveJobBlocklistResponse(rsp *http.Response) (*RemoveJobBlocklistRes
 	return response, nil
 }
 
-// ParseFetchJobBlocklistResponse parses an HTTP response from a FetchJobBlocklistWithResponse call
-func ParseFetchJobBlocklistResponse(rsp *http.Response) (*FetchJobBlocklistResponse, error) {
+// ParseFetchJobTasksResponse parses an HTTP response from a FetchJobTasksWithResponse call
+func ParseFetchJobTasksResponse(rsp *http.Response) (*FetchJobTasksResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobBlocklistResponse{
+	response := &FetchJobTasksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobBlocklist
+		var dest JobTasksSummary
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5917,22 +7929,22 @@ func ParseFetchJobBlocklistResponse(rsp *http.Response) (*FetchJobBlocklistRespo
 	return response, nil
 }
 
-// ParseFetchJobLastRenderedInfoResponse parses an HTTP response from a FetchJobLastRenderedInfoWithResponse call
-func ParseFetchJobLastRenderedInfoResponse(rsp *http.Response) (*FetchJobLastRenderedInfoResponse, error) {
+// ParseDeleteJobWhatWouldItDoResponse parses an HTTP response from a DeleteJobWhatWouldItDoWithResponse call
+func ParseDeleteJobWhatWouldItDoResponse(rsp *http.Response) (*DeleteJobWhatWouldItDoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobLastRenderedInfoResponse{
+	response := &DeleteJobWhatWouldItDoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobLastRenderedImageInfo
+		var dest JobDeletionInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5943,20 +7955,48 @@ func ParseFetchJobLastRenderedInfoResponse(rsp *http.Response) (*FetchJobLastRen
 	return response, nil
 }
 
-// ParseSetJobPriorityResponse parses an HTTP response from a SetJobPriorityWithResponse call
-func ParseSetJobPriorityResponse(rsp *http.Response) (*SetJobPriorityResponse, error) {
+// ParseShamanCheckoutResponse parses an HTTP response from a ShamanCheckoutWithResponse call
+func ParseShamanCheckoutResponse(rsp *http.Response) (*ShamanCheckoutResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetJobPriorityResponse{
+	response := &ShamanCheckoutResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ShamanCheckoutResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 424:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON424 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 425:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON425 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5969,20 +8009,27 @@ func ParseSetJobPriorityResponse(rsp *http.Response) (*SetJobPriorityResponse, e
 	return response, nil
 }
 
-// ParseSetJobStatusResponse parses an HTTP response from a SetJobStatusWithResponse call
-func ParseSetJobStatusResponse(rsp *http.Response) (*SetJobStatusResponse, error) {
+// ParseShamanCheckoutRequirementsResponse parses an HTTP response from a ShamanCheckoutRequirementsWithResponse call
+func ParseShamanCheckoutRequirementsResponse(rsp *http.Response) (*ShamanCheckoutRequirementsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetJobStatusResponse{
+	response := &ShamanCheckoutRequirementsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ShamanRequirementsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5995,22 +8042,22 @@ func ParseSetJobStatusResponse(rsp *http.Response) (*SetJobStatusResponse, error
 	return response, nil
 }
 
-// ParseFetchJobTasksResponse parses an HTTP response from a FetchJobTasksWithResponse call
-func ParseFetchJobTasksResponse(rsp *http.Response) (*FetchJobTasksResponse, error) {
+// ParseShamanFileStoreCheckResponse parses an HTTP response from a ShamanFileStoreCheckWithResponse call
+func ParseShamanFileStoreCheckResponse(rsp *http.Response) (*ShamanFileStoreCheckResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobTasksResponse{
+	response := &ShamanFileStoreCheckResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobTasksSummary
+		var dest ShamanSingleFileStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6028,67 +8075,74 @@ func ParseFetchJobTasksResponse(rsp *http.Response) (*FetchJobTasksResponse, err
 	return response, nil
 }
 
-// ParseDeleteJobWhatWouldItDoResponse parses an HTTP response from a DeleteJobWhatWouldItDoWithResponse call
-func ParseDeleteJobWhatWouldItDoResponse(rsp *http.Response) (*DeleteJobWhatWouldItDoResponse, error) {
+// ParseShamanFileStoreResponse parses an HTTP response from a ShamanFileStoreWithResponse call
+func ParseShamanFileStoreResponse(rsp *http.Response) (*ShamanFileStoreResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteJobWhatWouldItDoResponse{
+	response := &ShamanFileStoreResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobDeletionInfo
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 416:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON416 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 507:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON507 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseShamanCheckoutResponse parses an HTTP response from a ShamanCheckoutWithResponse call
-func ParseShamanCheckoutResponse(rsp *http.Response) (*ShamanCheckoutResponse, error) {
+// ParseGetShamanScrubStatusResponse parses an HTTP response from a GetShamanScrubStatusWithResponse call
+func ParseGetShamanScrubStatusResponse(rsp *http.Response) (*GetShamanScrubStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanCheckoutResponse{
+	response := &GetShamanScrubStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ShamanCheckoutResult
+		var dest ShamanScrubStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 424:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON424 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -6101,26 +8155,26 @@ func ParseShamanCheckoutResponse(rsp *http.Response) (*ShamanCheckoutResponse, e
 	return response, nil
 }
 
-// ParseShamanCheckoutRequirementsResponse parses an HTTP response from a ShamanCheckoutRequirementsWithResponse call
-func ParseShamanCheckoutRequirementsResponse(rsp *http.Response) (*ShamanCheckoutRequirementsResponse, error) {
+// ParseStartShamanScrubResponse parses an HTTP response from a StartShamanScrubWithResponse call
+func ParseStartShamanScrubResponse(rsp *http.Response) (*StartShamanScrubResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanCheckoutRequirementsResponse{
+	response := &StartShamanScrubResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ShamanRequirementsResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
@@ -6134,22 +8188,22 @@ func ParseShamanCheckoutRequirementsResponse(rsp *http.Response) (*ShamanCheckou
 	return response, nil
 }
 
-// ParseShamanFileStoreCheckResponse parses an HTTP response from a ShamanFileStoreCheckWithResponse call
-func ParseShamanFileStoreCheckResponse(rsp *http.Response) (*ShamanFileStoreCheckResponse, error) {
+// ParseGetShamanStatusResponse parses an HTTP response from a GetShamanStatusWithResponse call
+func ParseGetShamanStatusResponse(rsp *http.Response) (*GetShamanStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanFileStoreCheckResponse{
+	response := &GetShamanStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ShamanSingleFileStatus
+		var dest ShamanStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6167,15 +8221,15 @@ func ParseShamanFileStoreCheckResponse(rsp *http.Response) (*ShamanFileStoreChec
 	return response, nil
 }
 
-// ParseShamanFileStoreResponse parses an HTTP response from a ShamanFileStoreWithResponse call
-func ParseShamanFileStoreResponse(rsp *http.Response) (*ShamanFileStoreResponse, error) {
+// ParseSearchTaskLogsResponse parses an HTTP response from a SearchTaskLogsWithResponse call
+func ParseSearchTaskLogsResponse(rsp *http.Response) (*SearchTaskLogsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanFileStoreResponse{
+	response := &SearchTaskLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -6415,6 +8469,65 @@ func ParseUpdateWorkerTagResponse(rsp *http.Response) (*UpdateWorkerTagResponse,
 	return response, nil
 }
 
+// ParseFetchWorkerTagSleepScheduleResponse parses an HTTP response from a FetchWorkerTagSleepScheduleWithResponse call
+func ParseFetchWorkerTagSleepScheduleResponse(rsp *http.Response) (*FetchWorkerTagSleepScheduleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FetchWorkerTagSleepScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerSleepSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetWorkerTagSleepScheduleResponse parses an HTTP response from a SetWorkerTagSleepScheduleWithResponse call
+func ParseSetWorkerTagSleepScheduleResponse(rsp *http.Response) (*SetWorkerTagSleepScheduleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetWorkerTagSleepScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseFetchWorkerTagsResponse parses an HTTP response from a FetchWorkerTagsWithResponse call
 func ParseFetchWorkerTagsResponse(rsp *http.Response) (*FetchWorkerTagsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -6663,6 +8776,32 @@ func ParseSetWorkerSleepScheduleResponse(rsp *http.Response) (*SetWorkerSleepSch
 	return response, nil
 }
 
+// ParseWorkerHeartbeatResponse parses an HTTP response from a WorkerHeartbeatWithResponse call
+func ParseWorkerHeartbeatResponse(rsp *http.Response) (*WorkerHeartbeatResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkerHeartbeatResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseRegisterWorkerResponse parses an HTTP response from a RegisterWorkerWithResponse call
 func ParseRegisterWorkerResponse(rsp *http.Response) (*RegisterWorkerResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
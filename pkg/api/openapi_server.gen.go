@@ -37,15 +37,36 @@ type ServerInterface interface {
 	// Get the variables of this Manager. Used by the Blender add-on to recognise two-way variables, and for the web interface to do variable replacement based on the browser's platform.
 	// (GET /api/v3/configuration/variables/{audience}/{platform})
 	GetVariables(ctx echo.Context, audience ManagerVariableAudience, platform string) error
+	// Get list of job templates.
+	// (GET /api/v3/job-templates)
+	FetchJobTemplates(ctx echo.Context) error
+	// Create a new job template.
+	// (POST /api/v3/job-templates)
+	CreateJobTemplate(ctx echo.Context) error
+	// Remove this job template. Jobs that were submitted from it are kept.
+	// (DELETE /api/v3/job-templates/{template_id})
+	DeleteJobTemplate(ctx echo.Context, templateId string) error
+	// Get a single job template.
+	// (GET /api/v3/job-templates/{template_id})
+	FetchJobTemplate(ctx echo.Context, templateId string) error
+	// Update an existing job template.
+	// (PUT /api/v3/job-templates/{template_id})
+	UpdateJobTemplate(ctx echo.Context, templateId string) error
 	// Submit a new job for Flamenco Manager to execute.
 	// (POST /api/v3/jobs)
 	SubmitJob(ctx echo.Context) error
 	// Submit a new job for Flamenco Manager to check.
 	// (POST /api/v3/jobs/check)
 	SubmitJobCheck(ctx echo.Context) error
+	// Import a job from a ZIP archive, as produced by the `exportJobArchive` operation. The job keeps its UUID, so it cannot be imported into a Manager that already has that job. References to Workers, worker tags, and jobs that do not exist on this Manager are dropped.
+	// (POST /api/v3/jobs/import)
+	ImportJobArchive(ctx echo.Context) error
 	// Get the URL that serves the last-rendered images.
 	// (GET /api/v3/jobs/last-rendered)
 	FetchGlobalLastRenderedInfo(ctx echo.Context) error
+	// Get metrics of the queue of to-be-processed last-rendered images.
+	// (GET /api/v3/jobs/last-rendered-queue)
+	FetchLastRenderedQueueInfo(ctx echo.Context) error
 	// Mark jobs for deletion, based on certain criteria.
 	// (DELETE /api/v3/jobs/mass-delete)
 	DeleteJobMass(ctx echo.Context) error
@@ -64,6 +85,9 @@ type ServerInterface interface {
 	// Fetch info about the job.
 	// (GET /api/v3/jobs/{job_id})
 	FetchJob(ctx echo.Context, jobId string) error
+	// Export the job, its tasks, blocklist, task logs, and last-rendered images as a ZIP archive. The archive can be imported into another Flamenco Manager with the `importJobArchive` operation.
+	// (GET /api/v3/jobs/{job_id}/archive)
+	ExportJobArchive(ctx echo.Context, jobId string) error
 	// Remove entries from a job blocklist.
 	// (DELETE /api/v3/jobs/{job_id}/blocklist)
 	RemoveJobBlocklist(ctx echo.Context, jobId string) error
@@ -73,6 +97,12 @@ type ServerInterface interface {
 	// Get the URL that serves the last-rendered images of this job.
 	// (GET /api/v3/jobs/{job_id}/last-rendered)
 	FetchJobLastRenderedInfo(ctx echo.Context, jobId string) error
+	// Get a single JPEG image that shows the thumbnails of the previously rendered frames of this job, ordered by frame number.
+	// (GET /api/v3/jobs/{job_id}/last-rendered/contact-sheet)
+	FetchJobLastRenderedContactSheet(ctx echo.Context, jobId string, params FetchJobLastRenderedContactSheetParams) error
+	// Get the URLs of the thumbnails of previously rendered frames of this job. Only a limited number of frames is kept per job.
+	// (GET /api/v3/jobs/{job_id}/last-rendered/history)
+	FetchJobLastRenderedHistory(ctx echo.Context, jobId string) error
 
 	// (POST /api/v3/jobs/{job_id}/setpriority)
 	SetJobPriority(ctx echo.Context, jobId string) error
@@ -98,6 +128,18 @@ type ServerInterface interface {
 	// The file's contents should be sent in the request body.
 	// (POST /api/v3/shaman/files/{checksum}/{filesize})
 	ShamanFileStore(ctx echo.Context, checksum string, filesize int, params ShamanFileStoreParams) error
+	// Get whether an integrity check of the Shaman file store is running, and the report of the last finished check.
+	// (GET /api/v3/shaman/scrub)
+	GetShamanScrubStatus(ctx echo.Context) error
+	// Start checking all files in the Shaman file store against their checksum. Corrupt files are moved to quarantine. Use `getShamanScrubStatus` to get the results.
+	// (POST /api/v3/shaman/scrub)
+	StartShamanScrub(ctx echo.Context) error
+	// Get the status of the Shaman file storage, including its free disk space.
+	// (GET /api/v3/shaman/status)
+	GetShamanStatus(ctx echo.Context) error
+	// Search the task logs of a single job, or of all jobs that were active within a time range. The response is streamed as newline-delimited JSON, with one `TaskLogSearchMatch` object per line.
+	// (POST /api/v3/tasks/logs/search)
+	SearchTaskLogs(ctx echo.Context) error
 	// Fetch a single task.
 	// (GET /api/v3/tasks/{task_id})
 	FetchTask(ctx echo.Context, taskId string) error
@@ -122,6 +164,12 @@ type ServerInterface interface {
 	// Update an existing worker tag.
 	// (PUT /api/v3/worker-mgt/tag/{tag_id})
 	UpdateWorkerTag(ctx echo.Context, tagId string) error
+
+	// (GET /api/v3/worker-mgt/tag/{tag_id}/sleep-schedule)
+	FetchWorkerTagSleepSchedule(ctx echo.Context, tagId string) error
+
+	// (POST /api/v3/worker-mgt/tag/{tag_id}/sleep-schedule)
+	SetWorkerTagSleepSchedule(ctx echo.Context, tagId string) error
 	// Get list of worker tags.
 	// (GET /api/v3/worker-mgt/tags)
 	FetchWorkerTags(ctx echo.Context) error
@@ -149,6 +197,9 @@ type ServerInterface interface {
 
 	// (POST /api/v3/worker-mgt/workers/{worker_id}/sleep-schedule)
 	SetWorkerSleepSchedule(ctx echo.Context, workerId string) error
+	// Report the Worker's current load and memory usage.
+	// (POST /api/v3/worker/heartbeat)
+	WorkerHeartbeat(ctx echo.Context) error
 	// Register a new worker
 	// (POST /api/v3/worker/register-worker)
 	RegisterWorker(ctx echo.Context) error
@@ -173,9 +224,9 @@ type ServerInterface interface {
 	// The response indicates whether the worker is allowed to run / keep running the task. Optionally contains a queued worker status change.
 	// (GET /api/v3/worker/task/{task_id}/may-i-run)
 	MayWorkerRun(ctx echo.Context, taskId string) error
-	// Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. Currently only PNG and JPEG support is planned.
+	// Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. PNG, JPEG, and OpenEXR images are supported.
 	// (POST /api/v3/worker/task/{task_id}/output-produced)
-	TaskOutputProduced(ctx echo.Context, taskId string) error
+	TaskOutputProduced(ctx echo.Context, taskId string, params TaskOutputProducedParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -285,6 +336,72 @@ func (w *ServerInterfaceWrapper) GetVariables(ctx echo.Context) error {
 	return err
 }
 
+// FetchJobTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchJobTemplates(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchJobTemplates(ctx)
+	return err
+}
+
+// CreateJobTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateJobTemplate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateJobTemplate(ctx)
+	return err
+}
+
+// DeleteJobTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteJobTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "template_id" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteJobTemplate(ctx, templateId)
+	return err
+}
+
+// FetchJobTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchJobTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "template_id" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchJobTemplate(ctx, templateId)
+	return err
+}
+
+// UpdateJobTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateJobTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "template_id" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateJobTemplate(ctx, templateId)
+	return err
+}
+
 // SubmitJob converts echo context to params.
 func (w *ServerInterfaceWrapper) SubmitJob(ctx echo.Context) error {
 	var err error
@@ -303,6 +420,15 @@ func (w *ServerInterfaceWrapper) SubmitJobCheck(ctx echo.Context) error {
 	return err
 }
 
+// ImportJobArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportJobArchive(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportJobArchive(ctx)
+	return err
+}
+
 // FetchGlobalLastRenderedInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchGlobalLastRenderedInfo(ctx echo.Context) error {
 	var err error
@@ -312,6 +438,15 @@ func (w *ServerInterfaceWrapper) FetchGlobalLastRenderedInfo(ctx echo.Context) e
 	return err
 }
 
+// FetchLastRenderedQueueInfo converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchLastRenderedQueueInfo(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchLastRenderedQueueInfo(ctx)
+	return err
+}
+
 // DeleteJobMass converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteJobMass(ctx echo.Context) error {
 	var err error
@@ -387,6 +522,22 @@ func (w *ServerInterfaceWrapper) FetchJob(ctx echo.Context) error {
 	return err
 }
 
+// ExportJobArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportJobArchive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExportJobArchive(ctx, jobId)
+	return err
+}
+
 // RemoveJobBlocklist converts echo context to params.
 func (w *ServerInterfaceWrapper) RemoveJobBlocklist(ctx echo.Context) error {
 	var err error
@@ -435,6 +586,47 @@ func (w *ServerInterfaceWrapper) FetchJobLastRenderedInfo(ctx echo.Context) erro
 	return err
 }
 
+// FetchJobLastRenderedContactSheet converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchJobLastRenderedContactSheet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FetchJobLastRenderedContactSheetParams
+	// ------------- Optional query parameter "columns" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "columns", ctx.QueryParams(), &params.Columns)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columns: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchJobLastRenderedContactSheet(ctx, jobId, params)
+	return err
+}
+
+// FetchJobLastRenderedHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchJobLastRenderedHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchJobLastRenderedHistory(ctx, jobId)
+	return err
+}
+
 // SetJobPriority converts echo context to params.
 func (w *ServerInterfaceWrapper) SetJobPriority(ctx echo.Context) error {
 	var err error
@@ -594,12 +786,63 @@ func (w *ServerInterfaceWrapper) ShamanFileStore(ctx echo.Context) error {
 
 		params.XShamanOriginalFilename = &XShamanOriginalFilename
 	}
+	// ------------- Optional header parameter "X-Shaman-Upload-Offset" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Shaman-Upload-Offset")]; found {
+		var XShamanUploadOffset int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Shaman-Upload-Offset, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Shaman-Upload-Offset", runtime.ParamLocationHeader, valueList[0], &XShamanUploadOffset)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Shaman-Upload-Offset: %s", err))
+		}
+
+		params.XShamanUploadOffset = &XShamanUploadOffset
+	}
 
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.ShamanFileStore(ctx, checksum, filesize, params)
 	return err
 }
 
+// GetShamanScrubStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetShamanScrubStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetShamanScrubStatus(ctx)
+	return err
+}
+
+// StartShamanScrub converts echo context to params.
+func (w *ServerInterfaceWrapper) StartShamanScrub(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartShamanScrub(ctx)
+	return err
+}
+
+// GetShamanStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetShamanStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetShamanStatus(ctx)
+	return err
+}
+
+// SearchTaskLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchTaskLogs(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchTaskLogs(ctx)
+	return err
+}
+
 // FetchTask converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchTask(ctx echo.Context) error {
 	var err error
@@ -721,6 +964,38 @@ func (w *ServerInterfaceWrapper) UpdateWorkerTag(ctx echo.Context) error {
 	return err
 }
 
+// FetchWorkerTagSleepSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchWorkerTagSleepSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tag_id" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, ctx.Param("tag_id"), &tagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchWorkerTagSleepSchedule(ctx, tagId)
+	return err
+}
+
+// SetWorkerTagSleepSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) SetWorkerTagSleepSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tag_id" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, ctx.Param("tag_id"), &tagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetWorkerTagSleepSchedule(ctx, tagId)
+	return err
+}
+
 // FetchWorkerTags converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchWorkerTags(ctx echo.Context) error {
 	var err error
@@ -844,6 +1119,17 @@ func (w *ServerInterfaceWrapper) SetWorkerSleepSchedule(ctx echo.Context) error
 	return err
 }
 
+// WorkerHeartbeat converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerHeartbeat(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Worker_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WorkerHeartbeat(ctx)
+	return err
+}
+
 // RegisterWorker converts echo context to params.
 func (w *ServerInterfaceWrapper) RegisterWorker(ctx echo.Context) error {
 	var err error
@@ -957,8 +1243,28 @@ func (w *ServerInterfaceWrapper) TaskOutputProduced(ctx echo.Context) error {
 
 	ctx.Set(Worker_authScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskOutputProducedParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Flamenco-Output-Filename" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Flamenco-Output-Filename")]; found {
+		var XFlamencoOutputFilename string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Flamenco-Output-Filename, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Flamenco-Output-Filename", runtime.ParamLocationHeader, valueList[0], &XFlamencoOutputFilename)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Flamenco-Output-Filename: %s", err))
+		}
+
+		params.XFlamencoOutputFilename = &XFlamencoOutputFilename
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.TaskOutputProduced(ctx, taskId)
+	err = w.Handler.TaskOutputProduced(ctx, taskId, params)
 	return err
 }
 
@@ -998,18 +1304,28 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v3/configuration/setup-assistant", wrapper.SaveSetupAssistantConfig)
 	router.GET(baseURL+"/api/v3/configuration/shared-storage/:audience/:platform", wrapper.GetSharedStorage)
 	router.GET(baseURL+"/api/v3/configuration/variables/:audience/:platform", wrapper.GetVariables)
+	router.GET(baseURL+"/api/v3/job-templates", wrapper.FetchJobTemplates)
+	router.POST(baseURL+"/api/v3/job-templates", wrapper.CreateJobTemplate)
+	router.DELETE(baseURL+"/api/v3/job-templates/:template_id", wrapper.DeleteJobTemplate)
+	router.GET(baseURL+"/api/v3/job-templates/:template_id", wrapper.FetchJobTemplate)
+	router.PUT(baseURL+"/api/v3/job-templates/:template_id", wrapper.UpdateJobTemplate)
 	router.POST(baseURL+"/api/v3/jobs", wrapper.SubmitJob)
 	router.POST(baseURL+"/api/v3/jobs/check", wrapper.SubmitJobCheck)
+	router.POST(baseURL+"/api/v3/jobs/import", wrapper.ImportJobArchive)
 	router.GET(baseURL+"/api/v3/jobs/last-rendered", wrapper.FetchGlobalLastRenderedInfo)
+	router.GET(baseURL+"/api/v3/jobs/last-rendered-queue", wrapper.FetchLastRenderedQueueInfo)
 	router.DELETE(baseURL+"/api/v3/jobs/mass-delete", wrapper.DeleteJobMass)
 	router.POST(baseURL+"/api/v3/jobs/query", wrapper.QueryJobs)
 	router.GET(baseURL+"/api/v3/jobs/type/:typeName", wrapper.GetJobType)
 	router.GET(baseURL+"/api/v3/jobs/types", wrapper.GetJobTypes)
 	router.DELETE(baseURL+"/api/v3/jobs/:job_id", wrapper.DeleteJob)
 	router.GET(baseURL+"/api/v3/jobs/:job_id", wrapper.FetchJob)
+	router.GET(baseURL+"/api/v3/jobs/:job_id/archive", wrapper.ExportJobArchive)
 	router.DELETE(baseURL+"/api/v3/jobs/:job_id/blocklist", wrapper.RemoveJobBlocklist)
 	router.GET(baseURL+"/api/v3/jobs/:job_id/blocklist", wrapper.FetchJobBlocklist)
 	router.GET(baseURL+"/api/v3/jobs/:job_id/last-rendered", wrapper.FetchJobLastRenderedInfo)
+	router.GET(baseURL+"/api/v3/jobs/:job_id/last-rendered/contact-sheet", wrapper.FetchJobLastRenderedContactSheet)
+	router.GET(baseURL+"/api/v3/jobs/:job_id/last-rendered/history", wrapper.FetchJobLastRenderedHistory)
 	router.POST(baseURL+"/api/v3/jobs/:job_id/setpriority", wrapper.SetJobPriority)
 	router.POST(baseURL+"/api/v3/jobs/:job_id/setstatus", wrapper.SetJobStatus)
 	router.GET(baseURL+"/api/v3/jobs/:job_id/tasks", wrapper.FetchJobTasks)
@@ -1018,6 +1334,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v3/shaman/checkout/requirements", wrapper.ShamanCheckoutRequirements)
 	router.GET(baseURL+"/api/v3/shaman/files/:checksum/:filesize", wrapper.ShamanFileStoreCheck)
 	router.POST(baseURL+"/api/v3/shaman/files/:checksum/:filesize", wrapper.ShamanFileStore)
+	router.GET(baseURL+"/api/v3/shaman/scrub", wrapper.GetShamanScrubStatus)
+	router.POST(baseURL+"/api/v3/shaman/scrub", wrapper.StartShamanScrub)
+	router.GET(baseURL+"/api/v3/shaman/status", wrapper.GetShamanStatus)
+	router.POST(baseURL+"/api/v3/tasks/logs/search", wrapper.SearchTaskLogs)
 	router.GET(baseURL+"/api/v3/tasks/:task_id", wrapper.FetchTask)
 	router.GET(baseURL+"/api/v3/tasks/:task_id/log", wrapper.FetchTaskLogInfo)
 	router.GET(baseURL+"/api/v3/tasks/:task_id/logtail", wrapper.FetchTaskLogTail)
@@ -1026,6 +1346,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/v3/worker-mgt/tag/:tag_id", wrapper.DeleteWorkerTag)
 	router.GET(baseURL+"/api/v3/worker-mgt/tag/:tag_id", wrapper.FetchWorkerTag)
 	router.PUT(baseURL+"/api/v3/worker-mgt/tag/:tag_id", wrapper.UpdateWorkerTag)
+	router.GET(baseURL+"/api/v3/worker-mgt/tag/:tag_id/sleep-schedule", wrapper.FetchWorkerTagSleepSchedule)
+	router.POST(baseURL+"/api/v3/worker-mgt/tag/:tag_id/sleep-schedule", wrapper.SetWorkerTagSleepSchedule)
 	router.GET(baseURL+"/api/v3/worker-mgt/tags", wrapper.FetchWorkerTags)
 	router.POST(baseURL+"/api/v3/worker-mgt/tags", wrapper.CreateWorkerTag)
 	router.GET(baseURL+"/api/v3/worker-mgt/workers", wrapper.FetchWorkers)
@@ -1035,6 +1357,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v3/worker-mgt/workers/:worker_id/settags", wrapper.SetWorkerTags)
 	router.GET(baseURL+"/api/v3/worker-mgt/workers/:worker_id/sleep-schedule", wrapper.FetchWorkerSleepSchedule)
 	router.POST(baseURL+"/api/v3/worker-mgt/workers/:worker_id/sleep-schedule", wrapper.SetWorkerSleepSchedule)
+	router.POST(baseURL+"/api/v3/worker/heartbeat", wrapper.WorkerHeartbeat)
 	router.POST(baseURL+"/api/v3/worker/register-worker", wrapper.RegisterWorker)
 	router.POST(baseURL+"/api/v3/worker/sign-off", wrapper.SignOff)
 	router.POST(baseURL+"/api/v3/worker/sign-on", wrapper.SignOn)
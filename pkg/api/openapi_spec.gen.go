@@ -18,230 +18,316 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y923IbObYg+isI7hPhqtgkJUu+lNUvx+1Llartso8ld52JdoUEZoIkrEyADSBFsx2O",
-	"2B8xfzKzI+Zh9tP8QO0/msBaABKZieRFtmSVe/dDtcXMxGVhYd0vHweZLBdSMGH04OjjQGdzVlL452Ot",
-	"+Uyw/JTqC/t3znSm+MJwKQZHjaeEa0KJsf+imnBj/1YsY/yS5WSyImbOyK9SXTA1HgwHCyUXTBnOYJZM",
-	"liUVOfybG1bCP/4fxaaDo8G/7NWL23Mr23uCHww+DQdmtWCDowFViq7s3+/lxH7tftZGcTFzv58tFJeK",
-	"m1X0AheGzZjyb+Cvic8FLdMP1o+pDTXVxu1Y+J3gm3ZHVF/0L6SqeG4fTKUqqRkc4Q/D9oufhgPF/l5x",
-	"xfLB0d/8SxY4bi9hbdEWWlCKQBKvalif129hXjl5zzJjF/j4kvKCTgr2s5ycMGPscjqYc8LFrGBE43Mi",
-	"p4SSn+WE2NF0AkHmkmf4z+Y4v86ZIDN+ycSQFLzkBvDskhY8t/+tmCZG2t80I26QMXklihWptF0jWXIz",
-	"Jwg0mNzOHVCwA/w2suVsSqvCdNd1OmfEPcR1ED2XS+EWQyrNFFnatefMMFVyAfPPufYgGePw0ZjpKcIv",
-	"e0bKwvCFm4iLeiKLj2pKMwaDspwbu3Uc0a1/SgvNhl3gmjlTdtG0KOSS2E/bCyV0auw7c0beywmZU00m",
-	"jAmiq0nJjWH5mPwqqyInvFwUK5KzguFnRUHYB65xQKovNJlKhUO/l5MhoSK3BESWC17Yd7gZvxM1ok+k",
-	"LBgVsKNLWnTh83pl5lIQ9mGhmNZcAvAnjNi3K2pYbmEkVY4b9OfAYCfNowvrCmcz7KKGHfZYTGV3IS+Z",
-	"oaOcGuoGYuSOfflOtLQuxneO3h3UYNA+paf1X/YeLefUpCexFDmXdv3kGMgzLbS0GJJbir0oaMbmsgB4",
-	"sA/GAsWiEqKpHbCkoqIF4WJRGTLlzJ6pJnOe50yQ7yYso5VG8I6kGOH51/hg5GxWsJxI4bmBxc3vG2da",
-	"Q9PO/IKLiz9XxrQgkETVZ8KitK43bufBJdxxU5MJjEUmbE4vuVTdYyWPW68ueVFYlAlX6s8FEzlTdzSO",
-	"7cAarhcBclTvdAjrObfrOY8PAsZtYpxbwx2NODcmLwHaxSq6dDW95LBTQYQkhRQzpshCas0nBcN7w4U2",
-	"jOZAV0V8YriiOxHw7njqZwFh9zl+Jx7ba0PLRQGH5GYjRo4mbKQAAiwnU0VLRhQVMzYkyznP5vZg/c2h",
-	"lZElNTyDPUylpR84jM6YCN9NKkMyag+FyEumFCJT6ffuSKS2bCx9+1t8roU3TTRJcasLture2OOcCcOn",
-	"nKlwZR3kh6SstLHLrQT/e4X8w9Ha945/JcmDvd1UzRIs7LFYEfbBKEqomlWlFQw8m5gsVmP7oR6fyJK9",
-	"RgKx+u57YqGKN9dIkilGDUNUdkRkFa2h3msNqB0oPy9LlnNqWLEiitmhCIWt5mzKBbcfDC2ewfR2yiHA",
-	"RFbGrYgqw7OqoCrcsx4yrquJl3rWCUsJ+eLEfRk49M4jnLrPLzncoiuM8Ff7JS+s3NRGSotjbmVbCkwn",
-	"NShaclM1GdknCHHEuUC+nlRKMWGKFZFWwqF+XEDiSMbRY3L+0+OTn549PXt+/OLZ2evHpz+do/yec8Uy",
-	"I9WKLKiZk38l5+8Ge/8C/3s3OCd0sbDX391FJqrS7m/KC3Zm37f3jSv/T/jZyZpzqucsP6vf/C1xR/rO",
-	"pSv6OAhEu48uJgp2VJPjp/7KwLYjAj4mv0gimLZSgDaqykylmCbfgWCnhyTnmZ2KKs7094QqRnS1WEhl",
-	"2lt3ix9amf/wwG66kNQMhoDX224yQp0Gq/fIOEwJvZ49NznYufvm/IjQYklXSNPH5LzmV+dHiB7wtSNd",
-	"b49RBAeAOsFNke8KfsEI9UAjNM9HUnw/JudLNkkNs2STmhsC1pVU0BmzRA1pvZAGibqbxTO293IyJuco",
-	"S5wfEcEumYKh/9TGZUca7UpRNrQvAnBA77SzC1o0aY0/rRqgONMAiI6Dy2A4WLLJxjNLY6TXXWo8QSmH",
-	"a8vI6Ywpx5gNUERaWuafUHSYoQlt6Seq5/GNBy5DjjskQBPHrQo6YQXJ5shkYRl2ZBQ88OcxObU/c418",
-	"RIr68IO0zISulOUsTqQMMn1zUns/qgVI0dSwHokOlrSbau0n2NoskFI9O1pbizg7AoXLi+Yc4llsItgW",
-	"HRJM/QXXxlMoILn9iNFFAq91X23jpw1O2LPreorUBt2Ff03N/MmcZRdvmHZabksttxJ/d/MdjWTlRQEz",
-	"twj3nZDme0enk8ISCKxpjRdlWcDIJdWo+lvMm3KR4yyexCcH1mc4bdKSgCLPnIWFOlYilaVb46TQAsws",
-	"uVIYJCx0KiuRJ9ekZaWyjRJHdCQn+EH7SBFobkVh2HjPQ3dgG478ORd5feJb4V8PwiQsJt19WKoXCxJU",
-	"a5lxapAk292cMXF5SdXAIUa/AOHNgp3zcA+IYlYHAxGbEo02KGfMAnr3gWWVYZvMlf22wEDZo8cexmm6",
-	"E32SOpZnSknV3c+PTDDFM8LsY6KYXkihWcqwmidQ/afT09cErX/EvhHE9zAQObasNCuqHM0keClWhaQ5",
-	"0RKxOgAQV9uAbVG4pXGBdkourV75xE52f/8wcJ1gW8ipoROKuuak0ivLnRiBhfpFOeYlhaFcEEruvGFG",
-	"rUaPp4apO/jqnFEwX9jlcZHzjBqmnYEKNVTDS9S37VEwHZRPxYziLB+T56CperHEDcg1CC4WTagVjj0v",
-	"v6Md37PvZgVnAswmuSRalswqhjOiGNUSrBMExCn2AS8PpwWZ0OxCTqfIMYNB14uSXWtyybSmsxTutZAL",
-	"zr1+P4VZzwtaMpHJvzKlnZHJ6fz2nzMOAujh+GD08MFolueH9/L7hz944/HR4L/JSnkONgB7jTKXfqjB",
-	"4fhwRIvFnO4PhoPUz+S7ztjfezW5Rl9YxU4SQ2MZiReiZ8074cAQrkKQ2kpGhQFZdl6VVFgE1FUJn1ls",
-	"sbevYBZzJxUvcu/5AGmJlqCGnMerOh/CWBJ4Tf0JmOLcjcOvz2fcnBP3FdyjpGDVOni/vxYogs3fQjSF",
-	"DT+j14QWxavp4Ohv66n9iRcD7Vefhm2pgGaGXwZlZo1ggJKqNsR/YaVQbwBO8ko0daQIvH0AUisvmTa0",
-	"XMQ3yoqlI/skNSZYpNmZIwgsP6MJ0eN46mweBYNpLEsPXzgJ25vJ/AqIZf1IdSxB8hTHfqqNVCh0+2sY",
-	"pMEmLVi7cp4AxNu3x089bH8Gb88GR9G2PiorUgcXVbXI0+dwGjYvp3i2+Op4y021ZRq7YH/o9bSR7yog",
-	"22+ffkM8/nMhs4uCa9MvlS+BsWvHxxQD6g4uDpaTjCngMODKRNldWn6jFyzjU5555NxKMIrX80wYtUrJ",
-	"RN2XOpL2ep8g7udsK8dgeLuHiLZOoB46dgH2kJCn7nqk/SD2V0InsjLopPDWdbyAQRjA6w/OCnzQFWv0",
-	"nJZUnGVW1JQpTSEW5k/gZeJfjkxcfgGKlfKS5YQWUszQI+htEttYnJtr6QHNC6rNG2c6Py7pjKVh9EzI",
-	"ajaPxSZgFzSSLhacZYwYOcMt5nw6Zco+wxME47H9mlAyl9qMFCuo4ZeMvH3zwssq9mbWlnxu1zMmpxKY",
-	"G5jD0Cr05sXQ/mTFKEENI+8GH62Q9mnvoxTBBKmr6ZR/YPrTuwESr+ZZ2Q+aaKmKJBVywzR0jg0O2NZR",
-	"wFTRSD1H8ZJq7TH1hBUsS/tXXwfJHP2D9tmEOYr+Xk7AtFYVeYTCFl0iIcpC+czRrLOSfhgcDQ72Dw5H",
-	"+w9G+3dP7x4e3b13dPf+v+4fHO3vd4Wf7tcdz0FR4EJQy2SKxSTXLmwqFaivnq/WvKl1+Xagz0mQMkOt",
-	"CA/sP8/BK0CL100S1mW8jc2oCTeKqhUp3WAeocfkpd2Gpa4F+xDba53wXkq7CzCsVFYnIed0PBln55as",
-	"13fI4uoFW7XOaKEk7ONocLJQ3DDyXPHZ3OrRlWZqzErKC7vq1UQx8f9OnG1Bqpl/w8nDJ/ACOTH/539f",
-	"smLQA6fXLrTiCZjgumQ+DiYp6QdeWr347v7+cFBygX/tdxWD1jUIg/Tg/0lkVksfllEV6/k2yApeZwcG",
-	"jLYFkdljwPiQBaCVxSrK8ccFtSrfYDj4e8UqfA2+GAU5aoD7YBVDm3plYT0KNKnpwqvxKCyrD6qogqWt",
-	"NPgs8gU7tRhtpF9EXGozDC+6uGX1nZKRqpdNuIfAJ4Ll3nthg0hpr0elwWSOLM6+hfyA5WTKC6aR6QqW",
-	"WU1RrVIEvMXgzlJS550nnrseP70TqfYgunllus2I43CPMXnMrSYkcKX+kxTT9iYDJyR45j1Vsgxb71OV",
-	"UoA+pfpCn1RlSdUqFahULgo+5SwnhZMeMVjFQ31MnqBJAs0e8LD2ddif/CExalUBqi+6Ag18tbW1DcLF",
-	"3IK3MPT2MkL9/1UM9xwzLV5arfv+cFBGRL2PTH4aDiCE5myygjAzx67ABeYQ/Tf/rzMuGgQj0AFHIn7r",
-	"skBcy8ea+t1Nm0U+m/s854WxCnnNfYael7w4/suzmpUkHetyOtWsudD91EJrUH3cIchMb0mv+3YU+2p2",
-	"2VV0au1b8YaZSgl0zYEEAkIz9dSTO3EDtrCLrhQFQbaRuh+B+7wTgPrb3ik0ZVzxLjnd/YkUUz6rFPUC",
-	"ZXM9XD/nSps3lVinsKAhwTI9jtK5pXVT+2FtvHTzEVUJXfvxQkARSEKUTNmSTKmlmnpInCtXSDGCqDur",
-	"MGTxeoEfWFnR67/BvTex7JiwcmEs9bVvmTlbOelX3DFkwnpDOoDkY3BWvpWaBqswigo9ZYo8fn0McQne",
-	"vZX2wWjkhi9kRtOi/NPAPYA1WcZjLwXM5T4eb7RFtGdp724YH/AaLPkrVdy7oNoIcmaWckkTbOiVYKMl",
-	"XZFL9zE6XSEqT2oDPgxp76OL/4KIBQ4BXIpBZF9pDxx45PlHK7J+One6IFcYcealhznEeDjBgBIf2h0c",
-	"bdS7RcjpUibWBJZMN2ne8fUHQYW55S8KaqziMQrmFYy5BM7uBpmswqL7EA0+2mzNcFbQGtD+yy3O63GV",
-	"cyaaDitnSHK6gE6Kp61h9DoutY5CtdGnw8Ne0sXCwhhO2R8KsVuGOC4TosM4hlgnNrz6C2OLN5UQyaDt",
-	"4+BSWUYXF2FASroiF4wtLFESXn5LSztlZ57ugdYye48AjsL+m6A7rFmtd1fFon1tvQ3K4dLh9bHxmj0I",
-	"z3NGzvGR5U7snNitOGNzHDeM18dOAvCeSftfwT4YF6mBRPrc8urzITlvAuGcvHx7cmqV2XMIyDvfKtqx",
-	"BcgAtT4YpbA8+GyPvdO9pZI6B/f6i9VyySaGv/EYgq/m6gelheWbOYrz1G/noH/DZpZtK5Yj/e1Ckua5",
-	"YlrvmL7i6G/6psmpWVLF1lzDTVTr13BzUK4LYTBnwZqsdxOHPysBxjEAD6o4CcYDYjjIMI4SVjiIoNCz",
-	"+tRpnbCsUtysgv++RQG3deSu8+CeMFMtHmvNtaHCoPCZCn2IhTw5sbKdV5dB7rKjkDBMl1o7m9cziI2g",
-	"WwTH9geDfC1BrbuFJDxBnHvS61Q4YaD+O7uJ8xJwRU5+enxw/wFee12VQ6L5PyDYdLIyTKNA5mLYSeEW",
-	"5YMqugaOln0SZgOPLJKfQR12PZ5JFEIHR4PD+5P9e4/uZgcPJ/uHh4f53enk3v1ptv/wh0f07kFG9x9M",
-	"7uYP7u3nB/cfPHr4w/7kh/2HObu/fy9/uH/wiO3bgfg/2ODo7r2De+DSxdkKOZtxMYunenA4eXiQPTic",
-	"PLp3cG+a3z2cPDp8uD+dPNjff/Bo/4f97JDevf/w7sNsekjze/cOHhzen9z94WH2gP7w6P7+w0f1VAcP",
-	"P3V1fg+R10lqa3+NpEevCDl+HUfC+3F8sktwgzgXSNsaBTSc6qAUoXs2mmRMjgXB/BjnVtfeBeLGgnkt",
-	"B3hfafSgvAvbIcdP3w3QLuS14+DbD1EoFFcButq5M7mMdFHN9iBpYmSp1x4mHoyOn573RFo6lNlS8cW1",
-	"P+cFO1mwbKMOjIMPm8e0+TbV3D9lgrXP0KDWOpVUJuAV0MN5kNuIAYqzA33tRjNzKpyDsunkp7oxKHhQ",
-	"XIQs9ekg9TUmp5F08fnIt0Xsx5ZHEo66S+CcCka91EWR8jpa5RYd0eG0pNjyuct6PDRl1CMGp2kyA4wm",
-	"VtgktfGYyTGAznzsWsZYk0YPNvpU7GrceMN+YbcJ4F+5mdf+kq1A7ZXwzDsWk6AfOjF1SHK2YCKHDFoB",
-	"Gh6KM9/42Wwre0bH0eOK6ZxqbLVed7wdN1glLoRcCghSKSTNUR/DOJ+kWQAHe4Orgawvp6ddWfAAQaMB",
-	"u15Z4pqEhhsREG6AvfUffvO8MDA1zdXwtEDMpkRFn3mWMoyP0tkmZPO6M3Vp5Y7nvGBRsBIgmuUk7jX7",
-	"G/vggnWDXB8HBd8UDtQXM9yH60GLeKJw3b4wrkTk+3OxBqsdNAlH26GL578rz/1ShHAt0VMsP9mkubVZ",
-	"iYbPao5FcysUO50uCqajzqpK3lX7+wcPgj3YSWeVtpjfMTQb6QZMzIXCVLgHToC6o5vujpSnm0YW3h0s",
-	"scEw/Gk4KCIA7WhruQFXSevUi1pDDltvGEKaa0pih8wumDl+9bOcvAXfbzJlVTMTSnwMibZStrxkiviv",
-	"vbMBkvrAZqkxtlqwJfgXh1YdYpdcVvoMcfU8RDB50pc60X/62F5v92sO9Ast4wzidL56A9w7+W7jKKaQ",
-	"zXo/6RFXbKqYnp+FAIi1NvwozcJp/O57DL3A3dzRGIRRO0YB4TAbVWsXHae9Ewr+BAcnzeaQNXLJ84pi",
-	"JAdZwiwzJphCu74kJRUrP4grKbJQNINSB71+0N2B2F8AaNfY661xbkn1mYsH7Km0g1c0mDjcy/UdsRfd",
-	"SOfkaPg9HMG3L0PUAJSh4PmdupCIZmboJZc6OBjczls5Q3hPhLirWRRVNWoi3TqyFkft9tE3h6NS1Tia",
-	"CK8NiSUegG6l6ezPLSO5zbwqJwIiFDdiVjoAOZUXWsd647/CJOsgZal8f62iEybAjRsIPt5iDekxezr6",
-	"9pywS7DCQCUJI10GuReTozftQwtMdxXH5IkfExPfZ8zEz9H2Br4+e7H9BfZ/F3KmMa5BMOaSARcFz7gp",
-	"Vn7aCUOuBJ51+2g1DBvJqAuHCe/aMaTAOLXvjIT1NKaeepR5Lyffg/JmX7ev3NF2PQS8lvayplibXGyU",
-	"+hJH88r7LretlZEaxGcYe09MP5fCFDgjm1DZI5Wof7CS2ngzL2shqlysK6mxfuuR2h6WAeGm9V9Jjb0P",
-	"FAlaSQ254CJ3t35rGISg2qL4WU4gv6Uofg1BBo5XU31RyBk+jK91/Popnem1uzil+uKFnPVRtVN3KUg2",
-	"r8SFE9og/CPcYSVlSXKGHDrHhy4l1C4Rbi+9lDy3H+cIhCb7TOG13VnXiWUXEZDKLW1MXtJVSAgtq8Lw",
-	"BWRZCoaWefbBJF3DnratRd1TdP7thpU11bTbWIeZdvhtJOZTgGS/yAzA6MjMLgr1akJznL+3s1y6HdiG",
-	"u3C5zTKsc9R+rhDbLMN4lW+uSzZLiTqBVTuf9trkuDWYGOhFHzriC+SUzvoRkZuAhAmMckVNNscJnNKt",
-	"BVEoZ7KlILpZeOwr9dEE0jYXFt9cd2VdwNQaeGVUnFkpmCqzIVTUnY2VPmhl5Mh9lQ5gcVBKKsFeV0IZ",
-	"hpk6zt4t18J6x2ShzdTChbJsQS8gG0ozljI90To2lut4vfZ9X8whqray3do3k52lX/3nEp5OkMxnfHWW",
-	"hSyYbT9uhIldr4rZn5ufInH9lK1ObI/vSvIGx8nqybpNdURFVODIyDqZpGlB3yYt4vOzx9yDw9//O/nP",
-	"f/v933//j9//5+///p//9vv/+v0/fv8fsToLhpU4RQBmAa/b0WAPldA9Pd17LycawwjuHhyO4SVw41fi",
-	"4gzt6ofRIb7+5Ud7pgs9ODq4NxxArUc9OBrcHd3dx3JuZ4DjbKlDCUHQc7HEG/tgmHBFH8YLF5poV3Im",
-	"KxNKuDTWh1OEFe6ld+5q0XXGU1KateO5AoNY2eystsQOCi6qDxGqQtT0yB2VU+K7yZkxEmxQvENu5LZV",
-	"pDeYzOKz3mRN8q/WsVdb2aDqzLMeqHXC01G3EjOiV9qwss4Ndt+2qo1BRlomZ4Jr1jXvu5fr9FpKCrlk",
-	"apRRzULYjJvCL8qlOLzDA303GJJ3gyUXuVxq/COnaskF/lsumJjo3P7BTDYmJ2EqWS6o4aEy9I/yjibn",
-	"qhKghv/46tXJ+Z+IqgQ5h/heWZCcawOpYRBQb5V8GjLFfFHWsEjL7R9r7/+gBbE7Gjb2Qd4N0OSh3g18",
-	"cIorcI0GZy/CQ6m7hYLUearJu0HT2+HHezeoYV9KbYoVWlUuGDFMm72cTaqZq6CnCaOaQ606ZwzxKYQY",
-	"Pc0zkssMapRCTYSiaOwsqWv1mTHtD2fbl7sbkkwueOzgPG8XPRvb0c5DCdRuwbxT91ed92+JN8sJd7Y5",
-	"tEXmkmlxx5CSmgwrAdDMVLQII3UCw06x9CpYrnS7jh7gkSzyKAerWTK7XcYwlEz2JsJ34rixQCumlcin",
-	"hnWsBpROWi2o1q1auZ3KD0mgO0nSitVglHK3z5fEqhM1I/n7+GlIDXFmXWerQPclNSQUHZwwYklMXhV4",
-	"/e1SMGgFzMSYXSRVtDGLXb5Qh0VD/0VYSdObspV+7gSKrkk4QeRSwkS6DcKpN0pg4wPIr9Leg+vDxXyF",
-	"qyHhYzb2ufkhTSNK0xnvppF/yeYJ11FfB7M7zyarM58ts0ueq9MQEmvd0nqwg6EBdAwjK4unG2RfVOXE",
-	"Kmgb9v/yus6Cy3vZTdP4+r0lrqusjyc9u5z4tqWA2naQVFuLuHlFuEwb+lg4A+nGWjbg8JGuh0Vk//ws",
-	"z046Os4SGgjwallCh42Iry6mRAbPjTNXqkhP/PbNi9jrXc9OuNGsmIZIWrkUhaT5Nhkwtb00nCKWh4H9",
-	"953KZxShCDnnWk7NqF2bImUvrye8TeUl4lt9hfoScQWBrk5caUNYtxBRje5YGks2aj/XnncQf7vYv6O1",
-	"9zYRw6uaaLekSH6mvpNa56PBZyHKARK/vSgnHZVGVQwxz4VZgbsTKBacGJSyRFEPG2FYyT6cHkQYygUm",
-	"rP6JSGciab3AZwICX74D+Ub6jN9zT2+d80FIQ5iiLrMyVP5rS+12Wd9v8k50c6QLLlzbAhc5ApH8dzTJ",
-	"Qm18THDmcaUvINfk1SVTS8UNQ1mey0qDLVREBQp9SaKk+JDyXL2QM+eRCjQAnWNeKvYl9e2i4VRgQkZV",
-	"wXuKGJsGCdyBSiSRq84mTOoDikFaRMZAJwTlnQvMCsdxEsHm6xIRP48KrLlkftLUJar3uF2BS2ccDSVW",
-	"Oon6i7Nojy3J4DVxzzpG7rUBgdsZVPrH+vzESkNT7UlOKVIKz/drpwM0jChZOUE83Uqkb7h5ugtA7Wqb",
-	"AfTFdiQ3OqpGaGNUKDWZ0/npt2Gi2lqXHXpqW6PZi21KT3Yvza7KURtH10co+9H7bwfmF0cRErXF29mi",
-	"3S+j4PZIWFE1yxQDTilHQpqRYUUxomIlBYszaY8Gh+ODPtgf/c0nbFrJbVou2Mx1ExnV7SQGw0HJdZYo",
-	"GnTFVGe38I9f/ma15TOcqRlom5rCIXP/kZ3wmXjVPqyG79BZ5t0BPn59DE23opM4q4sz6yWdzZgaVfya",
-	"Dqbl1ez6J/vLOndWe/3H5AlJ+mQ6K1pzSgVjixNn+0r4ju3jYBvz4fGoRvpKKycWZhAhxkSOrs4g3/iw",
-	"5FBhLKerpp4WxrYEGxSlMXm8WBScOXcvunql/ZCD3eo8pyt9JqdnS8YuziHdDN5p/m5f9uGZiRWCTCjI",
-	"wb3RXFaK/PTT0cuXdcEp7MtSo2088uBoUEpiKgJx/BAWlZ+B1H00uPvD0f4+Fk1wSp+rfgV45d/af5Qs",
-	"qdmcpJuTRzM20mxBFQY0LeWoYNAJx5dWdVC3fNmOBQSdsYseMJPv3g1KiR4HU3lnw/dj8gysnSWjQpN3",
-	"A3bJ1MqO5wuodnsLhv1HohMAtKfyhQfNx3QscgDU5uHaPDaMPWxCszFutOI198JQw/p0aufLVnF5l+19",
-	"4UmNOBpsq0XlLcIaMo7okl6wLnJdxWm/fRpO47s4gNFCHZMNcV3DAdWWpNhDgOITw4Fh2r0ip1OrjIBx",
-	"oO0yrxGoPzYgUQgOEx+QbNWKpyuyU6ekQjSOi0JL2Ab0WUH/sVqf9tKs3+P8E6jNxV3qgFzVHhaUVmoN",
-	"0Cm8mky54Hre11dw+AXPcxj2t+Zk+6wxf6aaZ2sEz/FnRA8td4ke2sWI/lUCdb5UhZovFkazTbOJkCPU",
-	"0qxUqOl0BTvT9tExtT6WUvxihYU8RmclFcEUVKxcWsfKSxt0RriJHPdQwBNsG+PgGnRm4oUVGOS0jtu1",
-	"6ifR3P5NBQPjS1dK6GhkjVL+duhckh9fvyUYuBGsPM+e/fXZs3HdvuTH129H8FtCSGh23t05ps3Q2Zg8",
-	"cT1NnTezVQ2XuiBdNNy7lH8KbnZFRS5LAgMGE5Frs76Vx3Nb28kG3eKUzrYk/TW1D0igO3YCtwOLCM0T",
-	"NXR2xnPQLe4d3j3IH/yQjRh9kI/u3X/wYPRoMn0wYo+m+48m7N4PGZsk1IowQiTqb86cWSf6+xHXQser",
-	"+Z3F7KrCJ40hn9ZMjUaS7SxZzVLBH6/qkEpnCSWMJKfoBg+nHbGpT6hlQ1ksqw6Vsd3jjFapAhVvNVNQ",
-	"wNDlXzqWcfx0SBZU66VUeei2A2q1q1Np9R9vv6zNGhb1ADDA2SxfrXc6N2Yx+PQJms+hww8SCzITGUAC",
-	"rT5ltHSuKvxSH+3tTX24IJd73eKMmKNBnlNVupQmSNkdDAcFz5irIhCI04vLw874y+VyPBPVWKrZnvtG",
-	"780WxehwvD9mYjw3Jdad56ZorLYMXZpqZf/ueH8MCpJcMEEXHCwy9iesgwEns0cXfO/ycC9rl7WdoaEk",
-	"1EE8zqElmWnWvwUZE0oQwGgH+/seqkzA99TqoJiBvPfeedAQb7dMwG7OB4fXBLqwWF2EUgiIgl7QsivG",
-	"6JlmhbRppzsjXuq/QdAfEKB6jGciX0ju0ktnrjt3Z8BOIrCFfBK8exDKs+fNLH3Afs5F/udQ1Ow1Vi65",
-	"NnCnewMm4P1cVqKucQbqcejGCC+7wMYvtC4srpdYx0novra0Ev9SSTEbt07/OXcJd1KRUipGnrw49r0A",
-	"0VkDcW+aLClEzIEM5beTQoqF1ImTggJYiaMC3vlnma++GDRahTwTYPFdEKVyvj6IPMLilRKDyDAn+/rx",
-	"qFEYsLvSX5oXd4iLxDA3ONIpF+z24dRfacHB4UpjbLoKMrXw1HltL+vxfU/m+iA3EhUskzGKAoHXoGyj",
-	"7MdXxdrXN4af/xSIidVRaoxsFk/ZwO52GKcXGTE1YUsp4jlWD/usI9+hx82nYWOsFS2L5lhtuXgTgrQP",
-	"4g30Gb1kacGjKyesPY3HWca0Dv1HE9X8E0OGYHAhDcGN3QGf/qsFE49fH/s8+aKQS9eJ0jdV33OSpDvQ",
-	"c7Kg2YU97Hei/7g1M9ViRH192X6yc0IvWbKk7fUQnuRUSaYZg9XSbnqJ6N1CynuJZLEWMkAE+pJN6GLh",
-	"jSS5VZGmVVHUpUyMq3Rt5crbR0re1iFFPaWVsOKtszpBP1QBO1yRaSUyvInQs2sDeluESGF2b+Xifhxs",
-	"cL69j77a0ae9j94J+2kdSWoww2bTZquAcws7Vz7QqXBRPaVacXaOql1UnG6NKavFJyaMnMn9E7ap12/X",
-	"yEzTdcN2p5heS2sV+Soa9cbihr2NSmP2S2cS8IXGLHKGKmNo+99Rv1u3nEYbqt7iY/2oGpKgdsfSusPE",
-	"f2HoFTagPwM568p0bfMBeauxg4l9zQvtNM9HyEzWZMEhGQ3NKdgEM76mFLp/WsaRSh4hE6rr6sETJZe6",
-	"kQ52dYyv97g7jvtWTD2cH5JvsKLZtbD6Rr/q7iH/LCeuPkvJTQc9r1PjWLMgcItVVsJD3umyxKyo5sJb",
-	"ozplGqB97+7B9csIp4GihnQ4ZugMsuZcl3yfNtd8IZk0xzWkbRYrkles1Uk/o9ncI18YCu6DlKSwosk7",
-	"caPiETwgviVDkxIgjjnPDvQskKpzR7CsFCTUxbIP9hVrDPdzM4eQuUvZuVSo2m9xtUCv/br3K4uWsO56",
-	"3Uun6e94IUK2p6Wi2LJxbgXKX16dYnalq9Po0hfq9Dwzl9Vs/l8X6o9yoQCtNlwnwP6wbzsSmNKggtuS",
-	"2xM3tXeWJ65Zo6Jfv1memWz+YyEntFGXC1LIrpeL9FX320KgGaav3KkvVujToeH2ULFKNg/vkYug5Thk",
-	"EzN1yXRfcUS94fheQdcabKRaZyHNANA9y2mdX0m1HmH5mroubPcAoS04cz3Cr4la9nYgT9o+mz3Im73G",
-	"sPe3dGV5xlcmrRp7h8fEtaSQz2pviq+L6yjigxuhiIrhmoSMOpzXhNCdy/jWUKuXVF3gSmOQDWtp3HfV",
-	"zBQ3THG6AeNhPCiVt9OgyAO8tFAnXGEBA8sUAFU8JXRFMaGElj1x+3vZPPQuyYVBF0qi7XHOwrsh5X1C",
-	"s4uZkpXIx+/ELxLmc5VRz9td7c9JUFUh7Ml+xXJSLUBWEoYrcO1LkfuyICVF9ESvXQc82L9lJSvCPixY",
-	"ZoZY3YFxRc7r9sTndSK7dr1frJJW4J6oINTN2rJtAjH5u2+bnJa5oCmtK994TQTEdW5OmfDajUWapGLG",
-	"zPimNZxGl95+lgRQjTwrLk4MK0NARRU+tcgMIgyQAtccFz68PaQAhIBQAsYCfjvuVvdRnkLrZggUEznR",
-	"EgJ8uzzNim97H+1/f6ElW2sachVStjIM+QFvjZ2mXeelV8XAZ205xOVSBIHXwhSaoQZIbDifKNc/alwQ",
-	"ysokz0VvcRp6cINAS1q3wkthNzoBwAiV8R1UqYCkbg3EeqrAUMJ4XRB+xAizT1vJalthdagv0I/Tm2Lg",
-	"fttGnHqKJCiiY4Exhbo+RvHZzEqrN0u03grkiCwnkBnQ9U1iQGfESVEFGBIusqLKUTly5fWxz7RVB+QM",
-	"m92gyu1qJYVBLLv2Qfod8YD8IkODRx3iXV2ZSfLdipnvmwbLgFn9+tdXxYgbMQ1yX7m9zXRaCtJ7OdnC",
-	"zIQfiZxEOXx993FvUsjsoggZrumb+YaV8tLezD+Ht2/yQK5F4qq3klJYqoXF3++WrvYr1sdYLdj3rkGE",
-	"AohExcsCHLf0JPu7SbOMLaA8FhNGceaMWkBW3CS3jajYRYXVun5Y9s5HINj1fn8dvLq+i74WucCWsgbB",
-	"rGo1kwbhGdWggtt/m1ABaRSYgJrJ8HVrM78HQJNcQjCt03HDlnVzh+ulDoyQCagWd+Holzp2sfa1bW9o",
-	"6vsWkPIPblJsHvUVzIvJQUMRl/UIpJmJyxX1+GZAE3hd1wT6g7NIvxOX09vj6hBsSTxsrmiy9BOFvCOq",
-	"A2NEK+XBQV85LpcCHJbgI+Hw+xBH+5WJ5hpkDZJAvQUHhma8y0YErbMj16HnSahd9cdGzkYJtx7UbCYY",
-	"Q3SGMzNfCU1PGsNdBUmbC3KYCp6rcNg+q1mHBpJB8v+DoHFzk7sgcWgat5Y9n8Jb3wZPhr2EfL60rIgw",
-	"5kzHpdR0R/K5ZWIhdeuGAnDQza9edQMbtpH30jtOI9FyTs0I2vw5/+Aol704FWxOv86p+dV+dGyefisC",
-	"n/dI9sl5P8dNMhM2CIt8kQyFLfR9Jri36UAiNI4CkQi+qrSP1sBaokOwMxVy5qLgeuUxMBm5hm/1LPVw",
-	"aFiC+oUiuL9ykknhcwKKlZ+CaxJO23sffLV67MqPgqesTI9R6svAIsZV7MC655ux72EB3DVMG95/Uvdu",
-	"v5Z4n+YkKS9U3LHcx2gA4G7U+dRcaL8Hyr8BTNrVGI7d4civ9x9dP7EMK6GFYjRfuWLiTmC4d6O+dzw9",
-	"CEETMwhkJee6BdG6rfl5dE0Q5Xk2J1I48/6NsZuqxW5aRAoKmTNCSc4Vy4xU7vrrVVlwcRGiCyyCOghg",
-	"fJlBouKAUlnRpSgi6xv2IUdq4Ro0uxrvGS2KcMHrSL6afiBQ29kPbkGU6PgywWJCzX/AFMXoWpoRN5/f",
-	"lnLEJ3utVKTZUN+VTtmSoHwFWpLs/59ab+gIBr09JIjz8UEM41pi9h3XMN+5Um7VlbGw1oR6tI5hAMv1",
-	"CT8LqYx2F79mvG5jGxH+MWacUR+tGNhGe8DQ4txHQIIlSOEqarID72pjBYSwhO4tgWH3PsJMuio/7X2E",
-	"X/g/1jjU43b6UjEfWtuSAVsI8dPjg/sPiJ/HY4adDIqndgVG/+pOfvhhZ96oXDzkBMaV4hOz+t1vM2so",
-	"v33diUQlFSfgxUeYu1pbWxkib9UlisuYQfFtzNIUcVd/ROamgBndl3XEO2DkPzcyDlNGFUdUeLOhPHc1",
-	"o9iUKcfBA6cGaADPfzc42P/h3SAgVh1XB0oF+PdMpYQX6evt6SDHYVglknjHwRsHjpF4tNASx9CyZFIw",
-	"wgoN49T1y1PLBGwBAM4ZxZICDoT//winGT2hYvTU7nP0FgYYJGAYdcdMwVAqPuOCFjCnHR9a92CB9ELG",
-	"BdWdvGDVuKhfFbac9WEAuG+n5PkaWIJQDm9AW6oZx5j0TXt75RY2eu4WNtgYq7SNPCMzw8xIG8Vo2aQQ",
-	"QVOfcGHv93BzYvgTnEPH+H81u6IXQ7smxYP9Hza97tCxgYiO5GB878PkCMp9btUBDMOdMLNkDtkdOKNo",
-	"oKC1u3AQWAC2LlEduhNEZ4/LoOzcT3QhwkvsU6fX31p/A+ub4xDPx67KKZkw+2GYf7Jq3DuUKM57r9AR",
-	"gfayroIhUJdGdPINZ1Ns4EDAGVw+RT/fIc143cZDuJ9TqTI+KVYkK6Rr4vDT6elrkkkhMJDdN0eSUGjS",
-	"EV5XbVM3zosR9oFmhmhaMidJGukbqZFcVlbIww/0+J3wp4qphnib6lqDiRMgE5mvellpnNNup6i1iy5Y",
-	"YskRrIt7H13vmk/rDdCuH/YWYZehFc7tNBC6kvtJxwmWVBVTeUsty82mTGvMdokv1pz8nuv4sf70fQ+p",
-	"bwUJ/H7W4QJ0hfL40BPQ1JaY4MM51URAIxSyYuZ2oVMcgdBpwIWR2iXDrATc+wYHmKsE0wo78EOONyCe",
-	"gdbCWyDfqX3x9iCfYR/M3qKgXOxYWee0DZxvBa+iuCiqDZmypesYFCHZHY3b3oJ6xZ+E8XwXorVYtV1Q",
-	"QNRU6Eax6stbIDut3b75uABkgd9AYAB27Ar5YGCGZ9Mpy4wXa6ELL45ANVmyomhnx9lvGXWVLuZVSYXG",
-	"GGgQTsGFfMlpt/pGXcra3hEobO9vFAY0wsWq79U54UIbRtu5ZFF58N6SLqGQ97WxdJ+O4ae6chnVkNfR",
-	"aNBdl0JZX3YEVTsdGk5jpzRvAjYutT3kMdJ6uoSEjscwKmdmz9CZPYnZdtkkdUXmbRVxQ2d1YsdtjsCO",
-	"S+5DiXK4DJXAYs260W45hKnb3aFt346hITW+PsYazBtCtteA9cshclRNO03Go80nUDgI/fFrvXvdhu/N",
-	"vgDbW1QJmGIJtiZQvzx33AhPl03bAtgVDVoW01y3ynCdsELH7cnsdKXvqECvPNTJ2wZZGog2dNuENiUu",
-	"HZs2cbOPkG2IdQsHpm/kmr3oyVeoG8nr8ZpswmX8Wv89S1eoBSf+V78AuyH+DVI66Lpfh7KgPdTHtUCT",
-	"Dh1cFkOiZW3vy2hROEPfhZBLCMN6+/b46e25hCGAQ7DlrtcPJZEm6qVvW9SNcdOFu4Hb1nfV/gJWfL/W",
-	"TXdNbwUjlwzhP/WibsNhkCpj3wXe3kfX22EH0WsrlTIMe/3pvJ16zw53Ao9ysXy3U+Lz2tLS9RE8Nnjz",
-	"M1mWoekw+DAzCLkFB4qr0VobUJahjQsX5Ny1EDsH5Qo9gM2XMOTC9S8aWia+INyQKVfajMljsUKLDL4W",
-	"twqJhvE+QyDrVejRdTW586vi1JcmBWs47rZpwcvQN2wbeYXkzECb/HDE3q673c3fxqrkdP5uM62bPrrr",
-	"EiKSDcJug7HpltiBehFwO2uQx+idkNIL1L2GzoY8/U2gYaepVw8OdmV0cvxUN0wItd/V9wAncvrPiaNR",
-	"RXQLKYSGnvNFsID9ujt+FowtRjrqGryJyzXbDH9LLK+5s22ackBQS6Ov8rqkZBYLdUKmvrydKLiBcn1V",
-	"jLg2TroJGXyOcfsUr2yZCn2dv6pd6oq0yQpwUnnLWqMfbgLNW24M7J3HlO/4v0Z+wxeDvH195/8m6ue3",
-	"zvokiV/9jZpmPCRY3i+ud9wptydGzC+/YV7pKAodGa0+Esvy6i91AqmsvjeS0+ka0YvPxKvpdCsXzO2D",
-	"petwCSS20dvyb9Aus1XiM9J5qSZ1e+61AH9CiwKjFb11xkhSODecL9MJ5jszZ6s7ipEZlFJxw497T0Vs",
-	"OBRxrVfbTdF/qUtmaE4N/QrG1rhZ/R/iSm+Nho8rM2fCQFS87zNnscGHUvZZCz4bJzEQ2UiYweXgyohT",
-	"8frAkxhrXCJsUjCOTm3wtZEDVuq1mxDE0SuQCkn6v7jdWLU7hvgMr9DlX2HWhFj1AKEXFUb4Zt5PwjqH",
-	"lQ+u2+YTJkppLbX/Qgc83VlC/QNTHkfV3bl5ezKEJWTBuKAJzSzZKFiOtQkxccpRlFEzJsqjC/hWuagT",
-	"dhyVYWpUyIwWQOBoob80Vbtkjd1UKfcSBAet4bNOHndx49dXH9YZ3nvDuqHcWtSupI9c/SJ9PdCQlhmK",
-	"ZEV2j3v7h1+wdR+iWC9ivmbKd055ygRH0uny99OmcwyhcyyPZoZfoiWWgXvU14gqCrlEX4UDi9u64rO5",
-	"IUIuXQDf4c0yGH+RqICcNHTgWSkcVoeZZZCxPpPQkt1lZuCF2/HSOvcgDeNH0Nh0mwCnvMKp0k1tkhF0",
-	"/dfFDon2t28hGNXtpO86OtmIC1yiDwy8klXDjdWNPk3dkjrHQzeb+ztM8mUptXT5XGHsurTaTRtMPpM5",
-	"NYy6+mJIzGrBM4g9dN2GQGBeKDlTTOshtCPyDRqkIlPKi0qxjRzG8xXNRN5w1Flw+9GhejRTbPNN2Svp",
-	"asRHquoPK31JV86UUolvIinlJV39hbHFG/Q4f2PqGQZ+OzGmzl6OJObI9R4xKFUJskcuGFt4V3wdAE5e",
-	"LXztI0iko1xoQgm62mOZNDhlUv73HkTuSPSg7EUra62J6zoqfT1qy8osKjNaKJlX2TpB3xLLV/Dya//u",
-	"rWAOULNq7/2CzXbNJh66bxdi9rUSkQ+2TEQG6c+l2Pq2Fffu3r3+i/aCiZmZh+I9f4o7n+U8x37XlspS",
-	"4kAwcp9gXrlb6eH1r/Q1XUG+KbRdo8r1q7p39/5NuBF0tVhIZQ/qJcs5JaerhfOYAYoRxCgvTE5CunTd",
-	"xTSO/rp38OhmOuT5+g3IKYF0SIkdkqb2YrtCcc4tbeZKGlMwV07uDyV5YJ62BXQptSGKZZi9HkrfwX5R",
-	"HoiytTkAB/sm2Y9rRwgTGmvXYQ4FSO/ulO2XdzTJ+YxpKH7bPmPyJGTPQ5zY619+BDj//PrZj8Shkh10",
-	"UVAh0nFa6wQeM6/KiaC80HsLxS45W3qyxBUW/PPUniD192IQQFRdempeqWJwNNgbREaoNrE6bgZBddpa",
-	"eUwJ7ACSVLqFMH6WE28mBRnt7xVT3KJf3a5z2GqnMG5UgdSJQR+/Pm72N4xNZLIsK4HiJhTY6LT0bztw",
-	"ExM4bHgZ1kSg1X9vd2FsxmS3Ye+KkoVfUWcycDomSr1g+nyYBfhEnfvvIBh6Lr6Xk1DRLJ7Dpet/+u3T",
-	"/w0AAP//yB9oGqUMAQA=",
+	"H4sIAAAAAAAC/+y97ZIbN7Yg+CoIzm7IniFZpdKHLfWfq5Zku3wtS1dVat2elqMIMkESrmSCDSCrRCsU",
+	"MQ+xb7I7Eftj59e+QN83mjjnAEhkJpJMllSlsvv2D7eKiY8D4ODgfJ8Pg5larVUhCmsGjz8MzGwpVhz/",
+	"+cQYuShEdsrNOfydCTPTcm2lKgaPa1+ZNIwzC//ihkkLf2sxE/JCZGy6YXYp2Fulz4UeD4aDtVZroa0U",
+	"OMtMrVa8yPDf0ooV/uP/0GI+eDz4LwcVcAcOsoOn1GHwcTiwm7UYPB5wrfkG/v5VTaG3+9lYLYuF+/1s",
+	"raXS0m6iBrKwYiG0b0G/JroXfJX+sH1MY7ktdy4H9u+EWsKKuDnvBqQsZQYf5kqvuB08ph+GzYYfhwMt",
+	"/l5KLbLB47/5RrA5bi0BtmgJjV2KtiSGalid1y9hXjX9VcwsAPjkgsucT3Pxo5qeCGsBnBbmnMhikQtm",
+	"6DtTc8bZj2rKYDSTQJClkjNh2uO8XYqCLeSFKIYslytpEc8ueC4z+G8pDLMKfjOCuUHG7GWRb1hpAEZ2",
+	"Ke2S0abh5DB3QMHW5jeRLRNzXua2DdfpUjD3keBgZqkuCwcMK43Q7BJgz4QVeiULnH8pjd+SMQ0fjZme",
+	"IvxyYJXKrVy7iWRRTQT4qOd8JnBQkUkLS6cRHfxznhsxbG+uXQoNQPM8V5cMujYBZXxuoc1SsF/VlC25",
+	"YVMhCmbK6UpaK7Ixe6vKPGNytc43LBO5oG55zsR7aWhAbs4NmytNQ/+qpkPGiwwIiFqtZQ5tpB2/KypE",
+	"nyqVC17gii543t6fVxu7VAUT79daGCMVbv5UMGhdcisy2COlM1qgPweBK6kfXYArnM2wjRow7HExV21A",
+	"XgjLRxm33A0k2B1ofCcCrY3xraN3BzUYNE/pWfUX3KPLJbfpSZg0LFMAPztG8sxzowBDMqDY65zPxFLl",
+	"uB/ivYVNAVQiNIUBV7woec5ksS4tm0sBZ2rYUmaZKNhXUzHjpaHtHaliROdf4YNVi0UuMqYK/xoAbn5d",
+	"O9NqN2Hmn2Rx/ufSWlXsRtXnBaC0qRYO8xAId9zUbIpjsalY8gupdPtY2ZNG00uZ54Ay4Ur9ORdFJvQd",
+	"Q2O7bQ3XiyE5qlY6RHgmAM8kPggct45xDoY7hnBuzF7gbueb6NKFVrDvFqYqFMtVsRCarZUxcpoLujey",
+	"MFbwDOlqEZ8YQXQn2rw7nvpJQ+scvyuewLXhq3WOh+RmY1aNpmKkcQdExuaarwTTvFiIIbtcytkSDtbf",
+	"HF5ateJWznANcwX0g4YxM1GEftPSshmHQ2HqQmhNyLTya3ck0sAzlr79jXeugTd1NEm9Vudi076xx5ko",
+	"rJxLocOVdTs/ZKvSWAC3LOTfS3o/ZBHIn39CEmyCWnO9SDxhT4oNE++t5ozrRbkShfVvFpuuN2PoaMYn",
+	"aiVeEYHYfPU1g12lm2sVm2nBrSBUdkRkMx4k1lpt1B6UX65WIpPcinzDtIChGMelZmIuCwkdhoBnOD1M",
+	"OcQ9UaV1EHFt5azMuQ73rIOMm3LquZ5tzFKCvzhxPcMLvfcIp677hcRbdIUR/gI9ZS7tpoWUgGMOsp4M",
+	"00m1FQ2+qZyO4AvtOOFcIF9PS61FYfMNU8DhcD8uInHE45gxm/zw5OSH58/Ovjv+6fnZqyenP0yIf8+k",
+	"FjOr9IatuV2y/8Ym7wYH/wX/924wYXy9huvv7qIoyhWsby5zcQbt4b5J7f+JPztec8nNUmRnVctfEnek",
+	"61zarI/bgWj10cUkxo4bdvzMXxlcdkTAx+xnxQphrMhgY8qZLbUw7Ctk7MyQZXIGU3EthfmacS2YKddr",
+	"pW1z6Q744UAW9t4RLDpX3A6GiNd9FxmhTu2p98g4TDG9/nmuv2AT12fymPH8km+Ipo/ZpHqvJo8JPbC3",
+	"I11vjokFxw11jJtmX+XyXDDuN43xLBup4usxm1yKaWqYSzGtXkPEuhUv+EIAUSNaXyhLRN3N4h+2X9V0",
+	"zCbES0wes0JcCI1D/6mJy440AqTEG0JD3ByUO2H2gud1WuNPq9pQmmkwHFT7MhgOLsV055mlMdLLLhWe",
+	"EJcjDTzkfCG0e5gtUkS+ElbohKAjLE9ISz9ws4xvPL4y7LhFAgxzr1XOpyJnsyU9sggGjEyMB/08Zqfw",
+	"szT0jqiiOvzALYvClBpeFsdSBp6+Pincj3INHTJuRQdHhyDtJ1r7CXqrBVKiZ0tqaxBnR6AIvGjOIZ3F",
+	"LoIN6JB41H+SxnoKBf1NN2K0kcBL3Vdb+GntJexYdTVFaoHuwr/idvl0KWbnr4VxUm5DLAeOv734lkSy",
+	"8ayAXQLCfVUo+7Wj00lmCRnWtMSLnwgjL7kh0R8wby6LjGbxJD45sDmjaZOaBGJ5liIASm3hUhXKjpNM",
+	"CzRNQ4qDBEDnqiyyJExGlXq2k+OIjuSEOjSPlDbNQRSGjdc8dAe248i/k0VWnXgv/OtAmITGpL2Oxx8C",
+	"fUb2gBujZpJbIsmwmjNRXFxwPXCI0c1AeLVg6zzcB6bFWgsDoDPODOmgnDIL6d17MSut2KWu7NYFBsoe",
+	"ffZ7nKY7UZfUsTzXWun2er4XhdByxgR8ZlqYtSqMSClWswSq/3B6+oqR9o9Bi8C+h4HYMTyls7zMSE1C",
+	"l2KTK54xowirwwYStLW9zXMHmixITwk6jnfFU5jsweG98OoE3QKoR6acZM1paTbwOgmGgHqg3OOlCstl",
+	"wTi781pYvRk9mVuh71DTpeCovgDwZJHJGbfCOAUVSahWrkjehqMQJgifWlgtQVf1HUqqni1xA0qDjAug",
+	"CQfm2L/ld4x796DtLJeiQLVJpphRKwGC4YJpwY1C7QRDdkq8p8sjec6mfHau5nN6MYNC17OSbW3yShjD",
+	"FyncayAXnnvVPoVZ3+V8JYqZ+ovQximZnMwP/1xIZEDvjY9G3zwcLbLs3v3swb1vvfL48eCvqtT+BRug",
+	"vkbbCz/U4N743ojn6yU/HAwHqZ/ZV62xvx58bKIvQrEXx1ADI9Eg+la/E24bwlUIXNtK8MIiL7ssV7wA",
+	"BDTlCrsBtsDtywVg7rSUeeYtH8gt8RWKIZMYqskQx1L41lRdUBXnbhz1niyknTDXC+9RkrFqHLxfX2Mr",
+	"gs4fdjSFDT+S1YTn+cv54PHftlP7E88GQq+PwyZXwGdWXgRhZgtjQJyqscz3AC7UK4CTbyWpOlIEHj7A",
+	"sHDBjeWrdXyjMm7FCL6kxkSNtDhzBEFkZzzBehzPnc4jFzgNPOmhh+Ow3bEHCBi3nuoAQXLNsauxShPT",
+	"7a9h4AbfFb0hl4mNePPm+Jnf2x/VNB4rbSjqa6MCljqYqMp1lj6H07B4Naezpabjnotq8jTZoDr0atrI",
+	"dhWQ7ZePvxAe/zlXs/NcGtvNlV/iw27cO6YFUnc0cYiMzYTGFwZNmcS7K3hvzFrM5FzOPHL2YoxieJ4X",
+	"Vm9SPFG7UYvT3m4TpPWc9TIMhtYdRLRxAtXQsQmwg4Q8c9cjbQeBXxmfgmYQjRReu04XMDADdP3RWEEf",
+	"2myNWfIVL85mwGqq0m5n5k+wMfONIxWXB0CLlQIrNQddOlkEvU6ij8a5DkvH1vzEjX3tVOffabf19VUB",
+	"99v5ss11/UvEFXiNvCNcV7hmNPiwgqA+aI8l/SCBpG1SklC5mhZc5gYu3lqLC6lKg7rk2I5ggv5pSDY5",
+	"kpPxGyvK1RR0dqcKX1/U15Ha6vVP1M/6WYbQAti+glvB3g0+AFP58eCDX9nHdwOisPWth1b1u6Pz1NUh",
+	"WHuLRclz3yWaIyxhqh57f7ziC5G+cs8LVS6WMReO3AePmNW1FDMBNiu6MZmcz4WGb7RqtEVAb8bZUhk7",
+	"0iLnVl7Q5jvWFwh9ZRiSAE/6tLqORxVBo23K+Vy+F+YTT8oPUzurHfb89EGEkTqO4gU3xhO+E5GLWdpc",
+	"/yoIemRuhm9T4RiEX9UUNbVgJ68oIlCfiCeHXT5zT+DZir8fPB4cHR7dGx0+HB3ePb177/Hd+4/vPvhv",
+	"h0ePDw/bvHS7d8sQlecECCkthBbxCw6AzZVGbYhn0ypWp0HL93juk1sqLAeJELnJLEMjE89f1V/ENh9X",
+	"W4yeSqu53rCVG8wj9Ji9gGXAdc3F+1j972TBlYJVIP0pQcRlEz6ejmcT4BKqOwS4ei42jTNaa4XreDw4",
+	"WWtpBftOy8XSDoaD0gg9Fisuc4B6M9Wi+JepU1UpvfAtnHh1gg3Yif3//78LkQ869umV89R5ihrd9osS",
+	"+yat+Hu5AjXL3cPD4WAlC/rrsC1nNq5BGKQD/1EMf6VyOUty/M7IbRjYCeZcws6Su0ewOHAdCeDVKVfs",
+	"mXJa53Mh1t7BpxSVBT04mAXleIJyIH93BkLZGTE1yRugLhl3ih+4pwDWxvu1SesXQFfB2fvJgsoEny1d",
+	"e3S0KFCPxCOK6vRJHebPTOR8c2bETDlPuDpsP+MjCJvmmjDOjJrbUbSn7JJLa/xFlUG7Ae9RVkIrvuCy",
+	"IOUKzscyVaLjBHI9YHXZgJbfgKxS0HJLHWgzzEHgb8OfIeDa2Y7lvCB0dFDIwq9qzP670AqlbsMKxRza",
+	"jnvN6cDdunu+TU0t5A/YsCXXmdvSGiiwfCei3TFsgqwwNDvDYc7gJPDPmSoLO4kdNUrjjYY7Ll3qcp1E",
+	"JpA0JbS6FB19g1zn9asoLJEeuJgBjSNfvjXSbCDZuG7USgLUg+Hg76UoRRZ6jILMOyAiIUpB9s8SCNko",
+	"PPjOnsbRdSWpqw0AdhEvUpyldev0LfLgccpMsmx9FiG3yeZ7gdOB9UvXnivdyY25j8iORfwuoWBQBMCd",
+	"LY0g6gOCCbQitgu4ZYm3FZ1/xEwYw/UmRe0aYslZSldw56n7yo6f3YkUsihwexVoU3yKnfTG7InMDNxe",
+	"hNR3SYlaXtHrRDsvcs21WoWldym4UhsNLrPmpFyteErqOAFnQzmXImO5k/nrb86YPSVFMimr8WNloYaf",
+	"/CEhWUfC19pj7NVbGEAnXwdwD/NcJ795KlZrwIWr6etC77beLlKufR79E77Gbr5xH00U8qgibfJ4u9xU",
+	"CkNdgiIWuGU4LudqyDvVhjgumDxllqYnCKk31VVW7ml9yv5r0GXR4asM4/k9oYGR74Hb3lxGv0MoxPtd",
+	"80ETXGLwZ6x8c9EX2gi61/Q+BPhMfygiheDnU/MFlZ5H2p+cVq9xDT24+8jl0T3YfhWr0Tvuo/m3UhAN",
+	"imU1uZJ28PjBcLCKZJku6eDjcIBKj7PpBqb0Uho6ErmH5xf/rzNZ1J7y8EK7x/uXtuRHsHyo+I+7aePS",
+	"Jwtd38ncCg3Xxg829CLUT8f/+rySoJLuiWo+N6IOaJLTq7bqwx6u+qYnJ9W1otjjZZ9VRafWvKCvhS11",
+	"QQ5OKHgjE849NyOdlI1L2EfjHIWSNDG7G4G7fDwAsH0u1tXftlin9W+A1Z3u9FrOjH9kEP+9taGhhDJj",
+	"9pyTegUd0bllK2UsU4WgBtQ7+xMrxKXQrhPTAp3hGTnDq0KYFIdFjc/WWs2EMSLbJnS4gS0/FwXxPBXo",
+	"QHjdIC62ILYEP7yftAS7yd3l3z0zn83EmmIenM6OJjeymIlYuAHk000Nzk44yDG4FyS+KfMBAxUw6CdT",
+	"5vm+c+NpbZ2b9ikcQ9Bv+b4kqUdIELSRfCVa73E3SCCE0lxn/t6kxd4igFZp3IDxX/ILgS77EXaOk1Nt",
+	"neb4mYmYMDd+auwhUJkK+0j3XqM1OxmerZc9hrK9PU08bp9pG8OG7YuXIiYOnZ+qYi4XpeZeKdu4w+Y7",
+	"qY193cE+ORsS2XZBtpVEXGBf59Cx8ifx10eXhalcK+saKs7mAnRgIBKZIXPetYUqRhgIJQrLZjG8KOwx",
+	"pYNJMnhcTkHqZmK1tiBaQSu7FBunQS7uWDYVnV72KM9RvEzWy3KGUFjNCzMXmj15dQwrCw65abc4Q6Lu",
+	"T2rG0+rwZ0E0RLkTiCBQaZzLdR7v5BubszRXN4wPeAuW/IVr6b0CmwhyZi/VJU/ImC8LMbrkG3bhOpMf",
+	"LAZKwRsDbmUKHncXkgMfjcSYGofdK9SwwWMw+YC2qYmzp0hNCk6vGlii272T+jnz0bbB99ErLMfs9FIl",
+	"YELnkkDqmu7XQQshHPjA7sKtHwWLN0JDYntFLz3QXYiGnXYbmJ29sdpo37PHeT0pMymKug+hs+07fbpJ",
+	"6p4aw5htLO82dqcxTpshfsHXa9hjPGV/KAyWDOeGzuBhsiT3+IJv/lWI9euyKJJxtMfBy+0yuri0B2zF",
+	"N6Qy19S90uG2mJlVa572gVYKuQ7tGmnyXgcV4RZovQdhrLerHGqCgeXS4fWx9dYxzzFM6BOwumLCYCnO",
+	"/ycO5aTrA5Pgfi8U/BekYec8T0R6Aoz/ZMgm9U2YsBdvTk7ZVLAJavgnvQLQGhsZdq1rj1JYHtxoj70f",
+	"dMOs43yOt1+shpdsYvgbd+v+Yt7XqJEU2e4XxTlP9/OZfi0W0lihRfY2GJQaNqcs08KYPTMKOPqb/AiG",
+	"hkuuxZZruItqvQ03h4TEEJlwFhx8zH6y9SflJHAPgN+qOC+B34jhYEahbQjhINqFDuhTp3UiZiXYMYNL",
+	"dYMC9vWt3eZUeyJsuYasGMbywhLzmfJGj5k8NQXezuvCke+CUVgYpk2tnd34Obqr8x7xit3++V+KUWsv",
+	"IbmfyM497fTzOhGo23dGEee4JTU7+eHJ0YOHdO1NuRoyI3/D+L/pxgpDDJkLK2a5A8r7ubetFw0bP86G",
+	"SnciP4MqEna8UMSEgrPzg+nh/Ud3Z0ffTA/v3buX3Z1P7z+Yzw6/+fYRv3s044cPp3ezh/cPs6MHDx99",
+	"8+3h9NvDbzLx4PB+9s3h0SMBntMA9eDx3ftH9z8Ow2y5WixAURBN9fDe9Juj2cN700f3j+7Ps7v3po/u",
+	"fXM4nz48PHz46PDbw9k9fvfBN3e/mc3v8ez+/aOH9x5M7377zewh//bRg8NvHlVTHX3zsa1A9DvyKklt",
+	"4deIewx6BHqv4+BkP47PPxBciZxKomlqQhrOTRCKyGM2mmTMjgunpXGezkHsdWPhvPAC/Foa8kJ6F5bD",
+	"jp+9G5DRx6vagrt1MJ1zggJltYnT345MXi4OMI59BNTrgGLBR8fPJh3Bbw5lemrRCPbvZC5O1mK2U8am",
+	"wYf1Y9p9m6rXP2VfhW9kLWucSio5yxXQw2n7m4iBgrPb+spxwi554XxG637XvGaYJ/uGC1rkPkK/usbs",
+	"NOIuPh35erjj9z6SsjjHs25TOCeDcc92cTaD1vRPOPoxw+4+DBNidESGFM55mSPqIwJDczBzbtJkkjrJ",
+	"30SHQTkBXWMAd/kQwqQVHqlM6z3EBdVI9GCnWxLA4wbcsbFJwSlyx4MnjfYNRTV8S+jgrSjsCKm7yGhR",
+	"PnuQC3Vy9nI3C6CVINWDxxWHVUboCyBXtNIpxAJibDmeGYYGet2/Vjlmu7mzEFzfAa318k/MCCf0rM8X",
+	"B6TiOMBJhZ6w7xVb89k5X4ggNon3fAZW7IXS0i5XY/YU3T0NxcibdS5t4800IgpLHvqBpHardqlzMpGV",
+	"6xxFuCzpYnWxOMMOZ/6ge+pM9+8ki6t1whDDNBriwdK2wOWyLBdon0UHE/mbGEYMhCNR1XUzHj/6KIqb",
+	"HGUNrtbihs19be3ZlgugtC7XFt6ShLsbrtbzPbG2DykHu1wqI/w9MCxTLgrOzpaou+hFN/jMljw/822T",
+	"8oRrs8dRxsNtJ0mkq82FZ0hAC57O0JJ8vt6GlwsHCaG8fteq7UoO+veSa15YWYiz3uOTZ4xVY/ZkWhEZ",
+	"YEmCx8NUuFbwUql51Z8gSTMhWuBzOhOZM562H2rEfP9oOS1NLotzeiSlcQ/Oqcun5ttVnstauIcdaF1t",
+	"mCpwc6bWmxrQM164RdHWOqbN+TXtYeJNXuuT+F3pRId972xAQDftsIXodbQOcdn1U+i+uc+kOT9Z81li",
+	"Rd9pIVgmzTkza7RTRmyak7TqwkuCX+uIcws+I/PGHICYlTVjO2pG7faIZoMZz+jx70cDpDnL1eV2XVJz",
+	"GdKwqcgxbYqIWTvncUARnfXb5KL7vBsot47qG+e3PK8cPLsVVL20TtEOhNV1I0iQD/rwjAlCHwnvTfyA",
+	"hywtGMA4d7p4o2HIqOczoTU5ILTCB3bJ8UpDlnO9EO7pTbysPn0jzFFLbWYYh0cKSNKJEGxSZ/omFLUL",
+	"h61ipqdBVnZLYhV7niA6abLuNR01OtdQMKTz3vXnsmHM/kz2d4jQV+axh9365Do6vpV2WXke90JMb+ea",
+	"+VckiahDpwkeskysRYHMOorq3v23Pya/irn35ouQZOuGXXiHTGOQeaKYhbI4L6KMn/VrAA1ySTKscU+r",
+	"Q3w06pKoSkhjGDdGrKa5VxES6+UuAw4OeQQWGt7OIVPeoSNIsjISmf8T9ztxv6/6PEL3Dlfx1q2Jvfi2",
+	"XZ+Ww75DIvSJdALqgHS2NdNRtX4a7DVBg7kEnanpyrrTwNyHvetUh16T3vNGdJw3oKHrPvz6eVG6k+73",
+	"1xAZ4ExH3fwVH8ZH6cyraV3EdzKPY6wCtXLN4Dfx3qWACaaJONXMTeFAdTHDfbgetIgnCtftM+NK9Dx+",
+	"KtaczHQ5fS3A+pXyLQUNrgkZYK1YgPGLrnVDSV6XYRumJrjxZ05k6Cuck9Lh7Cr7FCssEi8OGFowk2T/",
+	"WPfhoChXBEu8jiT51/ZTwuijAeqQpkAYNra2uW07zr2TxXJXng746udOYQwBuXYfXIyNsC9dHiteOktg",
+	"JTBBIYWpG6BHBgY/1ZYdw0RV9Se2a9v2k5Uqn/Uu11M85VolBF4wwXUuhR6ysvB44pjOkIAoaFr8h5Cp",
+	"ypSriiRLwwgGGHnNyYtTWg//5N9HtITRGxxl9BIbT1xmK8e/ggvib0KriiH2LuiGTRzNnbSzVHVc/8/F",
+	"Ru1imfpdgSaud4i8lYV5N9CVagY1kZWJoSc77ciSuGYfTFIpw/Xee1mNo/CQdpyFFtnJLjeCplBgsFsl",
+	"2/EMzFTOwSBKtsOdix97Vx4eHj0MzonOVFgaYZhteT1a5QZMzEWWvcDRVEG9Nd/bpCo7cjfcwy0weClC",
+	"dFi0QXs6/tyA327j2PPKXSMsveaVU4cpiR1qdi7s8csf1fQNRjUlU1obYUMJkCFD0RyywTPf23u+YtJf",
+	"dKAzpKkrxCX8aIZs4lPLnBHdmIRgN8/Epk70nz73l3dCa7xefBVnGE/ns69t915RSXFaipDt+kEy1mut",
+	"1cJ70DV0OELPRGGjWI0QxBlqToTY9iE7HN09PKwc86E9vxBED9wcMI60xgcDk89CGIJ+Zhjfz7hhdw8P",
+	"/88QPhnrkuLhorw2v6rpHeMHQRyuK41rCZ3mWpjlWYgv3upFG+WedBos158im+tTVzuAQFOKbmNcjpew",
+	"avwTVbQQPiWLTF7IDIp/UJYJnGUhCqHJs1axFS82fhBnLF5rPsP6D52RCPtjTndVpH0T0vW+aJfcnLms",
+	"Nh3mEqJLwcnINa4IgxGYaIncjGuex+6VE6i2c3rEOzK7U1VXMcIOveBdZUwjHWEfd2TZkTYPu9UqP9WR",
+	"bhstj+P0uoi6w1GlKxxNxOcFZtdvoIM0nRK7Z3q7kAtsz+xcVRqtVLLsKgEe/StMsm2n4GnrLuB0IgqU",
+	"B3xrd4vR+WRyYKK+EyYu4LpSeQ2rXFp9r+WJWsJH2Ex3FSvPD1cNYCFs/J2839DbAy62+5X5v3O1MBRZ",
+	"VAjhMiSD/4cEAc1NOxX0FGNsC3zaDMNCQGRRRdwWxgCiCDB8ZRXCU5t67lHmVzX9GnWP0Bya3DEAD8O4",
+	"AbisqfdcrXeyuomjeemjB/oWEEkN4tOue1/o7qeZ8gJTwH+1KwesLKofgD3tkW6ggahqva3OyPalR1rn",
+	"AAbmdan+Siqcu7YiQSu5Zeey8CJu7z3wYPE8/5GiBnmevw1hPo5B4eY8Vwv6GF/ruPkpX5itq4DQqp/U",
+	"oouqnbpLQeYXx6liAFa4w1qpFcsEvdAZfXR5sgFEvL38QskMOme0CfXnM4XXsLIOt7koNROABoWjNsHZ",
+	"YlXmVq4x9XRBbk0Wol+SZNPRtq2oe0ru9/thZUU1YRnbMBOG7yMmnHLjdz8pJ+BmtAQFx9ddTVKIkxrv",
+	"zYz327bhPq/cbsbdhUp8Kuder025Dz+Op7CDIe/gf+HqnsVz7YKQbvsr3+OKlTevi4tMMWWBqXDxL9ty",
+	"G0f7vu36BCLXdYeoATvli+7bI224OYlr4MrT7A4vOuW9uWcsTNOTe97N8XYVbalvUh8qQy230RkXZ7ll",
+	"v2a8ONMCDQM7Iszd2QDLxEurRq7XuCtXoOhSV3gBT/nMPkEydeCSK9deeTp3kzgCv3eCJCNESknIq5B6",
+	"cAGs4IX2Ph1TVDenH+y7aeWlh/5TqWUrtu4Tep3NQma8vp1r0aXXKxd3V1lIUbtuIufHGdbuSvIGx2UH",
+	"khW4qkCsKImXVVWCubrVuk9qpk9P3Oo+3PvH/8X+43/843/+43/94//5x//8j//xj//3H//rH/93LIOj",
+	"CixOU4SzoKfL48EBPYoHZn4ACk+KPrp7dG+MjQbODuFcqO9Fh/jq5+/hTNdm8Pjofkgr/Xhwd3T3kArz",
+	"nSGOi0sTikGicE4u6eK9FQUd9GC8dhHN+Dyr0oZiPDX4aIoA4UF65a6qYGs8rZTdOp4rFYmnq88qnfkg",
+	"l0X5PkJVEEjFyB2V0zy08yKTM5k5U0WawJl6khZMokIKrko16BKuBlXGDE2GQL3JDxK4e6Q0yriELHPK",
+	"iI6lZXwyzmGkCwGOFNpAk6kITRg37FLkecONa88cLRQ/4BMl9MhR8zaulwCLg75xsQ5M6+1Nt6jiQnOi",
+	"0j51L6xNaEGVdKhSd69EstEN3aHKCTmjt4VWF8qe0WFto4juOL3+3pXYW1b5Bulo3xRW5rQnQCSHoamX",
+	"J2TB7rgUqHecRXVYSztLtWJccqJaxVzcQ7d3iCa+BpOxwziPZkhsFIq07mNE2EMRj4mNz9YhzfOO04iT",
+	"QjeSr+1S9vqmVXByn15R3tUO+tAK9yHVR7FgZmOsWFUJ6F3fRoVEzMc6U4tCGtE2ObrGlQ8pZ1BORI9m",
+	"3IgQV+qm8EC5HEDviHRBMOq7waUsMnVp6I+M60tZ0L/VWhRTk8Efws7G7CRMpVZrbmWoZv+9ggzFuixQ",
+	"S/b9y5cnkz9hxswJJsBQOcskRgQaO2FOB8dDnlRfSDoACXztE+NtsjxnsKJhbR3s3YA0kvrdwPspuKL8",
+	"hOjVjbBCrzUSTW7Yu0HdAuvHezeo9n6lDGgbUel5LpgVxh5kYlouXNVPwwQ36EvBna7SJ9Cl9CJyxjI1",
+	"w7rKWMclz2sr66aWHQEnZ/1LdA4h1EXG7nOTZqHGMYw2CWWb20U+Txs0JaRPk8FPROQZy5QwxR0fnAVD",
+	"UPBJGKkVOX1K5aJRsWyatT8Rj1SeRRkP62X+m6VXg4TvNfjviuMagNIwtYregsrlZ7pZc9MMoWhVq0lu",
+	"us/TzhdEht3t82X8qjTFkaR5/CzkTnJWF/+6ycIRc76oMqWGzOlWhVdPUv4YSr+ldLQwwC73ribfyQZx",
+	"7qU+c6xz22KTIHK72OY4d3CbfcbVyzgB7lpoqTJ6koaQRFBpp7NknM00lPm3m7zapqQIrFOs1VOtiqgk",
+	"fuW2SvgCtGElCzjFpSo1y/hmpOajlSrsktF/3U+XQpwjTYSsUahzdW8lUL/fIgNshPmCrfhMK8Mm/7IR",
+	"XOcbyP7zLzis+zeM6v6ZcYn/AlvE5F8AmnwzqdeErrNlQcIY3DtkR+y/sv8KEI/mWnYkxXG5XNMBqc2c",
+	"vO50kI1MKwd+VdNdL2ajgtugXy0oh4l4oDHgNGUK91DxmXRkRuUgN0xahsnvQiyDO6ZQEXLI5FiMPU/m",
+	"39s4h9p4P2Wtq/fZ3zHVVxVN8NHXUY+O8vieTTcxh947w7jTwyRg7alY3kMHjZocq0q4/Ds0DJdeDPI6",
+	"Hfi/LJBGn5Ss3w5hfPS6pFoLCflFFg355emrN6Yq61EIkRnP+NSmT4eMr8RK6c3ZYto9FV8BKDDV6ycv",
+	"MAzu+z8PrzZjd1qoiFn/NEeZKyvmr6JT31657toKCXrGYZ8707f4YFN1XyWsqh7natlpPX5Eh7oI509q",
+	"sbOQHjpWqAUprCM74yd5UKSDqIBqw5emxXFYCwxqI01kWNw5c6nz9MRQbCxyqatmZ9Iakc9Dzhh1WVDs",
+	"3ngPu2Q4UCom1plTwZ3KieB6tnwBrHYqqUIALZeFK56PbLlLHGuwN0juqeDsfcivLDqOKUxOm0PMFaAL",
+	"/kh253HXkGdEN1PVMwtfiy/wWAEHUCeC6UQtu/vpiIBt+3ElKe+c0Lu+JPpr99GGjP5Nh53q5Ng8dpIZ",
+	"s+cSTTkTgmYCYsEEk2tPKOmKl4idLBLaVbKIb458JgjvOXGZclEoLbIGb1lhyuCb2f17/JtH2ehBdvRg",
+	"dF9MH44e8bti9EDcmz2aPhTfTLGc9JpbKzSKTVxDwMRj9obqUlvF1FoUbd0oTX0246lwtFdCo+wPX0ey",
+	"MKIwEllVRPaO0I2Yv0gwu+5uxKhlYiexfhU4fL2DXWpNupXG1fkqdTFmz0gLhr/dPTw8bCgpk4UTwsa2",
+	"rqJ4j7ZLt6q50mP2k7uajawYuEDwzfDF0EpdRGWjfhLFwi7j+avlarEQ79epV8LpWnxSTQCSoe/yosy5",
+	"jqQv9tXr50fMbArL33/diHTOpRWa5+Q40uXJKYuZ2PNIG5UG3esK5EPpoGxwNQb30Wnizfk0WNyb72CJ",
+	"lfv7AdPO8oB40kV+Gj4M7QsXORZzVz/VORVxw9Zcm6pkjWM8g+KJjCv+6aa+IAtDzcfqziPyqTWlQR46",
+	"77uagzOGAqWk/FCfNhGzqjHfsVMuVBFelMTcwdKR5R77mrNMdT111W2mphV7jcyt4RB0ZRSbc711Bqss",
+	"z/eeIjhcF5noGB+FJOx4hliSiNSkg5SVORoTiGsxo32iNeAQw7hSXjUdQUgGE5RV1oKfn62mKaLNzxk1",
+	"YqXhmHTZ6TAc5njcwKlWYsGbrF00mcRwsyrOsx11b2pjRmli4MEZMlPOMMmeGwmJTKlbKTp2Z/7BV7Ef",
+	"nri2AfH8pjvEpI3ukH/cNFuQBT8lZsM3Bg/aJ2nbOd/HDjrxCXX9Qp2FqHJkVe4v5RlZTXib6vTFIuYV",
+	"CvXFpdjamlBg10S7cHIlcJH8rOD2X3pOuIqxQEtKm7Hf069vT/H/WiXzq7q49RSPQ7mvjpPa5o1L30I8",
+	"CxbZqKq30shxQKuLIvQPfyjsesc4qwHwrdtexT8Fq2yjgWPT2VeorlS+usLEC//OzRRon9DcZbH3H1sG",
+	"IADr611+qO16FMBZ4sqlixHCrKl34leXiknIuNA9KgzYywuhL7W0wsRl2nFbA5i+hHKS7U75KFfsbqAB",
+	"xLF4AwtCk5F9BU8FJ3Tx0eO00frzaLq2rthP4jjw3rWTP5Pzqq2R+T0oYfICve0od0y/s5CRDvlItHXL",
+	"gqqM0DiJzErbEtt/GqXbQkj8pClCUa2xX01Mal/V42y+anJ9Fq2xIVm9Yu5by/txa0xvP/+D7rG0MKrU",
+	"M3FWGt7XL/C163NCeoNoFLPfAJ+pVIDli1RqOU702Ov3Kn9YaRgHhnXaKEvV2wO5DYA57zuAOe/3sEXI",
+	"UouPrnwb01UKPv4yTNTgbzMd/k2rEN1Xwmxq6MiHwxmO2td2X4tS85ZsT1jjR+++n1QxI4o4qtRZRA78",
+	"L6PgkZtw8DNipgXyI2pUKDuyIs9HvNioQsS1IR4P7o2Puvb+8d98CYLBcDCfr9ZiMaCUCSNyZVmJwqLy",
+	"xcwSNTWvWLzDAZ769Ik3q8kF00w1bExO4ZB525HVCMieBNb3foMUq01mK/NodyrSUFrN51AhUQLhGV9N",
+	"FVNNm7iDHmYnINdiAbghY1SQmiVlIFwKru1UcDtu79wbT637TjNmf+F5KSp/y1ZeVe/NQ+0jzynKLCjm",
+	"FjQ+6Ugynp25yPeEhpteImjkw+MzCn0IdZDJK6O+76qcxun4WuqIMlke80lsNIWNLI2oG5N6JJuxYrU+",
+	"yyRU49eiX67c2sZBf6W53lQZc/eE4ePOq2NS7mI6AyJFOrYEJ1BDuYBtPkLHyEVhGFWcbXjbdNvFq5xE",
+	"uVqATw/axTvs3nRwXYoO+Dlh9N7n4JQ5qw26o9oUKjEb+zRkK6XxWqAig8pExH6J6UDHW4wxJ3JRvGy+",
+	"jLUYIueh715LSOpSGqGjZ+8sBGYMzCVfLIQelfKaXsFGdFPbMND5TH4SE9pa6fW/p57jSz+hLYi6n9OT",
+	"XIj1iXOXSxBg+Bzc6XwyJLLr+8fhBPYbCYcoMtIbB3HfO74B1mLujoxv6obzMLY0zrdszJ6s17kULmSM",
+	"wsUUdJTolTXJ+MacqfkZOMVNMA0vtqn/Do19XooEhKgiKdjR/RH68/3ww+MXL6rC2U3bZjzy4PFgpZgt",
+	"GWZtgnZF5lTpg7tHjw8PsetM4BaeZVQI/m+Do8Ojh6O7R6MjuIHhjwdwNOK91fzMOVojIxMP+S0NiVgd",
+	"frwHP378JfZ6c/XCa+0OH2G7VoxJbTmtMwcyMzJizTXFjF+qUS6sFdrrW/z5AumDsZDHF+K840DZV+8G",
+	"K0Ve47b0DuNfj9lzOB+XyfzdQFwIIGJ8827Q4XtbbUskz+PRJVs3D6FF0uFnpJV//etf/zp68WL07FnA",
+	"gSHwNAk0zZQw0Ag0ReAOWWSxgctY2A5+yc8FOt9mfFMvIuRd97EOJnXyqn/czRL/b6lyCRvbFVdTwdsn",
+	"uqaBXynXJua+Mhkv2i8KiABtlK9K6tNIUzdXNbZCPfTjn/jjmuyR5TmiSm9x7NSCaj6qyUQ+4QrsRpSm",
+	"QG0qN9LaPamNG+HiDtrqVrFt16tN5/UtH7MnhXPrxrmJdPn7Qj85dgCvjavs6/sEYud7iCKrtU8x5Pvd",
+	"sU/a6r3303Irusw+LkZVx9Ve+8e4Jo020WC9gMoajFLI3ov0oE2GrxKM2z8pZa1fnE3FeUYNhg6u4YAj",
+	"ug2GA4G1KIcDK4xrouZz9FGCaZuhsBUadMf8JsRM/OBYico24mruVunzkYTQPycJ85U5y/lvm+2JB+vl",
+	"fEOFlfVaFHGI2jB28fDB15WRwtlkDPOpUT8tgVif8xyG9W052S6D4Z+5kbMtWrvxJ2QFuNwnK8A+bttf",
+	"JAD/cxWs/Wzh8ZEEUd+Iv1QBeyFLY0MtrUOJ5yuYQvtHvVfK7JTWPNb2sich+NhbK/MNRe3NN57L4Jgd",
+	"uApTRGc5V27qbRS5NMWCcPjchSRCoLtnRsLfvBBoLWtz7i119mUzeDhT7PtXbyImDA2Rz5//5fnz8SCI",
+	"tt+/ejPC31Ih2zW9wd65KixfjNlTWqSP3XKV/DBsA4viU8Yg8m6mvcNITs40LzK1YjhgsGIa0Mi06h19",
+	"mulrh2L2lC96kv6K2gckMC0ji1sBIEL9RC1fnMkMZar79+4eZQ+/nY0Ef5iN7j94+HD0aDp/OBKP5oeP",
+	"puL+tzMxTagJwggfrhyy3k7ygiNu3R1vI2kBs6/9I2lJ+rhlanPeX08e+6K09eO93cHTTtEJ7fYpBV6F",
+	"046eqY9kosAq2aCiWMVGozNepoq9vDFCA6L6pKrUmB0/G2I28kulM/+JbBLk7ow6CW9+rmxCgHq4Mfiy",
+	"wbtarXRp7XrwEWCULioCnWdnNrIeBVp9KvjK+fNTT/P44GDuvo6lOqAsOgk/vO+4Xrn8ipg0GZ3GZ8JV",
+	"5AjE6aeLe63xLy8vx4uihKwgB66POVis89G98eFYFOOlXWFGECttXoPWTRe9C48Hd8eHY1QlqLUo+Fqi",
+	"OQt+opoyeDIHfC0PLu4d1DJXw4cFWZmUz+53nAHQwtYqjxOPieU8cLSjw0O/q4L0xyByu2pNB786Jy/C",
+	"254psOvzffzY2vQCsDoPZUUIBT2jBRDXCrVFGcSlidkQutR/w3wRg19qYzwvsrWSLtftgsKZ2wO2UjHD",
+	"zie39wADlw+82rRrs7+TRfbnUOP8la/9d03bHdV3h4l9eff2fn+HBRZ9Ym9UJLm+Y7oRLgnEZ4KLau0n",
+	"4DhRK0E5IC/Rt1griBeon/530mX/VJoU/U9/OmY+agqPE6P8sVQkuuOhfP7noM9uIcVamcRJYZ3KxFHh",
+	"2/lnlW0+226E8vvHxbpMHo8LNYUVi1AZnxLW4yMuZueDjzeDRwhoNyL9XL+4QwISIaQjnctC3D6c+gsH",
+	"RZ8VjMfYdBVkauCpcyy8qMZ3faOD3ElUqFDBKEp7sgVla4UXvijWvrox/PynQEwEOMLIevmKHc/dHuN0",
+	"IuPcVYvuxUVgpaZPPHKv5eb5q4j7JftKg8n+OKyNteGrvD5Wky/ehSDNg4DMRVJciDTj0eYTtp7Gk9lM",
+	"GOOV9/XRYJeTQ4bUN5hrChd2Bx1NXq5F8eTVsU/anedgQMC0MphXp+D5geMk3YFOfGV4kEs7j9sIW65H",
+	"3BhpLC9sN9k54RfiBBo/8W0JE66J8CSnSj6a8bYC7caazm06dD/hE9FABkwOcimmfL32SpIMRKR5medV",
+	"XQUXxI985e0jJW8qr/eO4jZkt3BaJ1ctCla4YfOymNFNZCuV7SI2Jzx5T0LUGZ4gC0e4heTUX76DD77e",
+	"zMeDD96Z4+M2klR7DFFE0nwlLPo6/u3DQMLeuVKcToSLKtpUgrMz6e4j4rSr/HwcJieMPPG6J2xSr1+u",
+	"8TFNV27an2J6Ka3+vgQhoymvNWo9QU+nEnA7iMgZXHjeFdtxMCnfbQMHsbNJdFvln7pRNaR82x9LPa6Y",
+	"/8TQqyzAfAJyhlNrqQ/Ymyhu1jPtPMtGqtiR84/IqEfiSzGl/HZzPsOY+kwl0xWxKTeVB+dUq0tTc+G8",
+	"OsZXa9wfx39V01HIMdWt1RB2tozyiJnrVGpE86AqN3H4mLXMw3KzL/GbQrxfUyo+tOKmEM/nVPy1DmZ1",
+	"uhB4vk01gYHo0T5cF6eVShGXWLNPRujXMm5Rio83gw9dwHnA0GTpwviJp3N/VC2kCWkWXA12X0uL57kz",
+	"uUK0O1rIwdhzs+iFH9hKGHKcr6EWoYWzUTXPo4VaVT+iA7UexmfojLLK1bL+dROJgw/+n2cy+1hV02uj",
+	"8TP8vYnGu1jz2nmG8D0tVupCZLfrrr9GoKpsyOE8oAxfnFmiyqzoKvlT6W2xtsmj60WDB1/2ytXx77Zc",
+	"EaC+wXF25xXZzYtFqL6VO9plpftlOFiXiUMlme12UXqfkuX3QfEJ2opQUJnN24ORTirnBeW7BJ5tD9L9",
+	"vbBDt0T0niJSy3hjjDqtNltUOYgHVCT0uvGsk3FTjh7eNE51AYR+TiWo7EgZ4pIcZ0wWVjWrYBK7ef/u",
+	"0fWj1WkQkUM2Z2H5onKNrrI+1xskcz5Lg1nH8w3LShFUk1RAdMZnSy9NhKFQwFEQnl8snCLxVtwowrGI",
+	"DQJAm0IPFS3EfNA7rtiP9RTYwklZrUtFtpoeVwsNFV/2fs0iELZdr/vphNB7XoiQrByd9OEnKpfx88vT",
+	"mCb7lClVhl9ITrhY/ueF+r1cKESrHdcJsT+sG0ZC2yjWB/XpKIO7nUxcMwlhdlusEcf4/Uc1faJnS4of",
+	"6HfTfpPr+kYGpm0qCwB+uNuIFDCd5h7f1ieMNtHxQfcPH10/gj1x5QpcbhnDV4J8JXmuBc82Pt23T/0c",
+	"O9zciltAeOVYKxTTOPvvx6/8UVOyPa2yclapDSfifR0ZJywga0UWz4VYm6BPQK2DjOO7/WEReeXVjQP5",
+	"0W/fkptQIHfMXvvcLWhkdA68Q+9aB9eTlJRVhsNMIYXDU2geAkqjmVbrdXC6777giX0q/CahcbN9pWsl",
+	"oLcrGb/P1ZTXCrliLuTrvVVd5aB7KJ2HXUoMpxLwBTrwQeTFJlUOu0t3DcmP8eyM0BcupV6iu9lBkV9O",
+	"MdWorGePXuBGd4Cz7fxGmExu+ynGG/pv0Py6DzE94dXMBithtZyFHD+4XPxDjaZitNZqJowR2SccRWOG",
+	"xEDMTQOPJALQPpQVN2ZUqd52KuFecGOuiSt1o+NUUhUnIhezLqPiq6BzQVuJT23h1JFEr5STc8dXZmGN",
+	"17tVT+KKY+mBuZeivSXk/t2HN8J5akEwFSqiyhXD6c7l9ryHL7g+J0jjLRtWZqyZ0IjNMy2t0JLv1ELr",
+	"cyp4vdegxGt7qaxKpkd1jvINDSI8x+lK22NNWThx+H1VP/Q2a4uDuhuHA/m2oTrJlM/OFxqcaMfvip+V",
+	"FZXdYIJxVU5pdrbi78H7xuE4Whugl8hYuUaZtLBSi5DLlVa34oSe5O7a2h5KcLFRJSN19JCKQAmp2cTP",
+	"y6Gml89uY1gm53OhgU3JaU3wRrtZG05BSEz+7jOfp3luTIzuirBfEwExOEfS9wUjmWTlVVAnFQthb5wN",
+	"J2B3OiPirkYuiS7AiqKG0Ugo54DMKCrGtQJckYDbQgrwTY+tmj3fuV+REINIMJe5paAv4EqNwsjY9psG",
+	"8tfBB/gvVOnd6lPhCqn18qjwA94aB4dmObhOVQ59a3IosX1jsybHGWlNRXd2nU9UScTV95vLWRgvfS6m",
+	"x2mYwQ1uWtItJDQKqzG7DPTQxueXkLr/JlZThQcljNfewg8UmtXPYNoLq0MBjE8yS+1mp1xthIiOhYcp",
+	"WF2slouF0LfPQEuRkNFLSnLZkMlilpcZKaGM01pSVocN1lHB5OYkw7uSimEQeK59dHuLPWA/Expg/xAo",
+	"in0hS/BG2K87ROytVt8vhxE3or+SJK61H52G1OpqgexQ51OnImNRMquu+3jgVBed1O35+5a+8RaexLUo",
+	"N28L/0FH4BFgWN3YIZvmanYO5HxYlfYg1VdKPGfc1LV67oLTH54/a+jjgEETOiU1OF3nRK66tYC71GnP",
+	"30fqNATPve7bVWoBfcMGbHtYyFHlRzX9c2h9k1h8LQJDtZQUKpdYfeGrS5dMEXEDYPvaFXF3/kSVz3rY",
+	"x54RBP5p4bOZWGMBPVFYLYWzfd16pyUPrdN0A/ZFW7Dv8/Rl8Or63qmtyIX62S0IBpqBBaj2YZCo2iU+",
+	"XrcJFeiJRRVkPYM0rYFrEdaAaJIpDKJ2KpqwZFNf4XammSKjAqrVq3x1Urk9LAhNfT5pnv8ISPk7N1M0",
+	"C7rtbbJIDhrqS+yBQAcug8TILIWwe6HTU+p5gh1vCK2G3Rl97bJcTQsuc/TeZVpdhrJGBCjDJcJ2I2yk",
+	"5wvAzVRergoziKEJ1OnbqADGvaPt1fB6oD6e18Gva7H4LIxqY32fiuFLaazSm9tFn2verD++ev69Mw75",
+	"ovcuDDRCAnf4UVmTsEJXxCy6hEOmNH2bbuizK2C1k2l195PXT2GLPeuK99Sdyl439AfX549H8/3KelL8",
+	"W4XG7qEIGFrH2X74OmZUGT8kL2tV6JMGneqRFkKHd8VV3hmHdb4C9VVR2QgbF+/q8BpE3ekr3+53L5X5",
+	"lbj0oR3EGxzN/N5c0cjrJwopzrgJshjZdY+OuorTuWyjAQQfdE/9Q8qOL3yBtuBtED7ZurEN9dDanQha",
+	"JWLchp4noZLb7xs5awUNO1CznssUA0GdYf5KaHpSG+4qSFoHyGEq+lS2Skq6po7E3hJxsxca1xe5DxKj",
+	"HnB34Cq2+mOwBLiWkDowrZ6gPZbCxBXxTEvYvmWaCO7gxqcX0rpHUNewoY+KIb3iNBJdLrkdXULaD+dR",
+	"NcpUJ04FK93bJbdvodOxffZH0TF4H64u1QKYV4IhLGG1AeSL2KlLDIH0SWe9FQxzrtIoxaI6n+AwK60R",
+	"+Zz0/JVCv1O0QCMbzy/5xkSzVMORKQ6reRbBYSgD2cWnH8o3fgppKt19s5YAhtA76SuUWdoevHXlvYhx",
+	"1Sz5irtcYaq0BxTTvOXRxvZPXfPrikSpT5Ly2yGJkHbfRw/gxt2ou04d0G6fHd8ijiGPHQhvzpU+QFJ3",
+	"nXcMw/0b9Vak03N1ujFnBpuYxo7iSa5g6El0TQjlwVNLFcID/+D6gfflx33sdbnOFc8o/Gi2LItzIgXG",
+	"kmkebh03RqymOdAMSAO2iR0yWc4taURu8r0sG+9lVyaAqBQVOlZtVrkszgP8EgVp3BAK3bJEFd2plsbi",
+	"M1tZrMJmWcXo4rCpmCtN6REChaqC5CoCSFjRzBTlAOLMxNQAgUHONaC6Fnwr0dMRmvUlfTFqXisZjCcK",
+	"aeZ7UsQvQAzr4IYUvG14y6mp1Hqw4yJj8UEM4wpF0KYsIHdG4bxnbtWVgb02jHu0jvcAwfXJ0dZKW+Mo",
+	"V8U5uIXtRPgnlJ2P+0DA8O41B+TBe8wFF6L1RBMUFd2MaFUAoX1LcNiDDziTKVcfDz7gL/K3LT6UtA9A",
+	"LU+s0sJHrTaY2AZC/PDk6MFD5ufxmEEuCsMUx+ub7uV62TJ4nMjfRDxZre5eYla/+j6z7mPD+NSLd4Kq",
+	"fNpzV5fkdqly+1yiuOQLVqycywqL3e0iZK5zyNF92Ua8A0b+cyPjMKUVckTFEV3P5khXX0OAyz+94OGl",
+	"xt3AN//d4Ojw23eDgFhVKAVKRVMR8iK5UoBheSYwohRJQyTeveC1A6fgC54bRWMYtRKqEEzkBsdxiqp8",
+	"kwTzXeE3cCk4pV92W/jvI5pm9JQXo2ewztEbHGCQ2MNQGSe9h0rLhSx4jnPC+GN2PKfq/xwisyu/Lscv",
+	"gBwaHDnJbbQKZqd1OynV1wspGJfYIhPTcrGQxaLP2l46wEbfOcAGe6ElSDYrSjTCdS7hMMrCl1Jy+x3U",
+	"IXiwzvdJGkRfpuZzIxyD6Jnfqco2PiGqhRNFg5+ssIOSy1l6RcN4NBRTxSXXThB3PzmRWqHhJfa/clJF",
+	"/T1qOdJt30HCidFLnGqQ1Kp01mbtrXVWMyvsyFgt+OqTLdlP/d5F9ORqimbP1rd1zEeH3+5q7q537WI7",
+	"En5jUi+lHkIsrUKjNSJ1NmRTMeOlEftTlBuM8OsEn7lQsUqpT7ehWtZcXGK4FtB0lFpD0XMSv8asenrx",
+	"2KrabYtQLENrMbP+Gvulf5M8e+0OHiR7ikGcCnspRBHDGYVCBAWc84UnGPAh1K0XOAiR8RkcPWgD8pSe",
+	"M59wefv75d+i6sQdGfKBe2ruxPkw/3RTwxfirSedj8ljBrdt4uqeFdZP4C1AtJIHh9/cDDaF66nFHFSQ",
+	"0SWwSlPkgtMn5gIzMivFcmktkvZGMesO7uwW8ZZI9V0Skm6OktWDL2sf8XGZKz2TU0hakytDYaE/nJ6+",
+	"gleqoKhkYk28W7djqdxDaWr4J5h4j94tfCWcjGgVOitAFyp/7zpACdnTxJtYVVxLYBQ+r51McpxDDOlK",
+	"0Bu0tyUhE5qZLqc7cmeDSALNgoH3uuWfaLLfmegDyvxLV6KRF5jxd+EM76jWnsen4kg0IHRVMbCyYpCS",
+	"IXaXCjUvabh9BSfLdXycvfNsNleBlA8Gu9HMMe3djEQOt3nj20WquLYEKqqP8zzoNTuwgC+4LIx1IYb+",
+	"wRyzp0rrcm0jvRCFJVjF/l5yzQsrC+HU7ovElZ3EHIBG24bpQVCOe2Jvkq4El5EdhOWmaMrvlpzU9Sip",
+	"A+AL0YxUbLzsuzXwuyaonTEazQ9Amj0wguvZcptrEHwHZ4Sf1OK61OtueJqrM0PAW2QHFCOQgQXwalSh",
+	"PzlH1/tRkX0C0C+Au+5i8ZD1hpOFiNNcFphb3gALBH+AL4LzdPzx5OXPY/acYxh+QToZNmlPNGFUMOem",
+	"ebtd/h0EYvBEgfU65WEVy44JTp3jx6/1/MlUKR41M+h2jKWKNS8WwqsrnE5LGkaSuci6N5IS0WGJuS1b",
+	"iE6k0HunnT8sTkM6w2qBiZv1Af7Ph4F3eywBVP0yG9Bwt9ajBBfSgfy4T+AfcUtdkQgxAcodDkeJHltO",
+	"Hqjr7tP/SS16Ry79HpDAr2cbLgARDPjQEXTZ1FBjxyVcdYX9N8LeWuf32BcPgHU2e7USlPiH1r7DY9JV",
+	"KWu4rPshxzsQz3KZ90K+U2h4e5DPivf2YJ1zWexZ9e20uTl/FLyKYjdRfhSXxEDESHbH0LJ7UK+4SxjP",
+	"MyVbsaqfFzmWu+7vRv7ZsOp6WNJ/LkdyegL/AJ7kuJAq5Rq6PYn5HLXmRchZ7EbgoInP82YCOugruKvC",
+	"tCxXvDCUZgRVhuhzfCF5uzLU2GdpRT8awIeJv1EUdI0Xq7pXEyYLYwVvpmsLlcK3lBtzTa7xSffJMPxU",
+	"Vy7x7Qdibl3NMl3bS2KRAcGg4rc0rhJLcLmxLkt3SBXIq+kSelM6htFqYQ8sX8BJLPolbPLl/xf969vw",
+	"xe+otE2VVdhdhrLgxshFYVBWu3R4HUy5sDrypYIxDGb5ro6x2uYdaSW2bOvnQ+Rqkg4yHi1+W3Ru3Kxz",
+	"rX3evcX1lpqpb+rnfx137qevLlPfsCsavAHTfocFYHohS48iMDXc3EnIDkwuxHoEi8nKfEcG53COJ9Dp",
+	"xPe59ptYn65PnW/UokIv5le2LRtAfFM9f5/ofUs4nS9FP7q4+C1YcV2kZCdC+Hji5ilemaj4Ib4sSdkL",
+	"MeqPktKeKNoavt8xzU0KPKkswstNBcbp5fcNwZXMJRIWG59Cw2tl62M6oUFqBr7Kdd61TphMTwpkbuT9",
+	"7yo1+jZsn9lW6vMybtZ9gbeU+/ziL/N+L/INsmCAld2FPfniD1PTc0++wNX1rKFe+rbRP3tduBu4bV1X",
+	"7V/R/dDDuuuumV575DJ8+K6e3tU8HeNSNN2bd/CB/rGPTNhL1xWGvf5Uvi3myeFOeOloUdntFEW9GoeA",
+	"HrNjSzd/plYrUbioNXRmn2HwOPoPlkXk9BW9hxIfvYmaz0HFOUGtD7mC1xtR7E1w4TFWrZkEhw1t7Jg9",
+	"KTakKqZmJBYTINEw3nkcyXrZrLGzp0D8RXHqc5OCLS9u35TADht6ClIsE2ADqQTQYHDqd/P7qLudMtLx",
+	"r7Gq+IaP7tp48mhNW8Idb14Lftu5835qao/ReyGlZ6h3y27mj4KGp3zRCwfbPDo7fmZqus3KTZezlXBZ",
+	"xf45cfRNJTrCTtFumKVcB9X82/3xc2/NU1PB8Md58m5Mw/W7025tp1xfFCP+U7t1q7Vbbc1WmzgdLAXX",
+	"dir4ltqydCg/hIbXefSvhVGlnok3TivQ2iPfgJXQwhvjgxBwhfNvmWS/uD+0mJWUH/NvH/x15aVdDh7/",
+	"7ZePv8Qn/1qEEhBv/ZF7bwUMjsFyH2Kl9Ib2qy0QNG3HoBvK2FpoqSCcGgzE003QECCr6ueUmunaaaTw",
+	"S4uFNFbokZtui3xADWtHeV1IBlPR3Nu0m7BYAupGVX9+J0TWLQ7ePqSNkJLAr6nvduFdfCRwqaueJoFU",
+	"oE8Yqfl8C2svF8XL+XzwBycAL1wxR8/dcMOc7qbnRa/f7VzYWPdH6mGwbtzRgi2wzoEbftx5KsWOQymu",
+	"1zBGU3Rf6pWwPOOWfwFlPohAots97neMhk9KuxSFBaAEe1ceHh49ZIAN3oLWpY36ZJykaDircAYXSqgi",
+	"TkhWB57EWMttt+AVndrgSyMHQuql5+C92CnwFIp197jdWLU/hvhUUhSGajGOXxosZ5DehE5UGFHLbBcv",
+	"HB1WNrhunWKYKCUVV/YxE/D0n4kDdlTdnZu3V6A/3iworwzjMyAbucio7h0FWTmKMqo7A3t0QaciWYRd",
+	"8VRG6FGuZjxHAsdz87mp2oWoraZMmS/RK3bLO+vkPRcwdX21R51hpzOeCWshKSbei1lpt+hnfla+1mTI",
+	"/xbKCUR6tfuH9z5fmKxDsU7EfCU0JvhUBXsmCimyKPQ7bZoh33H35Hk3EIv+aUb5zxz8SEQWbYtbupaL",
+	"pWWFunSe6/du9oHxF4mytFAmIuTCfUnB0lJqzIViUf0/unB7XtpQKMWPH+3GrtuEOOUVGjrKuZm+JPXY",
+	"wvR1gSFJv/tHiMJwK+m6jo43kgWB6D3ir6Q1c2O1wy4OH6U74FnD0xxZhD0m+ZpxRjFbH7sqQnHTCrlP",
+	"fJxqRgNzPoS6cE6nYlXIV8fWWi20MGbIADZf/F9pNucyL/VupY1/V4wospohGLbbj46ViYUWu2/KwYpv",
+	"RnKky+54ihd841QpZfGHiMZ8wTf/KsT6NXk0/MHEs3oweEiTGHHMkWtH9EDpsmAH7FyItXf1qCKf2Mu1",
+	"zxLv098Zxhm5csQ8aTD6pfw7OhC5xdGjsBdB1oBJmiocaztqq9KuSztaa5WVs22MPhDLl9j4lW97U3g+",
+	"7EpLB3N4jXc93T8F78OdD7mvXX6wqIAZw206F0WUk9APOwy7yndWeqrVkerIPehDika0h/2yN259Fa9e",
+	"KW/o+q6LK3d9PxLv9ZfKa3jUM68h8swu75tPDnT/7t3rJ08/iWJhlyG3+p9wcS6FWCYzfMDxbeLMbcHI",
+	"dSHEcZDeu35IX/EN2kUwKRzXzmRx/+6DmzDumXLtSmi/EJnk7HSzdnZsRDJGGBUlSnRnScJj0yfz/tEN",
+	"pZ90BymJv0CCqxRbgXoFqYsrRBLyoWqFyfaoXMnvil+jZHuw0StlLGabxJSK9ep7xEVFKfckbk659v6N",
+	"lflIFIZqo1DIJco87pSh5x3DMrkQBourNc+Yvfr5+yHWt6T9fbkWxfN/f+2JMGZA9wi1x9OK97CqM3iA",
+	"RQbFpakiFJDm+weS0YPpOUfcTogRpQew1Png8eBgEOntmpTquO6X2KoX79EkvB0Y0Np+A6HEjtMsI1sL",
+	"NVsl4J4pp05Op33CsGPU8oxrJYZMYtAnr46RaAaoYq2iWq3Kgjh0zIzTBH3c9KlITOBQ4UWAiT15dTwM",
+	"nm21WGhKbyb0BpcBF0Wr3EPUmgz9ANoTulRWYZa5DMkY4ea6HcQwAfgbIrt9MYl4Dpc06+MvH//3AKWE",
+	"HBsPeQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
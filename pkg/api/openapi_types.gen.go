@@ -74,6 +74,8 @@ const (
 	JobStatusRequeueing JobStatus = "requeueing"
 
 	JobStatusUnderConstruction JobStatus = "under-construction"
+
+	JobStatusWaiting JobStatus = "waiting"
 )
 
 // Defines values for ManagerVariableAudience.
@@ -314,6 +316,19 @@ type JobDeletionInfo struct {
 	ShamanCheckout bool `json:"shaman_checkout"`
 }
 
+// JobLastRenderedFrame defines model for JobLastRenderedFrame.
+type JobLastRenderedFrame struct {
+	Filename   string    `json:"filename"`
+	Frame      int       `json:"frame"`
+	RenderedAt time.Time `json:"rendered_at"`
+}
+
+// Thumbnails of previously rendered frames of a job, ordered by frame number. To construct the URL of a thumbnail, concatenate "{base}/{filename}".
+type JobLastRenderedHistory struct {
+	Base   string                 `json:"base"`
+	Frames []JobLastRenderedFrame `json:"frames"`
+}
+
 // Enough information for a client to piece together different strings to form a host-relative URL to the last-rendered image. To construct the URL, concatenate "{base}/{one of the suffixes}".
 type JobLastRenderedImageInfo struct {
 	Base     string   `json:"base"`
@@ -336,6 +351,21 @@ type JobPriorityChange struct {
 	Priority int `json:"priority"`
 }
 
+// Determines how failed tasks of a job are retried. Properties that are omitted keep the value determined by the job type.
+type JobRetryPolicy struct {
+	// Allow a Worker to retry a task it failed before. When false, each retry is done by a different Worker.
+	AllowSameWorker *bool `json:"allow_same_worker,omitempty"`
+
+	// Number of seconds a soft-failed task waits before it can be scheduled again. The delay doubles with every subsequent failure of the task.
+	DelaySeconds *int `json:"delay_seconds,omitempty"`
+
+	// Maximum delay in seconds. Zero means no maximum.
+	MaxDelaySeconds *int `json:"max_delay_seconds,omitempty"`
+
+	// Number of failures after which a task is hard-failed. Zero means the Manager's `task_fail_after_softfail_count` setting is used.
+	MaxFailures *int `json:"max_failures,omitempty"`
+}
+
 // JobSettings defines model for JobSettings.
 type JobSettings struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
@@ -362,6 +392,35 @@ type JobTasksSummary struct {
 	Tasks *[]TaskSummary `json:"tasks,omitempty"`
 }
 
+// JobTemplate defines model for JobTemplate.
+type JobTemplate struct {
+	// Embedded struct due to allOf(#/components/schemas/SubmittedJobTemplate)
+	SubmittedJobTemplate `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Created time.Time `json:"created"`
+
+	// UUID of the job template.
+	Id string `json:"id"`
+
+	// Why the last run could not submit a job.
+	LastError *string `json:"last_error,omitempty"`
+
+	// The job that was submitted by the last run.
+	LastJobId *string `json:"last_job_id,omitempty"`
+
+	// When the template last tried to submit a job.
+	LastRun *time.Time `json:"last_run,omitempty"`
+
+	// When the next job will be submitted. Only set for active templates.
+	NextRun *time.Time `json:"next_run,omitempty"`
+	Updated time.Time  `json:"updated"`
+}
+
+// JobTemplateList defines model for JobTemplateList.
+type JobTemplateList struct {
+	Templates []JobTemplate `json:"templates"`
+}
+
 // JobsQuery defines model for JobsQuery.
 type JobsQuery struct {
 	Limit *int `json:"limit,omitempty"`
@@ -393,6 +452,27 @@ type JobsQueryResult struct {
 	Jobs []Job `json:"jobs"`
 }
 
+// Metrics of the queue of last-rendered images. Each job has at most one image queued; newer images replace older ones.
+type LastRenderedQueueInfo struct {
+	// Number of images taken from the queue for processing.
+	ImagesProcessed int64 `json:"images_processed"`
+
+	// Number of images accepted into the queue since the Manager started.
+	ImagesQueued int64 `json:"images_queued"`
+
+	// Number of images rejected because the queue was full.
+	ImagesRejected int64 `json:"images_rejected"`
+
+	// Number of queued images that were replaced by a newer image of the same job.
+	ImagesReplaced int64 `json:"images_replaced"`
+
+	// Maximum number of jobs that can have an image queued.
+	MaxQueuedJobs int `json:"max_queued_jobs"`
+
+	// IDs of the jobs that have an image queued, in processing order.
+	QueuedJobs []string `json:"queued_jobs"`
+}
+
 // ManagerConfiguration defines model for ManagerConfiguration.
 type ManagerConfiguration struct {
 	// Whether this is considered the first time the Manager runs. This is determined by a few factors, like a non-existent configuration file or certain settings being empty while they shouldn't be.
@@ -483,8 +563,62 @@ type ShamanCheckoutResult struct {
 	CheckoutPath string `json:"checkoutPath"`
 }
 
+// Specification of a chunk of a file. Chunks are uploaded and stored just like files, by their SHA256 checksum and size.
+type ShamanChunkSpec struct {
+	// SHA256 checksum of the chunk
+	Sha string `json:"sha"`
+
+	// Chunk size in bytes
+	Size int `json:"size"`
+}
+
+// Parameters for uploading files as content-defined chunks. Only present when chunking is enabled on the Shaman server. Chunk boundaries are found with a rolling 'gear' hash; see the `pkg/shaman/chunker` Go package for the exact algorithm. Clients must split files with these parameters, for their chunks to be deduplicated.
+type ShamanChunking struct {
+	AvgChunkSize int64 `json:"avg_chunk_size"`
+	MaxChunkSize int64 `json:"max_chunk_size"`
+	MinChunkSize int64 `json:"min_chunk_size"`
+
+	// Only files of at least this size, in bytes, can be uploaded as chunks.
+	MinFileSize int64 `json:"min_file_size"`
+}
+
+// A file in the Shaman file store whose contents do not match its checksum and size.
+type ShamanCorruptFile struct {
+	ActualChecksum string `json:"actual_checksum"`
+	ActualSize     int64  `json:"actual_size"`
+
+	// SHA256 checksum the file should have.
+	Checksum string `json:"checksum"`
+
+	// Where the file was found in the file store.
+	Path string `json:"path"`
+
+	// Where the file was moved to. Absent when it could not be moved out of the file store.
+	QuarantinePath *string `json:"quarantine_path,omitempty"`
+
+	// Paths of checkouts that link to this file. These checkouts should be recreated. Checkouts that contain a copy of the file cannot be found this way.
+	ReferencedBy []string `json:"referenced_by"`
+
+	// Size in bytes the file should have.
+	Size int64 `json:"size"`
+}
+
+// Free disk space of a Shaman storage directory.
+type ShamanDiskSpace struct {
+	// When the free disk space was determined. Absent when it could not be determined.
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+	FreeBytes int64      `json:"free_bytes"`
+
+	// Whether the free disk space is below the configured minimum. For the file store, this means that uploads are refused.
+	IsLow bool   `json:"is_low"`
+	Path  string `json:"path"`
+}
+
 // Specification of a file in the Shaman storage.
 type ShamanFileSpec struct {
+	// The file's content-defined chunks, in order. When the Shaman server has chunking enabled, large files can be uploaded as these chunks instead of as a whole. See `ShamanChunking` for how to split files.
+	Chunks *[]ShamanChunkSpec `json:"chunks,omitempty"`
+
 	// Location of the file in the checkout
 	Path string `json:"path"`
 
@@ -497,6 +631,9 @@ type ShamanFileSpec struct {
 
 // Specification of a file, which could be in the Shaman storage, or not, depending on its status.
 type ShamanFileSpecWithStatus struct {
+	// Present when the file should be uploaded as chunks, instead of as a whole. Only the chunks that are unknown to the Shaman server are listed, so this can be empty. The file is assembled from its chunks in the background, once the checkout is requested.
+	Chunks *[]ShamanChunkSpec `json:"chunks,omitempty"`
+
 	// Location of the file in the checkout
 	Path string `json:"path"`
 
@@ -521,11 +658,46 @@ type ShamanRequirementsResponse struct {
 	Files []ShamanFileSpecWithStatus `json:"files"`
 }
 
+// Results of an integrity check of the Shaman file store.
+type ShamanScrubReport struct {
+	BytesChecked    int64               `json:"bytes_checked"`
+	CorruptFiles    []ShamanCorruptFile `json:"corrupt_files"`
+	FinishedAt      time.Time           `json:"finished_at"`
+	NumFilesChecked int                 `json:"num_files_checked"`
+	StartedAt       time.Time           `json:"started_at"`
+}
+
+// Status of the integrity check of the Shaman file store.
+type ShamanScrubStatus struct {
+	// Results of an integrity check of the Shaman file store.
+	LastReport *ShamanScrubReport `json:"last_report,omitempty"`
+
+	// Whether an integrity check is currently running.
+	Running bool `json:"running"`
+}
+
 // Status of a file in the Shaman storage.
 type ShamanSingleFileStatus struct {
+	// Number of bytes received by an earlier, unfinished upload of this file. The upload can be resumed from this offset by passing it in the `X-Shaman-Upload-Offset` header. Only non-zero when the status is `unknown`.
+	Offset *int64           `json:"offset,omitempty"`
 	Status ShamanFileStatus `json:"status"`
 }
 
+// Status of the Shaman file storage.
+type ShamanStatus struct {
+	// Free disk space of a Shaman storage directory.
+	Checkout *ShamanDiskSpace `json:"checkout,omitempty"`
+
+	// Parameters for uploading files as content-defined chunks. Only present when chunking is enabled on the Shaman server. Chunk boundaries are found with a rolling 'gear' hash; see the `pkg/shaman/chunker` Go package for the exact algorithm. Clients must split files with these parameters, for their chunks to be deduplicated.
+	Chunking *ShamanChunking `json:"chunking,omitempty"`
+
+	// Whether the Shaman file transfer API is available.
+	Enabled bool `json:"enabled"`
+
+	// Free disk space of a Shaman storage directory.
+	FileStore *ShamanDiskSpace `json:"file_store,omitempty"`
+}
+
 // Location of the shared storage, adjusted for a specific audience & platform. This uses two-way variables to adjust the shared storage path from the Manager's configuration.
 type SharedStorageLocation struct {
 	Audience ManagerVariableAudience `json:"audience"`
@@ -549,6 +721,9 @@ type SocketIOJobUpdate struct {
 	PreviousStatus *JobStatus `json:"previous_status,omitempty"`
 	Priority       int        `json:"priority"`
 
+	// Percentage of the job that has been completed, 0-100. This is the average progress of its tasks, where completed tasks count as 100%. Only sent when the progress of one of the job's tasks changed.
+	Progress *int `json:"progress,omitempty"`
+
 	// Indicates that the client should refresh all the job's tasks. This is sent for mass updates, where updating each individual task would generate too many updates to be practical.
 	RefreshTasks bool      `json:"refresh_tasks"`
 	Status       JobStatus `json:"status"`
@@ -606,7 +781,13 @@ type SocketIOTaskUpdate struct {
 	// Name of the task
 	Name           string      `json:"name"`
 	PreviousStatus *TaskStatus `json:"previous_status,omitempty"`
-	Status         TaskStatus  `json:"status"`
+
+	// Percentage of the task that has been completed, 0-100.
+	Progress int `json:"progress"`
+
+	// Progress of a render task, as parsed by the Worker from the output of the renderer. All properties are optional, and only sent when known.
+	RenderProgress *TaskRenderProgress `json:"render_progress,omitempty"`
+	Status         TaskStatus          `json:"status"`
 
 	// Timestamp of last update
 	Updated time.Time `json:"updated"`
@@ -650,11 +831,23 @@ type SocketIOWorkerUpdate struct {
 
 // Job definition submitted to Flamenco.
 type SubmittedJob struct {
+	// UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well.
+	DependsOn *[]string `json:"depends_on,omitempty"`
+
+	// Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit.
+	MaxWorkers *int `json:"max_workers,omitempty"`
+
 	// Arbitrary metadata strings. More complex structures can be modeled by using `a.b.c` notation for the key.
 	Metadata *JobMetadata `json:"metadata,omitempty"`
 	Name     string       `json:"name"`
-	Priority int          `json:"priority"`
-	Settings *JobSettings `json:"settings,omitempty"`
+
+	// Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately.
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	Priority  int        `json:"priority"`
+
+	// Determines how failed tasks of a job are retried. Properties that are omitted keep the value determined by the job type.
+	RetryPolicy *JobRetryPolicy `json:"retry_policy,omitempty"`
+	Settings    *JobSettings    `json:"settings,omitempty"`
 
 	// Storage info of a job, which Flamenco can use to remove job-related files when necessary.
 	Storage *JobStorageInfo `json:"storage,omitempty"`
@@ -672,6 +865,19 @@ type SubmittedJob struct {
 	WorkerTag *string `json:"worker_tag,omitempty"`
 }
 
+// Job that is submitted periodically, according to a cron-style schedule.
+type SubmittedJobTemplate struct {
+	// Cron expression, with the fields "minute hour day-of-month month day-of-week", evaluated in the timezone of the Manager. The macros `@yearly`, `@monthly`, `@weekly`, `@daily`, and `@hourly` are supported as well.
+	Cron string `json:"cron"`
+
+	// Only active templates submit jobs.
+	IsActive bool `json:"is_active"`
+
+	// Job definition submitted to Flamenco.
+	Job  SubmittedJob `json:"job"`
+	Name string       `json:"name"`
+}
+
 // The task as it exists in the Manager database, i.e. before variable replacement.
 type Task struct {
 	Activity string    `json:"activity"`
@@ -685,10 +891,19 @@ type Task struct {
 
 	// Timestamp of when any worker worked on this task.
 	LastTouched *time.Time `json:"last_touched,omitempty"`
-	Name        string     `json:"name"`
-	Priority    int        `json:"priority"`
-	Status      TaskStatus `json:"status"`
-	TaskType    string     `json:"task_type"`
+
+	// Minimum number of CPUs a Worker needs to get this task.
+	MinCpuCount *int `json:"min_cpu_count,omitempty"`
+
+	// Minimum amount of RAM, in GB, a Worker needs to get this task.
+	MinMemoryGb *int   `json:"min_memory_gb,omitempty"`
+	Name        string `json:"name"`
+	Priority    int    `json:"priority"`
+
+	// Percentage of the task that has been completed, 0-100.
+	Progress int        `json:"progress"`
+	Status   TaskStatus `json:"status"`
+	TaskType string     `json:"task_type"`
 
 	// Timestamp of last update.
 	Updated time.Time `json:"updated"`
@@ -709,6 +924,70 @@ type TaskLogInfo struct {
 	Url string `json:"url"`
 }
 
+// A task log line that matched the search query.
+type TaskLogSearchMatch struct {
+	JobId string `json:"job_id"`
+
+	// The log line itself, without line ending.
+	Line string `json:"line"`
+
+	// Line number in the task log, starting at 1.
+	LineNumber int    `json:"line_number"`
+	TaskId     string `json:"task_id"`
+	TaskName   string `json:"task_name"`
+}
+
+// Search query for task logs. Either `job_id` or `since` must be given. When `job_id` is given, `since` and `until` are ignored.
+type TaskLogSearchQuery struct {
+	// Perform case-insensitive matching.
+	IgnoreCase *bool `json:"ignore_case,omitempty"`
+
+	// Only search the task logs of this job.
+	JobId *string `json:"job_id,omitempty"`
+
+	// Maximum number of matches to return. Defaults to 1000.
+	Limit *int `json:"limit,omitempty"`
+
+	// Text to search for. Log lines that contain this text are returned.
+	Pattern string `json:"pattern"`
+
+	// Interpret the pattern as regular expression (RE2 syntax) instead of as literal text.
+	Regexp *bool `json:"regexp,omitempty"`
+
+	// Only search the task logs of jobs that were updated at or after this time.
+	Since *time.Time `json:"since,omitempty"`
+
+	// Only search the task logs of jobs that were created at or before this time.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// Progress of a render task, as parsed by the Worker from the output of the renderer. All properties are optional, and only sent when known.
+type TaskRenderProgress struct {
+	// The frame that is currently being rendered.
+	Frame *int `json:"frame,omitempty"`
+
+	// The number of frames this task has saved so far.
+	FramesDone *int `json:"frames_done,omitempty"`
+
+	// The number of frames this task should render.
+	FramesTotal *int `json:"frames_total,omitempty"`
+
+	// Render time of the most recently saved frame, in seconds.
+	LastFrameTime *float32 `json:"last_frame_time,omitempty"`
+
+	// Peak memory usage reported by the renderer, in megabytes.
+	MemoryPeakMb *float32 `json:"memory_peak_mb,omitempty"`
+
+	// Files the renderer could not open, such as missing textures.
+	MissingFiles *[]string `json:"missing_files,omitempty"`
+
+	// The number of samples rendered of the current frame.
+	SamplesDone *int `json:"samples_done,omitempty"`
+
+	// The total number of samples to render for the current frame.
+	SamplesTotal *int `json:"samples_total,omitempty"`
+}
+
 // TaskStatus defines model for TaskStatus.
 type TaskStatus string
 
@@ -735,8 +1014,14 @@ type TaskUpdate struct {
 	Activity *string `json:"activity,omitempty"`
 
 	// Log lines for this task, will be appended to logs sent earlier.
-	Log        *string     `json:"log,omitempty"`
-	TaskStatus *TaskStatus `json:"taskStatus,omitempty"`
+	Log *string `json:"log,omitempty"`
+
+	// Percentage of the task that has been completed. Overwrites previously sent progress.
+	Progress *int `json:"progress,omitempty"`
+
+	// Progress of a render task, as parsed by the Worker from the output of the renderer. All properties are optional, and only sent when known.
+	RenderProgress *TaskRenderProgress `json:"render_progress,omitempty"`
+	TaskStatus     *TaskStatus         `json:"taskStatus,omitempty"`
 }
 
 // Worker reference, as used in Task objects.
@@ -755,8 +1040,14 @@ type Worker struct {
 	IpAddress string `json:"ip_address"`
 
 	// Operating system of the Worker
-	Platform           string   `json:"platform"`
-	SupportedTaskTypes []string `json:"supported_task_types"`
+	Platform string `json:"platform"`
+
+	// Resource usage of a Worker, as last reported in its heartbeat.
+	ResourceUsage *WorkerResourceSample `json:"resource_usage,omitempty"`
+
+	// Hardware and operating system of a Worker, as reported when it signs on.
+	Resources          *WorkerResources `json:"resources,omitempty"`
+	SupportedTaskTypes []string         `json:"supported_task_types"`
 
 	// Tags of which this Worker is a member.
 	Tags *[]WorkerTag `json:"tags,omitempty"`
@@ -778,12 +1069,51 @@ type WorkerRegistration struct {
 	SupportedTaskTypes []string `json:"supported_task_types"`
 }
 
+// WorkerResourceSample defines model for WorkerResourceSample.
+type WorkerResourceSample struct {
+	// Embedded struct due to allOf(#/components/schemas/WorkerResourceUsage)
+	WorkerResourceUsage `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// When the Manager received this sample.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Resource usage of a Worker. Values that cannot be determined on the Worker's platform are left out.
+type WorkerResourceUsage struct {
+	// System load averaged over the last minute.
+	LoadAverage *float64 `json:"load_average,omitempty"`
+
+	// Amount of RAM in use, in bytes.
+	MemoryUsed *int64 `json:"memory_used,omitempty"`
+
+	// Free disk space of the Worker's temporary directory, in bytes.
+	TempDiskFree *int64 `json:"temp_disk_free,omitempty"`
+}
+
+// Hardware and operating system of a Worker, as reported when it signs on.
+type WorkerResources struct {
+	// Number of logical CPUs.
+	CpuCount *int `json:"cpu_count,omitempty"`
+
+	// Total amount of RAM, in bytes.
+	MemoryTotal *int64 `json:"memory_total,omitempty"`
+
+	// Description of the operating system, more detailed than the platform.
+	OsDescription *string `json:"os_description,omitempty"`
+
+	// Free disk space of the Worker's temporary directory, in bytes.
+	TempDiskFree *int64 `json:"temp_disk_free,omitempty"`
+}
+
 // WorkerSignOn defines model for WorkerSignOn.
 type WorkerSignOn struct {
-	CanRestart         *bool    `json:"can_restart,omitempty"`
-	Name               string   `json:"name"`
-	SoftwareVersion    string   `json:"software_version"`
-	SupportedTaskTypes []string `json:"supported_task_types"`
+	CanRestart *bool  `json:"can_restart,omitempty"`
+	Name       string `json:"name"`
+
+	// Hardware and operating system of a Worker, as reported when it signs on.
+	Resources          *WorkerResources `json:"resources,omitempty"`
+	SoftwareVersion    string           `json:"software_version"`
+	SupportedTaskTypes []string         `json:"supported_task_types"`
 }
 
 // Sleep schedule for a single Worker. Start and end time indicate the time of each day at which the schedule is active. Applies only when today is in `days_of_week`, or when `days_of_week` is empty.
@@ -792,8 +1122,20 @@ type WorkerSleepSchedule struct {
 	// Space-separated two-letter strings indicating days of week the schedule is active ("mo", "tu", etc.). Empty means "every day".
 	DaysOfWeek string `json:"days_of_week"`
 	EndTime    string `json:"end_time"`
-	IsActive   bool   `json:"is_active"`
-	StartTime  string `json:"start_time"`
+
+	// Dates, in YYYY-MM-DD notation, on which the schedule does not apply and the Worker stays awake all day. This can be used to keep Workers rendering during holidays.
+	ExceptionDates *[]string `json:"exception_dates,omitempty"`
+
+	// Time windows in which the Worker sleeps, in addition to the window from `start_time` to `end_time`.
+	ExtraWindows *[]WorkerSleepWindow `json:"extra_windows,omitempty"`
+	IsActive     bool                 `json:"is_active"`
+	StartTime    string               `json:"start_time"`
+}
+
+// Time window in which a Worker sleeps. An empty start time means "start of the day", and an empty end time means "end of the day".
+type WorkerSleepWindow struct {
+	EndTime   string `json:"end_time"`
+	StartTime string `json:"start_time"`
 }
 
 // WorkerStateChange defines model for WorkerStateChange.
@@ -870,6 +1212,12 @@ type CheckSharedStoragePathJSONBody PathCheckInput
 // SaveSetupAssistantConfigJSONBody defines parameters for SaveSetupAssistantConfig.
 type SaveSetupAssistantConfigJSONBody SetupAssistantConfig
 
+// CreateJobTemplateJSONBody defines parameters for CreateJobTemplate.
+type CreateJobTemplateJSONBody SubmittedJobTemplate
+
+// UpdateJobTemplateJSONBody defines parameters for UpdateJobTemplate.
+type UpdateJobTemplateJSONBody SubmittedJobTemplate
+
 // SubmitJobJSONBody defines parameters for SubmitJob.
 type SubmitJobJSONBody SubmittedJob
 
@@ -885,6 +1233,12 @@ type QueryJobsJSONBody JobsQuery
 // RemoveJobBlocklistJSONBody defines parameters for RemoveJobBlocklist.
 type RemoveJobBlocklistJSONBody JobBlocklist
 
+// FetchJobLastRenderedContactSheetParams defines parameters for FetchJobLastRenderedContactSheet.
+type FetchJobLastRenderedContactSheetParams struct {
+	// Number of thumbnails per row of the contact sheet.
+	Columns *int `json:"columns,omitempty"`
+}
+
 // SetJobPriorityJSONBody defines parameters for SetJobPriority.
 type SetJobPriorityJSONBody JobPriorityChange
 
@@ -904,14 +1258,23 @@ type ShamanFileStoreParams struct {
 
 	// The original filename. If sent along with the request, it will be included in the server logs, which can aid in debugging.
 	XShamanOriginalFilename *string `json:"X-Shaman-Original-Filename,omitempty"`
+
+	// Resume an earlier, unfinished upload of this file from this byte offset. The request body should then contain the file's contents from this offset onward. The offset can be obtained from the `shamanFileStoreCheck` operation.
+	XShamanUploadOffset *int64 `json:"X-Shaman-Upload-Offset,omitempty"`
 }
 
+// SearchTaskLogsJSONBody defines parameters for SearchTaskLogs.
+type SearchTaskLogsJSONBody TaskLogSearchQuery
+
 // SetTaskStatusJSONBody defines parameters for SetTaskStatus.
 type SetTaskStatusJSONBody TaskStatusChange
 
 // UpdateWorkerTagJSONBody defines parameters for UpdateWorkerTag.
 type UpdateWorkerTagJSONBody WorkerTag
 
+// SetWorkerTagSleepScheduleJSONBody defines parameters for SetWorkerTagSleepSchedule.
+type SetWorkerTagSleepScheduleJSONBody WorkerSleepSchedule
+
 // CreateWorkerTagJSONBody defines parameters for CreateWorkerTag.
 type CreateWorkerTagJSONBody WorkerTag
 
@@ -924,6 +1287,9 @@ type SetWorkerTagsJSONBody WorkerTagChangeRequest
 // SetWorkerSleepScheduleJSONBody defines parameters for SetWorkerSleepSchedule.
 type SetWorkerSleepScheduleJSONBody WorkerSleepSchedule
 
+// WorkerHeartbeatJSONBody defines parameters for WorkerHeartbeat.
+type WorkerHeartbeatJSONBody WorkerResourceUsage
+
 // RegisterWorkerJSONBody defines parameters for RegisterWorker.
 type RegisterWorkerJSONBody WorkerRegistration
 
@@ -936,6 +1302,12 @@ type WorkerStateChangedJSONBody WorkerStateChanged
 // TaskUpdateJSONBody defines parameters for TaskUpdate.
 type TaskUpdateJSONBody TaskUpdate
 
+// TaskOutputProducedParams defines parameters for TaskOutputProduced.
+type TaskOutputProducedParams struct {
+	// The filename of the rendered image, without directory. The frame number is taken from this filename, to keep a history of last-rendered images per job.
+	XFlamencoOutputFilename *string `json:"X-Flamenco-Output-Filename,omitempty"`
+}
+
 // CheckBlenderExePathJSONRequestBody defines body for CheckBlenderExePath for application/json ContentType.
 type CheckBlenderExePathJSONRequestBody CheckBlenderExePathJSONBody
 
@@ -945,6 +1317,12 @@ type CheckSharedStoragePathJSONRequestBody CheckSharedStoragePathJSONBody
 // SaveSetupAssistantConfigJSONRequestBody defines body for SaveSetupAssistantConfig for application/json ContentType.
 type SaveSetupAssistantConfigJSONRequestBody SaveSetupAssistantConfigJSONBody
 
+// CreateJobTemplateJSONRequestBody defines body for CreateJobTemplate for application/json ContentType.
+type CreateJobTemplateJSONRequestBody CreateJobTemplateJSONBody
+
+// UpdateJobTemplateJSONRequestBody defines body for UpdateJobTemplate for application/json ContentType.
+type UpdateJobTemplateJSONRequestBody UpdateJobTemplateJSONBody
+
 // SubmitJobJSONRequestBody defines body for SubmitJob for application/json ContentType.
 type SubmitJobJSONRequestBody SubmitJobJSONBody
 
@@ -972,12 +1350,18 @@ type ShamanCheckoutJSONRequestBody ShamanCheckoutJSONBody
 // ShamanCheckoutRequirementsJSONRequestBody defines body for ShamanCheckoutRequirements for application/json ContentType.
 type ShamanCheckoutRequirementsJSONRequestBody ShamanCheckoutRequirementsJSONBody
 
+// SearchTaskLogsJSONRequestBody defines body for SearchTaskLogs for application/json ContentType.
+type SearchTaskLogsJSONRequestBody SearchTaskLogsJSONBody
+
 // SetTaskStatusJSONRequestBody defines body for SetTaskStatus for application/json ContentType.
 type SetTaskStatusJSONRequestBody SetTaskStatusJSONBody
 
 // UpdateWorkerTagJSONRequestBody defines body for UpdateWorkerTag for application/json ContentType.
 type UpdateWorkerTagJSONRequestBody UpdateWorkerTagJSONBody
 
+// SetWorkerTagSleepScheduleJSONRequestBody defines body for SetWorkerTagSleepSchedule for application/json ContentType.
+type SetWorkerTagSleepScheduleJSONRequestBody SetWorkerTagSleepScheduleJSONBody
+
 // CreateWorkerTagJSONRequestBody defines body for CreateWorkerTag for application/json ContentType.
 type CreateWorkerTagJSONRequestBody CreateWorkerTagJSONBody
 
@@ -990,6 +1374,9 @@ type SetWorkerTagsJSONRequestBody SetWorkerTagsJSONBody
 // SetWorkerSleepScheduleJSONRequestBody defines body for SetWorkerSleepSchedule for application/json ContentType.
 type SetWorkerSleepScheduleJSONRequestBody SetWorkerSleepScheduleJSONBody
 
+// WorkerHeartbeatJSONRequestBody defines body for WorkerHeartbeat for application/json ContentType.
+type WorkerHeartbeatJSONRequestBody WorkerHeartbeatJSONBody
+
 // RegisterWorkerJSONRequestBody defines body for RegisterWorker for application/json ContentType.
 type RegisterWorkerJSONRequestBody RegisterWorkerJSONBody
 
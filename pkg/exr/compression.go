@@ -0,0 +1,113 @@
+package exr
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+)
+
+// decompress returns the uncompressed pixel data of a chunk.
+func decompress(compression Compression, data []byte, uncompressedSize int) ([]byte, error) {
+	// Data that would not get smaller by compressing is stored as-is.
+	if len(data) == uncompressedSize {
+		return data, nil
+	}
+	if len(data) > uncompressedSize {
+		return nil, fmt.Errorf("%w: chunk larger than its uncompressed size", ErrInvalid)
+	}
+
+	var (
+		buffer []byte
+		err    error
+	)
+	switch compression {
+	case CompressionNone:
+		return nil, fmt.Errorf("%w: uncompressed chunk has wrong size", ErrInvalid)
+	case CompressionRLE:
+		buffer, err = rleDecompress(data, uncompressedSize)
+	case CompressionZIPS, CompressionZIP:
+		buffer, err = zlibDecompress(data, uncompressedSize)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCompression, compression)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	undoPredictor(buffer)
+	return deinterleave(buffer), nil
+}
+
+func zlibDecompress(data []byte, uncompressedSize int) ([]byte, error) {
+	reader, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	defer reader.Close()
+
+	buffer := make([]byte, uncompressedSize)
+	if _, err := io.ReadFull(reader, buffer); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return buffer, nil
+}
+
+// rleDecompress decodes run-length encoded data. A negative count byte is
+// followed by that many literal bytes, a non-negative count byte N is followed
+// by a single byte that is repeated N+1 times.
+func rleDecompress(data []byte, uncompressedSize int) ([]byte, error) {
+	buffer := make([]byte, 0, uncompressedSize)
+	for len(data) > 0 {
+		count := int(int8(data[0]))
+		data = data[1:]
+
+		if count < 0 {
+			count = -count
+			if count > len(data) || len(buffer)+count > uncompressedSize {
+				return nil, fmt.Errorf("%w: RLE data", ErrInvalid)
+			}
+			buffer = append(buffer, data[:count]...)
+			data = data[count:]
+			continue
+		}
+
+		count++
+		if len(data) < 1 || len(buffer)+count > uncompressedSize {
+			return nil, fmt.Errorf("%w: RLE data", ErrInvalid)
+		}
+		for i := 0; i < count; i++ {
+			buffer = append(buffer, data[0])
+		}
+		data = data[1:]
+	}
+
+	if len(buffer) != uncompressedSize {
+		return nil, fmt.Errorf("%w: RLE data", ErrInvalid)
+	}
+	return buffer, nil
+}
+
+// undoPredictor reverses the delta encoding that is applied before compression.
+func undoPredictor(data []byte) {
+	for i := 1; i < len(data); i++ {
+		data[i] = data[i-1] + data[i] - 128
+	}
+}
+
+// deinterleave reverses the byte reordering that is applied before compression.
+// The first half of the data contains the even bytes, the second half the odd ones.
+func deinterleave(data []byte) []byte {
+	result := make([]byte, len(data))
+	half := (len(data) + 1) / 2
+	for i := range result {
+		if i%2 == 0 {
+			result[i] = data[i/2]
+		} else {
+			result[i] = data[half+i/2]
+		}
+	}
+	return result
+}
@@ -0,0 +1,358 @@
+// Package exr decodes OpenEXR images, for showing previews of rendered frames.
+//
+// Only scanline images are supported, uncompressed or compressed with RLE,
+// ZIPS, or ZIP. This covers Blender's default EXR settings. The linear HDR
+// data is tone-mapped to a 16-bit sRGB image, so the result is suitable for
+// previews, not for further processing.
+//
+// Importing this package registers the "exr" format with the `image` package.
+package exr
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// MimeType is the MIME type of OpenEXR files.
+const MimeType = "image/x-exr"
+
+// Blender names the compositor output of multilayer EXR files like this.
+const blenderCompositeLayer = "Composite.Combined"
+
+var ErrLayerNotFound = errors.New("exr: layer not found")
+
+func init() {
+	image.RegisterFormat("exr", "v/1\x01", Decode, DecodeConfig)
+}
+
+// Options influence how an EXR image is decoded.
+type Options struct {
+	// Layer is the name of the layer to decode, like "ViewLayer.Combined" for
+	// the "ViewLayer.Combined.R", "ViewLayer.Combined.G", etc. channels. When
+	// empty, the layer is chosen automatically; see ChooseLayer().
+	Layer string
+
+	// Exposure is applied before tone mapping, in stops.
+	Exposure float64
+
+	// ToneMap converts linear values to display values. Defaults to ToneMapStandard.
+	ToneMap ToneMapper
+}
+
+// Decode decodes an EXR image with the default options.
+func Decode(r io.Reader) (image.Image, error) {
+	return DecodeWithOptions(r, Options{})
+}
+
+// DecodeConfig returns the colour model and dimensions of an EXR image,
+// without decoding the pixel data.
+func DecodeConfig(r io.Reader) (image.Config, error) {
+	header, err := ReadHeader(r)
+	if err != nil {
+		return image.Config{}, err
+	}
+	return image.Config{
+		ColorModel: color.RGBA64Model,
+		Width:      header.DataWindow.width(),
+		Height:     header.DataWindow.height(),
+	}, nil
+}
+
+// ReadHeader reads the header of an EXR image.
+func ReadHeader(r io.Reader) (*Header, error) {
+	return readHeader(&headerReader{r: bufio.NewReader(r)})
+}
+
+// DecodeWithOptions decodes an EXR image, returning it as *image.RGBA64.
+func DecodeWithOptions(r io.Reader, options Options) (image.Image, error) {
+	hr := headerReader{r: bufio.NewReader(r)}
+	header, err := readHeader(&hr)
+	if err != nil {
+		return nil, err
+	}
+
+	layerName := options.Layer
+	if layerName == "" {
+		layerName, err = header.ChooseLayer()
+		if err != nil {
+			return nil, err
+		}
+	}
+	layer, err := header.layerChannels(layerName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch header.Compression {
+	case CompressionNone, CompressionRLE, CompressionZIPS, CompressionZIP:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCompression, header.Compression)
+	}
+
+	pixels, err := readPixels(&hr, header, layer)
+	if err != nil {
+		return nil, err
+	}
+
+	return pixels.toImage(options), nil
+}
+
+// Layers returns the names of the layers in the image. The channels of the
+// unnamed layer, like plain "R", "G", and "B", are returned as the "" layer.
+func (h *Header) Layers() []string {
+	seen := map[string]bool{}
+	layers := []string{}
+	for _, channel := range h.Channels {
+		layer, _ := splitChannelName(channel.Name)
+		if !seen[layer] {
+			seen[layer] = true
+			layers = append(layers, layer)
+		}
+	}
+	sort.Strings(layers)
+	return layers
+}
+
+// ChooseLayer returns the name of the layer that is most likely the final
+// image. This is the unnamed layer, Blender's compositor output, or the first
+// layer ending in "Combined", in that order. If none of those can be found,
+// the first layer with colour channels is returned, then the first layer with
+// a single channel.
+func (h *Header) ChooseLayer() (string, error) {
+	layers := h.Layers()
+
+	isColour := func(layer string) bool {
+		channels := h.channelsOfLayer(layer)
+		_, hasR := channels["R"]
+		_, hasG := channels["G"]
+		_, hasB := channels["B"]
+		return hasR && hasG && hasB
+	}
+	isGrey := func(layer string) bool {
+		channels := h.channelsOfLayer(layer)
+		delete(channels, "A")
+		return len(channels) == 1
+	}
+
+	candidates := []func(layer string) bool{
+		func(layer string) bool { return layer == "" && isColour(layer) },
+		func(layer string) bool { return layer == blenderCompositeLayer && isColour(layer) },
+		func(layer string) bool { return strings.HasSuffix(layer, "Combined") && isColour(layer) },
+		isColour,
+		isGrey,
+	}
+	for _, isCandidate := range candidates {
+		for _, layer := range layers {
+			if isCandidate(layer) {
+				return layer, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: no layer with colour or greyscale channels", ErrLayerNotFound)
+}
+
+// channelsOfLayer returns a mapping from channel name (without layer prefix)
+// to index in h.Channels.
+func (h *Header) channelsOfLayer(layer string) map[string]int {
+	channels := map[string]int{}
+	for idx, channel := range h.Channels {
+		channelLayer, name := splitChannelName(channel.Name)
+		if channelLayer == layer {
+			channels[name] = idx
+		}
+	}
+	return channels
+}
+
+// layerChannels holds the indices of the channels to decode, or -1 for
+// missing channels. For greyscale layers, only `r` is set.
+type layerChannels struct {
+	r, g, b, a int
+}
+
+func (h *Header) layerChannels(layer string) (layerChannels, error) {
+	channels := h.channelsOfLayer(layer)
+	if len(channels) == 0 {
+		return layerChannels{}, fmt.Errorf("%w: %q", ErrLayerNotFound, layer)
+	}
+
+	index := func(name string) int {
+		if idx, ok := channels[name]; ok {
+			return idx
+		}
+		return -1
+	}
+	result := layerChannels{r: index("R"), g: index("G"), b: index("B"), a: index("A")}
+	if result.r >= 0 && result.g >= 0 && result.b >= 0 {
+		return result, nil
+	}
+
+	// Use the single non-alpha channel as greyscale.
+	delete(channels, "A")
+	if len(channels) != 1 {
+		return layerChannels{}, fmt.Errorf("%w: layer %q has no RGB channels", ErrLayerNotFound, layer)
+	}
+	for _, idx := range channels {
+		result = layerChannels{r: idx, g: -1, b: -1, a: result.a}
+	}
+	return result, nil
+}
+
+// splitChannelName splits "ViewLayer.Combined.R" into "ViewLayer.Combined" and "R".
+func splitChannelName(name string) (layer, channel string) {
+	idx := strings.LastIndexByte(name, '.')
+	if idx < 0 {
+		return "", name
+	}
+	return name[:idx], name[idx+1:]
+}
+
+// floatPixels contains the linear pixel values of the decoded channels.
+type floatPixels struct {
+	width, height int
+	isGrey        bool
+	hasAlpha      bool
+	// Channel values, in RGBA order, per pixel. Greyscale images only use
+	// the R and A values.
+	values []float32
+}
+
+func readPixels(hr *headerReader, header *Header, layer layerChannels) (*floatPixels, error) {
+	width, height := header.DataWindow.width(), header.DataWindow.height()
+	pixels := floatPixels{
+		width:    width,
+		height:   height,
+		isGrey:   layer.g < 0,
+		hasAlpha: layer.a >= 0,
+		values:   make([]float32, width*height*4),
+	}
+
+	// Find where each channel starts within a scanline.
+	channelOffsets := make([]int, len(header.Channels))
+	offset := 0
+	for idx, channel := range header.Channels {
+		channelOffsets[idx] = offset
+		offset += width * channel.PixelType.size()
+	}
+	bytesPerLine := offset
+
+	// Skip the offset table. The chunks follow it directly, and each chunk
+	// contains its own Y coordinate, so they can just be read sequentially.
+	numChunks := header.numChunks()
+	if _, err := hr.r.Discard(numChunks * 8); err != nil {
+		return nil, fmt.Errorf("%w: file truncated", ErrInvalid)
+	}
+
+	linesPerChunk := header.Compression.linesPerChunk()
+	var chunkHeader [8]byte
+	for chunkIdx := 0; chunkIdx < numChunks; chunkIdx++ {
+		if err := hr.read(chunkHeader[:]); err != nil {
+			return nil, err
+		}
+		chunkY := int(int32(binary.LittleEndian.Uint32(chunkHeader[0:4]))) - int(header.DataWindow.YMin)
+		dataSize := int(binary.LittleEndian.Uint32(chunkHeader[4:8]))
+
+		if chunkY < 0 || chunkY >= height || chunkY%linesPerChunk != 0 {
+			return nil, fmt.Errorf("%w: chunk has invalid Y coordinate", ErrInvalid)
+		}
+		numLines := linesPerChunk
+		if chunkY+numLines > height {
+			numLines = height - chunkY
+		}
+		uncompressedSize := numLines * bytesPerLine
+		if dataSize > uncompressedSize {
+			return nil, fmt.Errorf("%w: chunk larger than its uncompressed size", ErrInvalid)
+		}
+
+		data := make([]byte, dataSize)
+		if err := hr.read(data); err != nil {
+			return nil, err
+		}
+		data, err := decompress(header.Compression, data, uncompressedSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for line := 0; line < numLines; line++ {
+			lineData := data[line*bytesPerLine : (line+1)*bytesPerLine]
+			pixelOffset := (chunkY + line) * width * 4
+			for component, channelIdx := range []int{layer.r, layer.g, layer.b, layer.a} {
+				if channelIdx < 0 {
+					continue
+				}
+				pixelType := header.Channels[channelIdx].PixelType
+				channelData := lineData[channelOffsets[channelIdx]:]
+				for x := 0; x < width; x++ {
+					pixels.values[pixelOffset+x*4+component] = readSample(channelData, x, pixelType)
+				}
+			}
+		}
+	}
+
+	return &pixels, nil
+}
+
+func readSample(data []byte, x int, pixelType PixelType) float32 {
+	switch pixelType {
+	case PixelTypeHalf:
+		return halfToFloat32(binary.LittleEndian.Uint16(data[x*2:]))
+	case PixelTypeFloat:
+		return math.Float32frombits(binary.LittleEndian.Uint32(data[x*4:]))
+	default:
+		return float32(binary.LittleEndian.Uint32(data[x*4:]))
+	}
+}
+
+// toImage tone-maps the linear pixels to an sRGB image.
+func (p *floatPixels) toImage(options Options) *image.RGBA64 {
+	toneMap := options.ToneMap
+	if toneMap == nil {
+		toneMap = ToneMapStandard
+	}
+	exposure := float32(math.Exp2(options.Exposure))
+
+	img := image.NewRGBA64(image.Rect(0, 0, p.width, p.height))
+	for idx := 0; idx < p.width*p.height; idx++ {
+		values := p.values[idx*4 : idx*4+4]
+
+		alpha := float32(1)
+		if p.hasAlpha {
+			alpha = clamp01(values[3])
+		}
+
+		// EXR colours are premultiplied with alpha, so that has to be undone
+		// before tone mapping.
+		var rgb [3]float32
+		for component := 0; component < 3; component++ {
+			value := values[component]
+			if p.isGrey {
+				value = values[0]
+			}
+			if alpha > 0 {
+				rgb[component] = toneMap(exposure*value/alpha) * alpha
+			}
+		}
+
+		img.Pix[idx*8+0], img.Pix[idx*8+1] = to16Bit(rgb[0])
+		img.Pix[idx*8+2], img.Pix[idx*8+3] = to16Bit(rgb[1])
+		img.Pix[idx*8+4], img.Pix[idx*8+5] = to16Bit(rgb[2])
+		img.Pix[idx*8+6], img.Pix[idx*8+7] = to16Bit(alpha)
+	}
+	return img
+}
+
+// to16Bit converts a value in [0, 1] to big-endian 16-bit, as used by image.RGBA64.
+func to16Bit(value float32) (byte, byte) {
+	value16 := uint16(clamp01(value)*0xffff + 0.5)
+	return byte(value16 >> 8), byte(value16)
+}
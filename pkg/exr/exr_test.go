@@ -0,0 +1,364 @@
+package exr
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"image"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeZIP(t *testing.T) {
+	// 20 lines means two chunks with ZIP compression, the last one incomplete.
+	width, height := 3, 20
+	pixels := map[string][]float32{
+		"R": filled(width*height, 1.0),
+		"G": filled(width*height, 0.5),
+		"B": filled(width*height, 0.0),
+		"A": filled(width*height, 1.0),
+	}
+	pixels["B"][width*height-1] = 4.0 // Overexposed pixel in the last chunk.
+
+	exrData := encodeTestEXR(t, width, height, CompressionZIP, PixelTypeHalf, pixels)
+
+	img, format, err := image.Decode(bytes.NewReader(exrData))
+	require.NoError(t, err)
+	assert.Equal(t, "exr", format)
+	assert.Equal(t, image.Rect(0, 0, width, height), img.Bounds())
+
+	rgba := img.(*image.RGBA64)
+	assert.Equal(t, uint16(0xffff), rgba.RGBA64At(0, 0).R)
+	assert.Equal(t, uint16(srgb16(0.5)), rgba.RGBA64At(0, 0).G)
+	assert.Equal(t, uint16(0), rgba.RGBA64At(0, 0).B)
+	assert.Equal(t, uint16(0xffff), rgba.RGBA64At(0, 0).A)
+	assert.Equal(t, uint16(0xffff), rgba.RGBA64At(width-1, height-1).B, "overexposed pixels should be clipped")
+}
+
+func TestDecodeUncompressedFloat(t *testing.T) {
+	width, height := 2, 2
+	pixels := map[string][]float32{
+		"R": {0.25, 0, 0, 1},
+		"G": {0.25, 0, 1, 0},
+		"B": {0.25, 1, 0, 0},
+	}
+	exrData := encodeTestEXR(t, width, height, CompressionNone, PixelTypeFloat, pixels)
+
+	img, err := DecodeWithOptions(bytes.NewReader(exrData), Options{Exposure: 1})
+	require.NoError(t, err)
+
+	rgba := img.(*image.RGBA64)
+	assert.Equal(t, uint16(srgb16(0.5)), rgba.RGBA64At(0, 0).R, "exposure of 1 stop should double the value")
+	assert.Equal(t, uint16(0xffff), rgba.RGBA64At(1, 0).B)
+	assert.Equal(t, uint16(0xffff), rgba.RGBA64At(0, 1).G)
+	assert.Equal(t, uint16(0xffff), rgba.RGBA64At(1, 1).R)
+	assert.Equal(t, uint16(0xffff), rgba.RGBA64At(1, 1).A, "images without alpha should be opaque")
+}
+
+func TestDecodePremultipliedAlpha(t *testing.T) {
+	pixels := map[string][]float32{
+		"R": {0.5},
+		"G": {0.5},
+		"B": {0.5},
+		"A": {0.5},
+	}
+	exrData := encodeTestEXR(t, 1, 1, CompressionZIPS, PixelTypeHalf, pixels)
+
+	img, err := Decode(bytes.NewReader(exrData))
+	require.NoError(t, err)
+
+	// The linear colour is 0.5/0.5 = 1.0, which results in a premultiplied 0.5.
+	pixel := img.(*image.RGBA64).RGBA64At(0, 0)
+	assert.Equal(t, uint16(0x8000), pixel.R)
+	assert.Equal(t, uint16(0x8000), pixel.A)
+}
+
+func TestDecodeMultilayer(t *testing.T) {
+	pixels := map[string][]float32{
+		"ViewLayer.Combined.R": {0.0},
+		"ViewLayer.Combined.G": {0.0},
+		"ViewLayer.Combined.B": {0.0},
+		"ViewLayer.Combined.A": {1.0},
+		"ViewLayer.Depth.Z":    {0.5},
+		"Composite.Combined.R": {1.0},
+		"Composite.Combined.G": {1.0},
+		"Composite.Combined.B": {1.0},
+		"Composite.Combined.A": {1.0},
+	}
+	exrData := encodeTestEXR(t, 1, 1, CompressionZIP, PixelTypeHalf, pixels)
+
+	header, err := ReadHeader(bytes.NewReader(exrData))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Composite.Combined", "ViewLayer.Combined", "ViewLayer.Depth"}, header.Layers())
+
+	layer, err := header.ChooseLayer()
+	require.NoError(t, err)
+	assert.Equal(t, "Composite.Combined", layer)
+
+	// Default: the composite layer.
+	img, err := Decode(bytes.NewReader(exrData))
+	require.NoError(t, err)
+	assert.Equal(t, uint16(0xffff), img.(*image.RGBA64).RGBA64At(0, 0).R)
+
+	// Explicit layer choice.
+	img, err = DecodeWithOptions(bytes.NewReader(exrData), Options{Layer: "ViewLayer.Combined"})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(0), img.(*image.RGBA64).RGBA64At(0, 0).R)
+
+	// Single-channel layers are decoded as greyscale.
+	img, err = DecodeWithOptions(bytes.NewReader(exrData), Options{Layer: "ViewLayer.Depth"})
+	require.NoError(t, err)
+	pixel := img.(*image.RGBA64).RGBA64At(0, 0)
+	assert.Equal(t, uint16(srgb16(0.5)), pixel.R)
+	assert.Equal(t, pixel.R, pixel.G)
+	assert.Equal(t, pixel.R, pixel.B)
+
+	_, err = DecodeWithOptions(bytes.NewReader(exrData), Options{Layer: "ViewLayer.Normal"})
+	assert.ErrorIs(t, err, ErrLayerNotFound)
+}
+
+func TestChooseLayerFallbacks(t *testing.T) {
+	header := Header{Channels: []Channel{
+		{Name: "RenderLayer.Combined.B"},
+		{Name: "RenderLayer.Combined.G"},
+		{Name: "RenderLayer.Combined.R"},
+		{Name: "RenderLayer.Emit.B"},
+		{Name: "RenderLayer.Emit.G"},
+		{Name: "RenderLayer.Emit.R"},
+	}}
+	layer, err := header.ChooseLayer()
+	require.NoError(t, err)
+	assert.Equal(t, "RenderLayer.Combined", layer)
+
+	header = Header{Channels: []Channel{
+		{Name: "Mist.Z"},
+		{Name: "Normal.X"},
+		{Name: "Normal.Y"},
+		{Name: "Normal.Z"},
+	}}
+	layer, err = header.ChooseLayer()
+	require.NoError(t, err)
+	assert.Equal(t, "Mist", layer)
+
+	header = Header{Channels: []Channel{
+		{Name: "Normal.X"},
+		{Name: "Normal.Y"},
+	}}
+	_, err = header.ChooseLayer()
+	assert.ErrorIs(t, err, ErrLayerNotFound)
+}
+
+func TestDecodeUnsupported(t *testing.T) {
+	pixels := map[string][]float32{"Y": {0.5}}
+
+	exrData := encodeTestEXR(t, 1, 1, CompressionPIZ, PixelTypeHalf, pixels)
+	_, err := Decode(bytes.NewReader(exrData))
+	assert.ErrorIs(t, err, ErrUnsupportedCompression)
+
+	// Set the 'tiled' flag in the version field.
+	exrData = encodeTestEXR(t, 1, 1, CompressionNone, PixelTypeHalf, pixels)
+	exrData[5] |= flagTiled >> 8
+	_, err = Decode(bytes.NewReader(exrData))
+	assert.ErrorIs(t, err, ErrUnsupportedFeature)
+
+	_, err = Decode(bytes.NewReader([]byte("not an EXR file at all")))
+	assert.ErrorIs(t, err, ErrNotEXR)
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	pixels := map[string][]float32{
+		"R": filled(16, 0.5),
+		"G": filled(16, 0.5),
+		"B": filled(16, 0.5),
+	}
+	exrData := encodeTestEXR(t, 4, 4, CompressionZIPS, PixelTypeHalf, pixels)
+
+	for _, size := range []int{10, len(exrData) / 2, len(exrData) - 1} {
+		_, err := Decode(bytes.NewReader(exrData[:size]))
+		assert.ErrorIs(t, err, ErrInvalid, "truncated to %d bytes", size)
+	}
+}
+
+func TestRLEDecompress(t *testing.T) {
+	// 3 literal bytes, then 'x' repeated 4 times.
+	compressed := []byte{0xfd, 'a', 'b', 'c', 3, 'x'}
+	decompressed, err := rleDecompress(compressed, 7)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abcxxxx"), decompressed)
+
+	_, err = rleDecompress(compressed, 6)
+	assert.ErrorIs(t, err, ErrInvalid)
+	_, err = rleDecompress(compressed[:3], 3)
+	assert.ErrorIs(t, err, ErrInvalid)
+}
+
+func TestHalfToFloat32(t *testing.T) {
+	assert.Equal(t, float32(0), halfToFloat32(0x0000))
+	assert.Equal(t, float32(1), halfToFloat32(0x3c00))
+	assert.Equal(t, float32(-2), halfToFloat32(0xc000))
+	assert.Equal(t, float32(0.5), halfToFloat32(0x3800))
+	assert.Equal(t, float32(65504), halfToFloat32(0x7bff))
+	assert.Equal(t, float32(math.Ldexp(1, -24)), halfToFloat32(0x0001), "smallest subnormal")
+	assert.Equal(t, float32(math.Ldexp(1023, -24)), halfToFloat32(0x03ff), "largest subnormal")
+	assert.True(t, math.IsInf(float64(halfToFloat32(0x7c00)), 1))
+	assert.True(t, math.IsNaN(float64(halfToFloat32(0x7e00))))
+}
+
+func TestToneMap(t *testing.T) {
+	assert.Equal(t, float32(0), ToneMapStandard(-1))
+	assert.Equal(t, float32(0), ToneMapStandard(float32(math.NaN())))
+	assert.InDelta(t, 1.0, ToneMapStandard(1), 1e-6)
+	assert.InDelta(t, 1.0, ToneMapStandard(100), 1e-6)
+
+	// Reinhard keeps a difference between bright values.
+	assert.Less(t, ToneMapReinhard(2), ToneMapReinhard(4))
+	assert.Less(t, ToneMapReinhard(100), float32(1))
+}
+
+func filled(numPixels int, value float32) []float32 {
+	values := make([]float32, numPixels)
+	for idx := range values {
+		values[idx] = value
+	}
+	return values
+}
+
+// srgb16 returns the 16-bit sRGB value for a linear value.
+func srgb16(linear float32) int {
+	return int(linearToSRGB(linear)*0xffff + 0.5)
+}
+
+// encodeTestEXR writes a scanline EXR file. Only compression methods that
+// the decoder supports are actually applied; others just produce a header
+// with that compression.
+func encodeTestEXR(
+	t *testing.T,
+	width, height int,
+	compression Compression,
+	pixelType PixelType,
+	pixels map[string][]float32,
+) []byte {
+	t.Helper()
+
+	names := []string{}
+	for name := range pixels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	le := binary.LittleEndian
+	buf := bytes.Buffer{}
+	writeUint32 := func(value uint32) { _ = binary.Write(&buf, le, value) }
+	writeAttribute := func(name, attrType string, value []byte) {
+		buf.WriteString(name + "\x00" + attrType + "\x00")
+		writeUint32(uint32(len(value)))
+		buf.Write(value)
+	}
+
+	writeUint32(magicNumber)
+	writeUint32(2)
+
+	chlist := bytes.Buffer{}
+	for _, name := range names {
+		chlist.WriteString(name + "\x00")
+		_ = binary.Write(&chlist, le, []int32{int32(pixelType), 0, 1, 1})
+	}
+	chlist.WriteByte(0)
+	writeAttribute("channels", "chlist", chlist.Bytes())
+	writeAttribute("compression", "compression", []byte{byte(compression)})
+
+	box := bytes.Buffer{}
+	_ = binary.Write(&box, le, []int32{0, 0, int32(width - 1), int32(height - 1)})
+	writeAttribute("dataWindow", "box2i", box.Bytes())
+	writeAttribute("displayWindow", "box2i", box.Bytes())
+	writeAttribute("lineOrder", "lineOrder", []byte{0})
+	buf.WriteByte(0) // End of header.
+
+	// Construct the chunks.
+	linesPerChunk := compression.linesPerChunk()
+	chunks := [][]byte{}
+	for chunkY := 0; chunkY < height; chunkY += linesPerChunk {
+		raw := bytes.Buffer{}
+		for y := chunkY; y < chunkY+linesPerChunk && y < height; y++ {
+			for _, name := range names {
+				for x := 0; x < width; x++ {
+					value := pixels[name][y*width+x]
+					if pixelType == PixelTypeHalf {
+						_ = binary.Write(&raw, le, float32ToHalf(t, value))
+					} else {
+						_ = binary.Write(&raw, le, math.Float32bits(value))
+					}
+				}
+			}
+		}
+
+		data := raw.Bytes()
+		if compression == CompressionZIP || compression == CompressionZIPS {
+			// Just like real encoders, only use the compressed data when it's smaller.
+			if compressed := zipCompress(t, data); len(compressed) < len(data) {
+				data = compressed
+			}
+		}
+
+		chunk := bytes.Buffer{}
+		_ = binary.Write(&chunk, le, []int32{int32(chunkY), int32(len(data))})
+		chunk.Write(data)
+		chunks = append(chunks, chunk.Bytes())
+	}
+
+	// Write the offset table and the chunks.
+	offset := uint64(buf.Len() + 8*len(chunks))
+	for _, chunk := range chunks {
+		_ = binary.Write(&buf, le, offset)
+		offset += uint64(len(chunk))
+	}
+	for _, chunk := range chunks {
+		buf.Write(chunk)
+	}
+	return buf.Bytes()
+}
+
+// zipCompress applies the reordering, predictor, and zlib compression of the
+// ZIP compression method.
+func zipCompress(t *testing.T, data []byte) []byte {
+	reordered := make([]byte, len(data))
+	half := (len(data) + 1) / 2
+	for i, value := range data {
+		if i%2 == 0 {
+			reordered[i/2] = value
+		} else {
+			reordered[half+i/2] = value
+		}
+	}
+
+	for i := len(reordered) - 1; i > 0; i-- {
+		reordered[i] = reordered[i] - reordered[i-1] + 128
+	}
+
+	compressed := bytes.Buffer{}
+	writer := zlib.NewWriter(&compressed)
+	_, err := writer.Write(reordered)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return compressed.Bytes()
+}
+
+// float32ToHalf converts values that are exactly representable as half-float.
+func float32ToHalf(t *testing.T, value float32) uint16 {
+	if value == 0 {
+		return 0
+	}
+	bits := math.Float32bits(value)
+	sign := uint16(bits>>16) & 0x8000
+	exponent := int((bits>>23)&0xff) - 127 + 15
+	mantissa := bits & 0x7fffff
+	require.True(t, exponent > 0 && exponent < 31 && mantissa&0x1fff == 0,
+		"value %v cannot be exactly represented as half-float", value)
+	return sign | uint16(exponent)<<10 | uint16(mantissa>>13)
+}
@@ -0,0 +1,325 @@
+package exr
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	magicNumber = 20000630
+
+	// Flags in the version field.
+	flagTiled      = 0x200
+	flagNonImage   = 0x800
+	flagMultiPart  = 0x1000
+	versionNumMask = 0xff
+
+	// Attribute names and values are limited in size, to avoid allocating huge
+	// amounts of memory for corrupt files.
+	maxAttributeSize = 1 << 20
+	maxNameLength    = 255
+
+	// maxPixels limits the image size, again to protect against corrupt files.
+	maxPixels = 1 << 26
+)
+
+// PixelType is the data type of the samples of a channel.
+type PixelType int32
+
+const (
+	PixelTypeUint  PixelType = 0
+	PixelTypeHalf  PixelType = 1
+	PixelTypeFloat PixelType = 2
+)
+
+// size returns the number of bytes of a single sample.
+func (pt PixelType) size() int {
+	if pt == PixelTypeHalf {
+		return 2
+	}
+	return 4
+}
+
+// Compression is the compression method of the pixel data.
+type Compression uint8
+
+const (
+	CompressionNone  Compression = 0
+	CompressionRLE   Compression = 1
+	CompressionZIPS  Compression = 2
+	CompressionZIP   Compression = 3
+	CompressionPIZ   Compression = 4
+	CompressionPXR24 Compression = 5
+	CompressionB44   Compression = 6
+	CompressionB44A  Compression = 7
+	CompressionDWAA  Compression = 8
+	CompressionDWAB  Compression = 9
+)
+
+var compressionNames = map[Compression]string{
+	CompressionNone:  "none",
+	CompressionRLE:   "RLE",
+	CompressionZIPS:  "ZIPS",
+	CompressionZIP:   "ZIP",
+	CompressionPIZ:   "PIZ",
+	CompressionPXR24: "PXR24",
+	CompressionB44:   "B44",
+	CompressionB44A:  "B44A",
+	CompressionDWAA:  "DWAA",
+	CompressionDWAB:  "DWAB",
+}
+
+func (c Compression) String() string {
+	if name, ok := compressionNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", c)
+}
+
+// linesPerChunk returns the number of scanlines that are compressed together.
+func (c Compression) linesPerChunk() int {
+	switch c {
+	case CompressionZIP, CompressionPXR24:
+		return 16
+	case CompressionPIZ, CompressionB44, CompressionB44A, CompressionDWAA:
+		return 32
+	case CompressionDWAB:
+		return 256
+	default:
+		return 1
+	}
+}
+
+// Channel describes a single channel of the image, like "R" or "ViewLayer.Combined.R".
+type Channel struct {
+	Name      string
+	PixelType PixelType
+	XSampling int32
+	YSampling int32
+}
+
+// Box is an integer rectangle. Contrary to image.Rectangle, the maximum is inclusive.
+type Box struct {
+	XMin, YMin, XMax, YMax int32
+}
+
+func (b Box) width() int  { return int(b.XMax) - int(b.XMin) + 1 }
+func (b Box) height() int { return int(b.YMax) - int(b.YMin) + 1 }
+
+// Header contains the attributes of an EXR file that are relevant for decoding it.
+type Header struct {
+	Channels    []Channel // In the order of the file, which is sorted by name.
+	Compression Compression
+	DataWindow  Box
+}
+
+var (
+	ErrNotEXR                 = errors.New("exr: not an OpenEXR file")
+	ErrUnsupportedFeature     = errors.New("exr: unsupported feature")
+	ErrUnsupportedCompression = errors.New("exr: unsupported compression")
+	ErrInvalid                = errors.New("exr: invalid file")
+)
+
+// headerReader reads the header, keeping track of the number of bytes read.
+type headerReader struct {
+	r         *bufio.Reader
+	bytesRead int64
+}
+
+func (hr *headerReader) read(data []byte) error {
+	n, err := io.ReadFull(hr.r, data)
+	hr.bytesRead += int64(n)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: file truncated", ErrInvalid)
+	}
+	return err
+}
+
+func (hr *headerReader) readUint32() (uint32, error) {
+	var buf [4]byte
+	if err := hr.read(buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(buf[:]), nil
+}
+
+// readString reads a null-terminated string.
+func (hr *headerReader) readString() (string, error) {
+	var builder strings.Builder
+	for {
+		b, err := hr.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				return "", fmt.Errorf("%w: file truncated", ErrInvalid)
+			}
+			return "", err
+		}
+		hr.bytesRead++
+		if b == 0 {
+			return builder.String(), nil
+		}
+		if builder.Len() >= maxNameLength {
+			return "", fmt.Errorf("%w: name too long", ErrInvalid)
+		}
+		builder.WriteByte(b)
+	}
+}
+
+// readHeader reads the magic number, version, and the header attributes.
+// Afterwards, the reader is positioned at the start of the offset table.
+func readHeader(hr *headerReader) (*Header, error) {
+	magic, err := hr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if magic != magicNumber {
+		return nil, ErrNotEXR
+	}
+
+	version, err := hr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case version&versionNumMask != 2:
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedFeature, version&versionNumMask)
+	case version&flagTiled != 0:
+		return nil, fmt.Errorf("%w: tiled images", ErrUnsupportedFeature)
+	case version&flagNonImage != 0:
+		return nil, fmt.Errorf("%w: deep data", ErrUnsupportedFeature)
+	case version&flagMultiPart != 0:
+		return nil, fmt.Errorf("%w: multi-part files", ErrUnsupportedFeature)
+	}
+
+	header := Header{}
+	var foundChannels, foundCompression, foundDataWindow bool
+	for {
+		name, err := hr.readString()
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			break // End of the header.
+		}
+		attrType, err := hr.readString()
+		if err != nil {
+			return nil, err
+		}
+		size, err := hr.readUint32()
+		if err != nil {
+			return nil, err
+		}
+		if size > maxAttributeSize {
+			return nil, fmt.Errorf("%w: attribute %q too large", ErrInvalid, name)
+		}
+		value := make([]byte, size)
+		if err := hr.read(value); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case name == "channels" && attrType == "chlist":
+			header.Channels, err = parseChannels(value)
+			foundChannels = true
+		case name == "compression" && attrType == "compression":
+			if len(value) != 1 {
+				return nil, fmt.Errorf("%w: compression attribute", ErrInvalid)
+			}
+			header.Compression = Compression(value[0])
+			foundCompression = true
+		case name == "dataWindow" && attrType == "box2i":
+			header.DataWindow, err = parseBox(value)
+			foundDataWindow = true
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !foundChannels || !foundCompression || !foundDataWindow {
+		return nil, fmt.Errorf("%w: missing required header attributes", ErrInvalid)
+	}
+	width, height := header.DataWindow.width(), header.DataWindow.height()
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("%w: empty data window", ErrInvalid)
+	}
+	if int64(width)*int64(height) > maxPixels {
+		return nil, fmt.Errorf("%w: image of %dx%d pixels is too large", ErrUnsupportedFeature, width, height)
+	}
+	return &header, nil
+}
+
+func parseChannels(value []byte) ([]Channel, error) {
+	channels := []Channel{}
+	for {
+		end := bytes.IndexByte(value, 0)
+		if end < 0 {
+			return nil, fmt.Errorf("%w: channel list", ErrInvalid)
+		}
+		if end == 0 {
+			break // End of the channel list.
+		}
+		name := string(value[:end])
+		value = value[end+1:]
+		if len(value) < 16 {
+			return nil, fmt.Errorf("%w: channel list", ErrInvalid)
+		}
+
+		channel := Channel{
+			Name:      name,
+			PixelType: PixelType(binary.LittleEndian.Uint32(value[0:4])),
+			// 1 byte pLinear, 3 bytes reserved.
+			XSampling: int32(binary.LittleEndian.Uint32(value[8:12])),
+			YSampling: int32(binary.LittleEndian.Uint32(value[12:16])),
+		}
+		value = value[16:]
+
+		if channel.PixelType < PixelTypeUint || channel.PixelType > PixelTypeFloat {
+			return nil, fmt.Errorf("%w: channel %q has pixel type %d", ErrInvalid, name, channel.PixelType)
+		}
+		if channel.XSampling != 1 || channel.YSampling != 1 {
+			return nil, fmt.Errorf("%w: sub-sampled channel %q", ErrUnsupportedFeature, name)
+		}
+		channels = append(channels, channel)
+	}
+
+	if len(channels) == 0 {
+		return nil, fmt.Errorf("%w: no channels", ErrInvalid)
+	}
+	return channels, nil
+}
+
+func parseBox(value []byte) (Box, error) {
+	if len(value) != 16 {
+		return Box{}, fmt.Errorf("%w: box2i attribute", ErrInvalid)
+	}
+	return Box{
+		XMin: int32(binary.LittleEndian.Uint32(value[0:4])),
+		YMin: int32(binary.LittleEndian.Uint32(value[4:8])),
+		XMax: int32(binary.LittleEndian.Uint32(value[8:12])),
+		YMax: int32(binary.LittleEndian.Uint32(value[12:16])),
+	}, nil
+}
+
+// bytesPerLine returns the number of bytes of uncompressed pixel data of a single scanline.
+func (h *Header) bytesPerLine() int {
+	width := h.DataWindow.width()
+	total := 0
+	for _, channel := range h.Channels {
+		total += width * channel.PixelType.size()
+	}
+	return total
+}
+
+// numChunks returns the number of entries in the offset table.
+func (h *Header) numChunks() int {
+	linesPerChunk := h.Compression.linesPerChunk()
+	return (h.DataWindow.height() + linesPerChunk - 1) / linesPerChunk
+}
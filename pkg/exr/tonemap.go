@@ -0,0 +1,72 @@
+package exr
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"math"
+)
+
+// ToneMapper converts a linear scene-referred value to a display value in the
+// range [0, 1]. It is applied to the red, green, and blue channels separately.
+type ToneMapper func(linear float32) float32
+
+// ToneMapStandard clips the linear value to [0, 1] and applies the sRGB
+// transfer function. This matches Blender's "Standard" view transform.
+func ToneMapStandard(linear float32) float32 {
+	return linearToSRGB(clamp01(linear))
+}
+
+// ToneMapReinhard compresses the linear value with the Reinhard operator
+// before applying the sRGB transfer function. Contrary to ToneMapStandard,
+// this keeps some detail in overexposed areas.
+func ToneMapReinhard(linear float32) float32 {
+	if linear <= 0 {
+		return 0
+	}
+	return linearToSRGB(linear / (1 + linear))
+}
+
+func linearToSRGB(value float32) float32 {
+	if value <= 0.0031308 {
+		return 12.92 * value
+	}
+	return float32(1.055*math.Pow(float64(value), 1/2.4) - 0.055)
+}
+
+func clamp01(value float32) float32 {
+	switch {
+	case value > 1:
+		return 1
+	case value >= 0:
+		return value
+	default:
+		// Also handles NaN, as comparisons with NaN are always false.
+		return 0
+	}
+}
+
+// halfToFloat32 converts an IEEE 754 half-precision float to a float32.
+func halfToFloat32(half uint16) float32 {
+	sign := uint32(half>>15) << 31
+	exponent := int32(half>>10) & 0x1f
+	mantissa := uint32(half) & 0x3ff
+
+	switch {
+	case exponent == 0 && mantissa == 0:
+		// Zero.
+		return math.Float32frombits(sign)
+	case exponent == 0:
+		// Subnormal number; normalise it.
+		for mantissa&0x400 == 0 {
+			mantissa <<= 1
+			exponent--
+		}
+		exponent++
+		mantissa &= 0x3ff
+	case exponent == 0x1f:
+		// Infinity or NaN.
+		return math.Float32frombits(sign | 0x7f800000 | mantissa<<13)
+	}
+
+	return math.Float32frombits(sign | uint32(exponent+127-15)<<23 | mantissa<<13)
+}
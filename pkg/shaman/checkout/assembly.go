@@ -0,0 +1,285 @@
+package checkout
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
+)
+
+// ErrAssemblyPending is returned by Checkout() when files are still being
+// assembled from their chunks. The checkout should be requested again later.
+var ErrAssemblyPending = errors.New("files are still being assembled from their chunks")
+
+// assembler assembles files from their chunks in the background.
+//
+// Once a file has been assembled, the chunks that were uploaded only as chunks
+// are removed, so that the data is only stored once. Chunks that are also
+// stored as files by themselves are kept, as checkouts may link to them. The
+// chunks can still be used for later uploads, as the assembler remembers where
+// they are in the assembled file. This is stored in the file store's chunk
+// index, so that it survives a restart.
+type assembler struct {
+	fileStore *filestore.Store
+
+	mutex sync.Mutex
+	// Files that are being assembled, keyed by file key.
+	pending map[string]pendingAssembly
+	// Errors of failed assemblies, keyed by file key. These are reported by
+	// the next checkout that needs the file.
+	failed map[string]error
+	// Location of chunks in assembled files, keyed by chunk key.
+	assembledChunks map[string]assembledChunk
+	// Chunks that were requested from the client as chunks, and not as files,
+	// keyed by chunk key. Only these are removed after assembly.
+	requestedChunks map[string]bool
+}
+
+// pendingAssembly contains the chunks of a file that is being assembled, and
+// where their data is read from.
+type pendingAssembly struct {
+	chunks    []filestore.Chunk
+	chunkData []filestore.ChunkData
+}
+
+// assembledChunk is the location of a chunk in an assembled file.
+type assembledChunk struct {
+	fileChecksum string
+	fileSize     int64
+	offset       int64
+}
+
+func newAssembler(fileStore *filestore.Store) *assembler {
+	a := &assembler{
+		fileStore:       fileStore,
+		pending:         map[string]pendingAssembly{},
+		failed:          map[string]error{},
+		assembledChunks: map[string]assembledChunk{},
+		requestedChunks: map[string]bool{},
+	}
+	a.loadChunkIndexes()
+	return a
+}
+
+// loadChunkIndexes remembers the location of the chunks of files that were
+// assembled before. Indexes of files that are no longer stored are removed.
+func (a *assembler) loadChunkIndexes() {
+	indexes, err := a.fileStore.ChunkIndexes()
+	if err != nil {
+		log.Error().Err(err).Msg("shaman: unable to load chunk indexes, chunks of assembled files will not be reused")
+		return
+	}
+
+	for _, index := range indexes {
+		_, status := a.fileStore.ResolveFile(index.Checksum, index.Size, filestore.ResolveStoredOnly)
+		if status != filestore.StatusStored {
+			a.removeChunkIndex(index.Checksum, index.Size)
+			continue
+		}
+		a.rememberChunks(index.Checksum, index.Size, index.Chunks)
+	}
+	log.Debug().Int("numIndexes", len(a.assembledChunks)).Msg("shaman: loaded chunk indexes")
+}
+
+func fileKey(checksum string, filesize int64) string {
+	return fmt.Sprintf("%s/%d", checksum, filesize)
+}
+
+// assemble starts assembling the file from its chunks in the background, and
+// returns ErrAssemblyPending. Returns ErrMissingFiles when not all chunks are
+// available, and the error of an earlier assembly of the file if that failed.
+func (a *assembler) assemble(checksum string, filesize int64, chunks []filestore.Chunk, logger zerolog.Logger) error {
+	key := fileKey(checksum, filesize)
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if _, isPending := a.pending[key]; isPending {
+		return ErrAssemblyPending
+	}
+	if err, hasFailed := a.failed[key]; hasFailed {
+		// Report the error once, and try again on the next request.
+		delete(a.failed, key)
+		return err
+	}
+
+	chunkData := make([]filestore.ChunkData, len(chunks))
+	for idx, chunk := range chunks {
+		data, ok := a.resolveChunk(chunk)
+		if !ok {
+			logger.Debug().
+				Str("chunk", fileKey(chunk.Checksum, chunk.Size)).
+				Msg("shaman: unable to assemble file, chunk is missing")
+			return ErrMissingFiles
+		}
+		chunkData[idx] = data
+	}
+
+	// Shutting down does not wait for this; an interrupted assembly leaves an
+	// unfinished upload behind, which is cleaned up by the garbage collector.
+	a.pending[key] = pendingAssembly{chunks, chunkData}
+	go a.run(checksum, filesize, chunks, chunkData, logger)
+	return ErrAssemblyPending
+}
+
+// run assembles the file, and removes the chunks that are no longer needed.
+func (a *assembler) run(checksum string, filesize int64, chunks []filestore.Chunk, chunkData []filestore.ChunkData, logger zerolog.Logger) {
+	key := fileKey(checksum, filesize)
+	logger = logger.With().Str("file", key).Int("numChunks", len(chunks)).Logger()
+
+	err := a.fileStore.AssembleFromChunks(checksum, filesize, chunkData)
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.pending, key)
+
+	if err != nil {
+		logger.Error().Err(err).Msg("shaman: unable to assemble file from chunks")
+		a.failed[key] = fmt.Errorf("assembling file %s from chunks: %w", key, err)
+		return
+	}
+	logger.Info().Msg("shaman: assembled file from chunks")
+
+	// Remember where the chunks are, before removing their own copies.
+	a.rememberChunks(checksum, filesize, chunks)
+	if err := a.fileStore.StoreChunkIndex(checksum, filesize, chunks); err != nil {
+		// Without the index, the chunks cannot be found after a restart. Keep
+		// them around, they'll be garbage collected eventually.
+		logger.Warn().Err(err).Msg("shaman: unable to store chunk index, keeping the chunks")
+		return
+	}
+
+	inUse := a.pendingChunkKeys()
+	for _, chunk := range chunks {
+		chunkKey := fileKey(chunk.Checksum, chunk.Size)
+		if inUse[chunkKey] || !a.requestedChunks[chunkKey] {
+			continue
+		}
+		delete(a.requestedChunks, chunkKey)
+
+		path, status := a.fileStore.ResolveFile(chunk.Checksum, chunk.Size, filestore.ResolveStoredOnly)
+		if status != filestore.StatusStored {
+			// Already removed, or the chunk was read from another assembled file.
+			continue
+		}
+		if err := a.fileStore.RemoveStoredFile(path); err != nil {
+			logger.Warn().Err(err).Str("path", path).Msg("shaman: unable to remove chunk of assembled file")
+		}
+	}
+}
+
+// rememberChunks stores the location of the chunks in the assembled file. The
+// mutex must be locked by the caller.
+func (a *assembler) rememberChunks(checksum string, filesize int64, chunks []filestore.Chunk) {
+	var offset int64
+	for _, chunk := range chunks {
+		a.assembledChunks[fileKey(chunk.Checksum, chunk.Size)] = assembledChunk{
+			fileChecksum: checksum,
+			fileSize:     filesize,
+			offset:       offset,
+		}
+		offset += chunk.Size
+	}
+}
+
+func (a *assembler) removeChunkIndex(checksum string, filesize int64) {
+	if err := a.fileStore.RemoveChunkIndex(checksum, filesize); err != nil {
+		log.Warn().Err(err).
+			Str("file", fileKey(checksum, filesize)).
+			Msg("shaman: unable to remove chunk index of file that is no longer stored")
+	}
+}
+
+// resolveChunk returns where the chunk's data can be found. The mutex must be
+// locked by the caller.
+func (a *assembler) resolveChunk(chunk filestore.Chunk) (filestore.ChunkData, bool) {
+	if data, err := a.fileStore.ResolveChunk(chunk); err == nil {
+		return data, true
+	}
+
+	key := fileKey(chunk.Checksum, chunk.Size)
+	location, found := a.assembledChunks[key]
+	if !found {
+		return filestore.ChunkData{}, false
+	}
+
+	path, status := a.fileStore.ResolveFile(location.fileChecksum, location.fileSize, filestore.ResolveStoredOnly)
+	if status != filestore.StatusStored {
+		// The assembled file was garbage collected.
+		delete(a.assembledChunks, key)
+		a.removeChunkIndex(location.fileChecksum, location.fileSize)
+		return filestore.ChunkData{}, false
+	}
+	return filestore.ChunkData{Path: path, Offset: location.offset, Size: chunk.Size}, true
+}
+
+// isChunkAvailable returns whether the chunk is stored, either by itself or as
+// part of an assembled file. Returns the path of the file that contains it.
+func (a *assembler) isChunkAvailable(chunk filestore.Chunk) (string, bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	data, ok := a.resolveChunk(chunk)
+	return data.Path, ok
+}
+
+// markChunkRequested records that the chunk is requested from the client as a
+// chunk. Unless it is also requested as a file, it will be removed once the
+// file it is part of has been assembled.
+func (a *assembler) markChunkRequested(chunk filestore.Chunk) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.requestedChunks[fileKey(chunk.Checksum, chunk.Size)] = true
+}
+
+// markFilesRequested records that the files are requested by themselves, so
+// that they are kept when they are also a chunk of an assembled file.
+func (a *assembler) markFilesRequested(files []api.ShamanFileSpec) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	for _, file := range files {
+		delete(a.requestedChunks, fileKey(file.Sha, int64(file.Size)))
+	}
+}
+
+// pendingChunkKeys returns the keys of the chunks of files that are being
+// assembled. The mutex must be locked by the caller.
+func (a *assembler) pendingChunkKeys() map[string]bool {
+	keys := map[string]bool{}
+	for _, assembly := range a.pending {
+		for _, chunk := range assembly.chunks {
+			keys[fileKey(chunk.Checksum, chunk.Size)] = true
+		}
+	}
+	return keys
+}
+
+// PendingChunkPaths returns the paths of the stored files that chunks are read
+// from, for assembling files. These should not be garbage collected.
+func (m *Manager) PendingChunkPaths() []string {
+	a := m.assembler
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	paths := []string{}
+	for _, assembly := range a.pending {
+		for _, data := range assembly.chunkData {
+			paths = append(paths, data.Path)
+		}
+	}
+	return paths
+}
+
+// isIdle returns whether no files are being assembled.
+func (a *assembler) isIdle() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return len(a.pending) == 0
+}
@@ -20,7 +20,8 @@ var (
 	validCheckoutRegexp = regexp.MustCompile(`^[^/?*:;{}\\][^?*:;{}\\]*$`)
 )
 
-// Checkout symlinks the requested files into the checkout directory.
+// Checkout places the requested files into the checkout directory, as
+// determined by the checkout mode.
 // Returns the actually-used checkout directory, relative to the configured checkout root.
 func (m *Manager) Checkout(ctx context.Context, checkout api.ShamanCheckout) (string, error) {
 	logger := (*zerolog.Ctx(ctx))
@@ -28,6 +29,12 @@ func (m *Manager) Checkout(ctx context.Context, checkout api.ShamanCheckout) (st
 		Str("checkoutPath", checkout.CheckoutPath).
 		Msg("shaman: user requested checkout creation")
 
+	// Files that were uploaded in chunks have to be assembled first. This is
+	// done in the background, as it can take a while for large files.
+	if err := m.assembleMissingFiles(checkout.Files, logger); err != nil {
+		return "", err
+	}
+
 	// Actually create the checkout.
 	resolvedCheckoutInfo, err := m.PrepareCheckout(checkout.CheckoutPath)
 	if err != nil {
@@ -53,8 +60,8 @@ func (m *Manager) Checkout(ctx context.Context, checkout api.ShamanCheckout) (st
 			return "", ErrMissingFiles
 		}
 
-		if err := m.SymlinkToCheckout(blobPath, resolvedCheckoutInfo.absolutePath, fileSpec.Path); err != nil {
-			return "", fmt.Errorf("symlinking %q to checkout: %w", fileSpec.Path, err)
+		if err := m.PlaceInCheckout(blobPath, resolvedCheckoutInfo.absolutePath, fileSpec.Path); err != nil {
+			return "", fmt.Errorf("placing %q in checkout: %w", fileSpec.Path, err)
 		}
 	}
 
@@ -63,6 +70,71 @@ func (m *Manager) Checkout(ctx context.Context, checkout api.ShamanCheckout) (st
 	return resolvedCheckoutInfo.RelativePath, nil
 }
 
+// assembleMissingFiles starts assembling the files that are not stored yet, but
+// whose chunks are. Returns ErrAssemblyPending when files are still being
+// assembled, and ErrMissingFiles when a file cannot be assembled because it was
+// not uploaded in chunks, or not all its chunks have been uploaded.
+func (m *Manager) assembleMissingFiles(files []api.ShamanFileSpec, logger zerolog.Logger) error {
+	// Files that are checked out should be kept, even when they are also a
+	// chunk of one of the assembled files.
+	m.assembler.markFilesRequested(files)
+
+	var isPending bool
+	for _, fileSpec := range files {
+		_, status := m.fileStore.ResolveFile(fileSpec.Sha, int64(fileSpec.Size), filestore.ResolveStoredOnly)
+		if status == filestore.StatusStored {
+			continue
+		}
+
+		chunks := m.chunksOf(fileSpec)
+		if chunks == nil {
+			// Caller should upload this file before we can create the checkout.
+			return ErrMissingFiles
+		}
+
+		err := m.assembler.assemble(fileSpec.Sha, int64(fileSpec.Size), chunks, logger)
+		switch {
+		case errors.Is(err, ErrAssemblyPending):
+			isPending = true
+		case err != nil:
+			logger.Debug().Err(err).Str("path", fileSpec.Path).Msg("shaman: unable to assemble file from chunks")
+			return err
+		}
+	}
+
+	if isPending {
+		return ErrAssemblyPending
+	}
+	return nil
+}
+
+// chunksOf returns the chunks of the file, or nil if the file cannot be
+// uploaded in chunks.
+func (m *Manager) chunksOf(fileSpec api.ShamanFileSpec) []filestore.Chunk {
+	if fileSpec.Chunks == nil || !m.chunking.IsChunkable(int64(fileSpec.Size)) {
+		return nil
+	}
+
+	chunks := make([]filestore.Chunk, len(*fileSpec.Chunks))
+	var chunksSize int64
+	for idx, chunkSpec := range *fileSpec.Chunks {
+		if chunkSpec.Size <= 0 {
+			return nil
+		}
+		chunks[idx] = filestore.Chunk{
+			Checksum: chunkSpec.Sha,
+			Size:     int64(chunkSpec.Size),
+		}
+		chunksSize += int64(chunkSpec.Size)
+	}
+
+	// Don't bother with chunks that cannot possibly make up the file.
+	if chunksSize != int64(fileSpec.Size) {
+		return nil
+	}
+	return chunks
+}
+
 func isValidCheckoutPath(checkoutPath string) bool {
 	if !validCheckoutRegexp.MatchString(checkoutPath) {
 		return false
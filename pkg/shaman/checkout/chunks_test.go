@@ -0,0 +1,321 @@
+package checkout
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/pkg/api"
+	"projects.blender.org/studio/flamenco/pkg/shaman/chunker"
+	"projects.blender.org/studio/flamenco/pkg/shaman/config"
+	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
+	"projects.blender.org/studio/flamenco/pkg/shaman/hasher"
+	"projects.blender.org/studio/flamenco/pkg/shaman/testsupport"
+)
+
+var testChunkParams = chunker.Params{MinSize: 64, AvgSize: 256, MaxSize: 1024}
+
+// chunkedFileSpec splits the data into chunks, and returns the file spec
+// describing it.
+func chunkedFileSpec(t *testing.T, data []byte, path string) api.ShamanFileSpec {
+	chunks, err := chunker.Split(bytes.NewReader(data), testChunkParams)
+	require.NoError(t, err)
+
+	chunkSpecs := []api.ShamanChunkSpec{}
+	for _, chunk := range chunks {
+		chunkSpecs = append(chunkSpecs, api.ShamanChunkSpec{Sha: chunk.Checksum, Size: int(chunk.Size)})
+	}
+	return api.ShamanFileSpec{
+		Sha:    hasher.Checksum(data),
+		Size:   len(data),
+		Path:   path,
+		Chunks: &chunkSpecs,
+	}
+}
+
+// uploadChunks stores the requested chunks of the data in the file store.
+func uploadChunks(t *testing.T, manager *Manager, data []byte, requested []api.ShamanChunkSpec) {
+	chunks, err := chunker.Split(bytes.NewReader(data), testChunkParams)
+	require.NoError(t, err)
+
+	isRequested := map[string]bool{}
+	for _, chunkSpec := range requested {
+		isRequested[chunkSpec.Sha] = true
+	}
+	for _, chunk := range chunks {
+		if !isRequested[chunk.Checksum] {
+			continue
+		}
+		file, err := manager.fileStore.OpenForUpload(chunk.Checksum, chunk.Size)
+		require.NoError(t, err)
+		_, err = file.Write(data[chunk.Offset : chunk.Offset+chunk.Size])
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+		require.NoError(t, manager.fileStore.MoveToStored(chunk.Checksum, chunk.Size, file.Name()))
+	}
+}
+
+// checkoutChunked requests the checkout, waits for its files to be assembled
+// from their chunks, and then requests the checkout again.
+func checkoutChunked(t *testing.T, manager *Manager, checkout api.ShamanCheckout) string {
+	ctx := context.Background()
+
+	_, err := manager.Checkout(ctx, checkout)
+	require.ErrorIs(t, err, ErrAssemblyPending)
+	require.Eventually(t, manager.assembler.isIdle, 5*time.Second, 10*time.Millisecond)
+
+	checkoutPath, err := manager.Checkout(ctx, checkout)
+	require.NoError(t, err)
+	return checkoutPath
+}
+
+func randomData(size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(47)).Read(data)
+	return data
+}
+
+func TestChunkedUpload(t *testing.T) {
+	testsupport.SkipTestIfUnableToSymlink(t)
+
+	manager, cleanup := createTestManager()
+	defer cleanup()
+	manager.chunking.Enabled = true
+	ctx := context.Background()
+
+	// Upload the first version of the file.
+	original := randomData(50_000)
+	originalSpec := chunkedFileSpec(t, original, "cache.vdb")
+	response, err := manager.ReportRequirements(ctx, api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{originalSpec},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Files, 1)
+	require.NotNil(t, response.Files[0].Chunks)
+	assert.Equal(t, *originalSpec.Chunks, *response.Files[0].Chunks, "all chunks should be requested")
+	uploadChunks(t, manager, original, *response.Files[0].Chunks)
+
+	checkoutPath := checkoutChunked(t, manager, api.ShamanCheckout{
+		CheckoutPath: "first",
+		Files:        []api.ShamanFileSpec{originalSpec},
+	})
+	checkedOut, err := os.ReadFile(filepath.Join(manager.checkoutBasePath, checkoutPath, "cache.vdb"))
+	require.NoError(t, err)
+	assert.Equal(t, original, checkedOut)
+
+	// The chunks should be removed after assembling the file.
+	for _, chunk := range *originalSpec.Chunks {
+		_, status := manager.fileStore.ResolveFile(chunk.Sha, int64(chunk.Size), filestore.ResolveEverything)
+		assert.Equal(t, filestore.StatusDoesNotExist, status, "chunk %s should have been removed", chunk.Sha)
+	}
+
+	// Modify the file a little, and upload it again.
+	modified := append([]byte{}, original...)
+	copy(modified[30_000:], []byte("some changed data"))
+	modifiedSpec := chunkedFileSpec(t, modified, "cache.vdb")
+	response, err = manager.ReportRequirements(ctx, api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{modifiedSpec},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Files, 1)
+	require.NotNil(t, response.Files[0].Chunks)
+	numRequested := len(*response.Files[0].Chunks)
+	assert.NotZero(t, numRequested)
+	assert.LessOrEqual(t, numRequested, 2, "only the changed chunks should be requested")
+	uploadChunks(t, manager, modified, *response.Files[0].Chunks)
+
+	checkoutPath = checkoutChunked(t, manager, api.ShamanCheckout{
+		CheckoutPath: "second",
+		Files:        []api.ShamanFileSpec{modifiedSpec},
+	})
+	checkedOut, err = os.ReadFile(filepath.Join(manager.checkoutBasePath, checkoutPath, "cache.vdb"))
+	require.NoError(t, err)
+	assert.Equal(t, modified, checkedOut)
+
+	// Once assembled, the file itself is known.
+	response, err = manager.ReportRequirements(ctx, api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{modifiedSpec},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, response.Files)
+}
+
+func TestChunkedUploadKeepsStandaloneFiles(t *testing.T) {
+	testsupport.SkipTestIfUnableToSymlink(t)
+
+	manager, cleanup := createTestManager()
+	defer cleanup()
+	manager.chunking.Enabled = true
+	ctx := context.Background()
+
+	// The first chunk of the large file is also checked out by itself.
+	data := randomData(50_000)
+	largeSpec := chunkedFileSpec(t, data, "cache.vdb")
+	firstChunk := (*largeSpec.Chunks)[0]
+	smallSpec := api.ShamanFileSpec{Sha: firstChunk.Sha, Size: firstChunk.Size, Path: "small.bin"}
+
+	response, err := manager.ReportRequirements(ctx, api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{largeSpec, smallSpec},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Files, 1, "the small file should be uploaded as chunk of the large file")
+	require.NotNil(t, response.Files[0].Chunks)
+	uploadChunks(t, manager, data, *response.Files[0].Chunks)
+
+	checkoutPath := checkoutChunked(t, manager, api.ShamanCheckout{
+		CheckoutPath: "checkout",
+		Files:        []api.ShamanFileSpec{largeSpec, smallSpec},
+	})
+
+	// The small file should still be there, as the checkout links to it.
+	checkedOut, err := os.ReadFile(filepath.Join(manager.checkoutBasePath, checkoutPath, "small.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, data[:firstChunk.Size], checkedOut)
+
+	// The other chunks were only uploaded as chunks, and should be removed.
+	for _, chunk := range (*largeSpec.Chunks)[1:] {
+		_, status := manager.fileStore.ResolveFile(chunk.Sha, int64(chunk.Size), filestore.ResolveEverything)
+		assert.Equal(t, filestore.StatusDoesNotExist, status, "chunk %s should have been removed", chunk.Sha)
+	}
+}
+
+func TestChunkedUploadAfterRestart(t *testing.T) {
+	testsupport.SkipTestIfUnableToSymlink(t)
+
+	conf, cleanup := config.CreateTestConfig()
+	defer cleanup()
+	manager := NewManager(conf, filestore.New(conf))
+	manager.chunking.Enabled = true
+	ctx := context.Background()
+
+	data := randomData(50_000)
+	spec := chunkedFileSpec(t, data, "cache.vdb")
+	response, err := manager.ReportRequirements(ctx, api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{spec},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Files, 1)
+	uploadChunks(t, manager, data, *response.Files[0].Chunks)
+	checkoutChunked(t, manager, api.ShamanCheckout{
+		CheckoutPath: "checkout",
+		Files:        []api.ShamanFileSpec{spec},
+	})
+
+	// After a restart, the chunks should still be found in the assembled file.
+	restarted := NewManager(conf, filestore.New(conf))
+	restarted.chunking.Enabled = true
+
+	modified := append([]byte{}, data...)
+	copy(modified[30_000:], []byte("some changed data"))
+	modifiedSpec := chunkedFileSpec(t, modified, "cache.vdb")
+	response, err = restarted.ReportRequirements(ctx, api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{modifiedSpec},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Files, 1)
+	require.NotNil(t, response.Files[0].Chunks)
+	numRequested := len(*response.Files[0].Chunks)
+	assert.NotZero(t, numRequested)
+	assert.LessOrEqual(t, numRequested, 2, "only the changed chunks should be requested")
+	uploadChunks(t, restarted, modified, *response.Files[0].Chunks)
+
+	checkoutPath := checkoutChunked(t, restarted, api.ShamanCheckout{
+		CheckoutPath: "modified",
+		Files:        []api.ShamanFileSpec{modifiedSpec},
+	})
+	checkedOut, err := os.ReadFile(filepath.Join(restarted.checkoutBasePath, checkoutPath, "cache.vdb"))
+	require.NoError(t, err)
+	assert.Equal(t, modified, checkedOut)
+
+	// The chunk index of a file that is no longer stored is removed at startup.
+	assembledPath, status := restarted.fileStore.ResolveFile(spec.Sha, int64(spec.Size), filestore.ResolveStoredOnly)
+	require.Equal(t, filestore.StatusStored, status)
+	require.NoError(t, restarted.fileStore.RemoveStoredFile(assembledPath))
+	restarted = NewManager(conf, filestore.New(conf))
+	indexes, err := restarted.fileStore.ChunkIndexes()
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	assert.Equal(t, modifiedSpec.Sha, indexes[0].Checksum)
+}
+
+func TestChunkedUploadMissingChunks(t *testing.T) {
+	manager, cleanup := createTestManager()
+	defer cleanup()
+	manager.chunking.Enabled = true
+
+	data := randomData(5_000)
+	_, err := manager.Checkout(context.Background(), api.ShamanCheckout{
+		CheckoutPath: "checkout",
+		Files:        []api.ShamanFileSpec{chunkedFileSpec(t, data, "cache.vdb")},
+	})
+	assert.ErrorIs(t, err, ErrMissingFiles)
+}
+
+func TestChunkedUploadAssemblyFailed(t *testing.T) {
+	manager, cleanup := createTestManager()
+	defer cleanup()
+	manager.chunking.Enabled = true
+	ctx := context.Background()
+
+	// Declare the wrong checksum, so that the assembled file doesn't match.
+	data := randomData(5_000)
+	spec := chunkedFileSpec(t, data, "cache.vdb")
+	spec.Sha = hasher.Checksum([]byte("something else"))
+	uploadChunks(t, manager, data, *spec.Chunks)
+	checkout := api.ShamanCheckout{
+		CheckoutPath: "checkout",
+		Files:        []api.ShamanFileSpec{spec},
+	}
+
+	_, err := manager.Checkout(ctx, checkout)
+	require.ErrorIs(t, err, ErrAssemblyPending)
+	require.Eventually(t, manager.assembler.isIdle, 5*time.Second, 10*time.Millisecond)
+
+	// The failure should be reported once, after which assembly is retried.
+	_, err = manager.Checkout(ctx, checkout)
+	assert.ErrorAs(t, err, &filestore.ErrChunksMismatch{})
+	_, err = manager.Checkout(ctx, checkout)
+	assert.ErrorIs(t, err, ErrAssemblyPending)
+	require.Eventually(t, manager.assembler.isIdle, 5*time.Second, 10*time.Millisecond)
+
+	// The chunks should be kept for another attempt.
+	for _, chunk := range *spec.Chunks {
+		_, status := manager.fileStore.ResolveFile(chunk.Sha, int64(chunk.Size), filestore.ResolveStoredOnly)
+		assert.Equal(t, filestore.StatusStored, status)
+	}
+}
+
+func TestChunkedUploadDisabled(t *testing.T) {
+	manager, cleanup := createTestManager()
+	defer cleanup()
+
+	spec := chunkedFileSpec(t, randomData(5_000), "cache.vdb")
+
+	// Without chunking, the whole file should be requested.
+	response, err := manager.ReportRequirements(context.Background(), api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{spec},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []api.ShamanFileSpecWithStatus{
+		{Sha: spec.Sha, Size: spec.Size, Path: spec.Path, Status: api.ShamanFileStatusUnknown},
+	}, response.Files)
+
+	// Files that are too small should not be chunked either.
+	manager.chunking.Enabled = true
+	manager.chunking.MinFileSizeMB = 1
+	response, err = manager.ReportRequirements(context.Background(), api.ShamanRequirementsRequest{
+		Files: []api.ShamanFileSpec{spec},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Files, 1)
+	assert.Nil(t, response.Files[0].Chunks)
+}
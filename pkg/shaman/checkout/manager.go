@@ -48,6 +48,9 @@ var (
 type Manager struct {
 	checkoutBasePath string
 	fileStore        *filestore.Store
+	checkoutMode     config.CheckoutMode
+	chunking         config.Chunking
+	assembler        *assembler
 
 	wg *sync.WaitGroup
 
@@ -87,7 +90,22 @@ func NewManager(conf config.Config, fileStore *filestore.Store) *Manager {
 		logger.Error().Err(err).Msg("unable to create checkout directory")
 	}
 
-	return &Manager{checkoutDir, fileStore, new(sync.WaitGroup), new(sync.Mutex)}
+	checkoutMode := conf.CheckoutMode.OrDefault()
+	if !checkoutMode.IsValid() {
+		logger.Error().
+			Str("checkoutMode", string(checkoutMode)).
+			Str("usingMode", string(config.CheckoutModeSymlink)).
+			Msg("unknown checkout mode, falling back to the default")
+		checkoutMode = config.CheckoutModeSymlink
+	}
+	logger.Debug().Str("checkoutMode", string(checkoutMode)).Msg("shaman: checkout mode")
+
+	return &Manager{checkoutDir, fileStore, checkoutMode, conf.Chunking, newAssembler(fileStore), new(sync.WaitGroup), new(sync.Mutex)}
+}
+
+// CheckoutMode returns how files are placed in checkouts.
+func (m *Manager) CheckoutMode() config.CheckoutMode {
+	return m.checkoutMode
 }
 
 // Close waits for still-running touch() calls to finish, then returns.
@@ -0,0 +1,114 @@
+package checkout
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/shaman/config"
+)
+
+// PlaceInCheckout puts the blob at the relative path in the checkout, by
+// symlinking, hardlinking, reflinking or copying it, depending on the checkout
+// mode.
+// It does *not* do any validation of the validity of the paths!
+func (m *Manager) PlaceInCheckout(blobPath, checkoutPath, relativePath string) error {
+	if m.checkoutMode == config.CheckoutModeSymlink {
+		return m.SymlinkToCheckout(blobPath, checkoutPath, relativePath)
+	}
+
+	targetPath := filepath.Join(checkoutPath, relativePath)
+	logger := log.With().
+		Str("blobPath", blobPath).
+		Str("targetPath", targetPath).
+		Str("checkoutMode", string(m.checkoutMode)).
+		Logger()
+
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0777); err != nil {
+		logger.Error().Err(err).Msg("shaman: unable to create parent directory")
+		return err
+	}
+
+	var err error
+	switch m.checkoutMode {
+	case config.CheckoutModeHardlink:
+		err = hardlinkOrCopy(blobPath, targetPath, logger)
+	case config.CheckoutModeReflink:
+		err = reflinkOrCopy(blobPath, targetPath, logger)
+	case config.CheckoutModeCopy:
+		err = copyFile(blobPath, targetPath)
+	default:
+		err = fmt.Errorf("unknown checkout mode %q", m.checkoutMode)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("shaman: unable to place file in checkout")
+		return err
+	}
+
+	// Change the modification time of the blob to mark it as 'referenced' just now.
+	m.wg.Add(1)
+	go func() {
+		if err := touchFile(blobPath); err != nil {
+			logger.Warn().Err(err).Msg("shaman: unable to touch blob path")
+		}
+		m.wg.Done()
+	}()
+
+	return nil
+}
+
+// hardlinkOrCopy creates a hard link at targetPath to blobPath. When that is
+// not possible, for example because they are on different filesystems, the
+// blob is copied instead.
+func hardlinkOrCopy(blobPath, targetPath string, logger zerolog.Logger) error {
+	err := os.Link(blobPath, targetPath)
+	if err == nil || os.IsExist(err) {
+		return err
+	}
+
+	logger.Debug().Err(err).Msg("shaman: unable to create hard link, copying file instead")
+	return copyFile(blobPath, targetPath)
+}
+
+// reflinkOrCopy creates a copy-on-write clone of blobPath at targetPath. When
+// the platform or filesystem does not support this, the blob is copied instead.
+func reflinkOrCopy(blobPath, targetPath string, logger zerolog.Logger) error {
+	err := reflink(blobPath, targetPath)
+	if err == nil || os.IsExist(err) {
+		return err
+	}
+
+	logger.Debug().Err(err).Msg("shaman: unable to create reflink, copying file instead")
+	return copyFile(blobPath, targetPath)
+}
+
+// copyFile copies the blob to targetPath, which must not exist yet.
+func copyFile(blobPath, targetPath string) error {
+	blob, err := os.Open(blobPath)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	target, err := os.OpenFile(targetPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0666)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(target, blob); err != nil {
+		target.Close()
+		os.Remove(targetPath)
+		return fmt.Errorf("copying %s: %w", blobPath, err)
+	}
+	if err := target.Close(); err != nil {
+		os.Remove(targetPath)
+		return err
+	}
+	return nil
+}
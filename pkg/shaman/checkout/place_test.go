@@ -0,0 +1,88 @@
+package checkout
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/pkg/shaman/config"
+	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
+)
+
+func TestPlaceInCheckout(t *testing.T) {
+	manager, cleanup := createTestManager()
+	defer cleanup()
+
+	blobPath := filepath.Join(manager.checkoutBasePath, "jemoeder.blob")
+	require.NoError(t, os.WriteFile(blobPath, []byte("op je hoofd"), 0600))
+
+	for _, mode := range []config.CheckoutMode{
+		config.CheckoutModeHardlink,
+		config.CheckoutModeReflink,
+		config.CheckoutModeCopy,
+	} {
+		t.Run(string(mode), func(t *testing.T) {
+			manager.checkoutMode = mode
+			relPath := filepath.Join("subdir", string(mode)+".txt")
+			require.NoError(t, manager.PlaceInCheckout(blobPath, manager.checkoutBasePath, relPath))
+			manager.wg.Wait()
+
+			targetPath := filepath.Join(manager.checkoutBasePath, relPath)
+			stat, err := os.Lstat(targetPath)
+			require.NoError(t, err)
+			assert.True(t, stat.Mode().IsRegular(), "should not be a symlink")
+
+			contents, err := os.ReadFile(targetPath)
+			require.NoError(t, err)
+			assert.Equal(t, "op je hoofd", string(contents))
+
+			blobStat, err := os.Stat(blobPath)
+			require.NoError(t, err)
+			assert.Equal(t, mode == config.CheckoutModeHardlink, os.SameFile(blobStat, stat))
+
+			// Placing the file again should fail, and not overwrite anything.
+			assert.Error(t, manager.PlaceInCheckout(blobPath, manager.checkoutBasePath, relPath))
+		})
+	}
+}
+
+func TestPlaceInCheckoutCopyIsIndependent(t *testing.T) {
+	manager, cleanup := createTestManager()
+	defer cleanup()
+	manager.checkoutMode = config.CheckoutModeCopy
+
+	blobPath := filepath.Join(manager.checkoutBasePath, "jemoeder.blob")
+	require.NoError(t, os.WriteFile(blobPath, []byte("op je hoofd"), 0600))
+
+	require.NoError(t, manager.PlaceInCheckout(blobPath, manager.checkoutBasePath, "copy.txt"))
+	manager.wg.Wait()
+
+	// Writing to the checked-out file should not affect the file store.
+	targetPath := filepath.Join(manager.checkoutBasePath, "copy.txt")
+	require.NoError(t, os.WriteFile(targetPath, []byte("overwritten"), 0600))
+	contents, err := os.ReadFile(blobPath)
+	require.NoError(t, err)
+	assert.Equal(t, "op je hoofd", string(contents))
+}
+
+func TestCheckoutModeConfig(t *testing.T) {
+	conf, confCleanup := config.CreateTestConfig()
+	defer confCleanup()
+	fileStore := filestore.New(conf)
+
+	manager := NewManager(conf, fileStore)
+	assert.Equal(t, config.CheckoutModeSymlink, manager.CheckoutMode(), "empty mode should mean symlinks")
+
+	conf.CheckoutMode = config.CheckoutModeCopy
+	manager = NewManager(conf, fileStore)
+	assert.Equal(t, config.CheckoutModeCopy, manager.CheckoutMode())
+
+	conf.CheckoutMode = "teleport"
+	manager = NewManager(conf, fileStore)
+	assert.Equal(t, config.CheckoutModeSymlink, manager.CheckoutMode(), "unknown mode should fall back to symlinks")
+}
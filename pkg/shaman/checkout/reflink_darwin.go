@@ -0,0 +1,19 @@
+package checkout
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// reflink creates a copy-on-write clone of blobPath at targetPath, which must
+// not exist yet. This is supported by APFS.
+func reflink(blobPath, targetPath string) error {
+	err := unix.Clonefile(blobPath, targetPath, unix.CLONE_NOFOLLOW)
+	if err != nil {
+		return &os.LinkError{Op: "clonefile", Old: blobPath, New: targetPath, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package checkout
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// reflink creates a copy-on-write clone of blobPath at targetPath, which must
+// not exist yet. This is supported by filesystems like Btrfs and XFS.
+func reflink(blobPath, targetPath string) error {
+	blob, err := os.Open(blobPath)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	target, err := os.OpenFile(targetPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0666)
+	if err != nil {
+		return err
+	}
+
+	if err := unix.IoctlFileClone(int(target.Fd()), int(blob.Fd())); err != nil {
+		target.Close()
+		os.Remove(targetPath)
+		return err
+	}
+	return target.Close()
+}
@@ -0,0 +1,14 @@
+//go:build !linux && !darwin
+
+package checkout
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import "errors"
+
+var errReflinkNotSupported = errors.New("reflinks are not supported on this platform")
+
+// reflink always fails on this platform, so that files are copied instead.
+func reflink(blobPath, targetPath string) error {
+	return errReflinkNotSupported
+}
@@ -4,7 +4,6 @@ package checkout
 
 import (
 	"context"
-	"fmt"
 
 	"projects.blender.org/studio/flamenco/pkg/api"
 	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
@@ -22,52 +21,108 @@ func (m *Manager) ReportRequirements(ctx context.Context, requirements api.Shama
 
 	alreadyRequested := map[string]bool{}
 	for _, fileSpec := range requirements.Files {
-		fileKey := fmt.Sprintf("%s/%d", fileSpec.Sha, fileSpec.Size)
-		if alreadyRequested[fileKey] {
+		fileSpecKey := fileKey(fileSpec.Sha, int64(fileSpec.Size))
+		if alreadyRequested[fileSpecKey] {
 			// User asked for this (checksum, filesize) tuple already.
 			continue
 		}
 
-		storePath, status := m.fileStore.ResolveFile(fileSpec.Sha, int64(fileSpec.Size), filestore.ResolveEverything)
-
-		var apiStatus api.ShamanFileStatus
-		switch status {
-		case filestore.StatusDoesNotExist:
-			// Caller can upload this file immediately.
-			apiStatus = api.ShamanFileStatusUnknown
-		case filestore.StatusUploading:
-			// Caller should postpone uploading this file until all 'unknown' files have been uploaded.
-			apiStatus = api.ShamanFileStatusUploading
-		case filestore.StatusStored:
-			// We expect this file to be sent soon, though, so we need to
-			// 'touch' it to make sure it won't be GC'd in the mean time.
-			go func() {
-				if err := touchFile(storePath); err != nil {
-					logger.Error().Err(err).Str("path", storePath).Msg("shaman: error touching file")
-				}
-			}()
-			// Only send a response when the caller needs to do something.
-			continue
-		default:
-			logger.Error().
-				Str("path", fileSpec.Path).
-				Str("status", status.String()).
-				Str("checksum", fileSpec.Sha).
-				Int("filesize", fileSpec.Size).
-				Msg("shaman: invalid status returned by ResolveFile, ignoring this file")
+		apiStatus, isNeeded := m.requiredStatus(fileSpec.Sha, int64(fileSpec.Size), fileSpec.Path, logger)
+		if !isNeeded {
 			continue
 		}
 
-		alreadyRequested[fileKey] = true
-		fileSpec := api.ShamanFileSpecWithStatus{
+		alreadyRequested[fileSpecKey] = true
+		fileSpecWithStatus := api.ShamanFileSpecWithStatus{
 			Path:   fileSpec.Path,
 			Sha:    fileSpec.Sha,
 			Size:   fileSpec.Size,
 			Status: apiStatus,
 		}
-		logger.Trace().Interface("fileSpec", fileSpec).Msg("shaman: file needed from client")
-		missing.Files = append(missing.Files, fileSpec)
+
+		// Large files can be uploaded as chunks, of which only the unknown ones
+		// are needed.
+		if chunks := m.chunksOf(fileSpec); chunks != nil {
+			missingChunks := []api.ShamanChunkSpec{}
+			for _, chunk := range chunks {
+				chunkKey := fileKey(chunk.Checksum, chunk.Size)
+				if alreadyRequested[chunkKey] {
+					continue
+				}
+				if !m.isChunkNeeded(chunk, fileSpec.Path, logger) {
+					continue
+				}
+				alreadyRequested[chunkKey] = true
+				missingChunks = append(missingChunks, api.ShamanChunkSpec{
+					Sha:  chunk.Checksum,
+					Size: int(chunk.Size),
+				})
+			}
+			fileSpecWithStatus.Chunks = &missingChunks
+		}
+
+		logger.Trace().Interface("fileSpec", fileSpecWithStatus).Msg("shaman: file needed from client")
+		missing.Files = append(missing.Files, fileSpecWithStatus)
 	}
 
+	// Files can also be a chunk of another file. These have to be kept after
+	// that other file has been assembled.
+	m.assembler.markFilesRequested(requirements.Files)
+
 	return missing, nil
 }
+
+// isChunkNeeded returns whether the chunk is needed from the client. Chunks
+// are not needed when they are stored, either by themselves or as part of a file
+// that was assembled from chunks earlier.
+func (m *Manager) isChunkNeeded(chunk filestore.Chunk, path string, logger *zerolog.Logger) bool {
+	storePath, isAvailable := m.assembler.isChunkAvailable(chunk)
+	if !isAvailable {
+		_, isNeeded := m.requiredStatus(chunk.Checksum, chunk.Size, path, logger)
+		if isNeeded {
+			m.assembler.markChunkRequested(chunk)
+		}
+		return isNeeded
+	}
+
+	// Just like stored files, make sure it won't be GC'd before it's used.
+	go func() {
+		if err := touchFile(storePath); err != nil {
+			logger.Error().Err(err).Str("path", storePath).Msg("shaman: error touching file")
+		}
+	}()
+	return false
+}
+
+// requiredStatus returns the status of the file or chunk in the file store, and
+// whether it is needed from the client.
+func (m *Manager) requiredStatus(checksum string, filesize int64, path string, logger *zerolog.Logger) (api.ShamanFileStatus, bool) {
+	storePath, status := m.fileStore.ResolveFile(checksum, filesize, filestore.ResolveEverything)
+
+	switch status {
+	case filestore.StatusDoesNotExist:
+		// Caller can upload this file immediately.
+		return api.ShamanFileStatusUnknown, true
+	case filestore.StatusUploading:
+		// Caller should postpone uploading this file until all 'unknown' files have been uploaded.
+		return api.ShamanFileStatusUploading, true
+	case filestore.StatusStored:
+		// We expect this file to be sent soon, though, so we need to
+		// 'touch' it to make sure it won't be GC'd in the mean time.
+		go func() {
+			if err := touchFile(storePath); err != nil {
+				logger.Error().Err(err).Str("path", storePath).Msg("shaman: error touching file")
+			}
+		}()
+		// Only send a response when the caller needs to do something.
+		return api.ShamanFileStatusStored, false
+	default:
+		logger.Error().
+			Str("path", path).
+			Str("status", status.String()).
+			Str("checksum", checksum).
+			Int64("filesize", filesize).
+			Msg("shaman: invalid status returned by ResolveFile, ignoring this file")
+		return api.ShamanFileStatusUnknown, false
+	}
+}
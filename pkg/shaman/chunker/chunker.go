@@ -0,0 +1,147 @@
+// Package chunker splits files into content-defined chunks.
+//
+// Chunk boundaries are determined by a rolling 'gear' hash over the file
+// contents, rather than by fixed offsets. Inserting or removing data in a file
+// thus only changes the chunks around the modification, and the other chunks
+// can be deduplicated against an earlier version of the same file.
+//
+// Clients that upload chunks to the Shaman server should split files exactly as
+// this package does, otherwise their chunks will not be deduplicated against
+// chunks uploaded by others:
+//
+//   - The hash is a 64-bit unsigned integer, which is reset to 0 at the start of
+//     each chunk.
+//   - For every byte b, the hash is updated as `hash = (hash << 1) + gear[b]`,
+//     wrapping around on overflow. The gear table is generated by SplitMix64,
+//     see gearTable.
+//   - After updating the hash, a chunk ends when its size is at least MinSize
+//     and the top log2(AvgSize) bits of the hash are all zero, or when its size
+//     is MaxSize.
+//   - The last chunk ends at the end of the file.
+package chunker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// Params determine the sizes of the chunks.
+type Params struct {
+	// Chunks are at least this large, except the last chunk of a file.
+	MinSize int64
+	// Chunks are on average this large. Must be a power of two.
+	AvgSize int64
+	// Chunks are at most this large.
+	MaxSize int64
+}
+
+// DefaultParams are the chunking parameters used by the Shaman server.
+var DefaultParams = Params{
+	MinSize: 512 * 1024,
+	AvgSize: 2 * 1024 * 1024,
+	MaxSize: 8 * 1024 * 1024,
+}
+
+var ErrInvalidParams = errors.New("invalid chunking parameters")
+
+// Chunk describes a part of a file.
+type Chunk struct {
+	Offset int64
+	Size   int64
+	// Checksum is the hex-encoded SHA256 checksum of the chunk.
+	Checksum string
+}
+
+// Validate returns ErrInvalidParams when chunks cannot be created with these
+// parameters.
+func (p Params) Validate() error {
+	switch {
+	case p.MinSize <= 0:
+		return fmt.Errorf("%w: minimum size must be positive", ErrInvalidParams)
+	case p.AvgSize&(p.AvgSize-1) != 0:
+		return fmt.Errorf("%w: average size must be a power of two", ErrInvalidParams)
+	case p.MinSize > p.AvgSize || p.AvgSize > p.MaxSize:
+		return fmt.Errorf("%w: sizes must be minimum <= average <= maximum", ErrInvalidParams)
+	}
+	return nil
+}
+
+// Split reads the file contents, and returns its chunks in order.
+func Split(reader io.Reader, params Params) ([]Chunk, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	numBits := bits.TrailingZeros64(uint64(params.AvgSize))
+	mask := ^uint64(0) << (64 - numBits)
+
+	chunks := []Chunk{}
+	checksummer := sha256.New()
+	var offset, size int64
+	var hash uint64
+
+	finishChunk := func() {
+		chunks = append(chunks, Chunk{
+			Offset:   offset,
+			Size:     size,
+			Checksum: fmt.Sprintf("%x", checksummer.Sum(nil)),
+		})
+		offset += size
+		size = 0
+		hash = 0
+		checksummer.Reset()
+	}
+
+	buf := make([]byte, 256*1024)
+	for {
+		numRead, err := reader.Read(buf)
+
+		// Start of the data in buf that has not been checksummed yet.
+		start := 0
+		for i, b := range buf[:numRead] {
+			size++
+			hash = (hash << 1) + gearTable[b]
+			if size < params.MinSize {
+				continue
+			}
+			if hash&mask == 0 || size >= params.MaxSize {
+				checksummer.Write(buf[start : i+1])
+				start = i + 1
+				finishChunk()
+			}
+		}
+		checksummer.Write(buf[start:numRead])
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if size > 0 {
+		finishChunk()
+	}
+	return chunks, nil
+}
+
+// gearTable maps each byte value to a pseudo-random 64-bit number. It contains
+// the first 256 outputs of SplitMix64, seeded with 0.
+var gearTable = func() [256]uint64 {
+	var table [256]uint64
+	var state uint64
+	for i := range table {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return table
+}()
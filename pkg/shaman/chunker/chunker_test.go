@@ -0,0 +1,98 @@
+package chunker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/pkg/shaman/hasher"
+)
+
+var testParams = Params{MinSize: 64, AvgSize: 256, MaxSize: 1024}
+
+func randomData(size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(47)).Read(data)
+	return data
+}
+
+func TestGearTable(t *testing.T) {
+	// Pin the first values of SplitMix64, as other implementations depend on them.
+	assert.Equal(t, uint64(0xe220a8397b1dcdaf), gearTable[0])
+	assert.Equal(t, uint64(0x6e789e6aa1b965f4), gearTable[1])
+}
+
+func TestSplit(t *testing.T) {
+	data := randomData(50_000)
+
+	chunks, err := Split(bytes.NewReader(data), testParams)
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 10)
+
+	var offset int64
+	for i, chunk := range chunks {
+		assert.Equal(t, offset, chunk.Offset, "chunk %d", i)
+		if i < len(chunks)-1 {
+			assert.GreaterOrEqual(t, chunk.Size, testParams.MinSize, "chunk %d", i)
+		}
+		assert.LessOrEqual(t, chunk.Size, testParams.MaxSize, "chunk %d", i)
+
+		chunkData := data[chunk.Offset : chunk.Offset+chunk.Size]
+		assert.Equal(t, hasher.Checksum(chunkData), chunk.Checksum, "chunk %d", i)
+		offset += chunk.Size
+	}
+	assert.EqualValues(t, len(data), offset, "chunks should cover the entire file")
+}
+
+func TestSplitEmpty(t *testing.T) {
+	chunks, err := Split(bytes.NewReader(nil), testParams)
+	require.NoError(t, err)
+	assert.Empty(t, chunks)
+}
+
+func TestSplitDeduplicates(t *testing.T) {
+	original := randomData(50_000)
+
+	// Insert some bytes in the middle of the file.
+	modified := append([]byte{}, original[:20_000]...)
+	modified = append(modified, []byte("some inserted data")...)
+	modified = append(modified, original[20_000:]...)
+
+	originalChunks, err := Split(bytes.NewReader(original), testParams)
+	require.NoError(t, err)
+	modifiedChunks, err := Split(bytes.NewReader(modified), testParams)
+	require.NoError(t, err)
+
+	known := map[string]bool{}
+	for _, chunk := range originalChunks {
+		known[chunk.Checksum] = true
+	}
+	numNew := 0
+	for _, chunk := range modifiedChunks {
+		if !known[chunk.Checksum] {
+			numNew++
+		}
+	}
+
+	// Only the chunks around the modification should differ.
+	assert.NotZero(t, numNew)
+	assert.LessOrEqual(t, numNew, 3, "%d of %d chunks are new", numNew, len(modifiedChunks))
+}
+
+func TestParamsValidate(t *testing.T) {
+	assert.NoError(t, DefaultParams.Validate())
+	assert.NoError(t, testParams.Validate())
+
+	assert.ErrorIs(t, Params{MinSize: 0, AvgSize: 256, MaxSize: 1024}.Validate(), ErrInvalidParams)
+	assert.ErrorIs(t, Params{MinSize: 64, AvgSize: 300, MaxSize: 1024}.Validate(), ErrInvalidParams)
+	assert.ErrorIs(t, Params{MinSize: 512, AvgSize: 256, MaxSize: 1024}.Validate(), ErrInvalidParams)
+	assert.ErrorIs(t, Params{MinSize: 64, AvgSize: 256, MaxSize: 128}.Validate(), ErrInvalidParams)
+
+	_, err := Split(bytes.NewReader(nil), Params{})
+	assert.ErrorIs(t, err, ErrInvalidParams)
+}
@@ -45,6 +45,8 @@ type GCStats struct {
 	numFilesDeleted      int
 	numFilesNotDeleted   int
 	bytesDeleted         int64
+
+	numUnfinishedUploadsDeleted int
 }
 
 func (s *Server) periodicCleanup() {
@@ -53,11 +55,23 @@ func (s *Server) periodicCleanup() {
 
 	for {
 		s.GCStorage(false)
-
-		select {
-		case <-s.shutdownChan:
-			return
-		case <-time.After(s.config.GarbageCollect.Period):
+		lastRun := time.Now()
+		nextRun := time.After(s.config.GarbageCollect.Period)
+
+	waitForNextRun:
+		for {
+			select {
+			case <-s.shutdownChan:
+				return
+			case <-nextRun:
+				break waitForNextRun
+			case <-s.gcRequests:
+				// Low disk space, so start early. Don't do this too often, though.
+				if time.Since(lastRun) >= lowDiskSpaceGCInterval {
+					log.Info().Msg("shaman: starting garbage collection early because of low disk space")
+					break waitForNextRun
+				}
+			}
 		}
 	}
 }
@@ -84,6 +98,8 @@ func (s *Server) GCStorage(doDryRun bool) (stats GCStats) {
 
 	logger.Info().Msg("performing garbage collection on storage")
 
+	stats.numUnfinishedUploadsDeleted = s.gcUnfinishedUploads(doDryRun, logger)
+
 	// Scan the storage for all the paths that are older than the threshold.
 	oldFiles, err := s.gcFindOldFiles(ageThreshold, logger)
 	if err != nil {
@@ -112,6 +128,13 @@ func (s *Server) GCStorage(doDryRun bool) (stats GCStats) {
 			return
 		}
 	}
+	// Files in checkouts made with hard links are not found by the above, so
+	// check those separately.
+	s.gcFilterHardlinkedFiles(oldFiles, logger)
+	// Chunks are not linked from any checkout, but are needed until the files
+	// that are made from them have been assembled.
+	s.gcFilterPendingChunks(oldFiles, logger)
+
 	stats.numStillUsedOldFiles = stats.numOldFiles - len(oldFiles)
 	stats.numUnusedOldFiles = len(oldFiles)
 	infoLogger := logger.With().
@@ -140,6 +163,43 @@ func (s *Server) GCStorage(doDryRun bool) (stats GCStats) {
 	return
 }
 
+// gcUnfinishedUploads deletes uploads that were interrupted and haven't been
+// resumed for a while. Returns the number of deleted files.
+func (s *Server) gcUnfinishedUploads(doDryRun bool, logger zerolog.Logger) int {
+	maxAge := s.config.GarbageCollect.UnfinishedUploadMaxAge
+	if maxAge == 0 {
+		return 0
+	}
+	ageThreshold := time.Now().Add(-maxAge).Round(1 * time.Second)
+	logger = logger.With().Time("unfinishedUploadAgeThreshold", ageThreshold).Logger()
+
+	paths, err := s.fileStore.UnfinishedUploads(ageThreshold)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to find unfinished uploads")
+		return 0
+	}
+
+	numDeleted := 0
+	for _, path := range paths {
+		pathLogger := logger.With().Str("path", path).Logger()
+		if doDryRun {
+			pathLogger.Info().Msg("would delete unfinished upload")
+			continue
+		}
+		if err := s.fileStore.RemoveUnfinishedUpload(path); err != nil {
+			pathLogger.Warn().Err(err).Msg("unable to delete unfinished upload")
+			continue
+		}
+		pathLogger.Debug().Msg("deleted unfinished upload")
+		numDeleted++
+	}
+
+	if numDeleted > 0 {
+		logger.Info().Int("numUnfinishedUploadsDeleted", numDeleted).Msg("removed unfinished uploads")
+	}
+	return numDeleted
+}
+
 func (s *Server) gcFindOldFiles(ageThreshold time.Time, logger zerolog.Logger) (mtimeMap, error) {
 	oldFiles := mtimeMap{}
 	visit := func(path string, info os.FileInfo, err error) error {
@@ -225,6 +285,35 @@ func (s *Server) gcFilterLinkedFiles(checkoutPath string, oldFiles mtimeMap, log
 	return nil
 }
 
+// gcFilterHardlinkedFiles removes all paths from 'oldFiles' that have other
+// hard links to them, as those are still used in a checkout. Deleting them would
+// not free up any disk space either.
+func (s *Server) gcFilterHardlinkedFiles(oldFiles mtimeMap, logger zerolog.Logger) {
+	for path := range oldFiles {
+		numLinks, err := numHardLinks(path)
+		if err != nil {
+			logger.Warn().Str("path", path).Err(err).Msg("unable to determine number of hard links; ignoring")
+			continue
+		}
+		if numLinks > 1 {
+			delete(oldFiles, path)
+			logger.Trace().Str("path", path).Msg("shaman: file is hard-linked, should not be garbage-collected")
+		}
+	}
+}
+
+// gcFilterPendingChunks removes all paths from 'oldFiles' that are used for
+// assembling files from their chunks.
+func (s *Server) gcFilterPendingChunks(oldFiles mtimeMap, logger zerolog.Logger) {
+	for _, path := range s.checkoutMan.PendingChunkPaths() {
+		if _, isOld := oldFiles[path]; !isOld {
+			continue
+		}
+		delete(oldFiles, path)
+		logger.Trace().Str("path", path).Msg("shaman: chunk is being assembled, should not be garbage-collected")
+	}
+}
+
 func (s *Server) gcDeleteOldFiles(doDryRun bool, oldFiles mtimeMap, logger zerolog.Logger) (int, int64) {
 	deletedFiles := 0
 	var deletedBytes int64
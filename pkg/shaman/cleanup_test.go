@@ -33,6 +33,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"projects.blender.org/studio/flamenco/pkg/shaman/config"
 	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
 	"projects.blender.org/studio/flamenco/pkg/shaman/jwtauth"
@@ -252,3 +253,53 @@ func TestGarbageCollect(t *testing.T) {
 	assert.FileExists(t, absPaths["781.blob"])
 	assert.FileExists(t, absPaths["3367.blob"])
 }
+
+func TestGCUnfinishedUploads(t *testing.T) {
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	oldFile, err := server.fileStore.OpenPartialUpload("abcdefxxx", 123)
+	require.NoError(t, err)
+	require.NoError(t, oldFile.Close())
+	newFile, err := server.fileStore.OpenPartialUpload("abcdefyyy", 123)
+	require.NoError(t, err)
+	require.NoError(t, newFile.Close())
+
+	oldTime := time.Now().Add(-2 * server.config.GarbageCollect.UnfinishedUploadMaxAge)
+	require.NoError(t, os.Chtimes(oldFile.Name(), oldTime, oldTime))
+
+	stats := server.GCStorage(true)
+	assert.Zero(t, stats.numUnfinishedUploadsDeleted)
+	assert.FileExists(t, oldFile.Name(), "file should exist after dry-run GC")
+
+	stats = server.GCStorage(false)
+	assert.Equal(t, 1, stats.numUnfinishedUploadsDeleted)
+	assert.NoFileExists(t, oldFile.Name())
+	assert.FileExists(t, newFile.Name(), "recent unfinished upload should be kept")
+}
+
+func TestGCHardlinkedFiles(t *testing.T) {
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	filestore.LinkTestFileStore(server.config.FileStorePath())
+
+	expectOld := mtimeMap{}
+	makeOld(server, expectOld, "stored/59/0c148428d5c35fab3ebad2f3365bb469ab9c531b60831f3e826c472027a0b9/3367.blob")
+	makeOld(server, expectOld, "stored/80/b749c27b2fef7255e7e7b3c2029b03b31299c75ff1f1c72732081c70a713a3/7488.blob")
+
+	absPaths := map[string]string{}
+	for absPath := range expectOld {
+		absPaths[filepath.Base(absPath)] = absPath
+	}
+
+	// Hard-link one of the files into a checkout.
+	checkoutInfo, err := server.checkoutMan.PrepareCheckout("checkoutID")
+	require.NoError(t, err)
+	linkPath := filepath.Join(server.config.CheckoutPath(), checkoutInfo.RelativePath, "use-of-3367.blob")
+	require.NoError(t, os.Link(absPaths["3367.blob"], linkPath))
+
+	server.GCStorage(false)
+	assert.FileExists(t, absPaths["3367.blob"], "hard-linked file should exist after GC")
+	assert.NoFileExists(t, absPaths["7488.blob"], "unused file should not exist after GC")
+}
@@ -46,7 +46,45 @@ type Config struct {
 
 	Enabled        bool           `yaml:"enabled"`
 	StoragePath    string         `yaml:"-"` // Needs to be set externally, not saved in config.
+	CheckoutMode   CheckoutMode   `yaml:"checkoutMode"`
 	GarbageCollect GarbageCollect `yaml:"garbageCollect"`
+	DiskSpace      DiskSpace      `yaml:"diskSpace"`
+	Chunking       Chunking       `yaml:"chunking"`
+	Scrub          Scrub          `yaml:"scrub"`
+}
+
+// CheckoutMode determines how files from the file store are placed in a checkout.
+type CheckoutMode string
+
+const (
+	// CheckoutModeSymlink creates symbolic links to the file store.
+	CheckoutModeSymlink CheckoutMode = "symlink"
+	// CheckoutModeHardlink creates hard links to the file store. Files are
+	// copied when the checkout is on another filesystem than the file store.
+	CheckoutModeHardlink CheckoutMode = "hardlink"
+	// CheckoutModeReflink creates copy-on-write clones of the files in the file
+	// store. Files are copied when the filesystem does not support this.
+	CheckoutModeReflink CheckoutMode = "reflink"
+	// CheckoutModeCopy copies the files from the file store.
+	CheckoutModeCopy CheckoutMode = "copy"
+)
+
+// IsValid returns whether the checkout mode is one of the known modes. The
+// empty string is considered valid, and means the default mode.
+func (m CheckoutMode) IsValid() bool {
+	switch m {
+	case "", CheckoutModeSymlink, CheckoutModeHardlink, CheckoutModeReflink, CheckoutModeCopy:
+		return true
+	}
+	return false
+}
+
+// OrDefault returns the checkout mode, or the default mode when it is empty.
+func (m CheckoutMode) OrDefault() CheckoutMode {
+	if m == "" {
+		return CheckoutModeSymlink
+	}
+	return m
 }
 
 // GarbageCollect contains the config options for the GC.
@@ -57,12 +95,73 @@ type GarbageCollect struct {
 	MaxAge time.Duration `yaml:"maxAge"`
 	// Paths to check for symlinks before GC'ing files.
 	ExtraCheckoutDirs []string `yaml:"extraCheckoutPaths"`
+	// How long unfinished uploads are kept for resuming, before they are GC'd:
+	UnfinishedUploadMaxAge time.Duration `yaml:"unfinishedUploadMaxAge"`
 
 	// Used by the -gc CLI arg to silently disable the garbage collector
 	// while we're performing a manual sweep.
 	SilentlyDisable bool `yaml:"-"`
 }
 
+// DiskSpace contains the config options for monitoring free disk space.
+type DiskSpace struct {
+	// How frequently the free disk space is checked. Zero disables monitoring.
+	CheckPeriod time.Duration `yaml:"checkPeriod"`
+	// Uploads are refused when they would leave less free space than this on
+	// the file store, in MB. Zero disables this check.
+	MinFreeMB uint64 `yaml:"minFreeMB"`
+	// Garbage collection is started early when there is less free space than
+	// this on the file store, in MB. Zero disables this.
+	GarbageCollectBelowMB uint64 `yaml:"garbageCollectBelowMB"`
+}
+
+// MinFreeBytes returns the minimum free space on the file store, in bytes.
+func (d DiskSpace) MinFreeBytes() uint64 {
+	return d.MinFreeMB * 1_000_000
+}
+
+// GarbageCollectBelowBytes returns the free space on the file store below
+// which garbage collection is started early, in bytes.
+func (d DiskSpace) GarbageCollectBelowBytes() uint64 {
+	return d.GarbageCollectBelowMB * 1_000_000
+}
+
+// Chunking contains the config options for uploading large files in chunks.
+type Chunking struct {
+	// Whether clients may upload files as content-defined chunks, so that only
+	// the changed parts of a file have to be uploaded again.
+	Enabled bool `yaml:"enabled"`
+	// Only files of at least this size, in MB, can be uploaded in chunks.
+	MinFileSizeMB uint64 `yaml:"minFileSizeMB"`
+}
+
+// MinFileSizeBytes returns the minimum size of files that can be uploaded in
+// chunks, in bytes.
+func (c Chunking) MinFileSizeBytes() int64 {
+	return int64(c.MinFileSizeMB * 1_000_000)
+}
+
+// IsChunkable returns whether a file of the given size can be uploaded in chunks.
+func (c Chunking) IsChunkable(filesize int64) bool {
+	return c.Enabled && filesize >= c.MinFileSizeBytes()
+}
+
+// Scrub contains the config options for verifying the integrity of the file store.
+type Scrub struct {
+	// How frequently all stored files are checked against their checksum. Zero
+	// disables periodic scrubbing; it can then still be started on demand.
+	Period time.Duration `yaml:"period"`
+	// Maximum speed at which stored files are read, in MB per second. Zero
+	// means unlimited.
+	RateLimitMBPerSecond uint64 `yaml:"rateLimitMBPerSecond"`
+}
+
+// RateLimitBytesPerSecond returns the maximum speed at which stored files are
+// read, in bytes per second. Zero means unlimited.
+func (s Scrub) RateLimitBytesPerSecond() int64 {
+	return int64(s.RateLimitMBPerSecond * 1_000_000)
+}
+
 // FileStorePath returns the sub-directory of the configured storage path,
 // used for the file store (i.e. the place where binary blobs are uploaded to).
 func (c Config) FileStorePath() string {
@@ -47,9 +47,10 @@ func CreateTestConfig() (conf Config, cleanup func()) {
 		StoragePath: tempDir,
 
 		GarbageCollect: GarbageCollect{
-			Period:            8 * time.Hour,
-			MaxAge:            31 * 24 * time.Hour,
-			ExtraCheckoutDirs: []string{},
+			Period:                 8 * time.Hour,
+			MaxAge:                 31 * 24 * time.Hour,
+			ExtraCheckoutDirs:      []string{},
+			UnfinishedUploadMaxAge: 7 * 24 * time.Hour,
 		},
 	}
 
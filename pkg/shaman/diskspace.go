@@ -0,0 +1,132 @@
+package shaman
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/sysinfo"
+)
+
+// lowDiskSpaceGCInterval is the minimum time between garbage collection runs
+// that are started early because of low disk space. This prevents continuous
+// runs when garbage collection cannot free up enough space.
+const lowDiskSpaceGCInterval = 1 * time.Hour
+
+// freeDiskSpace is a variable so that unit tests can mock it.
+var freeDiskSpace = sysinfo.FreeDiskSpace
+
+// DiskSpace describes the free disk space of one of the Shaman directories.
+type DiskSpace struct {
+	Path      string
+	FreeBytes uint64
+	// IsLow indicates the free space is below the configured minimum. For the
+	// file store this means that uploads are refused.
+	IsLow bool
+	// CheckedAt is the time at which the free space was last determined. It is
+	// the zero time when it could not be determined at all.
+	CheckedAt time.Time
+}
+
+// DiskSpaceStatus contains the free disk space of the Shaman directories.
+type DiskSpaceStatus struct {
+	FileStore DiskSpace
+	Checkout  DiskSpace
+}
+
+// diskSpaceMonitor keeps track of the last-known free disk space.
+type diskSpaceMonitor struct {
+	mutex  sync.Mutex
+	status DiskSpaceStatus
+}
+
+// DiskSpaceStatus returns the free disk space of the file store and checkout
+// directories, as determined by the last check. When periodic checks are
+// disabled, the free disk space is determined on the spot.
+func (s *Server) DiskSpaceStatus() DiskSpaceStatus {
+	if s.config.DiskSpace.CheckPeriod == 0 {
+		return s.measureDiskSpaceStatus()
+	}
+
+	s.diskSpace.mutex.Lock()
+	defer s.diskSpace.mutex.Unlock()
+	return s.diskSpace.status
+}
+
+func (s *Server) periodicDiskSpaceCheck() {
+	defer log.Debug().Msg("shaman: shutting down periodic disk space check")
+	defer s.wg.Done()
+
+	for {
+		s.checkDiskSpace()
+
+		select {
+		case <-s.shutdownChan:
+			return
+		case <-time.After(s.config.DiskSpace.CheckPeriod):
+		}
+	}
+}
+
+// checkDiskSpace determines the free disk space of the Shaman directories, and
+// triggers a garbage collection run when the file store is running low.
+func (s *Server) checkDiskSpace() {
+	status := s.measureDiskSpaceStatus()
+
+	s.diskSpace.mutex.Lock()
+	s.diskSpace.status = status
+	s.diskSpace.mutex.Unlock()
+
+	fileStore := status.FileStore
+
+	gcBelow := s.config.DiskSpace.GarbageCollectBelowBytes()
+	if gcBelow == 0 || fileStore.CheckedAt.IsZero() || fileStore.FreeBytes >= gcBelow {
+		return
+	}
+
+	log.Warn().
+		Str("fileStorePath", fileStore.Path).
+		Str("freeSize", humanizeByteSize(int64(fileStore.FreeBytes))).
+		Str("threshold", humanizeByteSize(int64(gcBelow))).
+		Msg("shaman: low on disk space, requesting garbage collection")
+
+	// Don't block when a garbage collection run was already requested.
+	select {
+	case s.gcRequests <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Server) measureDiskSpaceStatus() DiskSpaceStatus {
+	minFree := s.config.DiskSpace.MinFreeBytes()
+	return DiskSpaceStatus{
+		FileStore: s.measureDiskSpace(s.config.FileStorePath(), minFree),
+		Checkout:  s.measureDiskSpace(s.config.CheckoutPath(), minFree),
+	}
+}
+
+func (s *Server) measureDiskSpace(path string, minFree uint64) DiskSpace {
+	diskSpace := DiskSpace{Path: path}
+
+	free, err := freeDiskSpace(path)
+	if err != nil {
+		log.Warn().Err(err).Str("path", path).Msg("shaman: unable to determine free disk space")
+		return diskSpace
+	}
+
+	diskSpace.FreeBytes = free
+	diskSpace.IsLow = free < minFree
+	diskSpace.CheckedAt = time.Now()
+
+	if diskSpace.IsLow {
+		log.Error().
+			Str("path", path).
+			Str("freeSize", humanizeByteSize(int64(free))).
+			Str("minFreeSize", humanizeByteSize(int64(minFree))).
+			Msg("shaman: free disk space is below the configured minimum")
+	}
+	return diskSpace
+}
@@ -0,0 +1,60 @@
+package shaman
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockFreeDiskSpace(t *testing.T, freeBytes uint64) {
+	origFreeDiskSpace := freeDiskSpace
+	t.Cleanup(func() { freeDiskSpace = origFreeDiskSpace })
+	freeDiskSpace = func(path string) (uint64, error) {
+		return freeBytes, nil
+	}
+}
+
+func TestDiskSpaceStatus(t *testing.T) {
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	server.config.DiskSpace.MinFreeMB = 100
+	mockFreeDiskSpace(t, 50_000_000)
+
+	// Without periodic checks, the status should be determined on the spot.
+	status := server.DiskSpaceStatus()
+	assert.Equal(t, server.config.FileStorePath(), status.FileStore.Path)
+	assert.Equal(t, uint64(50_000_000), status.FileStore.FreeBytes)
+	assert.True(t, status.FileStore.IsLow)
+	assert.False(t, status.FileStore.CheckedAt.IsZero())
+	assert.Equal(t, server.config.CheckoutPath(), status.Checkout.Path)
+	assert.True(t, status.Checkout.IsLow)
+
+	// With periodic checks, the last-checked status should be returned.
+	server.config.DiskSpace.CheckPeriod = 1
+	assert.Zero(t, server.DiskSpaceStatus())
+	server.checkDiskSpace()
+	assert.Equal(t, uint64(50_000_000), server.DiskSpaceStatus().FileStore.FreeBytes)
+}
+
+func TestCheckDiskSpaceRequestsGC(t *testing.T) {
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	server.config.DiskSpace.GarbageCollectBelowMB = 100
+
+	// Plenty of space, so no need for garbage collection.
+	mockFreeDiskSpace(t, 200_000_000)
+	server.checkDiskSpace()
+	assert.Len(t, server.gcRequests, 0)
+
+	// Low on space, so garbage collection should be requested, but only once.
+	mockFreeDiskSpace(t, 50_000_000)
+	server.checkDiskSpace()
+	server.checkDiskSpace()
+	require.Len(t, server.gcRequests, 1)
+	assert.False(t, server.DiskSpaceStatus().FileStore.IsLow, "no minimum free space configured")
+}
@@ -26,7 +26,15 @@ import (
 	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
 )
 
-func (fs *FileServer) CheckFile(checksum string, filesize int64) filestore.FileStatus {
-	_, status := fs.fileStore.ResolveFile(checksum, filesize, filestore.ResolveEverything)
-	return status
+// CheckFile returns the status of the file, and the number of bytes received
+// by an unfinished upload of it. Uploads can be resumed from that offset.
+func (fs *FileServer) CheckFile(checksum string, filesize int64) (status filestore.FileStatus, offset int64) {
+	_, status = fs.fileStore.ResolveFile(checksum, filesize, filestore.ResolveEverything)
+	if status == filestore.StatusStored {
+		return status, 0
+	}
+	if status == filestore.StatusDoesNotExist && fs.isPartialUploadLocked(checksum, filesize) {
+		status = filestore.StatusUploading
+	}
+	return status, fs.fileStore.PartialUploadSize(checksum, filesize)
 }
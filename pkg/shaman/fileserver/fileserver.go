@@ -38,6 +38,9 @@ type FileServer struct {
 	receiverMutex    sync.Mutex
 	receiverChannels map[string]receiverChannel
 
+	// Partial uploads that are currently being written to, protected by receiverMutex.
+	partialUploads map[string]struct{}
+
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 	wg        sync.WaitGroup
@@ -51,6 +54,7 @@ func New(fileStore *filestore.Store) *FileServer {
 		fileStore,
 		sync.Mutex{},
 		map[string]receiverChannel{},
+		map[string]struct{}{},
 		ctx,
 		ctxCancel,
 		sync.WaitGroup{},
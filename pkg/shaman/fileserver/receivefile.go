@@ -24,9 +24,12 @@ package fileserver
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
+	"os"
 
 	"github.com/rs/zerolog"
 	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
@@ -61,10 +64,30 @@ func (e ErrFileChecksumMismatch) Error() string {
 	return fmt.Sprintf("file SHA256 mismatched, declared %s but received %s", e.DeclaredChecksum, e.ActualChecksum)
 }
 
+// ErrUploadInProgress indicates that an upload cannot be resumed, because
+// another upload of the same file is currently in progress.
+var ErrUploadInProgress = errors.New("file is being uploaded by someone else, unable to resume upload")
+
+// ErrInvalidOffset indicates that an upload cannot be resumed at the requested
+// offset, because not that many bytes have been received before.
+type ErrInvalidOffset struct {
+	RequestedOffset int64
+	ReceivedBytes   int64
+}
+
+func (e ErrInvalidOffset) Error() string {
+	return fmt.Sprintf("unable to resume upload at offset %d, only %d bytes were received", e.RequestedOffset, e.ReceivedBytes)
+}
+
 // ReceiveFile streams a file from a HTTP request to disk.
+//
+// The file is received into a partial upload file, which is kept when the
+// upload is interrupted. When offset > 0, the upload resumes such an earlier
+// upload, and the body should contain the file's contents from that offset
+// onward.
 func (fs *FileServer) ReceiveFile(
 	ctx context.Context, bodyReader io.ReadCloser,
-	checksum string, filesize int64,
+	checksum string, filesize int64, offset int64,
 	canDefer bool, originalFilename string,
 ) error {
 	logger := *zerolog.Ctx(ctx)
@@ -75,6 +98,7 @@ func (fs *FileServer) ReceiveFile(
 		Str("path", originalFilename).
 		Str("checksum", checksum).
 		Int64("filesize", filesize).
+		Int64("offset", offset).
 		Str("status", status.String()).
 		Logger()
 
@@ -89,8 +113,46 @@ func (fs *FileServer) ReceiveFile(
 		}
 	}
 
+	if offset < 0 || offset > filesize {
+		return ErrInvalidOffset{
+			RequestedOffset: offset,
+			ReceivedBytes:   fs.fileStore.PartialUploadSize(checksum, filesize),
+		}
+	}
+
+	// Refuse the upload before receiving any data, when it would fill up the disk.
+	if err := fs.fileStore.CheckFreeSpace(filesize - offset); err != nil {
+		logger.Error().Err(err).Msg("shaman: refusing upload")
+		return err
+	}
+
+	if !fs.lockPartialUpload(checksum, filesize) {
+		switch {
+		case canDefer:
+			logger.Info().Msg("shaman: someone is uploading this file and client can defer")
+			return ErrFileShouldDefer
+		case offset > 0:
+			logger.Info().Msg("shaman: someone is uploading this file, unable to resume upload")
+			return ErrUploadInProgress
+		}
+
+		// Receive the file into a temporary file instead. Whichever upload
+		// finishes first will store the file.
+		logger.Info().Msg("shaman: receiving file")
+		return fs.receiveToTempFile(logger, bodyReader, checksum, filesize)
+	}
+	defer fs.unlockPartialUpload(checksum, filesize)
+
 	logger.Info().Msg("shaman: receiving file")
+	return fs.receiveToPartialFile(logger, bodyReader, checksum, filesize, offset)
+}
 
+// receiveToTempFile receives the file into a new temporary file, which is
+// removed when the upload is interrupted.
+func (fs *FileServer) receiveToTempFile(
+	logger zerolog.Logger, bodyReader io.ReadCloser,
+	checksum string, filesize int64,
+) error {
 	streamTo, err := fs.fileStore.OpenForUpload(checksum, filesize)
 	if err != nil {
 		return fmt.Errorf("opening file for writing uploaded data: %w", err)
@@ -102,6 +164,69 @@ func (fs *FileServer) ReceiveFile(
 		fs.fileStore.RemoveUploadedFile(streamTo.Name())
 	}()
 
+	_, err = fs.receiveInto(logger, streamTo, bodyReader, checksum, filesize, 0, sha256.New())
+	return err
+}
+
+// receiveToPartialFile receives the file into its partial upload file, starting
+// at the given offset. The partial upload file is kept when the upload is
+// interrupted, so that it can be resumed later.
+func (fs *FileServer) receiveToPartialFile(
+	logger zerolog.Logger, bodyReader io.ReadCloser,
+	checksum string, filesize int64, offset int64,
+) error {
+	streamTo, err := fs.fileStore.OpenPartialUpload(checksum, filesize)
+	if err != nil {
+		return fmt.Errorf("opening partial upload for writing uploaded data: %w", err)
+	}
+	defer streamTo.Close()
+
+	stat, err := streamTo.Stat()
+	if err != nil {
+		return fmt.Errorf("inspecting partial upload: %w", err)
+	}
+	if offset > stat.Size() {
+		logger.Warn().Int64("receivedBytes", stat.Size()).Msg("shaman: unable to resume upload beyond the received bytes")
+		return ErrInvalidOffset{
+			RequestedOffset: offset,
+			ReceivedBytes:   stat.Size(),
+		}
+	}
+
+	// Hash the data received earlier, and discard anything beyond the offset, as
+	// the client will send that again.
+	checksummer := sha256.New()
+	if _, err := io.Copy(checksummer, io.NewSectionReader(streamTo, 0, offset)); err != nil {
+		return fmt.Errorf("reading partial upload: %w", err)
+	}
+	if err := streamTo.Truncate(offset); err != nil {
+		return fmt.Errorf("truncating partial upload: %w", err)
+	}
+	if _, err := streamTo.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("seeking in partial upload: %w", err)
+	}
+	if offset > 0 {
+		logger.Info().Msg("shaman: resuming upload")
+	}
+
+	interrupted, err := fs.receiveInto(logger, streamTo, bodyReader, checksum, filesize, offset, checksummer)
+	if err != nil && !interrupted {
+		fs.fileStore.RemovePartialUpload(checksum, filesize)
+	}
+	return err
+}
+
+// receiveInto streams the request body into the file, which already contains
+// `offset` bytes of data that have been written to `checksummer`. When the file
+// was received completely and correctly, it is moved to the 'stored' storage
+// bin.
+//
+// Returns whether the upload was interrupted, meaning that the data received
+// so far is incomplete but can be resumed.
+func (fs *FileServer) receiveInto(
+	logger zerolog.Logger, streamTo *os.File, bodyReader io.ReadCloser,
+	checksum string, filesize int64, offset int64, checksummer hash.Hash,
+) (interrupted bool, err error) {
 	// Abort this upload when the file has been finished by someone else.
 	uploadDone := make(chan struct{})
 	uploadAlreadyCompleted := false
@@ -110,7 +235,7 @@ func (fs *FileServer) ReceiveFile(
 	go func() {
 		select {
 		case <-uploadDone:
-			close(receiverChannel)
+			fs.closeReceiveListener(checksum, filesize, receiverChannel)
 			return
 		case <-receiverChannel:
 		}
@@ -124,7 +249,8 @@ func (fs *FileServer) ReceiveFile(
 	}()
 
 	// TODO: pass context to hasher.Copy()
-	written, actualChecksum, err := hasher.Copy(streamTo, bodyReader)
+	written, actualChecksum, err := hasher.CopyWithHasher(streamTo, bodyReader, checksummer)
+	received := offset + written
 	if err != nil {
 		if closeErr := streamTo.Close(); closeErr != nil {
 			logger.Error().
@@ -133,31 +259,32 @@ func (fs *FileServer) ReceiveFile(
 				Msg("error closing local file after other I/O error occured")
 		}
 
-		logger = logger.With().Err(err).Logger()
+		logger = logger.With().Err(err).Int64("receivedBytes", received).Logger()
 		switch {
 		case uploadAlreadyCompleted:
 			logger.Debug().Msg("aborted upload")
-			return ErrFileAlreadyExists
+			return false, ErrFileAlreadyExists
 		case err == io.ErrUnexpectedEOF:
 			logger.Debug().Msg("unexpected EOF, client probably just disconnected")
-			return err
+			return true, err
 		default:
-			return fmt.Errorf("unable to copy request body to file: %w", err)
+			return true, fmt.Errorf("unable to copy request body to file: %w", err)
 		}
 	}
 
 	if err := streamTo.Close(); err != nil {
-		return fmt.Errorf("closing local file: %w", err)
+		return false, fmt.Errorf("closing local file: %w", err)
 	}
 
-	if written != filesize {
+	if received != filesize {
 		logger.Warn().
 			Int64("declaredSize", filesize).
-			Int64("actualSize", written).
+			Int64("actualSize", received).
 			Msg("mismatch between expected and actual size")
-		return ErrFileSizeMismatch{
+		// Too little data can still be completed by resuming the upload.
+		return received < filesize, ErrFileSizeMismatch{
 			DeclaredSize: filesize,
-			ActualSize:   written,
+			ActualSize:   received,
 		}
 	}
 
@@ -166,14 +293,14 @@ func (fs *FileServer) ReceiveFile(
 			Str("declaredChecksum", checksum).
 			Str("actualChecksum", actualChecksum).
 			Msg("mismatch between expected and actual checksum")
-		return ErrFileChecksumMismatch{
+		return false, ErrFileChecksumMismatch{
 			DeclaredChecksum: checksum,
 			ActualChecksum:   actualChecksum,
 		}
 	}
 
 	logger.Debug().
-		Int64("receivedBytes", written).
+		Int64("receivedBytes", received).
 		Str("checksum", actualChecksum).
 		Str("tempFile", streamTo.Name()).
 		Msg("File received")
@@ -183,8 +310,8 @@ func (fs *FileServer) ReceiveFile(
 			Err(err).
 			Str("tempFile", streamTo.Name()).
 			Msg("unable to move file from 'upload' to 'stored' storage")
-		return err
+		return false, err
 	}
 
-	return nil
+	return false, nil
 }
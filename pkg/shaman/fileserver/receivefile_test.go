@@ -25,6 +25,7 @@ package fileserver
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"io/ioutil"
 	"testing"
@@ -33,6 +34,7 @@ import (
 	"projects.blender.org/studio/flamenco/pkg/shaman/hasher"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
 )
 
@@ -49,7 +51,7 @@ func TestStoreFile(t *testing.T) {
 
 	testWithChecksum := func(checksum string, reportSize int64) error {
 		buffer := io.NopCloser(bytes.NewBuffer(payload))
-		return server.ReceiveFile(context.Background(), buffer, checksum, reportSize, false, "testfile.txt")
+		return server.ReceiveFile(context.Background(), buffer, checksum, reportSize, 0, false, "testfile.txt")
 	}
 
 	var err error
@@ -90,6 +92,137 @@ func TestStoreFile(t *testing.T) {
 	assert.EqualValues(t, payload, savedContent, "The file should be saved uncompressed")
 }
 
+func TestStoreFileInsufficientDiskSpace(t *testing.T) {
+	conf, configCleanup := config.CreateTestConfig()
+	defer configCleanup()
+
+	// No disk is this large.
+	conf.DiskSpace.MinFreeMB = 1 << 40
+	server := New(filestore.New(conf))
+	server.Go()
+	defer server.Close()
+
+	payload := []byte("hähähä")
+	filesize := int64(len(payload))
+	checksum := hasher.Checksum(payload)
+
+	buffer := io.NopCloser(bytes.NewBuffer(payload))
+	err := server.ReceiveFile(context.Background(), buffer, checksum, filesize, 0, false, "testfile.txt")
+	assert.ErrorAs(t, err, &filestore.ErrInsufficientDiskSpace{})
+
+	path, status := server.fileStore.ResolveFile(checksum, filesize, filestore.ResolveEverything)
+	assert.Equal(t, filestore.StatusDoesNotExist, status)
+	assert.Equal(t, "", path)
+}
+
+// interruptedReader returns its data, and then fails as if the connection dropped.
+type interruptedReader struct {
+	data io.Reader
+}
+
+func (r *interruptedReader) Read(p []byte) (int, error) {
+	n, err := r.data.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func TestResumeUpload(t *testing.T) {
+	server, cleanup := createTestServer()
+	defer cleanup()
+
+	payload := []byte("hähähä hihihi hohoho")
+	filesize := int64(len(payload))
+	checksum := hasher.Checksum(payload)
+	ctx := context.Background()
+
+	// Interrupt the upload halfway.
+	body := io.NopCloser(&interruptedReader{bytes.NewReader(payload[:12])})
+	err := server.ReceiveFile(ctx, body, checksum, filesize, 0, false, "testfile.txt")
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	status, offset := server.CheckFile(checksum, filesize)
+	assert.Equal(t, filestore.StatusDoesNotExist, status)
+	assert.EqualValues(t, 12, offset)
+
+	// Resuming beyond the received data should fail.
+	body = io.NopCloser(bytes.NewReader(payload[13:]))
+	err = server.ReceiveFile(ctx, body, checksum, filesize, 13, false, "testfile.txt")
+	assert.ErrorIs(t, err, ErrInvalidOffset{RequestedOffset: 13, ReceivedBytes: 12})
+
+	// Resuming before the end of the received data should discard the rest.
+	body = io.NopCloser(bytes.NewReader(payload[10:]))
+	err = server.ReceiveFile(ctx, body, checksum, filesize, 10, false, "testfile.txt")
+	require.NoError(t, err)
+
+	path, status := server.fileStore.ResolveFile(checksum, filesize, filestore.ResolveEverything)
+	assert.Equal(t, filestore.StatusStored, status)
+	savedContent, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, payload, savedContent)
+
+	status, offset = server.CheckFile(checksum, filesize)
+	assert.Equal(t, filestore.StatusStored, status)
+	assert.Zero(t, offset)
+	assert.Zero(t, server.fileStore.PartialUploadSize(checksum, filesize))
+}
+
+func TestResumeUploadChecksumMismatch(t *testing.T) {
+	server, cleanup := createTestServer()
+	defer cleanup()
+
+	payload := []byte("hähähä hihihi hohoho")
+	filesize := int64(len(payload))
+	checksum := hasher.Checksum(payload)
+	ctx := context.Background()
+
+	body := io.NopCloser(&interruptedReader{bytes.NewReader(payload[:12])})
+	err := server.ReceiveFile(ctx, body, checksum, filesize, 0, false, "testfile.txt")
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// Resuming with the wrong data should discard the partial upload.
+	body = io.NopCloser(bytes.NewReader(bytes.ToUpper(payload[12:])))
+	err = server.ReceiveFile(ctx, body, checksum, filesize, 12, false, "testfile.txt")
+	assert.ErrorAs(t, err, &ErrFileChecksumMismatch{})
+
+	status, offset := server.CheckFile(checksum, filesize)
+	assert.Equal(t, filestore.StatusDoesNotExist, status)
+	assert.Zero(t, offset)
+}
+
+func TestResumeUploadInProgress(t *testing.T) {
+	server, cleanup := createTestServer()
+	defer cleanup()
+
+	payload := []byte("hähähä")
+	filesize := int64(len(payload))
+	checksum := hasher.Checksum(payload)
+	ctx := context.Background()
+
+	// Pretend another upload is writing to the partial upload.
+	require.True(t, server.lockPartialUpload(checksum, filesize))
+
+	status, _ := server.CheckFile(checksum, filesize)
+	assert.Equal(t, filestore.StatusUploading, status)
+
+	body := io.NopCloser(bytes.NewReader(payload[2:]))
+	err := server.ReceiveFile(ctx, body, checksum, filesize, 2, false, "testfile.txt")
+	assert.ErrorIs(t, err, ErrUploadInProgress)
+
+	body = io.NopCloser(bytes.NewReader(payload))
+	err = server.ReceiveFile(ctx, body, checksum, filesize, 0, true, "testfile.txt")
+	assert.ErrorIs(t, err, ErrFileShouldDefer)
+
+	// Uploads from the start should still be possible.
+	body = io.NopCloser(bytes.NewReader(payload))
+	err = server.ReceiveFile(ctx, body, checksum, filesize, 0, false, "testfile.txt")
+	require.NoError(t, err)
+
+	status, _ = server.CheckFile(checksum, filesize)
+	assert.Equal(t, filestore.StatusStored, status)
+}
+
 func createTestServer() (server *FileServer, cleanup func()) {
 	config, configCleanup := config.CreateTestConfig()
 
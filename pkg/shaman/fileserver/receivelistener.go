@@ -44,19 +44,56 @@ func (fs *FileServer) receiveListenerFor(checksum string, filesize int64) chan s
 
 	channel = make(receiverChannel)
 	fs.receiverChannels[key] = channel
+	return channel
+}
 
-	go func() {
-		// Wait until the channel closes.
-		select {
-		case <-channel:
-		}
+// closeReceiveListener closes the channel returned by receiveListenerFor(),
+// unless another upload of the same file already closed it. The channel is
+// unregistered at the same time, so that a new upload of the file gets a new
+// channel.
+func (fs *FileServer) closeReceiveListener(checksum string, filesize int64, channel receiverChannel) {
+	fs.receiverMutex.Lock()
+	defer fs.receiverMutex.Unlock()
 
-		fs.receiverMutex.Lock()
-		defer fs.receiverMutex.Unlock()
-		delete(fs.receiverChannels, key)
-	}()
+	key := fmt.Sprintf("%s/%d", checksum, filesize)
+	if fs.receiverChannels[key] != channel {
+		return
+	}
+	delete(fs.receiverChannels, key)
+	close(channel)
+}
 
-	return channel
+// lockPartialUpload marks the partial upload of the given file as being written
+// to. Returns false when another upload is already writing to it.
+func (fs *FileServer) lockPartialUpload(checksum string, filesize int64) bool {
+	fs.receiverMutex.Lock()
+	defer fs.receiverMutex.Unlock()
+
+	key := fmt.Sprintf("%s/%d", checksum, filesize)
+	if _, isLocked := fs.partialUploads[key]; isLocked {
+		return false
+	}
+	fs.partialUploads[key] = struct{}{}
+	return true
+}
+
+func (fs *FileServer) unlockPartialUpload(checksum string, filesize int64) {
+	fs.receiverMutex.Lock()
+	defer fs.receiverMutex.Unlock()
+
+	key := fmt.Sprintf("%s/%d", checksum, filesize)
+	delete(fs.partialUploads, key)
+}
+
+// isPartialUploadLocked returns whether some upload is currently writing to the
+// partial upload of the given file.
+func (fs *FileServer) isPartialUploadLocked(checksum string, filesize int64) bool {
+	fs.receiverMutex.Lock()
+	defer fs.receiverMutex.Unlock()
+
+	key := fmt.Sprintf("%s/%d", checksum, filesize)
+	_, isLocked := fs.partialUploads[key]
+	return isLocked
 }
 
 func (fs *FileServer) receiveListenerPeriodicCheck() {
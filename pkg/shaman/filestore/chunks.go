@@ -0,0 +1,263 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/shaman/hasher"
+)
+
+// Chunk identifies a stored file that is a part of a larger file. Chunks are
+// stored just like any other file, by their checksum and size.
+type Chunk struct {
+	Checksum string
+	Size     int64
+}
+
+// ErrMissingChunk is returned when a file cannot be assembled, because one of
+// its chunks has not been stored.
+type ErrMissingChunk struct {
+	Checksum string
+	Size     int64
+}
+
+func (e ErrMissingChunk) Error() string {
+	return fmt.Sprintf("chunk %s/%d is not stored", e.Checksum, e.Size)
+}
+
+// ErrChunksMismatch is returned when the concatenated chunks do not match the
+// declared file size or checksum.
+type ErrChunksMismatch struct {
+	DeclaredChecksum string
+	DeclaredSize     int64
+	ActualChecksum   string
+	ActualSize       int64
+}
+
+func (e ErrChunksMismatch) Error() string {
+	if e.ActualChecksum == "" {
+		return fmt.Sprintf("chunks of file %s/%d add up to %d bytes",
+			e.DeclaredChecksum, e.DeclaredSize, e.ActualSize)
+	}
+	return fmt.Sprintf("chunks of file %s/%d have SHA256 %s",
+		e.DeclaredChecksum, e.DeclaredSize, e.ActualChecksum)
+}
+
+// ChunkData locates the contents of a chunk. This is either a stored chunk, or
+// a part of a larger stored file that was assembled from chunks earlier.
+type ChunkData struct {
+	Path   string
+	Offset int64
+	Size   int64
+}
+
+// ResolveChunk returns the location of the stored chunk, or ErrMissingChunk
+// when it has not been stored.
+func (s *Store) ResolveChunk(chunk Chunk) (ChunkData, error) {
+	path, status := s.ResolveFile(chunk.Checksum, chunk.Size, ResolveStoredOnly)
+	if status != StatusStored {
+		return ChunkData{}, ErrMissingChunk(chunk)
+	}
+	return ChunkData{Path: path, Size: chunk.Size}, nil
+}
+
+// AssembleFromChunks concatenates the chunk data, and stores the result as the
+// file with the given checksum and size. The chunks themselves are not touched;
+// removing them is up to the caller.
+func (s *Store) AssembleFromChunks(checksum string, filesize int64, chunks []ChunkData) error {
+	var chunksSize int64
+	for _, chunk := range chunks {
+		chunksSize += chunk.Size
+	}
+	if chunksSize != filesize {
+		return ErrChunksMismatch{
+			DeclaredChecksum: checksum,
+			DeclaredSize:     filesize,
+			ActualSize:       chunksSize,
+		}
+	}
+
+	if err := s.CheckFreeSpace(filesize); err != nil {
+		return err
+	}
+
+	logger := log.With().
+		Str("checksum", checksum).
+		Int64("filesize", filesize).
+		Int("numChunks", len(chunks)).
+		Logger()
+	logger.Debug().Msg("shaman: assembling file from chunks")
+
+	assembled, err := s.OpenForUpload(checksum, filesize)
+	if err != nil {
+		return fmt.Errorf("opening file for assembling chunks: %w", err)
+	}
+	defer func() {
+		assembled.Close()
+		s.RemoveUploadedFile(assembled.Name())
+	}()
+
+	checksummer := sha256.New()
+	var actualChecksum string
+	for _, chunk := range chunks {
+		actualChecksum, err = s.appendChunk(assembled, chunk, checksummer)
+		if err != nil {
+			return err
+		}
+	}
+	if len(chunks) == 0 {
+		actualChecksum = fmt.Sprintf("%x", checksummer.Sum(nil))
+	}
+
+	if err := assembled.Close(); err != nil {
+		return fmt.Errorf("closing assembled file: %w", err)
+	}
+	if actualChecksum != checksum {
+		return ErrChunksMismatch{
+			DeclaredChecksum: checksum,
+			DeclaredSize:     filesize,
+			ActualChecksum:   actualChecksum,
+			ActualSize:       chunksSize,
+		}
+	}
+
+	return s.MoveToStored(checksum, filesize, assembled.Name())
+}
+
+// appendChunk copies the chunk into the assembled file. Returns the checksum of
+// all the data written so far.
+func (s *Store) appendChunk(assembled *os.File, chunk ChunkData, checksummer hash.Hash) (string, error) {
+	chunkFile, err := os.Open(chunk.Path)
+	if err != nil {
+		return "", fmt.Errorf("opening chunk: %w", err)
+	}
+	defer chunkFile.Close()
+
+	if _, err := chunkFile.Seek(chunk.Offset, io.SeekStart); err != nil {
+		return "", fmt.Errorf("seeking chunk %s to offset %d: %w", chunk.Path, chunk.Offset, err)
+	}
+
+	written, checksum, err := hasher.CopyWithHasher(assembled, io.LimitReader(chunkFile, chunk.Size), checksummer)
+	switch {
+	case err != nil:
+		return "", fmt.Errorf("copying chunk %s: %w", chunk.Path, err)
+	case written != chunk.Size:
+		return "", fmt.Errorf("copying chunk %s: got %d bytes, expected %d", chunk.Path, written, chunk.Size)
+	}
+	return checksum, nil
+}
+
+// ChunkIndex lists the chunks that a stored file was assembled from, in order.
+type ChunkIndex struct {
+	Checksum string
+	Size     int64
+	Chunks   []Chunk
+}
+
+// StoreChunkIndex stores the list of chunks that the stored file was assembled
+// from, so that the chunks can be found in the file after a restart.
+//
+// The index is a text file with a "{checksum} {size}" line per chunk.
+func (s *Store) StoreChunkIndex(checksum string, filesize int64, chunks []Chunk) error {
+	indexPath := s.chunkIndex.pathFor(s.partialFilePath(checksum, filesize))
+	if err := os.MkdirAll(filepath.Dir(indexPath), 0777); err != nil {
+		return fmt.Errorf("creating chunk index directory: %w", err)
+	}
+
+	contents := strings.Builder{}
+	for _, chunk := range chunks {
+		fmt.Fprintf(&contents, "%s %d\n", chunk.Checksum, chunk.Size)
+	}
+
+	// Write to a temporary file first, so that an interrupted write cannot
+	// leave a truncated index behind.
+	tempFile, err := os.CreateTemp(filepath.Dir(indexPath), filepath.Base(indexPath)+"-*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating chunk index: %w", err)
+	}
+	_, writeErr := tempFile.WriteString(contents.String())
+	closeErr := tempFile.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		os.Remove(tempFile.Name())
+		return fmt.Errorf("writing chunk index %s: %w", tempFile.Name(), err)
+	}
+	if err := os.Rename(tempFile.Name(), indexPath); err != nil {
+		os.Remove(tempFile.Name())
+		return fmt.Errorf("moving chunk index into place: %w", err)
+	}
+	return nil
+}
+
+// ChunkIndexes returns all chunk indexes. Files in the chunk index directory
+// that cannot be parsed are logged and skipped.
+func (s *Store) ChunkIndexes() ([]ChunkIndex, error) {
+	indexes := []ChunkIndex{}
+	visit := func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(path, s.chunkIndex.fileSuffix) {
+			return nil
+		}
+
+		logger := log.With().Str("path", path).Logger()
+		checksum, filesize, ok := s.chunkIndex.parsePath(path)
+		if !ok {
+			logger.Warn().Msg("shaman: ignoring unexpected file in chunk index directory")
+			return nil
+		}
+		chunks, err := readChunkIndex(path)
+		if err != nil {
+			logger.Warn().Err(err).Msg("shaman: ignoring unreadable chunk index")
+			return nil
+		}
+		indexes = append(indexes, ChunkIndex{Checksum: checksum, Size: filesize, Chunks: chunks})
+		return nil
+	}
+
+	if err := filepath.WalkDir(s.chunkIndex.storagePrefix(""), visit); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return indexes, nil
+		}
+		return nil, fmt.Errorf("reading chunk indexes: %w", err)
+	}
+	return indexes, nil
+}
+
+// RemoveChunkIndex removes the chunk index of the file, if there is one.
+func (s *Store) RemoveChunkIndex(checksum string, filesize int64) error {
+	indexPath := s.chunkIndex.pathFor(s.partialFilePath(checksum, filesize))
+	err := s.removeFile(indexPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func readChunkIndex(path string) ([]Chunk, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := []Chunk{}
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		var chunk Chunk
+		if _, err := fmt.Sscanf(line, "%s %d", &chunk.Checksum, &chunk.Size); err != nil {
+			return nil, fmt.Errorf("parsing line %q: %w", line, err)
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks, nil
+}
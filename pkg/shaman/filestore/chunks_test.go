@@ -0,0 +1,146 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"projects.blender.org/studio/flamenco/pkg/shaman/hasher"
+)
+
+// storeTestChunk stores the data as a file, and returns it as a Chunk.
+func storeTestChunk(t *testing.T, store *Store, data []byte) Chunk {
+	chunk := Chunk{Checksum: hasher.Checksum(data), Size: int64(len(data))}
+
+	file, err := store.OpenForUpload(chunk.Checksum, chunk.Size)
+	require.NoError(t, err)
+	_, err = file.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.NoError(t, store.MoveToStored(chunk.Checksum, chunk.Size, file.Name()))
+	return chunk
+}
+
+// resolveTestChunks returns the locations of the stored chunks.
+func resolveTestChunks(t *testing.T, store *Store, chunks ...Chunk) []ChunkData {
+	chunkData := make([]ChunkData, len(chunks))
+	for idx, chunk := range chunks {
+		var err error
+		chunkData[idx], err = store.ResolveChunk(chunk)
+		require.NoError(t, err)
+	}
+	return chunkData
+}
+
+func TestAssembleFromChunks(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	chunks := []Chunk{
+		storeTestChunk(t, store, []byte("je moešje ")),
+		storeTestChunk(t, store, []byte("is een ")),
+		storeTestChunk(t, store, []byte("chunk")),
+	}
+	contents := []byte("je moešje is een chunk")
+	checksum := hasher.Checksum(contents)
+	fileSize := int64(len(contents))
+
+	require.NoError(t, store.AssembleFromChunks(checksum, fileSize, resolveTestChunks(t, store, chunks...)))
+
+	foundPath, status := store.ResolveFile(checksum, fileSize, ResolveStoredOnly)
+	require.Equal(t, StatusStored, status)
+	assembled, err := os.ReadFile(foundPath)
+	require.NoError(t, err)
+	assert.Equal(t, contents, assembled)
+
+	// Removing the chunks is up to the caller.
+	for _, chunk := range chunks {
+		_, status := store.ResolveFile(chunk.Checksum, chunk.Size, ResolveStoredOnly)
+		assert.Equal(t, StatusStored, status)
+	}
+
+	// Parts of the assembled file can be used as chunks too.
+	chunkData := resolveTestChunks(t, store, storeTestChunk(t, store, []byte("je moeder ")))
+	chunkData = append(chunkData, ChunkData{Path: foundPath, Offset: 11, Size: 12})
+	contents = []byte("je moeder is een chunk")
+	checksum = hasher.Checksum(contents)
+	fileSize = int64(len(contents))
+	require.NoError(t, store.AssembleFromChunks(checksum, fileSize, chunkData))
+
+	foundPath, status = store.ResolveFile(checksum, fileSize, ResolveStoredOnly)
+	require.Equal(t, StatusStored, status)
+	assembled, err = os.ReadFile(foundPath)
+	require.NoError(t, err)
+	assert.Equal(t, contents, assembled)
+}
+
+func TestAssembleFromChunksErrors(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	chunk1 := storeTestChunk(t, store, []byte("je moešje "))
+	chunk2 := Chunk{Checksum: hasher.Checksum([]byte("is weg")), Size: 6}
+	contents := []byte("je moešje is weg")
+	checksum := hasher.Checksum(contents)
+	fileSize := int64(len(contents))
+
+	_, err := store.ResolveChunk(chunk2)
+	assert.ErrorIs(t, err, ErrMissingChunk(chunk2))
+
+	err = store.AssembleFromChunks(checksum, fileSize, resolveTestChunks(t, store, chunk1))
+	assert.ErrorIs(t, err, ErrChunksMismatch{
+		DeclaredChecksum: checksum,
+		DeclaredSize:     fileSize,
+		ActualSize:       chunk1.Size,
+	})
+
+	// Chunks in the wrong order produce a different checksum.
+	chunk2 = storeTestChunk(t, store, []byte("is weg"))
+	err = store.AssembleFromChunks(checksum, fileSize, resolveTestChunks(t, store, chunk2, chunk1))
+	assert.ErrorAs(t, err, &ErrChunksMismatch{})
+
+	// Chunks cannot extend beyond the end of their file.
+	chunkData := resolveTestChunks(t, store, chunk1, chunk2)
+	chunkData[1].Offset = 2
+	chunkData[1].Size = 6
+	err = store.AssembleFromChunks(checksum, fileSize, chunkData)
+	assert.ErrorContains(t, err, "got 4 bytes, expected 6")
+
+	_, status := store.ResolveFile(checksum, fileSize, ResolveEverything)
+	assert.Equal(t, StatusDoesNotExist, status, "failed assembly should not leave files behind")
+}
+
+func TestChunkIndex(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	indexes, err := store.ChunkIndexes()
+	require.NoError(t, err)
+	assert.Empty(t, indexes)
+
+	contents := []byte("je moešje is een chunk")
+	checksum := hasher.Checksum(contents)
+	chunks := []Chunk{
+		{Checksum: hasher.Checksum([]byte("je moešje ")), Size: 11},
+		{Checksum: hasher.Checksum([]byte("is een chunk")), Size: 12},
+	}
+	require.NoError(t, store.StoreChunkIndex(checksum, 23, chunks))
+
+	// Files that are not chunk indexes should be ignored.
+	junkPath := store.chunkIndex.storagePrefix("junk.txt")
+	require.NoError(t, os.WriteFile(junkPath, []byte("not an index"), 0o666))
+
+	indexes, err = store.ChunkIndexes()
+	require.NoError(t, err)
+	assert.Equal(t, []ChunkIndex{{Checksum: checksum, Size: 23, Chunks: chunks}}, indexes)
+
+	require.NoError(t, store.RemoveChunkIndex(checksum, 23))
+	require.NoError(t, store.RemoveChunkIndex(checksum, 23), "removing a removed index should be fine")
+	indexes, err = store.ChunkIndexes()
+	require.NoError(t, err)
+	assert.Empty(t, indexes)
+}
@@ -0,0 +1,55 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"fmt"
+
+	"projects.blender.org/studio/flamenco/pkg/sysinfo"
+)
+
+// freeDiskSpace is a variable so that unit tests can mock it.
+var freeDiskSpace = sysinfo.FreeDiskSpace
+
+// ErrInsufficientDiskSpace is returned when storing a file would leave less
+// free disk space than the configured minimum.
+type ErrInsufficientDiskSpace struct {
+	FreeBytes    uint64
+	FileSize     int64
+	MinFreeBytes uint64
+	StoragePath  string
+}
+
+func (e ErrInsufficientDiskSpace) Error() string {
+	return fmt.Sprintf("not enough free disk space on %s to store %d bytes: %d bytes free, minimum is %d bytes",
+		e.StoragePath, e.FileSize, e.FreeBytes, e.MinFreeBytes)
+}
+
+// FreeSpace returns the free disk space on the file store, in bytes.
+func (s *Store) FreeSpace() (uint64, error) {
+	return freeDiskSpace(s.baseDir)
+}
+
+// CheckFreeSpace returns an ErrInsufficientDiskSpace error when storing a file
+// of the given size would leave less than the configured minimum of free disk
+// space.
+func (s *Store) CheckFreeSpace(filesize int64) error {
+	if s.minFreeBytes == 0 {
+		return nil
+	}
+
+	free, err := s.FreeSpace()
+	if err != nil {
+		return err
+	}
+
+	if filesize < 0 || free < uint64(filesize) || free-uint64(filesize) < s.minFreeBytes {
+		return ErrInsufficientDiskSpace{
+			FreeBytes:    free,
+			FileSize:     filesize,
+			MinFreeBytes: s.minFreeBytes,
+			StoragePath:  s.baseDir,
+		}
+	}
+	return nil
+}
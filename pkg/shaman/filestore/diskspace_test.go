@@ -0,0 +1,42 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckFreeSpace(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	origFreeDiskSpace := freeDiskSpace
+	defer func() { freeDiskSpace = origFreeDiskSpace }()
+	freeDiskSpace = func(path string) (uint64, error) {
+		assert.Equal(t, store.baseDir, path)
+		return 10_000, nil
+	}
+
+	// Without minimum, anything goes.
+	assert.NoError(t, store.CheckFreeSpace(50_000))
+
+	store.minFreeBytes = 1_000
+	assert.NoError(t, store.CheckFreeSpace(9_000))
+	assert.ErrorIs(t, store.CheckFreeSpace(9_001), ErrInsufficientDiskSpace{
+		FreeBytes:    10_000,
+		FileSize:     9_001,
+		MinFreeBytes: 1_000,
+		StoragePath:  store.baseDir,
+	})
+	assert.ErrorAs(t, store.CheckFreeSpace(50_000), &ErrInsufficientDiskSpace{})
+
+	// Errors determining the free space should be passed on.
+	errMocked := errors.New("mocked error")
+	freeDiskSpace = func(path string) (uint64, error) {
+		return 0, errMocked
+	}
+	assert.ErrorIs(t, store.CheckFreeSpace(1), errMocked)
+}
@@ -39,6 +39,14 @@ type Store struct {
 
 	uploading storageBin
 	stored    storageBin
+	partial   storageBin
+	// Stored files that turned out to be corrupt are moved here.
+	quarantine storageBin
+	// Lists of the chunks that stored files were assembled from.
+	chunkIndex storageBin
+
+	// Uploads are refused when they would leave less free disk space than this.
+	minFreeBytes uint64
 }
 
 // New returns a new file store.
@@ -49,6 +57,10 @@ func New(conf config.Config) *Store {
 		storageDir,
 		storageBin{storageDir, "uploading", true, ".tmp"},
 		storageBin{storageDir, "stored", false, ".blob"},
+		storageBin{storageDir, "partial", false, ".partial"},
+		storageBin{storageDir, "quarantine", false, ".blob"},
+		storageBin{storageDir, "chunk-index", false, ".chunks"},
+		conf.DiskSpace.MinFreeBytes(),
 	}
 	store.createDirectoryStructure()
 	return store
@@ -72,6 +84,9 @@ func (s *Store) createDirectoryStructure() {
 
 	mkdir(s.uploading.dirName)
 	mkdir(s.stored.dirName)
+	mkdir(s.partial.dirName)
+	mkdir(s.quarantine.dirName)
+	mkdir(s.chunkIndex.dirName)
 }
 
 // StoragePath returns the directory path of the 'stored' storage bin.
@@ -126,12 +141,12 @@ func (s *Store) OpenForUpload(checksum string, filesize int64) (*os.File, error)
 	return s.uploading.openForWriting(partial)
 }
 
-// MoveToStored moves a file from 'uploading' to 'stored' storage.
+// MoveToStored moves a file from 'uploading' or 'partial' to 'stored' storage.
 // It is assumed that the checksum and filesize have been verified.
 func (s *Store) MoveToStored(checksum string, filesize int64, uploadedFilePath string) error {
-	// Check that the uploaded file path is actually in the 'uploading' storage.
+	// Check that the uploaded file path is actually in the 'uploading' or 'partial' storage.
 	partial := s.partialFilePath(checksum, filesize)
-	if !s.uploading.contains(partial, uploadedFilePath) {
+	if !s.uploading.contains(partial, uploadedFilePath) && !s.partial.contains(partial, uploadedFilePath) {
 		return ErrNotInUploading
 	}
 
@@ -149,7 +164,8 @@ func (s *Store) MoveToStored(checksum string, filesize int64, uploadedFilePath s
 		return err
 	}
 
-	s.RemoveUploadedFile(uploadedFilePath)
+	// Clean up the directory structure. The file itself is already gone.
+	_ = s.removeFile(uploadedFilePath)
 	return nil
 }
 
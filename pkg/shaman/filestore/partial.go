@@ -0,0 +1,85 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Partial uploads are kept in the 'partial' storage bin, at a path determined
+// by the file's checksum and size. This way an interrupted upload can be
+// resumed later, instead of having to start again from zero.
+
+// OpenPartialUpload opens the partial upload file for the given checksum and
+// filesize, creating it when it does not exist yet. The caller is responsible
+// for ensuring that only one upload writes to the file at a time.
+func (s *Store) OpenPartialUpload(checksum string, filesize int64) (*os.File, error) {
+	path := s.partial.pathFor(s.partialFilePath(checksum, filesize))
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return nil, err
+	}
+	// This creates the file with 0666 permissions (before umask).
+	return os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+}
+
+// PartialUploadSize returns the number of bytes received by an unfinished
+// upload of the given file, or 0 when there is no such upload.
+func (s *Store) PartialUploadSize(checksum string, filesize int64) int64 {
+	path := s.partial.pathFor(s.partialFilePath(checksum, filesize))
+	stat, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return stat.Size()
+}
+
+// RemovePartialUpload removes the partial upload file for the given checksum
+// and filesize. Errors are ignored.
+func (s *Store) RemovePartialUpload(checksum string, filesize int64) {
+	path := s.partial.pathFor(s.partialFilePath(checksum, filesize))
+	_ = s.removeFile(path)
+}
+
+// UnfinishedUploads returns the paths of files in the 'uploading' and
+// 'partial' storage bins that have not been modified since the given time.
+func (s *Store) UnfinishedUploads(olderThan time.Time) ([]string, error) {
+	paths := []string{}
+	visit := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// The file may have been finished & moved while walking.
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !info.ModTime().Before(olderThan) {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	}
+
+	for _, bin := range []storageBin{s.uploading, s.partial} {
+		if err := filepath.Walk(bin.storagePrefix(""), visit); err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+// RemoveUnfinishedUpload removes a file from the 'uploading' or 'partial'
+// storage bin.
+func (s *Store) RemoveUnfinishedUpload(filePath string) error {
+	if !s.uploading.contains("", filePath) && !s.partial.contains("", filePath) {
+		log.Error().Str("file", filePath).
+			Msg("shaman: RemoveUnfinishedUpload called with file not in 'uploading' or 'partial' storage bin")
+		return os.ErrNotExist
+	}
+	return s.removeFile(filePath)
+}
@@ -0,0 +1,98 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartialUpload(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	assert.DirExists(t, filepath.Join(store.baseDir, "partial"))
+	assert.Zero(t, store.PartialUploadSize("abcdefxxx", 123))
+
+	file, err := store.OpenPartialUpload("abcdefxxx", 123)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(store.baseDir, "partial", "ab", "cdefxxx", "123.partial"), file.Name())
+	_, err = file.Write([]byte("je moešje"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// A partial upload is not yet known to the rest of the store.
+	_, status := store.ResolveFile("abcdefxxx", 123, ResolveEverything)
+	assert.Equal(t, StatusDoesNotExist, status)
+	assert.EqualValues(t, len("je moešje"), store.PartialUploadSize("abcdefxxx", 123))
+
+	// Reopening should keep the contents.
+	file, err = store.OpenPartialUpload("abcdefxxx", 123)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	assert.EqualValues(t, len("je moešje"), store.PartialUploadSize("abcdefxxx", 123))
+
+	store.RemovePartialUpload("abcdefxxx", 123)
+	assert.NoFileExists(t, file.Name())
+	assert.NoDirExists(t, filepath.Dir(file.Name()))
+	assert.Zero(t, store.PartialUploadSize("abcdefxxx", 123))
+}
+
+func TestMoveToStoredFromPartial(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	contents := []byte("je moešje")
+	fileSize := int64(len(contents))
+
+	file, err := store.OpenPartialUpload("abcdefxxx", fileSize)
+	require.NoError(t, err)
+	_, err = file.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	require.NoError(t, store.MoveToStored("abcdefxxx", fileSize, file.Name()))
+
+	foundPath, status := store.ResolveFile("abcdefxxx", fileSize, ResolveEverything)
+	assert.Equal(t, StatusStored, status)
+	assert.FileExists(t, foundPath)
+	assert.NoFileExists(t, file.Name())
+	assert.NoDirExists(t, filepath.Dir(file.Name()))
+}
+
+func TestUnfinishedUploads(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	oldTime := time.Now().Add(-2 * time.Hour)
+	threshold := time.Now().Add(-1 * time.Hour)
+
+	oldPartial := filepath.Join(store.baseDir, "partial", "ab", "cdefxxx", "123.partial")
+	newPartial := filepath.Join(store.baseDir, "partial", "ab", "cdefyyy", "123.partial")
+	oldUploading := filepath.Join(store.baseDir, "uploading", "ab", "cdefzzz", "123-unique-code.tmp")
+	oldStored := filepath.Join(store.baseDir, "stored", "ab", "cdefxxx", "123.blob")
+	for _, path := range []string{oldPartial, newPartial, oldUploading, oldStored} {
+		mustCreateFile(path)
+	}
+	for _, path := range []string{oldPartial, oldUploading, oldStored} {
+		require.NoError(t, os.Chtimes(path, oldTime, oldTime))
+	}
+
+	paths, err := store.UnfinishedUploads(threshold)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{oldUploading, oldPartial}, paths)
+
+	assert.Error(t, store.RemoveUnfinishedUpload(oldStored))
+	assert.FileExists(t, oldStored)
+
+	for _, path := range paths {
+		assert.NoError(t, store.RemoveUnfinishedUpload(path))
+		assert.NoFileExists(t, path)
+	}
+	assert.FileExists(t, newPartial)
+}
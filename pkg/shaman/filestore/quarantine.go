@@ -0,0 +1,56 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ErrNotInStored is returned when a path is not of a file in the 'stored'
+// storage bin.
+type ErrNotInStored struct {
+	Path string
+}
+
+func (e ErrNotInStored) Error() string {
+	return fmt.Sprintf("%s is not a file in the 'stored' storage bin", e.Path)
+}
+
+// QuarantinePath returns the directory path of the 'quarantine' storage bin.
+func (s *Store) QuarantinePath() string {
+	return s.quarantine.storagePrefix("")
+}
+
+// ParseStoredPath returns the checksum and file size of a file in the 'stored'
+// storage bin, as determined by its path.
+func (s *Store) ParseStoredPath(storedPath string) (checksum string, filesize int64, err error) {
+	checksum, filesize, ok := s.stored.parsePath(storedPath)
+	if !ok {
+		return "", 0, ErrNotInStored{storedPath}
+	}
+	return checksum, filesize, nil
+}
+
+// Quarantine moves a file from the 'stored' to the 'quarantine' storage bin.
+// This makes the file unknown to the file store, so that it will be uploaded
+// again when needed. Returns the path of the quarantined file.
+func (s *Store) Quarantine(storedPath string) (string, error) {
+	checksum, filesize, err := s.ParseStoredPath(storedPath)
+	if err != nil {
+		return "", err
+	}
+
+	targetPath := s.quarantine.pathFor(s.partialFilePath(checksum, filesize))
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0777); err != nil {
+		return "", err
+	}
+	if err := os.Rename(storedPath, targetPath); err != nil {
+		return "", err
+	}
+
+	// Clean up the directory structure. The file itself is already gone.
+	_ = s.removeFile(storedPath)
+	return targetPath, nil
+}
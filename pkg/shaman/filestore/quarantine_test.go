@@ -0,0 +1,59 @@
+package filestore
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStoredPath(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	storedPath := filepath.Join(store.StoragePath(), "ab", "cdefxxx", "123.blob")
+	checksum, filesize, err := store.ParseStoredPath(storedPath)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefxxx", checksum)
+	assert.EqualValues(t, 123, filesize)
+
+	for _, invalidPath := range []string{
+		filepath.Join(store.BasePath(), "uploading", "ab", "cdefxxx", "123-4567.tmp"),
+		filepath.Join(store.StoragePath(), "ab", "cdefxxx", "123.tmp"),
+		filepath.Join(store.StoragePath(), "ab", "cdefxxx", "size.blob"),
+		filepath.Join(store.StoragePath(), "abc", "defxxx", "123.blob"),
+		filepath.Join(store.StoragePath(), "ab", "123.blob"),
+	} {
+		_, _, err := store.ParseStoredPath(invalidPath)
+		assert.ErrorIs(t, err, ErrNotInStored{invalidPath}, "path %s", invalidPath)
+	}
+}
+
+func TestQuarantine(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	assert.DirExists(t, store.QuarantinePath())
+
+	store.MustStoreFileForTest("abcdefxxx", 9, []byte("corrupted"))
+	storedPath, status := store.ResolveFile("abcdefxxx", 9, ResolveStoredOnly)
+	require.Equal(t, StatusStored, status)
+
+	quarantinePath, err := store.Quarantine(storedPath)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(store.QuarantinePath(), "ab", "cdefxxx", "9.blob"), quarantinePath)
+	assert.FileExists(t, quarantinePath)
+	assert.NoFileExists(t, storedPath)
+	assert.NoDirExists(t, filepath.Dir(storedPath))
+
+	// The file should have to be uploaded again.
+	_, status = store.ResolveFile("abcdefxxx", 9, ResolveEverything)
+	assert.Equal(t, StatusDoesNotExist, status)
+
+	// Only stored files can be quarantined.
+	_, err = store.Quarantine(quarantinePath)
+	assert.ErrorIs(t, err, ErrNotInStored{quarantinePath})
+}
@@ -26,6 +26,8 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 type storageBin struct {
@@ -87,6 +89,27 @@ func (s *storageBin) pathFor(partialPath string) string {
 	return s.storagePrefix(partialPath) + s.fileSuffix
 }
 
+// parsePath returns the checksum and file size of a file in this storage bin,
+// as determined by its path. Only valid for bins without temp suffixes.
+func (s *storageBin) parsePath(path string) (checksum string, filesize int64, ok bool) {
+	relPath, err := filepath.Rel(s.storagePrefix(""), path)
+	if err != nil {
+		return "", 0, false
+	}
+
+	// The relative path should be {checksum[0:2]}/{checksum[2:]}/{filesize}{suffix}
+	parts := strings.Split(filepath.ToSlash(relPath), "/")
+	if len(parts) != 3 || len(parts[0]) != 2 || !strings.HasSuffix(parts[2], s.fileSuffix) {
+		return "", 0, false
+	}
+
+	filesize, err = strconv.ParseInt(strings.TrimSuffix(parts[2], s.fileSuffix), 10, 64)
+	if err != nil || filesize < 0 {
+		return "", 0, false
+	}
+	return parts[0] + parts[1], filesize, true
+}
+
 // openForWriting makes sure there is a place to write to.
 func (s *storageBin) openForWriting(partialPath string) (*os.File, error) {
 	if !s.hasTempSuffix {
@@ -0,0 +1,24 @@
+//go:build !windows
+
+package shaman
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// numHardLinks returns the number of hard links to the file.
+func numHardLinks(path string) (uint64, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	sysStat, ok := stat.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, fmt.Errorf("unable to get file information of %s", path)
+	}
+	return uint64(sysStat.Nlink), nil
+}
@@ -0,0 +1,23 @@
+package shaman
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os"
+	"syscall"
+)
+
+// numHardLinks returns the number of hard links to the file.
+func numHardLinks(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	var info syscall.ByHandleFileInformation
+	if err := syscall.GetFileInformationByHandle(syscall.Handle(file.Fd()), &info); err != nil {
+		return 0, err
+	}
+	return uint64(info.NumberOfLinks), nil
+}
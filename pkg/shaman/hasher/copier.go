@@ -25,12 +25,19 @@ package hasher
 import (
 	"crypto/sha256"
 	"fmt"
+	"hash"
 	"io"
 )
 
 // Copy copies from src to dst and computes a checksum on the copied bytes.
 func Copy(dst io.Writer, src io.Reader) (written int64, checksum string, err error) {
-	hasher := sha256.New()
+	return CopyWithHasher(dst, src, sha256.New())
+}
+
+// CopyWithHasher copies from src to dst, and computes a checksum with the given
+// hasher. This makes it possible to include previously hashed data in the
+// checksum, for example when resuming an upload.
+func CopyWithHasher(dst io.Writer, src io.Reader, hasher hash.Hash) (written int64, checksum string, err error) {
 	var buf []byte
 
 	// copied from io.copyBuffer
@@ -0,0 +1,380 @@
+package shaman
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"projects.blender.org/studio/flamenco/pkg/shaman/hasher"
+)
+
+var ErrScrubRunning = errors.New("an integrity check of the file store is already running")
+
+// ScrubReport contains the results of an integrity check of the file store.
+type ScrubReport struct {
+	StartedAt       time.Time
+	FinishedAt      time.Time
+	NumFilesChecked int
+	BytesChecked    int64
+	CorruptFiles    []CorruptFile
+}
+
+// CorruptFile describes a stored file whose contents do not match the checksum
+// and size in its path.
+type CorruptFile struct {
+	// Path is where the file was found in the file store.
+	Path string
+	// QuarantinePath is where the file was moved to. It is empty when the file
+	// could not be moved.
+	QuarantinePath string
+
+	Checksum       string
+	Size           int64
+	ActualChecksum string
+	ActualSize     int64
+
+	// ReferencedBy contains the paths in checkouts that link to the corrupt
+	// file. Copies of the file cannot be found this way.
+	ReferencedBy []string
+}
+
+// scrubState keeps track of the running and last-finished integrity check.
+type scrubState struct {
+	mutex      sync.Mutex
+	isRunning  bool
+	lastReport *ScrubReport
+}
+
+// Scrub checks all files in the file store against the checksum and size in
+// their path. Corrupt files are moved to quarantine, so that they are uploaded
+// again when they are needed. Returns ErrScrubRunning when another check is
+// already running.
+func (s *Server) Scrub(ctx context.Context) (ScrubReport, error) {
+	if !s.scrubStart() {
+		return ScrubReport{}, ErrScrubRunning
+	}
+	report, err := s.scrubFileStore(ctx)
+	s.scrubFinish(report, err)
+	return report, err
+}
+
+// StartScrub starts an integrity check of the file store in the background.
+// Returns ErrScrubRunning when another check is already running.
+func (s *Server) StartScrub() error {
+	if !s.scrubStart() {
+		return ErrScrubRunning
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ctx, cancel := s.scrubContext()
+		defer cancel()
+
+		report, err := s.scrubFileStore(ctx)
+		s.scrubFinish(report, err)
+	}()
+	return nil
+}
+
+// ScrubStatus returns whether an integrity check is running, and the report of
+// the last finished check. The report is nil when no check has finished yet.
+func (s *Server) ScrubStatus() (isRunning bool, lastReport *ScrubReport) {
+	s.scrub.mutex.Lock()
+	defer s.scrub.mutex.Unlock()
+	return s.scrub.isRunning, s.scrub.lastReport
+}
+
+func (s *Server) scrubStart() bool {
+	s.scrub.mutex.Lock()
+	defer s.scrub.mutex.Unlock()
+	if s.scrub.isRunning {
+		return false
+	}
+	s.scrub.isRunning = true
+	return true
+}
+
+func (s *Server) scrubFinish(report ScrubReport, err error) {
+	s.scrub.mutex.Lock()
+	defer s.scrub.mutex.Unlock()
+	s.scrub.isRunning = false
+	if err == nil {
+		s.scrub.lastReport = &report
+	}
+}
+
+// scrubContext returns a context that is cancelled when the server shuts down.
+func (s *Server) scrubContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-s.shutdownChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+func (s *Server) periodicScrub() {
+	defer log.Debug().Msg("shaman: shutting down periodic integrity check")
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-s.shutdownChan:
+			return
+		case <-time.After(s.config.Scrub.Period):
+		}
+
+		ctx, cancel := s.scrubContext()
+		_, err := s.Scrub(ctx)
+		cancel()
+
+		switch {
+		case errors.Is(err, ErrScrubRunning):
+			log.Info().Msg("shaman: skipping periodic integrity check, another one is still running")
+		case errors.Is(err, context.Canceled):
+			// Shutting down, which is handled at the top of the loop.
+		case err != nil:
+			log.Error().Err(err).Msg("shaman: integrity check of the file store failed")
+		}
+	}
+}
+
+func (s *Server) scrubFileStore(ctx context.Context) (ScrubReport, error) {
+	report := ScrubReport{StartedAt: time.Now()}
+
+	logger := log.With().
+		Str("fileStorePath", s.fileStore.StoragePath()).
+		Int64("rateLimitBytesPerSecond", s.config.Scrub.RateLimitBytesPerSecond()).
+		Logger()
+	logger.Info().Msg("shaman: checking integrity of the file store")
+
+	// The rate limit is shared by all files, so that many small files are not
+	// read faster than a few large ones.
+	limiter := newRateLimitedWriter(ctx, s.config.Scrub.RateLimitBytesPerSecond())
+
+	visit := func(path string, entry fs.DirEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Garbage collection may have removed it while walking.
+				return nil
+			}
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		pathLogger := logger.With().Str("path", path).Logger()
+		checksum, size, err := s.fileStore.ParseStoredPath(path)
+		if err != nil {
+			pathLogger.Warn().Err(err).Msg("shaman: unexpected file in file store, skipping integrity check")
+			return nil
+		}
+
+		actualSize, actualChecksum, err := scrubFile(path, limiter)
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.Is(err, fs.ErrNotExist):
+			return nil
+		case err != nil:
+			pathLogger.Warn().Err(err).Msg("shaman: unable to check integrity of stored file")
+			return nil
+		}
+
+		report.NumFilesChecked++
+		report.BytesChecked += actualSize
+		if actualChecksum == checksum && actualSize == size {
+			return nil
+		}
+
+		report.CorruptFiles = append(report.CorruptFiles, CorruptFile{
+			Path:           path,
+			Checksum:       checksum,
+			Size:           size,
+			ActualChecksum: actualChecksum,
+			ActualSize:     actualSize,
+		})
+		return nil
+	}
+	err := filepath.WalkDir(s.fileStore.StoragePath(), visit)
+	switch {
+	case errors.Is(err, context.Canceled):
+		logger.Info().Int("numFilesChecked", report.NumFilesChecked).Msg("shaman: integrity check aborted")
+		return report, err
+	case err != nil:
+		logger.Error().Err(err).Msg("shaman: unable to walk file store path to check its integrity")
+		return report, err
+	}
+
+	if len(report.CorruptFiles) > 0 {
+		s.scrubFindReferences(report.CorruptFiles, logger)
+		s.scrubQuarantine(report.CorruptFiles, logger)
+	}
+
+	report.FinishedAt = time.Now()
+	infoLogger := logger.With().
+		Int("numFilesChecked", report.NumFilesChecked).
+		Str("checkedSize", humanizeByteSize(report.BytesChecked)).
+		Int("numCorruptFiles", len(report.CorruptFiles)).
+		Stringer("duration", report.FinishedAt.Sub(report.StartedAt)).
+		Logger()
+	if len(report.CorruptFiles) > 0 {
+		infoLogger.Error().Msg("shaman: integrity check found corrupt files in the file store")
+	} else {
+		infoLogger.Info().Msg("shaman: integrity check found no corrupt files")
+	}
+	return report, nil
+}
+
+// scrubFile returns the size and checksum of the file contents.
+func scrubFile(path string, limiter *rateLimitedWriter) (int64, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+
+	return hasher.Copy(limiter, file)
+}
+
+// scrubFindReferences searches the checkouts for symlinks and hard links to the
+// corrupt files.
+func (s *Server) scrubFindReferences(corruptFiles []CorruptFile, logger zerolog.Logger) {
+	byPath := map[string]*CorruptFile{}
+	hardlinked := map[*CorruptFile]os.FileInfo{}
+	for idx := range corruptFiles {
+		corrupt := &corruptFiles[idx]
+		byPath[corrupt.Path] = corrupt
+
+		numLinks, err := numHardLinks(corrupt.Path)
+		if err != nil || numLinks < 2 {
+			continue
+		}
+		if info, err := os.Stat(corrupt.Path); err == nil {
+			hardlinked[corrupt] = info
+		}
+	}
+
+	visit := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		switch {
+		case info.Mode()&os.ModeSymlink != 0:
+			linkTarget, err := filepath.EvalSymlinks(path)
+			if err != nil {
+				return nil
+			}
+			if corrupt, found := byPath[linkTarget]; found {
+				corrupt.ReferencedBy = append(corrupt.ReferencedBy, path)
+			}
+		case info.Mode().IsRegular():
+			for corrupt, corruptInfo := range hardlinked {
+				if os.SameFile(info, corruptInfo) {
+					corrupt.ReferencedBy = append(corrupt.ReferencedBy, path)
+				}
+			}
+		}
+		return nil
+	}
+
+	dirsToCheck := []string{s.config.CheckoutPath()}
+	dirsToCheck = append(dirsToCheck, s.config.GarbageCollect.ExtraCheckoutDirs...)
+	for _, checkDir := range dirsToCheck {
+		if err := filepath.Walk(checkDir, visit); err != nil {
+			logger.Warn().
+				Str("checkoutPath", checkDir).
+				Err(err).
+				Msg("shaman: unable to walk checkout path to find links to corrupt files")
+		}
+	}
+}
+
+// scrubQuarantine moves the corrupt files out of the file store.
+func (s *Server) scrubQuarantine(corruptFiles []CorruptFile, logger zerolog.Logger) {
+	for idx := range corruptFiles {
+		corrupt := &corruptFiles[idx]
+		pathLogger := logger.With().
+			Str("path", corrupt.Path).
+			Str("checksum", corrupt.Checksum).
+			Int64("size", corrupt.Size).
+			Str("actualChecksum", corrupt.ActualChecksum).
+			Int64("actualSize", corrupt.ActualSize).
+			Strs("referencedBy", corrupt.ReferencedBy).
+			Logger()
+
+		quarantinePath, err := s.fileStore.Quarantine(corrupt.Path)
+		if err != nil {
+			pathLogger.Error().Err(err).Msg("shaman: corrupt file found in file store, unable to move it to quarantine")
+			continue
+		}
+		corrupt.QuarantinePath = quarantinePath
+		pathLogger.Error().
+			Str("quarantinePath", quarantinePath).
+			Msg("shaman: corrupt file found in file store, moved it to quarantine")
+	}
+}
+
+// rateLimitedWriter discards everything written to it, at no more than the
+// given number of bytes per second.
+type rateLimitedWriter struct {
+	ctx            context.Context
+	bytesPerSecond int64
+
+	startedAt time.Time
+	written   int64
+}
+
+func newRateLimitedWriter(ctx context.Context, bytesPerSecond int64) *rateLimitedWriter {
+	return &rateLimitedWriter{
+		ctx:            ctx,
+		bytesPerSecond: bytesPerSecond,
+		startedAt:      time.Now(),
+	}
+}
+
+func (w *rateLimitedWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	w.written += int64(len(p))
+	if w.bytesPerSecond <= 0 {
+		return len(p), nil
+	}
+
+	expectedDuration := time.Duration(float64(w.written) / float64(w.bytesPerSecond) * float64(time.Second))
+	wait := expectedDuration - time.Since(w.startedAt)
+	if wait <= 0 {
+		return len(p), nil
+	}
+
+	select {
+	case <-w.ctx.Done():
+		return 0, w.ctx.Err()
+	case <-time.After(wait):
+		return len(p), nil
+	}
+}
@@ -0,0 +1,140 @@
+package shaman
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
+	"projects.blender.org/studio/flamenco/pkg/shaman/testsupport"
+)
+
+func TestScrub(t *testing.T) {
+	testsupport.SkipTestIfUnableToSymlink(t)
+
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	filestore.LinkTestFileStore(server.config.FileStorePath())
+
+	// Corrupt one of the stored files, and symlink it into a checkout.
+	corruptPath := filepath.Join(server.fileStore.StoragePath(),
+		"59", "0c148428d5c35fab3ebad2f3365bb469ab9c531b60831f3e826c472027a0b9", "3367.blob")
+	require.NoError(t, os.WriteFile(corruptPath, []byte("bit rot"), 0666))
+
+	checkoutInfo, err := server.checkoutMan.PrepareCheckout("checkoutID")
+	require.NoError(t, err)
+	linkPath := filepath.Join(server.config.CheckoutPath(), checkoutInfo.RelativePath, "use-of-3367.blob")
+	require.NoError(t, os.Symlink(corruptPath, linkPath))
+
+	isRunning, lastReport := server.ScrubStatus()
+	assert.False(t, isRunning)
+	assert.Nil(t, lastReport)
+
+	report, err := server.Scrub(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, report.NumFilesChecked)
+	assert.EqualValues(t, 7217+6664+7488+781+6001+7459+len("bit rot")+486, report.BytesChecked)
+	require.Len(t, report.CorruptFiles, 1)
+
+	corrupt := report.CorruptFiles[0]
+	assert.Equal(t, corruptPath, corrupt.Path)
+	assert.Equal(t, "590c148428d5c35fab3ebad2f3365bb469ab9c531b60831f3e826c472027a0b9", corrupt.Checksum)
+	assert.EqualValues(t, 3367, corrupt.Size)
+	assert.Equal(t, "28d39a93160599ba951ef3430709d416c9ff1e7812c55088fc0c4ece31efac0e", corrupt.ActualChecksum)
+	assert.EqualValues(t, len("bit rot"), corrupt.ActualSize)
+	assert.Equal(t, []string{linkPath}, corrupt.ReferencedBy)
+
+	assert.NoFileExists(t, corruptPath)
+	assert.FileExists(t, corrupt.QuarantinePath)
+
+	isRunning, lastReport = server.ScrubStatus()
+	assert.False(t, isRunning)
+	assert.Equal(t, &report, lastReport)
+
+	// The corrupt file is gone, so a second run should not find anything.
+	report, err = server.Scrub(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 7, report.NumFilesChecked)
+	assert.Empty(t, report.CorruptFiles)
+}
+
+func TestScrubHardlinkedFile(t *testing.T) {
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	filestore.LinkTestFileStore(server.config.FileStorePath())
+
+	corruptPath := filepath.Join(server.fileStore.StoragePath(),
+		"80", "b749c27b2fef7255e7e7b3c2029b03b31299c75ff1f1c72732081c70a713a3", "7488.blob")
+	require.NoError(t, os.WriteFile(corruptPath, []byte("bit rot"), 0666))
+
+	checkoutInfo, err := server.checkoutMan.PrepareCheckout("checkoutID")
+	require.NoError(t, err)
+	linkPath := filepath.Join(server.config.CheckoutPath(), checkoutInfo.RelativePath, "use-of-7488.blob")
+	require.NoError(t, os.Link(corruptPath, linkPath))
+
+	report, err := server.Scrub(context.Background())
+	require.NoError(t, err)
+	require.Len(t, report.CorruptFiles, 1)
+	assert.Equal(t, []string{linkPath}, report.CorruptFiles[0].ReferencedBy)
+	assert.FileExists(t, report.CorruptFiles[0].QuarantinePath)
+}
+
+func TestScrubAlreadyRunning(t *testing.T) {
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	require.True(t, server.scrubStart())
+	_, err := server.Scrub(context.Background())
+	assert.ErrorIs(t, err, ErrScrubRunning)
+	assert.ErrorIs(t, server.StartScrub(), ErrScrubRunning)
+
+	server.scrubFinish(ScrubReport{}, nil)
+	require.NoError(t, server.StartScrub())
+	server.wg.Wait()
+
+	isRunning, lastReport := server.ScrubStatus()
+	assert.False(t, isRunning)
+	assert.NotNil(t, lastReport)
+}
+
+func TestScrubCancelled(t *testing.T) {
+	server, cleanup := createTestShaman()
+	defer cleanup()
+
+	filestore.LinkTestFileStore(server.config.FileStorePath())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := server.Scrub(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// A cancelled run should not replace the last report.
+	isRunning, lastReport := server.ScrubStatus()
+	assert.False(t, isRunning)
+	assert.Nil(t, lastReport)
+}
+
+func TestRateLimitedWriter(t *testing.T) {
+	limiter := newRateLimitedWriter(context.Background(), 1000)
+
+	startTime := time.Now()
+	written, err := limiter.Write(make([]byte, 100))
+	require.NoError(t, err)
+	assert.Equal(t, 100, written)
+	assert.GreaterOrEqual(t, time.Since(startTime), 100*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter = newRateLimitedWriter(ctx, 1000)
+	_, err = limiter.Write(make([]byte, 100))
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"projects.blender.org/studio/flamenco/pkg/api"
 	"projects.blender.org/studio/flamenco/pkg/shaman/checkout"
+	"projects.blender.org/studio/flamenco/pkg/shaman/chunker"
 	"projects.blender.org/studio/flamenco/pkg/shaman/config"
 	"projects.blender.org/studio/flamenco/pkg/shaman/fileserver"
 	"projects.blender.org/studio/flamenco/pkg/shaman/filestore"
@@ -38,7 +39,11 @@ import (
 	"projects.blender.org/studio/flamenco/pkg/sysinfo"
 )
 
-var ErrDoesNotExist = checkout.ErrDoesNotExist
+var (
+	ErrDoesNotExist    = checkout.ErrDoesNotExist
+	ErrMissingFiles    = checkout.ErrMissingFiles
+	ErrAssemblyPending = checkout.ErrAssemblyPending
+)
 
 // Server represents a Shaman Server.
 type Server struct {
@@ -49,6 +54,12 @@ type Server struct {
 	fileServer  *fileserver.FileServer
 	checkoutMan *checkout.Manager
 
+	diskSpace diskSpaceMonitor
+	// gcRequests is used to start a garbage collection run before its period has passed.
+	gcRequests chan struct{}
+
+	scrub scrubState
+
 	shutdownChan chan struct{}
 	wg           sync.WaitGroup
 }
@@ -60,8 +71,6 @@ func NewServer(conf config.Config, auther jwtauth.Authenticator) *Server {
 		return nil
 	}
 
-	checkPlatformSymlinkSupport()
-
 	if conf.StoragePath == "" {
 		log.Error().Interface("config", conf).Msg("shaman: no checkout path configured, unable to start")
 		return nil
@@ -71,6 +80,10 @@ func NewServer(conf config.Config, auther jwtauth.Authenticator) *Server {
 	checkoutMan := checkout.NewManager(conf, fileStore)
 	fileServer := fileserver.New(fileStore)
 
+	if checkoutMan.CheckoutMode() == config.CheckoutModeSymlink {
+		checkPlatformSymlinkSupport()
+	}
+
 	shamanServer := &Server{
 		config:      conf,
 		auther:      auther,
@@ -78,6 +91,8 @@ func NewServer(conf config.Config, auther jwtauth.Authenticator) *Server {
 		fileServer:  fileServer,
 		checkoutMan: checkoutMan,
 
+		gcRequests: make(chan struct{}, 1),
+
 		shutdownChan: make(chan struct{}),
 		wg:           sync.WaitGroup{},
 	}
@@ -109,7 +124,7 @@ func checkPlatformSymlinkSupport() {
 		Str("os", runtime.GOOS).
 		Str("arch", runtime.GOARCH).
 		Str("osDetail", osDetail).
-		Msg("this platform does not reliably support symbolic links, " +
+		Msg("this platform does not reliably support symbolic links, consider using another checkoutMode, " +
 			"see https://flamenco.blender.org/usage/shared-storage/shaman/#requirements")
 }
 
@@ -127,6 +142,20 @@ func (s *Server) Go() {
 		s.wg.Add(1)
 		go s.periodicCleanup()
 	}
+
+	if s.config.DiskSpace.CheckPeriod == 0 {
+		log.Warn().Msg("disk space monitoring disabled, set diskSpace.checkPeriod > 0 in configuration")
+	} else {
+		s.wg.Add(1)
+		go s.periodicDiskSpaceCheck()
+	}
+
+	if s.config.Scrub.Period == 0 {
+		log.Info().Msg("periodic integrity check of the file store disabled, set scrub.period > 0 in configuration")
+	} else {
+		s.wg.Add(1)
+		go s.periodicScrub()
+	}
 }
 
 // Close shuts down the Shaman server.
@@ -163,9 +192,10 @@ var fsStatusToApiStatus = map[filestore.FileStatus]api.ShamanFileStatus{
 }
 
 // Check the status of a file on the Shaman server.
-// status (stored, currently being uploaded, unknown).
-func (s *Server) FileStoreCheck(ctx context.Context, checksum string, filesize int64) api.ShamanFileStatus {
-	status := s.fileServer.CheckFile(checksum, filesize)
+// status (stored, currently being uploaded, unknown), and the number of bytes
+// received by an earlier, unfinished upload.
+func (s *Server) FileStoreCheck(ctx context.Context, checksum string, filesize int64) api.ShamanSingleFileStatus {
+	status, offset := s.fileServer.CheckFile(checksum, filesize)
 	apiStatus, ok := fsStatusToApiStatus[status]
 	if !ok {
 		log.Warn().
@@ -173,21 +203,41 @@ func (s *Server) FileStoreCheck(ctx context.Context, checksum string, filesize i
 			Int64("filesize", filesize).
 			Int("fileserverStatus", int(status)).
 			Msg("shaman: unknown status on fileserver")
-		return api.ShamanFileStatusUnknown
+		return api.ShamanSingleFileStatus{Status: api.ShamanFileStatusUnknown}
+	}
+
+	fileStatus := api.ShamanSingleFileStatus{Status: apiStatus}
+	if offset > 0 {
+		fileStatus.Offset = &offset
 	}
-	return apiStatus
+	return fileStatus
 }
 
 // Store a new file on the Shaman server. Note that the Shaman server can return
 // early when another client finishes uploading the exact same file, to prevent
-// double uploads.
-func (s *Server) FileStore(ctx context.Context, file io.ReadCloser, checksum string, filesize int64, canDefer bool, originalFilename string) error {
-	err := s.fileServer.ReceiveFile(ctx, file, checksum, filesize, canDefer, originalFilename)
+// double uploads. When offset > 0, an earlier, unfinished upload is resumed from
+// that offset.
+func (s *Server) FileStore(ctx context.Context, file io.ReadCloser, checksum string, filesize int64, offset int64, canDefer bool, originalFilename string) error {
+	err := s.fileServer.ReceiveFile(ctx, file, checksum, filesize, offset, canDefer, originalFilename)
 	// TODO: Maybe translate this error into something that can be understood by
 	// the caller without relying on types declared in the `fileserver` package?
 	return err
 }
 
+// Chunking returns the parameters for uploading files as content-defined
+// chunks, or nil when chunking is disabled.
+func (s *Server) Chunking() *api.ShamanChunking {
+	if !s.config.Chunking.Enabled {
+		return nil
+	}
+	return &api.ShamanChunking{
+		MinFileSize:  s.config.Chunking.MinFileSizeBytes(),
+		MinChunkSize: chunker.DefaultParams.MinSize,
+		AvgChunkSize: chunker.DefaultParams.AvgSize,
+		MaxChunkSize: chunker.DefaultParams.MaxSize,
+	}
+}
+
 // EraseCheckout deletes the symlinks and the directory structure that makes up the checkout.
 func (s *Server) EraseCheckout(checkoutID string) error {
 	return s.checkoutMan.EraseCheckout(checkoutID)
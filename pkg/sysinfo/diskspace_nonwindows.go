@@ -0,0 +1,19 @@
+//go:build !windows
+
+package sysinfo
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+func freeDiskSpace(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, fmt.Errorf("getting filesystem info of %s: %w", path, err)
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
@@ -2,6 +2,15 @@ package sysinfo
 
 // SPDX-License-Identifier: GPL-3.0-or-later
 
+import (
+	"errors"
+	"runtime"
+)
+
+// ErrNotSupported is returned when some information cannot be obtained on the
+// current platform.
+var ErrNotSupported = errors.New("not supported on this platform")
+
 // CanSymlink tries to determine whether the running system can use symbolic
 // links.
 func CanSymlink() (bool, error) {
@@ -13,3 +22,31 @@ func CanSymlink() (bool, error) {
 func Description() (string, error) {
 	return description()
 }
+
+// CPUCount returns the number of logical CPUs.
+func CPUCount() int {
+	return runtime.NumCPU()
+}
+
+// MemoryTotal returns the total amount of RAM, in bytes.
+func MemoryTotal() (uint64, error) {
+	return memoryTotal()
+}
+
+// MemoryUsed returns the amount of RAM in use, in bytes. Memory used for
+// caches that can be reclaimed by the operating system is not counted.
+func MemoryUsed() (uint64, error) {
+	return memoryUsed()
+}
+
+// LoadAverage returns the system load, averaged over the last minute.
+// Returns ErrNotSupported on Windows, as it has no such concept.
+func LoadAverage() (float64, error) {
+	return loadAverage()
+}
+
+// FreeDiskSpace returns the disk space available to the current user on the
+// filesystem that contains the given path, in bytes.
+func FreeDiskSpace(path string) (uint64, error) {
+	return freeDiskSpace(path)
+}
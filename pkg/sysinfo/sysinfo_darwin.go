@@ -2,6 +2,13 @@ package sysinfo
 
 // SPDX-License-Identifier: GPL-3.0-or-later
 
+import (
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
 // canSymlink always returns true, as symlinking on non-Windows platforms is not
 // hard.
 func canSymlink() (bool, error) {
@@ -12,3 +19,35 @@ func description() (string, error) {
 	// TODO: figure out how to get more info on macOS.
 	return "macOS", nil
 }
+
+func memoryTotal() (uint64, error) {
+	total, err := unix.SysctlUint64("hw.memsize")
+	if err != nil {
+		return 0, fmt.Errorf("getting hw.memsize: %w", err)
+	}
+	return total, nil
+}
+
+func memoryUsed() (uint64, error) {
+	// TODO: get this from the Mach host_statistics64() call, which is not
+	// available without cgo.
+	return 0, ErrNotSupported
+}
+
+func loadAverage() (float64, error) {
+	// vm.loadavg is a `struct loadavg { fixpt_t ldavg[3]; long fscale; }`, where
+	// fixpt_t is a uint32.
+	raw, err := unix.SysctlRaw("vm.loadavg")
+	if err != nil {
+		return 0, fmt.Errorf("getting vm.loadavg: %w", err)
+	}
+	if len(raw) < 24 {
+		return 0, fmt.Errorf("unexpected size %d of vm.loadavg", len(raw))
+	}
+	load := binary.LittleEndian.Uint32(raw[0:4])
+	scale := binary.LittleEndian.Uint64(raw[16:24])
+	if scale == 0 {
+		return 0, fmt.Errorf("vm.loadavg has zero scale")
+	}
+	return float64(load) / float64(scale), nil
+}
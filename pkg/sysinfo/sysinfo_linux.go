@@ -3,9 +3,15 @@ package sysinfo
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
 
 	the_other_sysinfo "github.com/zcalusic/sysinfo"
+	"golang.org/x/sys/unix"
 )
 
 // canSymlink always returns true, as symlinking on non-Windows platforms is not
@@ -20,3 +26,67 @@ func description() (string, error) {
 	description := fmt.Sprintf("%s (%s)", si.OS.Name, si.Kernel.Release)
 	return description, nil
 }
+
+func memoryTotal() (uint64, error) {
+	return readMeminfo("MemTotal")
+}
+
+func memoryUsed() (uint64, error) {
+	total, err := readMeminfo("MemTotal")
+	if err != nil {
+		return 0, err
+	}
+	available, err := readMeminfo("MemAvailable")
+	if err != nil {
+		return 0, err
+	}
+	return total - available, nil
+}
+
+// readMeminfo returns a value from /proc/meminfo, in bytes.
+func readMeminfo(key string) (uint64, error) {
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	return parseMeminfo(file, key)
+}
+
+// parseMeminfo finds a value in the /proc/meminfo format, and returns it in bytes.
+func parseMeminfo(reader io.Reader, key string) (uint64, error) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		// Lines look like "MemTotal:       16318480 kB".
+		name, value, found := strings.Cut(scanner.Text(), ":")
+		if !found || name != key {
+			continue
+		}
+
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			return 0, fmt.Errorf("no value for %s in /proc/meminfo", key)
+		}
+		amount, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value for %s in /proc/meminfo: %w", key, err)
+		}
+		if len(fields) > 1 && fields[1] == "kB" {
+			amount *= 1024
+		}
+		return amount, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("reading /proc/meminfo: %w", err)
+	}
+	return 0, fmt.Errorf("%s not found in /proc/meminfo", key)
+}
+
+func loadAverage() (float64, error) {
+	var info unix.Sysinfo_t
+	if err := unix.Sysinfo(&info); err != nil {
+		return 0, fmt.Errorf("calling sysinfo: %w", err)
+	}
+	// The load averages are fixed-point numbers with 16 bits for the fraction.
+	return float64(info.Loads[0]) / (1 << 16), nil
+}
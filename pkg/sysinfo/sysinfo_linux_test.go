@@ -0,0 +1,35 @@
+package sysinfo
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMeminfo(t *testing.T) {
+	meminfo := `MemTotal:       16318480 kB
+MemFree:         1030092 kB
+MemAvailable:    9238016 kB
+HugePages_Total:       0
+`
+
+	total, err := parseMeminfo(strings.NewReader(meminfo), "MemTotal")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16318480*1024), total)
+
+	available, err := parseMeminfo(strings.NewReader(meminfo), "MemAvailable")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9238016*1024), available)
+
+	// Values without unit are not scaled.
+	hugePages, err := parseMeminfo(strings.NewReader(meminfo), "HugePages_Total")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), hugePages)
+
+	_, err = parseMeminfo(strings.NewReader(meminfo), "SwapTotal")
+	assert.Error(t, err)
+}
@@ -151,3 +151,63 @@ func hasSystemPrivilege(privilegeName string) (bool, error) {
 
 	return false, nil
 }
+
+// memoryStatusEx mirrors the MEMORYSTATUSEX struct of the Windows API.
+type memoryStatusEx struct {
+	length               uint32
+	memoryLoad           uint32
+	totalPhys            uint64
+	availPhys            uint64
+	totalPageFile        uint64
+	availPageFile        uint64
+	totalVirtual         uint64
+	availVirtual         uint64
+	availExtendedVirtual uint64
+}
+
+var procGlobalMemoryStatusEx = windows.NewLazySystemDLL("kernel32.dll").NewProc("GlobalMemoryStatusEx")
+
+func globalMemoryStatus() (memoryStatusEx, error) {
+	status := memoryStatusEx{}
+	status.length = uint32(unsafe.Sizeof(status))
+	result, _, err := procGlobalMemoryStatusEx.Call(uintptr(unsafe.Pointer(&status)))
+	if result == 0 {
+		return status, fmt.Errorf("calling GlobalMemoryStatusEx: %w", err)
+	}
+	return status, nil
+}
+
+func memoryTotal() (uint64, error) {
+	status, err := globalMemoryStatus()
+	if err != nil {
+		return 0, err
+	}
+	return status.totalPhys, nil
+}
+
+func memoryUsed() (uint64, error) {
+	status, err := globalMemoryStatus()
+	if err != nil {
+		return 0, err
+	}
+	return status.totalPhys - status.availPhys, nil
+}
+
+// loadAverage returns ErrNotSupported, as Windows has no load average.
+func loadAverage() (float64, error) {
+	return 0, ErrNotSupported
+}
+
+func freeDiskSpace(path string) (uint64, error) {
+	pathU16, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+
+	var freeBytesAvailable uint64
+	err = windows.GetDiskFreeSpaceEx(pathU16, &freeBytesAvailable, nil, nil)
+	if err != nil {
+		return 0, fmt.Errorf("getting free disk space of %s: %w", path, err)
+	}
+	return freeBytesAvailable, nil
+}
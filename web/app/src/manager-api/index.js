@@ -30,14 +30,21 @@ import Job from './model/Job';
 import JobAllOf from './model/JobAllOf';
 import JobBlocklistEntry from './model/JobBlocklistEntry';
 import JobDeletionInfo from './model/JobDeletionInfo';
+import JobLastRenderedFrame from './model/JobLastRenderedFrame';
+import JobLastRenderedHistory from './model/JobLastRenderedHistory';
 import JobLastRenderedImageInfo from './model/JobLastRenderedImageInfo';
 import JobPriorityChange from './model/JobPriorityChange';
+import JobRetryPolicy from './model/JobRetryPolicy';
 import JobStatus from './model/JobStatus';
 import JobStatusChange from './model/JobStatusChange';
 import JobStorageInfo from './model/JobStorageInfo';
 import JobTasksSummary from './model/JobTasksSummary';
+import JobTemplate from './model/JobTemplate';
+import JobTemplateAllOf from './model/JobTemplateAllOf';
+import JobTemplateList from './model/JobTemplateList';
 import JobsQuery from './model/JobsQuery';
 import JobsQueryResult from './model/JobsQueryResult';
+import LastRenderedQueueInfo from './model/LastRenderedQueueInfo';
 import ManagerConfiguration from './model/ManagerConfiguration';
 import ManagerVariable from './model/ManagerVariable';
 import ManagerVariableAudience from './model/ManagerVariableAudience';
@@ -49,12 +56,19 @@ import SecurityError from './model/SecurityError';
 import SetupAssistantConfig from './model/SetupAssistantConfig';
 import ShamanCheckout from './model/ShamanCheckout';
 import ShamanCheckoutResult from './model/ShamanCheckoutResult';
+import ShamanChunkSpec from './model/ShamanChunkSpec';
+import ShamanChunking from './model/ShamanChunking';
+import ShamanCorruptFile from './model/ShamanCorruptFile';
+import ShamanDiskSpace from './model/ShamanDiskSpace';
 import ShamanFileSpec from './model/ShamanFileSpec';
 import ShamanFileSpecWithStatus from './model/ShamanFileSpecWithStatus';
 import ShamanFileStatus from './model/ShamanFileStatus';
 import ShamanRequirementsRequest from './model/ShamanRequirementsRequest';
 import ShamanRequirementsResponse from './model/ShamanRequirementsResponse';
+import ShamanScrubReport from './model/ShamanScrubReport';
+import ShamanScrubStatus from './model/ShamanScrubStatus';
 import ShamanSingleFileStatus from './model/ShamanSingleFileStatus';
+import ShamanStatus from './model/ShamanStatus';
 import SharedStorageLocation from './model/SharedStorageLocation';
 import SocketIOJobUpdate from './model/SocketIOJobUpdate';
 import SocketIOLastRenderedUpdate from './model/SocketIOLastRenderedUpdate';
@@ -66,8 +80,12 @@ import SocketIOTaskUpdate from './model/SocketIOTaskUpdate';
 import SocketIOWorkerTagUpdate from './model/SocketIOWorkerTagUpdate';
 import SocketIOWorkerUpdate from './model/SocketIOWorkerUpdate';
 import SubmittedJob from './model/SubmittedJob';
+import SubmittedJobTemplate from './model/SubmittedJobTemplate';
 import Task from './model/Task';
 import TaskLogInfo from './model/TaskLogInfo';
+import TaskLogSearchMatch from './model/TaskLogSearchMatch';
+import TaskLogSearchQuery from './model/TaskLogSearchQuery';
+import TaskRenderProgress from './model/TaskRenderProgress';
 import TaskStatus from './model/TaskStatus';
 import TaskStatusChange from './model/TaskStatusChange';
 import TaskSummary from './model/TaskSummary';
@@ -77,8 +95,13 @@ import Worker from './model/Worker';
 import WorkerAllOf from './model/WorkerAllOf';
 import WorkerList from './model/WorkerList';
 import WorkerRegistration from './model/WorkerRegistration';
+import WorkerResourceSample from './model/WorkerResourceSample';
+import WorkerResourceSampleAllOf from './model/WorkerResourceSampleAllOf';
+import WorkerResourceUsage from './model/WorkerResourceUsage';
+import WorkerResources from './model/WorkerResources';
 import WorkerSignOn from './model/WorkerSignOn';
 import WorkerSleepSchedule from './model/WorkerSleepSchedule';
+import WorkerSleepWindow from './model/WorkerSleepWindow';
 import WorkerStateChange from './model/WorkerStateChange';
 import WorkerStateChanged from './model/WorkerStateChanged';
 import WorkerStatus from './model/WorkerStatus';
@@ -236,6 +259,18 @@ export {
      */
     JobDeletionInfo,
 
+    /**
+     * The JobLastRenderedFrame model constructor.
+     * @property {module:model/JobLastRenderedFrame}
+     */
+    JobLastRenderedFrame,
+
+    /**
+     * The JobLastRenderedHistory model constructor.
+     * @property {module:model/JobLastRenderedHistory}
+     */
+    JobLastRenderedHistory,
+
     /**
      * The JobLastRenderedImageInfo model constructor.
      * @property {module:model/JobLastRenderedImageInfo}
@@ -248,6 +283,12 @@ export {
      */
     JobPriorityChange,
 
+    /**
+     * The JobRetryPolicy model constructor.
+     * @property {module:model/JobRetryPolicy}
+     */
+    JobRetryPolicy,
+
     /**
      * The JobStatus model constructor.
      * @property {module:model/JobStatus}
@@ -272,6 +313,24 @@ export {
      */
     JobTasksSummary,
 
+    /**
+     * The JobTemplate model constructor.
+     * @property {module:model/JobTemplate}
+     */
+    JobTemplate,
+
+    /**
+     * The JobTemplateAllOf model constructor.
+     * @property {module:model/JobTemplateAllOf}
+     */
+    JobTemplateAllOf,
+
+    /**
+     * The JobTemplateList model constructor.
+     * @property {module:model/JobTemplateList}
+     */
+    JobTemplateList,
+
     /**
      * The JobsQuery model constructor.
      * @property {module:model/JobsQuery}
@@ -284,6 +343,12 @@ export {
      */
     JobsQueryResult,
 
+    /**
+     * The LastRenderedQueueInfo model constructor.
+     * @property {module:model/LastRenderedQueueInfo}
+     */
+    LastRenderedQueueInfo,
+
     /**
      * The ManagerConfiguration model constructor.
      * @property {module:model/ManagerConfiguration}
@@ -350,6 +415,30 @@ export {
      */
     ShamanCheckoutResult,
 
+    /**
+     * The ShamanChunkSpec model constructor.
+     * @property {module:model/ShamanChunkSpec}
+     */
+    ShamanChunkSpec,
+
+    /**
+     * The ShamanChunking model constructor.
+     * @property {module:model/ShamanChunking}
+     */
+    ShamanChunking,
+
+    /**
+     * The ShamanCorruptFile model constructor.
+     * @property {module:model/ShamanCorruptFile}
+     */
+    ShamanCorruptFile,
+
+    /**
+     * The ShamanDiskSpace model constructor.
+     * @property {module:model/ShamanDiskSpace}
+     */
+    ShamanDiskSpace,
+
     /**
      * The ShamanFileSpec model constructor.
      * @property {module:model/ShamanFileSpec}
@@ -380,12 +469,30 @@ export {
      */
     ShamanRequirementsResponse,
 
+    /**
+     * The ShamanScrubReport model constructor.
+     * @property {module:model/ShamanScrubReport}
+     */
+    ShamanScrubReport,
+
+    /**
+     * The ShamanScrubStatus model constructor.
+     * @property {module:model/ShamanScrubStatus}
+     */
+    ShamanScrubStatus,
+
     /**
      * The ShamanSingleFileStatus model constructor.
      * @property {module:model/ShamanSingleFileStatus}
      */
     ShamanSingleFileStatus,
 
+    /**
+     * The ShamanStatus model constructor.
+     * @property {module:model/ShamanStatus}
+     */
+    ShamanStatus,
+
     /**
      * The SharedStorageLocation model constructor.
      * @property {module:model/SharedStorageLocation}
@@ -452,6 +559,12 @@ export {
      */
     SubmittedJob,
 
+    /**
+     * The SubmittedJobTemplate model constructor.
+     * @property {module:model/SubmittedJobTemplate}
+     */
+    SubmittedJobTemplate,
+
     /**
      * The Task model constructor.
      * @property {module:model/Task}
@@ -464,6 +577,24 @@ export {
      */
     TaskLogInfo,
 
+    /**
+     * The TaskLogSearchMatch model constructor.
+     * @property {module:model/TaskLogSearchMatch}
+     */
+    TaskLogSearchMatch,
+
+    /**
+     * The TaskLogSearchQuery model constructor.
+     * @property {module:model/TaskLogSearchQuery}
+     */
+    TaskLogSearchQuery,
+
+    /**
+     * The TaskRenderProgress model constructor.
+     * @property {module:model/TaskRenderProgress}
+     */
+    TaskRenderProgress,
+
     /**
      * The TaskStatus model constructor.
      * @property {module:model/TaskStatus}
@@ -518,6 +649,30 @@ export {
      */
     WorkerRegistration,
 
+    /**
+     * The WorkerResourceSample model constructor.
+     * @property {module:model/WorkerResourceSample}
+     */
+    WorkerResourceSample,
+
+    /**
+     * The WorkerResourceSampleAllOf model constructor.
+     * @property {module:model/WorkerResourceSampleAllOf}
+     */
+    WorkerResourceSampleAllOf,
+
+    /**
+     * The WorkerResourceUsage model constructor.
+     * @property {module:model/WorkerResourceUsage}
+     */
+    WorkerResourceUsage,
+
+    /**
+     * The WorkerResources model constructor.
+     * @property {module:model/WorkerResources}
+     */
+    WorkerResources,
+
     /**
      * The WorkerSignOn model constructor.
      * @property {module:model/WorkerSignOn}
@@ -530,6 +685,12 @@ export {
      */
     WorkerSleepSchedule,
 
+    /**
+     * The WorkerSleepWindow model constructor.
+     * @property {module:model/WorkerSleepWindow}
+     */
+    WorkerSleepWindow,
+
     /**
      * The WorkerStateChange model constructor.
      * @property {module:model/WorkerStateChange}
@@ -19,15 +19,22 @@ import Error from '../model/Error';
 import Job from '../model/Job';
 import JobBlocklistEntry from '../model/JobBlocklistEntry';
 import JobDeletionInfo from '../model/JobDeletionInfo';
+import JobLastRenderedHistory from '../model/JobLastRenderedHistory';
 import JobLastRenderedImageInfo from '../model/JobLastRenderedImageInfo';
 import JobPriorityChange from '../model/JobPriorityChange';
 import JobStatusChange from '../model/JobStatusChange';
 import JobTasksSummary from '../model/JobTasksSummary';
+import JobTemplate from '../model/JobTemplate';
+import JobTemplateList from '../model/JobTemplateList';
 import JobsQuery from '../model/JobsQuery';
 import JobsQueryResult from '../model/JobsQueryResult';
+import LastRenderedQueueInfo from '../model/LastRenderedQueueInfo';
 import SubmittedJob from '../model/SubmittedJob';
+import SubmittedJobTemplate from '../model/SubmittedJobTemplate';
 import Task from '../model/Task';
 import TaskLogInfo from '../model/TaskLogInfo';
+import TaskLogSearchMatch from '../model/TaskLogSearchMatch';
+import TaskLogSearchQuery from '../model/TaskLogSearchQuery';
 import TaskStatusChange from '../model/TaskStatusChange';
 
 /**
@@ -50,6 +57,51 @@ export default class JobsApi {
 
 
 
+    /**
+     * Create a new job template.
+     * @param {module:model/SubmittedJobTemplate} submittedJobTemplate The job template.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobTemplate} and HTTP response
+     */
+    createJobTemplateWithHttpInfo(submittedJobTemplate) {
+      let postBody = submittedJobTemplate;
+      // verify the required parameter 'submittedJobTemplate' is set
+      if (submittedJobTemplate === undefined || submittedJobTemplate === null) {
+        throw new Error("Missing the required parameter 'submittedJobTemplate' when calling createJobTemplate");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = JobTemplate;
+      return this.apiClient.callApi(
+        '/api/v3/job-templates', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Create a new job template.
+     * @param {module:model/SubmittedJobTemplate} submittedJobTemplate The job template.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobTemplate}
+     */
+    createJobTemplate(submittedJobTemplate) {
+      return this.createJobTemplateWithHttpInfo(submittedJobTemplate)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Request deletion this job, including its tasks and any log files. The actual deletion may happen in the background. No job files will be deleted (yet). 
      * @param {String} jobId 
@@ -96,6 +148,52 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Remove this job template. Jobs that were submitted from it are kept.
+     * @param {String} templateId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    deleteJobTemplateWithHttpInfo(templateId) {
+      let postBody = null;
+      // verify the required parameter 'templateId' is set
+      if (templateId === undefined || templateId === null) {
+        throw new Error("Missing the required parameter 'templateId' when calling deleteJobTemplate");
+      }
+
+      let pathParams = {
+        'template_id': templateId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/job-templates/{template_id}', 'DELETE',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Remove this job template. Jobs that were submitted from it are kept.
+     * @param {String} templateId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    deleteJobTemplate(templateId) {
+      return this.deleteJobTemplateWithHttpInfo(templateId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Get info about what would be deleted when deleting this job. The job itself, its logs, and the last-rendered images will always be deleted. The job files are only deleted conditionally, and this operation can be used to figure that out. 
      * @param {String} jobId 
@@ -142,6 +240,52 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Export the job, its tasks, blocklist, task logs, and last-rendered images as a ZIP archive. The archive can be imported into another Flamenco Manager with the `importJobArchive` operation. 
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link File} and HTTP response
+     */
+    exportJobArchiveWithHttpInfo(jobId) {
+      let postBody = null;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling exportJobArchive");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/zip', 'application/json'];
+      let returnType = File;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}/archive', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Export the job, its tasks, blocklist, task logs, and last-rendered images as a ZIP archive. The archive can be imported into another Flamenco Manager with the `importJobArchive` operation. 
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link File}
+     */
+    exportJobArchive(jobId) {
+      return this.exportJobArchiveWithHttpInfo(jobId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Get the URL that serves the last-rendered images.
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobLastRenderedImageInfo} and HTTP response
@@ -273,6 +417,104 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Get a single JPEG image that shows the thumbnails of the previously rendered frames of this job, ordered by frame number. 
+     * @param {String} jobId 
+     * @param {Object} opts Optional parameters
+     * @param {Number} opts.columns Number of thumbnails per row of the contact sheet.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link File} and HTTP response
+     */
+    fetchJobLastRenderedContactSheetWithHttpInfo(jobId, opts) {
+      opts = opts || {};
+      let postBody = null;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling fetchJobLastRenderedContactSheet");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+        'columns': opts['columns']
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['image/jpeg', 'application/json'];
+      let returnType = File;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}/last-rendered/contact-sheet', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get a single JPEG image that shows the thumbnails of the previously rendered frames of this job, ordered by frame number. 
+     * @param {String} jobId 
+     * @param {Object} opts Optional parameters
+     * @param {Number} opts.columns Number of thumbnails per row of the contact sheet.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link File}
+     */
+    fetchJobLastRenderedContactSheet(jobId, opts) {
+      return this.fetchJobLastRenderedContactSheetWithHttpInfo(jobId, opts)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Get the URLs of the thumbnails of previously rendered frames of this job. Only a limited number of frames is kept per job. 
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobLastRenderedHistory} and HTTP response
+     */
+    fetchJobLastRenderedHistoryWithHttpInfo(jobId) {
+      let postBody = null;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling fetchJobLastRenderedHistory");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = JobLastRenderedHistory;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}/last-rendered/history', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get the URLs of the thumbnails of previously rendered frames of this job. Only a limited number of frames is kept per job. 
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobLastRenderedHistory}
+     */
+    fetchJobLastRenderedHistory(jobId) {
+      return this.fetchJobLastRenderedHistoryWithHttpInfo(jobId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Get the URL that serves the last-rendered images of this job.
      * @param {String} jobId 
@@ -365,6 +607,130 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Get a single job template.
+     * @param {String} templateId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobTemplate} and HTTP response
+     */
+    fetchJobTemplateWithHttpInfo(templateId) {
+      let postBody = null;
+      // verify the required parameter 'templateId' is set
+      if (templateId === undefined || templateId === null) {
+        throw new Error("Missing the required parameter 'templateId' when calling fetchJobTemplate");
+      }
+
+      let pathParams = {
+        'template_id': templateId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = JobTemplate;
+      return this.apiClient.callApi(
+        '/api/v3/job-templates/{template_id}', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get a single job template.
+     * @param {String} templateId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobTemplate}
+     */
+    fetchJobTemplate(templateId) {
+      return this.fetchJobTemplateWithHttpInfo(templateId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Get list of job templates.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobTemplateList} and HTTP response
+     */
+    fetchJobTemplatesWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = JobTemplateList;
+      return this.apiClient.callApi(
+        '/api/v3/job-templates', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get list of job templates.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobTemplateList}
+     */
+    fetchJobTemplates() {
+      return this.fetchJobTemplatesWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Get metrics of the queue of to-be-processed last-rendered images.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/LastRenderedQueueInfo} and HTTP response
+     */
+    fetchLastRenderedQueueInfoWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = LastRenderedQueueInfo;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/last-rendered-queue', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get metrics of the queue of to-be-processed last-rendered images.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/LastRenderedQueueInfo}
+     */
+    fetchLastRenderedQueueInfo() {
+      return this.fetchLastRenderedQueueInfoWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Fetch a single task.
      * @param {String} taskId 
@@ -588,6 +954,51 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Import a job from a ZIP archive, as produced by the `exportJobArchive` operation. The job keeps its UUID, so it cannot be imported into a Manager that already has that job. References to Workers, worker tags, and jobs that do not exist on this Manager are dropped. 
+     * @param {File} body The job archive.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/Job} and HTTP response
+     */
+    importJobArchiveWithHttpInfo(body) {
+      let postBody = body;
+      // verify the required parameter 'body' is set
+      if (body === undefined || body === null) {
+        throw new Error("Missing the required parameter 'body' when calling importJobArchive");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = ['application/zip'];
+      let accepts = ['application/json'];
+      let returnType = Job;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/import', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Import a job from a ZIP archive, as produced by the `exportJobArchive` operation. The job keeps its UUID, so it cannot be imported into a Manager that already has that job. References to Workers, worker tags, and jobs that do not exist on this Manager are dropped. 
+     * @param {File} body The job archive.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/Job}
+     */
+    importJobArchive(body) {
+      return this.importJobArchiveWithHttpInfo(body)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Fetch list of jobs.
      * @param {module:model/JobsQuery} jobsQuery Specification of which jobs to get.
@@ -684,6 +1095,51 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Search the task logs of a single job, or of all jobs that were active within a time range. The response is streamed as newline-delimited JSON, with one `TaskLogSearchMatch` object per line. 
+     * @param {module:model/TaskLogSearchQuery} taskLogSearchQuery What to search for, and where.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/TaskLogSearchMatch} and HTTP response
+     */
+    searchTaskLogsWithHttpInfo(taskLogSearchQuery) {
+      let postBody = taskLogSearchQuery;
+      // verify the required parameter 'taskLogSearchQuery' is set
+      if (taskLogSearchQuery === undefined || taskLogSearchQuery === null) {
+        throw new Error("Missing the required parameter 'taskLogSearchQuery' when calling searchTaskLogs");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/x-ndjson', 'application/json'];
+      let returnType = TaskLogSearchMatch;
+      return this.apiClient.callApi(
+        '/api/v3/tasks/logs/search', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Search the task logs of a single job, or of all jobs that were active within a time range. The response is streamed as newline-delimited JSON, with one `TaskLogSearchMatch` object per line. 
+     * @param {module:model/TaskLogSearchQuery} taskLogSearchQuery What to search for, and where.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/TaskLogSearchMatch}
+     */
+    searchTaskLogs(taskLogSearchQuery) {
+      return this.searchTaskLogsWithHttpInfo(taskLogSearchQuery)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * @param {String} jobId 
      * @param {module:model/JobPriorityChange} jobPriorityChange The new priority.
@@ -924,4 +1380,56 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Update an existing job template.
+     * @param {String} templateId 
+     * @param {module:model/SubmittedJobTemplate} submittedJobTemplate The updated job template.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobTemplate} and HTTP response
+     */
+    updateJobTemplateWithHttpInfo(templateId, submittedJobTemplate) {
+      let postBody = submittedJobTemplate;
+      // verify the required parameter 'templateId' is set
+      if (templateId === undefined || templateId === null) {
+        throw new Error("Missing the required parameter 'templateId' when calling updateJobTemplate");
+      }
+      // verify the required parameter 'submittedJobTemplate' is set
+      if (submittedJobTemplate === undefined || submittedJobTemplate === null) {
+        throw new Error("Missing the required parameter 'submittedJobTemplate' when calling updateJobTemplate");
+      }
+
+      let pathParams = {
+        'template_id': templateId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = JobTemplate;
+      return this.apiClient.callApi(
+        '/api/v3/job-templates/{template_id}', 'PUT',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Update an existing job template.
+     * @param {String} templateId 
+     * @param {module:model/SubmittedJobTemplate} submittedJobTemplate The updated job template.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobTemplate}
+     */
+    updateJobTemplate(templateId, submittedJobTemplate) {
+      return this.updateJobTemplateWithHttpInfo(templateId, submittedJobTemplate)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
 }
@@ -18,7 +18,9 @@ import ShamanCheckout from '../model/ShamanCheckout';
 import ShamanCheckoutResult from '../model/ShamanCheckoutResult';
 import ShamanRequirementsRequest from '../model/ShamanRequirementsRequest';
 import ShamanRequirementsResponse from '../model/ShamanRequirementsResponse';
+import ShamanScrubStatus from '../model/ShamanScrubStatus';
 import ShamanSingleFileStatus from '../model/ShamanSingleFileStatus';
+import ShamanStatus from '../model/ShamanStatus';
 
 /**
 * Shaman service.
@@ -40,6 +42,84 @@ export default class ShamanApi {
 
 
 
+    /**
+     * Get whether an integrity check of the Shaman file store is running, and the report of the last finished check. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/ShamanScrubStatus} and HTTP response
+     */
+    getShamanScrubStatusWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = ShamanScrubStatus;
+      return this.apiClient.callApi(
+        '/api/v3/shaman/scrub', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get whether an integrity check of the Shaman file store is running, and the report of the last finished check. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/ShamanScrubStatus}
+     */
+    getShamanScrubStatus() {
+      return this.getShamanScrubStatusWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Get the status of the Shaman file storage, including its free disk space.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/ShamanStatus} and HTTP response
+     */
+    getShamanStatusWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = ShamanStatus;
+      return this.apiClient.callApi(
+        '/api/v3/shaman/status', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get the status of the Shaman file storage, including its free disk space.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/ShamanStatus}
+     */
+    getShamanStatus() {
+      return this.getShamanStatusWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Create a directory, and symlink the required files into it. The files must all have been uploaded to Shaman before calling this endpoint.
      * @param {module:model/ShamanCheckout} shamanCheckout Set of files to check out.
@@ -138,6 +218,7 @@ export default class ShamanApi {
      * @param {Object} opts Optional parameters
      * @param {Boolean} opts.xShamanCanDeferUpload The client indicates that it can defer uploading this file. The \"208\" response will not only be returned when the file is already fully known to the Shaman server, but also when someone else is currently uploading this file. 
      * @param {String} opts.xShamanOriginalFilename The original filename. If sent along with the request, it will be included in the server logs, which can aid in debugging. 
+     * @param {Number} opts.xShamanUploadOffset Resume an earlier, unfinished upload of this file from this byte offset. The request body should then contain the file's contents from this offset onward. The offset can be obtained from the `shamanFileStoreCheck` operation. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
      */
     shamanFileStoreWithHttpInfo(checksum, filesize, body, opts) {
@@ -164,7 +245,8 @@ export default class ShamanApi {
       };
       let headerParams = {
         'X-Shaman-Can-Defer-Upload': opts['xShamanCanDeferUpload'],
-        'X-Shaman-Original-Filename': opts['xShamanOriginalFilename']
+        'X-Shaman-Original-Filename': opts['xShamanOriginalFilename'],
+        'X-Shaman-Upload-Offset': opts['xShamanUploadOffset']
       };
       let formParams = {
       };
@@ -188,6 +270,7 @@ export default class ShamanApi {
      * @param {Object} opts Optional parameters
      * @param {Boolean} opts.xShamanCanDeferUpload The client indicates that it can defer uploading this file. The \"208\" response will not only be returned when the file is already fully known to the Shaman server, but also when someone else is currently uploading this file. 
      * @param {String} opts.xShamanOriginalFilename The original filename. If sent along with the request, it will be included in the server logs, which can aid in debugging. 
+     * @param {Number} opts.xShamanUploadOffset Resume an earlier, unfinished upload of this file from this byte offset. The request body should then contain the file's contents from this offset onward. The offset can be obtained from the `shamanFileStoreCheck` operation. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}
      */
     shamanFileStore(checksum, filesize, body, opts) {
@@ -251,4 +334,43 @@ export default class ShamanApi {
     }
 
 
+    /**
+     * Start checking all files in the Shaman file store against their checksum. Corrupt files are moved to quarantine. Use `getShamanScrubStatus` to get the results. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    startShamanScrubWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/shaman/scrub', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Start checking all files in the Shaman file store against their checksum. Corrupt files are moved to quarantine. Use `getShamanScrubStatus` to get the results. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    startShamanScrub() {
+      return this.startShamanScrubWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
 }
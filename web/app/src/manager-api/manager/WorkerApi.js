@@ -20,6 +20,7 @@ import RegisteredWorker from '../model/RegisteredWorker';
 import SecurityError from '../model/SecurityError';
 import TaskUpdate from '../model/TaskUpdate';
 import WorkerRegistration from '../model/WorkerRegistration';
+import WorkerResourceUsage from '../model/WorkerResourceUsage';
 import WorkerSignOn from '../model/WorkerSignOn';
 import WorkerStateChange from '../model/WorkerStateChange';
 import WorkerStateChanged from '../model/WorkerStateChanged';
@@ -259,12 +260,15 @@ export default class WorkerApi {
 
 
     /**
-     * Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. Currently only PNG and JPEG support is planned. 
+     * Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. PNG, JPEG, and OpenEXR images are supported. 
      * @param {String} taskId 
      * @param {File} body Contents of the file
+     * @param {Object} opts Optional parameters
+     * @param {String} opts.xFlamencoOutputFilename The filename of the rendered image, without directory. The frame number is taken from this filename, to keep a history of last-rendered images per job. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
      */
-    taskOutputProducedWithHttpInfo(taskId, body) {
+    taskOutputProducedWithHttpInfo(taskId, body, opts) {
+      opts = opts || {};
       let postBody = body;
       // verify the required parameter 'taskId' is set
       if (taskId === undefined || taskId === null) {
@@ -281,12 +285,13 @@ export default class WorkerApi {
       let queryParams = {
       };
       let headerParams = {
+        'X-Flamenco-Output-Filename': opts['xFlamencoOutputFilename']
       };
       let formParams = {
       };
 
       let authNames = ['worker_auth'];
-      let contentTypes = ['image/jpeg', 'image/png'];
+      let contentTypes = ['image/jpeg', 'image/png', 'image/x-exr'];
       let accepts = ['application/json'];
       let returnType = null;
       return this.apiClient.callApi(
@@ -297,13 +302,15 @@ export default class WorkerApi {
     }
 
     /**
-     * Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. Currently only PNG and JPEG support is planned. 
+     * Store the most recently rendered frame here. Note that it is up to the Worker to ensure this is in a format that's digestable by the Manager. PNG, JPEG, and OpenEXR images are supported. 
      * @param {String} taskId 
      * @param {File} body Contents of the file
+     * @param {Object} opts Optional parameters
+     * @param {String} opts.xFlamencoOutputFilename The filename of the rendered image, without directory. The frame number is taken from this filename, to keep a history of last-rendered images per job. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}
      */
-    taskOutputProduced(taskId, body) {
-      return this.taskOutputProducedWithHttpInfo(taskId, body)
+    taskOutputProduced(taskId, body, opts) {
+      return this.taskOutputProducedWithHttpInfo(taskId, body, opts)
         .then(function(response_and_data) {
           return response_and_data.data;
         });
@@ -362,6 +369,51 @@ export default class WorkerApi {
     }
 
 
+    /**
+     * Report the Worker's current load and memory usage.
+     * @param {module:model/WorkerResourceUsage} workerResourceUsage Resource usage of the Worker
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    workerHeartbeatWithHttpInfo(workerResourceUsage) {
+      let postBody = workerResourceUsage;
+      // verify the required parameter 'workerResourceUsage' is set
+      if (workerResourceUsage === undefined || workerResourceUsage === null) {
+        throw new Error("Missing the required parameter 'workerResourceUsage' when calling workerHeartbeat");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['worker_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/worker/heartbeat', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Report the Worker's current load and memory usage.
+     * @param {module:model/WorkerResourceUsage} workerResourceUsage Resource usage of the Worker
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    workerHeartbeat(workerResourceUsage) {
+      return this.workerHeartbeatWithHttpInfo(workerResourceUsage)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/WorkerStateChange} and HTTP response
      */
@@ -315,6 +315,50 @@ export default class WorkerMgtApi {
     }
 
 
+    /**
+     * @param {String} tagId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/WorkerSleepSchedule} and HTTP response
+     */
+    fetchWorkerTagSleepScheduleWithHttpInfo(tagId) {
+      let postBody = null;
+      // verify the required parameter 'tagId' is set
+      if (tagId === undefined || tagId === null) {
+        throw new Error("Missing the required parameter 'tagId' when calling fetchWorkerTagSleepSchedule");
+      }
+
+      let pathParams = {
+        'tag_id': tagId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = WorkerSleepSchedule;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/tag/{tag_id}/sleep-schedule', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * @param {String} tagId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/WorkerSleepSchedule}
+     */
+    fetchWorkerTagSleepSchedule(tagId) {
+      return this.fetchWorkerTagSleepScheduleWithHttpInfo(tagId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Get list of worker tags.
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/WorkerTagList} and HTTP response
@@ -493,6 +537,56 @@ export default class WorkerMgtApi {
     }
 
 
+    /**
+     * @param {String} tagId 
+     * @param {module:model/WorkerSleepSchedule} workerSleepSchedule The new sleep schedule.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    setWorkerTagSleepScheduleWithHttpInfo(tagId, workerSleepSchedule) {
+      let postBody = workerSleepSchedule;
+      // verify the required parameter 'tagId' is set
+      if (tagId === undefined || tagId === null) {
+        throw new Error("Missing the required parameter 'tagId' when calling setWorkerTagSleepSchedule");
+      }
+      // verify the required parameter 'workerSleepSchedule' is set
+      if (workerSleepSchedule === undefined || workerSleepSchedule === null) {
+        throw new Error("Missing the required parameter 'workerSleepSchedule' when calling setWorkerTagSleepSchedule");
+      }
+
+      let pathParams = {
+        'tag_id': tagId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/tag/{tag_id}/sleep-schedule', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * @param {String} tagId 
+     * @param {module:model/WorkerSleepSchedule} workerSleepSchedule The new sleep schedule.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    setWorkerTagSleepSchedule(tagId, workerSleepSchedule) {
+      return this.setWorkerTagSleepScheduleWithHttpInfo(tagId, workerSleepSchedule)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * @param {String} workerId 
      * @param {module:model/WorkerTagChangeRequest} workerTagChangeRequest The list of worker tag IDs this worker should be a member of.
@@ -13,6 +13,7 @@
 
 import ApiClient from '../ApiClient';
 import JobAllOf from './JobAllOf';
+import JobRetryPolicy from './JobRetryPolicy';
 import JobStatus from './JobStatus';
 import JobStorageInfo from './JobStorageInfo';
 import SubmittedJob from './SubmittedJob';
@@ -100,6 +101,18 @@ class Job {
             if (data.hasOwnProperty('worker_tag')) {
                 obj['worker_tag'] = ApiClient.convertToType(data['worker_tag'], 'String');
             }
+            if (data.hasOwnProperty('depends_on')) {
+                obj['depends_on'] = ApiClient.convertToType(data['depends_on'], ['String']);
+            }
+            if (data.hasOwnProperty('not_before')) {
+                obj['not_before'] = ApiClient.convertToType(data['not_before'], 'Date');
+            }
+            if (data.hasOwnProperty('max_workers')) {
+                obj['max_workers'] = ApiClient.convertToType(data['max_workers'], 'Number');
+            }
+            if (data.hasOwnProperty('retry_policy')) {
+                obj['retry_policy'] = JobRetryPolicy.constructFromObject(data['retry_policy']);
+            }
             if (data.hasOwnProperty('id')) {
                 obj['id'] = ApiClient.convertToType(data['id'], 'String');
             }
@@ -175,6 +188,29 @@ Job.prototype['storage'] = undefined;
  */
 Job.prototype['worker_tag'] = undefined;
 
+/**
+ * UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well. 
+ * @member {Array.<String>} depends_on
+ */
+Job.prototype['depends_on'] = undefined;
+
+/**
+ * Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately. 
+ * @member {Date} not_before
+ */
+Job.prototype['not_before'] = undefined;
+
+/**
+ * Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit. 
+ * @member {Number} max_workers
+ */
+Job.prototype['max_workers'] = undefined;
+
+/**
+ * @member {module:model/JobRetryPolicy} retry_policy
+ */
+Job.prototype['retry_policy'] = undefined;
+
 /**
  * UUID of the Job
  * @member {String} id
@@ -253,6 +289,25 @@ SubmittedJob.prototype['storage'] = undefined;
  * @member {String} worker_tag
  */
 SubmittedJob.prototype['worker_tag'] = undefined;
+/**
+ * UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well. 
+ * @member {Array.<String>} depends_on
+ */
+SubmittedJob.prototype['depends_on'] = undefined;
+/**
+ * Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately. 
+ * @member {Date} not_before
+ */
+SubmittedJob.prototype['not_before'] = undefined;
+/**
+ * Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit. 
+ * @member {Number} max_workers
+ */
+SubmittedJob.prototype['max_workers'] = undefined;
+/**
+ * @member {module:model/JobRetryPolicy} retry_policy
+ */
+SubmittedJob.prototype['retry_policy'] = undefined;
 // Implement JobAllOf interface:
 /**
  * UUID of the Job
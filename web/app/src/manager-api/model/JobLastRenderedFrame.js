@@ -0,0 +1,93 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobLastRenderedFrame model module.
+ * @module model/JobLastRenderedFrame
+ * @version 0.0.0
+ */
+class JobLastRenderedFrame {
+    /**
+     * Constructs a new <code>JobLastRenderedFrame</code>.
+     * @alias module:model/JobLastRenderedFrame
+     * @param frame {Number} 
+     * @param filename {String} 
+     * @param renderedAt {Date} 
+     */
+    constructor(frame, filename, renderedAt) { 
+        
+        JobLastRenderedFrame.initialize(this, frame, filename, renderedAt);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, frame, filename, renderedAt) { 
+        obj['frame'] = frame;
+        obj['filename'] = filename;
+        obj['rendered_at'] = renderedAt;
+    }
+
+    /**
+     * Constructs a <code>JobLastRenderedFrame</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobLastRenderedFrame} obj Optional instance to populate.
+     * @return {module:model/JobLastRenderedFrame} The populated <code>JobLastRenderedFrame</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobLastRenderedFrame();
+
+            if (data.hasOwnProperty('frame')) {
+                obj['frame'] = ApiClient.convertToType(data['frame'], 'Number');
+            }
+            if (data.hasOwnProperty('filename')) {
+                obj['filename'] = ApiClient.convertToType(data['filename'], 'String');
+            }
+            if (data.hasOwnProperty('rendered_at')) {
+                obj['rendered_at'] = ApiClient.convertToType(data['rendered_at'], 'Date');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Number} frame
+ */
+JobLastRenderedFrame.prototype['frame'] = undefined;
+
+/**
+ * @member {String} filename
+ */
+JobLastRenderedFrame.prototype['filename'] = undefined;
+
+/**
+ * @member {Date} rendered_at
+ */
+JobLastRenderedFrame.prototype['rendered_at'] = undefined;
+
+
+
+
+
+
+export default JobLastRenderedFrame;
+
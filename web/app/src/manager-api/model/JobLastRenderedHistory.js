@@ -0,0 +1,85 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import JobLastRenderedFrame from './JobLastRenderedFrame';
+
+/**
+ * The JobLastRenderedHistory model module.
+ * @module model/JobLastRenderedHistory
+ * @version 0.0.0
+ */
+class JobLastRenderedHistory {
+    /**
+     * Constructs a new <code>JobLastRenderedHistory</code>.
+     * Thumbnails of previously rendered frames of a job, ordered by frame number. To construct the URL of a thumbnail, concatenate \&quot;{base}/{filename}\&quot;. 
+     * @alias module:model/JobLastRenderedHistory
+     * @param base {String} 
+     * @param frames {Array.<module:model/JobLastRenderedFrame>} 
+     */
+    constructor(base, frames) { 
+        
+        JobLastRenderedHistory.initialize(this, base, frames);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, base, frames) { 
+        obj['base'] = base;
+        obj['frames'] = frames;
+    }
+
+    /**
+     * Constructs a <code>JobLastRenderedHistory</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobLastRenderedHistory} obj Optional instance to populate.
+     * @return {module:model/JobLastRenderedHistory} The populated <code>JobLastRenderedHistory</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobLastRenderedHistory();
+
+            if (data.hasOwnProperty('base')) {
+                obj['base'] = ApiClient.convertToType(data['base'], 'String');
+            }
+            if (data.hasOwnProperty('frames')) {
+                obj['frames'] = ApiClient.convertToType(data['frames'], [JobLastRenderedFrame]);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} base
+ */
+JobLastRenderedHistory.prototype['base'] = undefined;
+
+/**
+ * @member {Array.<module:model/JobLastRenderedFrame>} frames
+ */
+JobLastRenderedHistory.prototype['frames'] = undefined;
+
+
+
+
+
+
+export default JobLastRenderedHistory;
+
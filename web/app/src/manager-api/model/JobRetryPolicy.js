@@ -0,0 +1,100 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobRetryPolicy model module.
+ * @module model/JobRetryPolicy
+ * @version 0.0.0
+ */
+class JobRetryPolicy {
+    /**
+     * Constructs a new <code>JobRetryPolicy</code>.
+     * Determines how failed tasks of a job are retried. Properties that are omitted keep the value determined by the job type. 
+     * @alias module:model/JobRetryPolicy
+     */
+    constructor() { 
+        
+        JobRetryPolicy.initialize(this);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj) { 
+    }
+
+    /**
+     * Constructs a <code>JobRetryPolicy</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobRetryPolicy} obj Optional instance to populate.
+     * @return {module:model/JobRetryPolicy} The populated <code>JobRetryPolicy</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobRetryPolicy();
+
+            if (data.hasOwnProperty('max_failures')) {
+                obj['max_failures'] = ApiClient.convertToType(data['max_failures'], 'Number');
+            }
+            if (data.hasOwnProperty('delay_seconds')) {
+                obj['delay_seconds'] = ApiClient.convertToType(data['delay_seconds'], 'Number');
+            }
+            if (data.hasOwnProperty('max_delay_seconds')) {
+                obj['max_delay_seconds'] = ApiClient.convertToType(data['max_delay_seconds'], 'Number');
+            }
+            if (data.hasOwnProperty('allow_same_worker')) {
+                obj['allow_same_worker'] = ApiClient.convertToType(data['allow_same_worker'], 'Boolean');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Number of failures after which a task is hard-failed. Zero means the Manager's `task_fail_after_softfail_count` setting is used. 
+ * @member {Number} max_failures
+ */
+JobRetryPolicy.prototype['max_failures'] = undefined;
+
+/**
+ * Number of seconds a soft-failed task waits before it can be scheduled again. The delay doubles with every subsequent failure of the task. 
+ * @member {Number} delay_seconds
+ */
+JobRetryPolicy.prototype['delay_seconds'] = undefined;
+
+/**
+ * Maximum delay in seconds. Zero means no maximum.
+ * @member {Number} max_delay_seconds
+ */
+JobRetryPolicy.prototype['max_delay_seconds'] = undefined;
+
+/**
+ * Allow a Worker to retry a task it failed before. When false, each retry is done by a different Worker. 
+ * @member {Boolean} allow_same_worker
+ */
+JobRetryPolicy.prototype['allow_same_worker'] = undefined;
+
+
+
+
+
+
+export default JobRetryPolicy;
+
@@ -0,0 +1,232 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import JobTemplateAllOf from './JobTemplateAllOf';
+import SubmittedJob from './SubmittedJob';
+import SubmittedJobTemplate from './SubmittedJobTemplate';
+
+/**
+ * The JobTemplate model module.
+ * @module model/JobTemplate
+ * @version 0.0.0
+ */
+class JobTemplate {
+    /**
+     * Constructs a new <code>JobTemplate</code>.
+     * @alias module:model/JobTemplate
+     * @implements module:model/SubmittedJobTemplate
+     * @implements module:model/JobTemplateAllOf
+     * @param name {String} 
+     * @param cron {String} Cron expression, with the fields \"minute hour day-of-month month day-of-week\", evaluated in the timezone of the Manager. The macros `@yearly`, `@monthly`, `@weekly`, `@daily`, and `@hourly` are supported as well. 
+     * @param isActive {Boolean} Only active templates submit jobs.
+     * @param job {module:model/SubmittedJob} 
+     * @param id {String} UUID of the job template.
+     * @param created {Date} 
+     * @param updated {Date} 
+     */
+    constructor(name, cron, isActive, job, id, created, updated) { 
+        SubmittedJobTemplate.initialize(this, name, cron, isActive, job);JobTemplateAllOf.initialize(this, id, created, updated);
+        JobTemplate.initialize(this, name, cron, isActive, job, id, created, updated);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, name, cron, isActive, job, id, created, updated) { 
+        obj['name'] = name;
+        obj['cron'] = cron;
+        obj['is_active'] = isActive;
+        obj['job'] = job;
+        obj['id'] = id;
+        obj['created'] = created;
+        obj['updated'] = updated;
+    }
+
+    /**
+     * Constructs a <code>JobTemplate</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobTemplate} obj Optional instance to populate.
+     * @return {module:model/JobTemplate} The populated <code>JobTemplate</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobTemplate();
+            SubmittedJobTemplate.constructFromObject(data, obj);
+            JobTemplateAllOf.constructFromObject(data, obj);
+
+            if (data.hasOwnProperty('name')) {
+                obj['name'] = ApiClient.convertToType(data['name'], 'String');
+            }
+            if (data.hasOwnProperty('cron')) {
+                obj['cron'] = ApiClient.convertToType(data['cron'], 'String');
+            }
+            if (data.hasOwnProperty('is_active')) {
+                obj['is_active'] = ApiClient.convertToType(data['is_active'], 'Boolean');
+            }
+            if (data.hasOwnProperty('job')) {
+                obj['job'] = SubmittedJob.constructFromObject(data['job']);
+            }
+            if (data.hasOwnProperty('id')) {
+                obj['id'] = ApiClient.convertToType(data['id'], 'String');
+            }
+            if (data.hasOwnProperty('created')) {
+                obj['created'] = ApiClient.convertToType(data['created'], 'Date');
+            }
+            if (data.hasOwnProperty('updated')) {
+                obj['updated'] = ApiClient.convertToType(data['updated'], 'Date');
+            }
+            if (data.hasOwnProperty('next_run')) {
+                obj['next_run'] = ApiClient.convertToType(data['next_run'], 'Date');
+            }
+            if (data.hasOwnProperty('last_run')) {
+                obj['last_run'] = ApiClient.convertToType(data['last_run'], 'Date');
+            }
+            if (data.hasOwnProperty('last_job_id')) {
+                obj['last_job_id'] = ApiClient.convertToType(data['last_job_id'], 'String');
+            }
+            if (data.hasOwnProperty('last_error')) {
+                obj['last_error'] = ApiClient.convertToType(data['last_error'], 'String');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} name
+ */
+JobTemplate.prototype['name'] = undefined;
+
+/**
+ * Cron expression, with the fields \"minute hour day-of-month month day-of-week\", evaluated in the timezone of the Manager. The macros `@yearly`, `@monthly`, `@weekly`, `@daily`, and `@hourly` are supported as well. 
+ * @member {String} cron
+ */
+JobTemplate.prototype['cron'] = undefined;
+
+/**
+ * Only active templates submit jobs.
+ * @member {Boolean} is_active
+ */
+JobTemplate.prototype['is_active'] = undefined;
+
+/**
+ * @member {module:model/SubmittedJob} job
+ */
+JobTemplate.prototype['job'] = undefined;
+
+/**
+ * UUID of the job template.
+ * @member {String} id
+ */
+JobTemplate.prototype['id'] = undefined;
+
+/**
+ * @member {Date} created
+ */
+JobTemplate.prototype['created'] = undefined;
+
+/**
+ * @member {Date} updated
+ */
+JobTemplate.prototype['updated'] = undefined;
+
+/**
+ * When the next job will be submitted. Only set for active templates.
+ * @member {Date} next_run
+ */
+JobTemplate.prototype['next_run'] = undefined;
+
+/**
+ * When the template last tried to submit a job.
+ * @member {Date} last_run
+ */
+JobTemplate.prototype['last_run'] = undefined;
+
+/**
+ * The job that was submitted by the last run.
+ * @member {String} last_job_id
+ */
+JobTemplate.prototype['last_job_id'] = undefined;
+
+/**
+ * Why the last run could not submit a job.
+ * @member {String} last_error
+ */
+JobTemplate.prototype['last_error'] = undefined;
+
+
+// Implement SubmittedJobTemplate interface:
+/**
+ * @member {String} name
+ */
+SubmittedJobTemplate.prototype['name'] = undefined;
+/**
+ * Cron expression, with the fields \"minute hour day-of-month month day-of-week\", evaluated in the timezone of the Manager. The macros `@yearly`, `@monthly`, `@weekly`, `@daily`, and `@hourly` are supported as well. 
+ * @member {String} cron
+ */
+SubmittedJobTemplate.prototype['cron'] = undefined;
+/**
+ * Only active templates submit jobs.
+ * @member {Boolean} is_active
+ */
+SubmittedJobTemplate.prototype['is_active'] = undefined;
+/**
+ * @member {module:model/SubmittedJob} job
+ */
+SubmittedJobTemplate.prototype['job'] = undefined;
+// Implement JobTemplateAllOf interface:
+/**
+ * UUID of the job template.
+ * @member {String} id
+ */
+JobTemplateAllOf.prototype['id'] = undefined;
+/**
+ * @member {Date} created
+ */
+JobTemplateAllOf.prototype['created'] = undefined;
+/**
+ * @member {Date} updated
+ */
+JobTemplateAllOf.prototype['updated'] = undefined;
+/**
+ * When the next job will be submitted. Only set for active templates.
+ * @member {Date} next_run
+ */
+JobTemplateAllOf.prototype['next_run'] = undefined;
+/**
+ * When the template last tried to submit a job.
+ * @member {Date} last_run
+ */
+JobTemplateAllOf.prototype['last_run'] = undefined;
+/**
+ * The job that was submitted by the last run.
+ * @member {String} last_job_id
+ */
+JobTemplateAllOf.prototype['last_job_id'] = undefined;
+/**
+ * Why the last run could not submit a job.
+ * @member {String} last_error
+ */
+JobTemplateAllOf.prototype['last_error'] = undefined;
+
+
+
+
+export default JobTemplate;
+
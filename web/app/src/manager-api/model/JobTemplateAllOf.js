@@ -0,0 +1,130 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobTemplateAllOf model module.
+ * @module model/JobTemplateAllOf
+ * @version 0.0.0
+ */
+class JobTemplateAllOf {
+    /**
+     * Constructs a new <code>JobTemplateAllOf</code>.
+     * @alias module:model/JobTemplateAllOf
+     * @param id {String} UUID of the job template.
+     * @param created {Date} 
+     * @param updated {Date} 
+     */
+    constructor(id, created, updated) { 
+        
+        JobTemplateAllOf.initialize(this, id, created, updated);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, id, created, updated) { 
+        obj['id'] = id;
+        obj['created'] = created;
+        obj['updated'] = updated;
+    }
+
+    /**
+     * Constructs a <code>JobTemplateAllOf</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobTemplateAllOf} obj Optional instance to populate.
+     * @return {module:model/JobTemplateAllOf} The populated <code>JobTemplateAllOf</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobTemplateAllOf();
+
+            if (data.hasOwnProperty('id')) {
+                obj['id'] = ApiClient.convertToType(data['id'], 'String');
+            }
+            if (data.hasOwnProperty('created')) {
+                obj['created'] = ApiClient.convertToType(data['created'], 'Date');
+            }
+            if (data.hasOwnProperty('updated')) {
+                obj['updated'] = ApiClient.convertToType(data['updated'], 'Date');
+            }
+            if (data.hasOwnProperty('next_run')) {
+                obj['next_run'] = ApiClient.convertToType(data['next_run'], 'Date');
+            }
+            if (data.hasOwnProperty('last_run')) {
+                obj['last_run'] = ApiClient.convertToType(data['last_run'], 'Date');
+            }
+            if (data.hasOwnProperty('last_job_id')) {
+                obj['last_job_id'] = ApiClient.convertToType(data['last_job_id'], 'String');
+            }
+            if (data.hasOwnProperty('last_error')) {
+                obj['last_error'] = ApiClient.convertToType(data['last_error'], 'String');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * UUID of the job template.
+ * @member {String} id
+ */
+JobTemplateAllOf.prototype['id'] = undefined;
+
+/**
+ * @member {Date} created
+ */
+JobTemplateAllOf.prototype['created'] = undefined;
+
+/**
+ * @member {Date} updated
+ */
+JobTemplateAllOf.prototype['updated'] = undefined;
+
+/**
+ * When the next job will be submitted. Only set for active templates.
+ * @member {Date} next_run
+ */
+JobTemplateAllOf.prototype['next_run'] = undefined;
+
+/**
+ * When the template last tried to submit a job.
+ * @member {Date} last_run
+ */
+JobTemplateAllOf.prototype['last_run'] = undefined;
+
+/**
+ * The job that was submitted by the last run.
+ * @member {String} last_job_id
+ */
+JobTemplateAllOf.prototype['last_job_id'] = undefined;
+
+/**
+ * Why the last run could not submit a job.
+ * @member {String} last_error
+ */
+JobTemplateAllOf.prototype['last_error'] = undefined;
+
+
+
+
+
+
+export default JobTemplateAllOf;
+
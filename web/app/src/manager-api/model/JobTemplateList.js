@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import JobTemplate from './JobTemplate';
+
+/**
+ * The JobTemplateList model module.
+ * @module model/JobTemplateList
+ * @version 0.0.0
+ */
+class JobTemplateList {
+    /**
+     * Constructs a new <code>JobTemplateList</code>.
+     * @alias module:model/JobTemplateList
+     * @param templates {Array.<module:model/JobTemplate>} 
+     */
+    constructor(templates) { 
+        
+        JobTemplateList.initialize(this, templates);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, templates) { 
+        obj['templates'] = templates;
+    }
+
+    /**
+     * Constructs a <code>JobTemplateList</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobTemplateList} obj Optional instance to populate.
+     * @return {module:model/JobTemplateList} The populated <code>JobTemplateList</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobTemplateList();
+
+            if (data.hasOwnProperty('templates')) {
+                obj['templates'] = ApiClient.convertToType(data['templates'], [JobTemplate]);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Array.<module:model/JobTemplate>} templates
+ */
+JobTemplateList.prototype['templates'] = undefined;
+
+
+
+
+
+
+export default JobTemplateList;
+
@@ -0,0 +1,130 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The LastRenderedQueueInfo model module.
+ * @module model/LastRenderedQueueInfo
+ * @version 0.0.0
+ */
+class LastRenderedQueueInfo {
+    /**
+     * Constructs a new <code>LastRenderedQueueInfo</code>.
+     * Metrics of the queue of last-rendered images. Each job has at most one image queued; newer images replace older ones. 
+     * @alias module:model/LastRenderedQueueInfo
+     * @param queuedJobs {Array.<String>} IDs of the jobs that have an image queued, in processing order.
+     * @param maxQueuedJobs {Number} Maximum number of jobs that can have an image queued.
+     * @param imagesQueued {Number} Number of images accepted into the queue since the Manager started.
+     * @param imagesReplaced {Number} Number of queued images that were replaced by a newer image of the same job.
+     * @param imagesRejected {Number} Number of images rejected because the queue was full.
+     * @param imagesProcessed {Number} Number of images taken from the queue for processing.
+     */
+    constructor(queuedJobs, maxQueuedJobs, imagesQueued, imagesReplaced, imagesRejected, imagesProcessed) { 
+        
+        LastRenderedQueueInfo.initialize(this, queuedJobs, maxQueuedJobs, imagesQueued, imagesReplaced, imagesRejected, imagesProcessed);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, queuedJobs, maxQueuedJobs, imagesQueued, imagesReplaced, imagesRejected, imagesProcessed) { 
+        obj['queued_jobs'] = queuedJobs;
+        obj['max_queued_jobs'] = maxQueuedJobs;
+        obj['images_queued'] = imagesQueued;
+        obj['images_replaced'] = imagesReplaced;
+        obj['images_rejected'] = imagesRejected;
+        obj['images_processed'] = imagesProcessed;
+    }
+
+    /**
+     * Constructs a <code>LastRenderedQueueInfo</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/LastRenderedQueueInfo} obj Optional instance to populate.
+     * @return {module:model/LastRenderedQueueInfo} The populated <code>LastRenderedQueueInfo</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new LastRenderedQueueInfo();
+
+            if (data.hasOwnProperty('queued_jobs')) {
+                obj['queued_jobs'] = ApiClient.convertToType(data['queued_jobs'], ['String']);
+            }
+            if (data.hasOwnProperty('max_queued_jobs')) {
+                obj['max_queued_jobs'] = ApiClient.convertToType(data['max_queued_jobs'], 'Number');
+            }
+            if (data.hasOwnProperty('images_queued')) {
+                obj['images_queued'] = ApiClient.convertToType(data['images_queued'], 'Number');
+            }
+            if (data.hasOwnProperty('images_replaced')) {
+                obj['images_replaced'] = ApiClient.convertToType(data['images_replaced'], 'Number');
+            }
+            if (data.hasOwnProperty('images_rejected')) {
+                obj['images_rejected'] = ApiClient.convertToType(data['images_rejected'], 'Number');
+            }
+            if (data.hasOwnProperty('images_processed')) {
+                obj['images_processed'] = ApiClient.convertToType(data['images_processed'], 'Number');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * IDs of the jobs that have an image queued, in processing order.
+ * @member {Array.<String>} queued_jobs
+ */
+LastRenderedQueueInfo.prototype['queued_jobs'] = undefined;
+
+/**
+ * Maximum number of jobs that can have an image queued.
+ * @member {Number} max_queued_jobs
+ */
+LastRenderedQueueInfo.prototype['max_queued_jobs'] = undefined;
+
+/**
+ * Number of images accepted into the queue since the Manager started.
+ * @member {Number} images_queued
+ */
+LastRenderedQueueInfo.prototype['images_queued'] = undefined;
+
+/**
+ * Number of queued images that were replaced by a newer image of the same job.
+ * @member {Number} images_replaced
+ */
+LastRenderedQueueInfo.prototype['images_replaced'] = undefined;
+
+/**
+ * Number of images rejected because the queue was full.
+ * @member {Number} images_rejected
+ */
+LastRenderedQueueInfo.prototype['images_rejected'] = undefined;
+
+/**
+ * Number of images taken from the queue for processing.
+ * @member {Number} images_processed
+ */
+LastRenderedQueueInfo.prototype['images_processed'] = undefined;
+
+
+
+
+
+
+export default LastRenderedQueueInfo;
+
@@ -0,0 +1,86 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The ShamanChunkSpec model module.
+ * @module model/ShamanChunkSpec
+ * @version 0.0.0
+ */
+class ShamanChunkSpec {
+    /**
+     * Constructs a new <code>ShamanChunkSpec</code>.
+     * Specification of a chunk of a file. Chunks are uploaded and stored just like files, by their SHA256 checksum and size. 
+     * @alias module:model/ShamanChunkSpec
+     * @param sha {String} SHA256 checksum of the chunk
+     * @param size {Number} Chunk size in bytes
+     */
+    constructor(sha, size) { 
+        
+        ShamanChunkSpec.initialize(this, sha, size);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, sha, size) { 
+        obj['sha'] = sha;
+        obj['size'] = size;
+    }
+
+    /**
+     * Constructs a <code>ShamanChunkSpec</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/ShamanChunkSpec} obj Optional instance to populate.
+     * @return {module:model/ShamanChunkSpec} The populated <code>ShamanChunkSpec</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new ShamanChunkSpec();
+
+            if (data.hasOwnProperty('sha')) {
+                obj['sha'] = ApiClient.convertToType(data['sha'], 'String');
+            }
+            if (data.hasOwnProperty('size')) {
+                obj['size'] = ApiClient.convertToType(data['size'], 'Number');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * SHA256 checksum of the chunk
+ * @member {String} sha
+ */
+ShamanChunkSpec.prototype['sha'] = undefined;
+
+/**
+ * Chunk size in bytes
+ * @member {Number} size
+ */
+ShamanChunkSpec.prototype['size'] = undefined;
+
+
+
+
+
+
+export default ShamanChunkSpec;
+
@@ -0,0 +1,105 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The ShamanChunking model module.
+ * @module model/ShamanChunking
+ * @version 0.0.0
+ */
+class ShamanChunking {
+    /**
+     * Constructs a new <code>ShamanChunking</code>.
+     * Parameters for uploading files as content-defined chunks. Only present when chunking is enabled on the Shaman server. Chunk boundaries are found with a rolling &#39;gear&#39; hash; see the &#x60;pkg/shaman/chunker&#x60; Go package for the exact algorithm. Clients must split files with these parameters, for their chunks to be deduplicated. 
+     * @alias module:model/ShamanChunking
+     * @param minFileSize {Number} Only files of at least this size, in bytes, can be uploaded as chunks.
+     * @param minChunkSize {Number} 
+     * @param avgChunkSize {Number} 
+     * @param maxChunkSize {Number} 
+     */
+    constructor(minFileSize, minChunkSize, avgChunkSize, maxChunkSize) { 
+        
+        ShamanChunking.initialize(this, minFileSize, minChunkSize, avgChunkSize, maxChunkSize);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, minFileSize, minChunkSize, avgChunkSize, maxChunkSize) { 
+        obj['min_file_size'] = minFileSize;
+        obj['min_chunk_size'] = minChunkSize;
+        obj['avg_chunk_size'] = avgChunkSize;
+        obj['max_chunk_size'] = maxChunkSize;
+    }
+
+    /**
+     * Constructs a <code>ShamanChunking</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/ShamanChunking} obj Optional instance to populate.
+     * @return {module:model/ShamanChunking} The populated <code>ShamanChunking</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new ShamanChunking();
+
+            if (data.hasOwnProperty('min_file_size')) {
+                obj['min_file_size'] = ApiClient.convertToType(data['min_file_size'], 'Number');
+            }
+            if (data.hasOwnProperty('min_chunk_size')) {
+                obj['min_chunk_size'] = ApiClient.convertToType(data['min_chunk_size'], 'Number');
+            }
+            if (data.hasOwnProperty('avg_chunk_size')) {
+                obj['avg_chunk_size'] = ApiClient.convertToType(data['avg_chunk_size'], 'Number');
+            }
+            if (data.hasOwnProperty('max_chunk_size')) {
+                obj['max_chunk_size'] = ApiClient.convertToType(data['max_chunk_size'], 'Number');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Only files of at least this size, in bytes, can be uploaded as chunks.
+ * @member {Number} min_file_size
+ */
+ShamanChunking.prototype['min_file_size'] = undefined;
+
+/**
+ * @member {Number} min_chunk_size
+ */
+ShamanChunking.prototype['min_chunk_size'] = undefined;
+
+/**
+ * @member {Number} avg_chunk_size
+ */
+ShamanChunking.prototype['avg_chunk_size'] = undefined;
+
+/**
+ * @member {Number} max_chunk_size
+ */
+ShamanChunking.prototype['max_chunk_size'] = undefined;
+
+
+
+
+
+
+export default ShamanChunking;
+
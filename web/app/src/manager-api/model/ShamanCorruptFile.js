@@ -0,0 +1,137 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The ShamanCorruptFile model module.
+ * @module model/ShamanCorruptFile
+ * @version 0.0.0
+ */
+class ShamanCorruptFile {
+    /**
+     * Constructs a new <code>ShamanCorruptFile</code>.
+     * A file in the Shaman file store whose contents do not match its checksum and size. 
+     * @alias module:model/ShamanCorruptFile
+     * @param checksum {String} SHA256 checksum the file should have.
+     * @param size {Number} Size in bytes the file should have.
+     * @param actualChecksum {String} 
+     * @param actualSize {Number} 
+     * @param path {String} Where the file was found in the file store.
+     * @param referencedBy {Array.<String>} Paths of checkouts that link to this file. These checkouts should be recreated. Checkouts that contain a copy of the file cannot be found this way. 
+     */
+    constructor(checksum, size, actualChecksum, actualSize, path, referencedBy) { 
+        
+        ShamanCorruptFile.initialize(this, checksum, size, actualChecksum, actualSize, path, referencedBy);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, checksum, size, actualChecksum, actualSize, path, referencedBy) { 
+        obj['checksum'] = checksum;
+        obj['size'] = size;
+        obj['actual_checksum'] = actualChecksum;
+        obj['actual_size'] = actualSize;
+        obj['path'] = path;
+        obj['referenced_by'] = referencedBy;
+    }
+
+    /**
+     * Constructs a <code>ShamanCorruptFile</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/ShamanCorruptFile} obj Optional instance to populate.
+     * @return {module:model/ShamanCorruptFile} The populated <code>ShamanCorruptFile</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new ShamanCorruptFile();
+
+            if (data.hasOwnProperty('checksum')) {
+                obj['checksum'] = ApiClient.convertToType(data['checksum'], 'String');
+            }
+            if (data.hasOwnProperty('size')) {
+                obj['size'] = ApiClient.convertToType(data['size'], 'Number');
+            }
+            if (data.hasOwnProperty('actual_checksum')) {
+                obj['actual_checksum'] = ApiClient.convertToType(data['actual_checksum'], 'String');
+            }
+            if (data.hasOwnProperty('actual_size')) {
+                obj['actual_size'] = ApiClient.convertToType(data['actual_size'], 'Number');
+            }
+            if (data.hasOwnProperty('path')) {
+                obj['path'] = ApiClient.convertToType(data['path'], 'String');
+            }
+            if (data.hasOwnProperty('quarantine_path')) {
+                obj['quarantine_path'] = ApiClient.convertToType(data['quarantine_path'], 'String');
+            }
+            if (data.hasOwnProperty('referenced_by')) {
+                obj['referenced_by'] = ApiClient.convertToType(data['referenced_by'], ['String']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * SHA256 checksum the file should have.
+ * @member {String} checksum
+ */
+ShamanCorruptFile.prototype['checksum'] = undefined;
+
+/**
+ * Size in bytes the file should have.
+ * @member {Number} size
+ */
+ShamanCorruptFile.prototype['size'] = undefined;
+
+/**
+ * @member {String} actual_checksum
+ */
+ShamanCorruptFile.prototype['actual_checksum'] = undefined;
+
+/**
+ * @member {Number} actual_size
+ */
+ShamanCorruptFile.prototype['actual_size'] = undefined;
+
+/**
+ * Where the file was found in the file store.
+ * @member {String} path
+ */
+ShamanCorruptFile.prototype['path'] = undefined;
+
+/**
+ * Where the file was moved to. Absent when it could not be moved out of the file store. 
+ * @member {String} quarantine_path
+ */
+ShamanCorruptFile.prototype['quarantine_path'] = undefined;
+
+/**
+ * Paths of checkouts that link to this file. These checkouts should be recreated. Checkouts that contain a copy of the file cannot be found this way. 
+ * @member {Array.<String>} referenced_by
+ */
+ShamanCorruptFile.prototype['referenced_by'] = undefined;
+
+
+
+
+
+
+export default ShamanCorruptFile;
+
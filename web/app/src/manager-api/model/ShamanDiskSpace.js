@@ -0,0 +1,104 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The ShamanDiskSpace model module.
+ * @module model/ShamanDiskSpace
+ * @version 0.0.0
+ */
+class ShamanDiskSpace {
+    /**
+     * Constructs a new <code>ShamanDiskSpace</code>.
+     * Free disk space of a Shaman storage directory.
+     * @alias module:model/ShamanDiskSpace
+     * @param path {String} 
+     * @param freeBytes {Number} 
+     * @param isLow {Boolean} Whether the free disk space is below the configured minimum. For the file store, this means that uploads are refused. 
+     */
+    constructor(path, freeBytes, isLow) { 
+        
+        ShamanDiskSpace.initialize(this, path, freeBytes, isLow);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, path, freeBytes, isLow) { 
+        obj['path'] = path;
+        obj['free_bytes'] = freeBytes;
+        obj['is_low'] = isLow;
+    }
+
+    /**
+     * Constructs a <code>ShamanDiskSpace</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/ShamanDiskSpace} obj Optional instance to populate.
+     * @return {module:model/ShamanDiskSpace} The populated <code>ShamanDiskSpace</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new ShamanDiskSpace();
+
+            if (data.hasOwnProperty('path')) {
+                obj['path'] = ApiClient.convertToType(data['path'], 'String');
+            }
+            if (data.hasOwnProperty('free_bytes')) {
+                obj['free_bytes'] = ApiClient.convertToType(data['free_bytes'], 'Number');
+            }
+            if (data.hasOwnProperty('is_low')) {
+                obj['is_low'] = ApiClient.convertToType(data['is_low'], 'Boolean');
+            }
+            if (data.hasOwnProperty('checked_at')) {
+                obj['checked_at'] = ApiClient.convertToType(data['checked_at'], 'Date');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} path
+ */
+ShamanDiskSpace.prototype['path'] = undefined;
+
+/**
+ * @member {Number} free_bytes
+ */
+ShamanDiskSpace.prototype['free_bytes'] = undefined;
+
+/**
+ * Whether the free disk space is below the configured minimum. For the file store, this means that uploads are refused. 
+ * @member {Boolean} is_low
+ */
+ShamanDiskSpace.prototype['is_low'] = undefined;
+
+/**
+ * When the free disk space was determined. Absent when it could not be determined. 
+ * @member {Date} checked_at
+ */
+ShamanDiskSpace.prototype['checked_at'] = undefined;
+
+
+
+
+
+
+export default ShamanDiskSpace;
+
@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import ShamanChunkSpec from './ShamanChunkSpec';
 
 /**
  * The ShamanFileSpec model module.
@@ -63,6 +64,9 @@ class ShamanFileSpec {
             if (data.hasOwnProperty('path')) {
                 obj['path'] = ApiClient.convertToType(data['path'], 'String');
             }
+            if (data.hasOwnProperty('chunks')) {
+                obj['chunks'] = ApiClient.convertToType(data['chunks'], [ShamanChunkSpec]);
+            }
         }
         return obj;
     }
@@ -88,6 +92,12 @@ ShamanFileSpec.prototype['size'] = undefined;
  */
 ShamanFileSpec.prototype['path'] = undefined;
 
+/**
+ * The file's content-defined chunks, in order. When the Shaman server has chunking enabled, large files can be uploaded as these chunks instead of as a whole. See `ShamanChunking` for how to split files. 
+ * @member {Array.<module:model/ShamanChunkSpec>} chunks
+ */
+ShamanFileSpec.prototype['chunks'] = undefined;
+
 
 
 
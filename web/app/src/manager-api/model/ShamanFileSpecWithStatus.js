@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import ShamanChunkSpec from './ShamanChunkSpec';
 import ShamanFileStatus from './ShamanFileStatus';
 
 /**
@@ -69,6 +70,9 @@ class ShamanFileSpecWithStatus {
             if (data.hasOwnProperty('status')) {
                 obj['status'] = ShamanFileStatus.constructFromObject(data['status']);
             }
+            if (data.hasOwnProperty('chunks')) {
+                obj['chunks'] = ApiClient.convertToType(data['chunks'], [ShamanChunkSpec]);
+            }
         }
         return obj;
     }
@@ -99,6 +103,12 @@ ShamanFileSpecWithStatus.prototype['path'] = undefined;
  */
 ShamanFileSpecWithStatus.prototype['status'] = undefined;
 
+/**
+ * Present when the file should be uploaded as chunks, instead of as a whole. Only the chunks that are unknown to the Shaman server are listed, so this can be empty. The file is assembled from its chunks in the background, once the checkout is requested. 
+ * @member {Array.<module:model/ShamanChunkSpec>} chunks
+ */
+ShamanFileSpecWithStatus.prototype['chunks'] = undefined;
+
 
 
 
@@ -0,0 +1,115 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import ShamanCorruptFile from './ShamanCorruptFile';
+
+/**
+ * The ShamanScrubReport model module.
+ * @module model/ShamanScrubReport
+ * @version 0.0.0
+ */
+class ShamanScrubReport {
+    /**
+     * Constructs a new <code>ShamanScrubReport</code>.
+     * Results of an integrity check of the Shaman file store.
+     * @alias module:model/ShamanScrubReport
+     * @param startedAt {Date} 
+     * @param finishedAt {Date} 
+     * @param numFilesChecked {Number} 
+     * @param bytesChecked {Number} 
+     * @param corruptFiles {Array.<module:model/ShamanCorruptFile>} 
+     */
+    constructor(startedAt, finishedAt, numFilesChecked, bytesChecked, corruptFiles) { 
+        
+        ShamanScrubReport.initialize(this, startedAt, finishedAt, numFilesChecked, bytesChecked, corruptFiles);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, startedAt, finishedAt, numFilesChecked, bytesChecked, corruptFiles) { 
+        obj['started_at'] = startedAt;
+        obj['finished_at'] = finishedAt;
+        obj['num_files_checked'] = numFilesChecked;
+        obj['bytes_checked'] = bytesChecked;
+        obj['corrupt_files'] = corruptFiles;
+    }
+
+    /**
+     * Constructs a <code>ShamanScrubReport</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/ShamanScrubReport} obj Optional instance to populate.
+     * @return {module:model/ShamanScrubReport} The populated <code>ShamanScrubReport</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new ShamanScrubReport();
+
+            if (data.hasOwnProperty('started_at')) {
+                obj['started_at'] = ApiClient.convertToType(data['started_at'], 'Date');
+            }
+            if (data.hasOwnProperty('finished_at')) {
+                obj['finished_at'] = ApiClient.convertToType(data['finished_at'], 'Date');
+            }
+            if (data.hasOwnProperty('num_files_checked')) {
+                obj['num_files_checked'] = ApiClient.convertToType(data['num_files_checked'], 'Number');
+            }
+            if (data.hasOwnProperty('bytes_checked')) {
+                obj['bytes_checked'] = ApiClient.convertToType(data['bytes_checked'], 'Number');
+            }
+            if (data.hasOwnProperty('corrupt_files')) {
+                obj['corrupt_files'] = ApiClient.convertToType(data['corrupt_files'], [ShamanCorruptFile]);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Date} started_at
+ */
+ShamanScrubReport.prototype['started_at'] = undefined;
+
+/**
+ * @member {Date} finished_at
+ */
+ShamanScrubReport.prototype['finished_at'] = undefined;
+
+/**
+ * @member {Number} num_files_checked
+ */
+ShamanScrubReport.prototype['num_files_checked'] = undefined;
+
+/**
+ * @member {Number} bytes_checked
+ */
+ShamanScrubReport.prototype['bytes_checked'] = undefined;
+
+/**
+ * @member {Array.<module:model/ShamanCorruptFile>} corrupt_files
+ */
+ShamanScrubReport.prototype['corrupt_files'] = undefined;
+
+
+
+
+
+
+export default ShamanScrubReport;
+
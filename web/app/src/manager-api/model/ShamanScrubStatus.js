@@ -0,0 +1,84 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import ShamanScrubReport from './ShamanScrubReport';
+
+/**
+ * The ShamanScrubStatus model module.
+ * @module model/ShamanScrubStatus
+ * @version 0.0.0
+ */
+class ShamanScrubStatus {
+    /**
+     * Constructs a new <code>ShamanScrubStatus</code>.
+     * Status of the integrity check of the Shaman file store.
+     * @alias module:model/ShamanScrubStatus
+     * @param running {Boolean} Whether an integrity check is currently running.
+     */
+    constructor(running) { 
+        
+        ShamanScrubStatus.initialize(this, running);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, running) { 
+        obj['running'] = running;
+    }
+
+    /**
+     * Constructs a <code>ShamanScrubStatus</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/ShamanScrubStatus} obj Optional instance to populate.
+     * @return {module:model/ShamanScrubStatus} The populated <code>ShamanScrubStatus</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new ShamanScrubStatus();
+
+            if (data.hasOwnProperty('running')) {
+                obj['running'] = ApiClient.convertToType(data['running'], 'Boolean');
+            }
+            if (data.hasOwnProperty('last_report')) {
+                obj['last_report'] = ShamanScrubReport.constructFromObject(data['last_report']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Whether an integrity check is currently running.
+ * @member {Boolean} running
+ */
+ShamanScrubStatus.prototype['running'] = undefined;
+
+/**
+ * @member {module:model/ShamanScrubReport} last_report
+ */
+ShamanScrubStatus.prototype['last_report'] = undefined;
+
+
+
+
+
+
+export default ShamanScrubStatus;
+
@@ -54,6 +54,9 @@ class ShamanSingleFileStatus {
             if (data.hasOwnProperty('status')) {
                 obj['status'] = ShamanFileStatus.constructFromObject(data['status']);
             }
+            if (data.hasOwnProperty('offset')) {
+                obj['offset'] = ApiClient.convertToType(data['offset'], 'Number');
+            }
         }
         return obj;
     }
@@ -66,6 +69,12 @@ class ShamanSingleFileStatus {
  */
 ShamanSingleFileStatus.prototype['status'] = undefined;
 
+/**
+ * Number of bytes received by an earlier, unfinished upload of this file. The upload can be resumed from this offset by passing it in the `X-Shaman-Upload-Offset` header. Only non-zero when the status is `unknown`. 
+ * @member {Number} offset
+ */
+ShamanSingleFileStatus.prototype['offset'] = undefined;
+
 
 
 
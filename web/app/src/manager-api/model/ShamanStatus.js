@@ -0,0 +1,101 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import ShamanChunking from './ShamanChunking';
+import ShamanDiskSpace from './ShamanDiskSpace';
+
+/**
+ * The ShamanStatus model module.
+ * @module model/ShamanStatus
+ * @version 0.0.0
+ */
+class ShamanStatus {
+    /**
+     * Constructs a new <code>ShamanStatus</code>.
+     * Status of the Shaman file storage.
+     * @alias module:model/ShamanStatus
+     * @param enabled {Boolean} Whether the Shaman file transfer API is available.
+     */
+    constructor(enabled) { 
+        
+        ShamanStatus.initialize(this, enabled);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, enabled) { 
+        obj['enabled'] = enabled;
+    }
+
+    /**
+     * Constructs a <code>ShamanStatus</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/ShamanStatus} obj Optional instance to populate.
+     * @return {module:model/ShamanStatus} The populated <code>ShamanStatus</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new ShamanStatus();
+
+            if (data.hasOwnProperty('enabled')) {
+                obj['enabled'] = ApiClient.convertToType(data['enabled'], 'Boolean');
+            }
+            if (data.hasOwnProperty('file_store')) {
+                obj['file_store'] = ShamanDiskSpace.constructFromObject(data['file_store']);
+            }
+            if (data.hasOwnProperty('checkout')) {
+                obj['checkout'] = ShamanDiskSpace.constructFromObject(data['checkout']);
+            }
+            if (data.hasOwnProperty('chunking')) {
+                obj['chunking'] = ShamanChunking.constructFromObject(data['chunking']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Whether the Shaman file transfer API is available.
+ * @member {Boolean} enabled
+ */
+ShamanStatus.prototype['enabled'] = undefined;
+
+/**
+ * @member {module:model/ShamanDiskSpace} file_store
+ */
+ShamanStatus.prototype['file_store'] = undefined;
+
+/**
+ * @member {module:model/ShamanDiskSpace} checkout
+ */
+ShamanStatus.prototype['checkout'] = undefined;
+
+/**
+ * @member {module:model/ShamanChunking} chunking
+ */
+ShamanStatus.prototype['chunking'] = undefined;
+
+
+
+
+
+
+export default ShamanStatus;
+
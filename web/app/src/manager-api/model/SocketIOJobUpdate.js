@@ -85,6 +85,9 @@ class SocketIOJobUpdate {
             if (data.hasOwnProperty('refresh_tasks')) {
                 obj['refresh_tasks'] = ApiClient.convertToType(data['refresh_tasks'], 'Boolean');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
             if (data.hasOwnProperty('delete_requested_at')) {
                 obj['delete_requested_at'] = ApiClient.convertToType(data['delete_requested_at'], 'Date');
             }
@@ -143,6 +146,12 @@ SocketIOJobUpdate.prototype['priority'] = 50;
  */
 SocketIOJobUpdate.prototype['refresh_tasks'] = undefined;
 
+/**
+ * Percentage of the job that has been completed, 0-100. This is the average progress of its tasks, where completed tasks count as 100%. Only sent when the progress of one of the job's tasks changed. 
+ * @member {Number} progress
+ */
+SocketIOJobUpdate.prototype['progress'] = undefined;
+
 /**
  * If job deletion was requested, this is the timestamp at which that request was stored on Flamenco Manager. 
  * @member {Date} delete_requested_at
@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import TaskRenderProgress from './TaskRenderProgress';
 import TaskStatus from './TaskStatus';
 
 /**
@@ -30,10 +31,11 @@ class SocketIOTaskUpdate {
      * @param updated {Date} Timestamp of last update
      * @param status {module:model/TaskStatus} 
      * @param activity {String} 
+     * @param progress {Number} Percentage of the task that has been completed, 0-100.
      */
-    constructor(id, jobId, name, updated, status, activity) { 
+    constructor(id, jobId, name, updated, status, activity, progress) { 
         
-        SocketIOTaskUpdate.initialize(this, id, jobId, name, updated, status, activity);
+        SocketIOTaskUpdate.initialize(this, id, jobId, name, updated, status, activity, progress);
     }
 
     /**
@@ -41,13 +43,14 @@ class SocketIOTaskUpdate {
      * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
      * Only for internal use.
      */
-    static initialize(obj, id, jobId, name, updated, status, activity) { 
+    static initialize(obj, id, jobId, name, updated, status, activity, progress) { 
         obj['id'] = id;
         obj['job_id'] = jobId;
         obj['name'] = name;
         obj['updated'] = updated;
         obj['status'] = status;
         obj['activity'] = activity;
+        obj['progress'] = progress;
     }
 
     /**
@@ -82,6 +85,12 @@ class SocketIOTaskUpdate {
             if (data.hasOwnProperty('activity')) {
                 obj['activity'] = ApiClient.convertToType(data['activity'], 'String');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
+            if (data.hasOwnProperty('render_progress')) {
+                obj['render_progress'] = TaskRenderProgress.constructFromObject(data['render_progress']);
+            }
         }
         return obj;
     }
@@ -127,6 +136,17 @@ SocketIOTaskUpdate.prototype['previous_status'] = undefined;
  */
 SocketIOTaskUpdate.prototype['activity'] = undefined;
 
+/**
+ * Percentage of the task that has been completed, 0-100.
+ * @member {Number} progress
+ */
+SocketIOTaskUpdate.prototype['progress'] = undefined;
+
+/**
+ * @member {module:model/TaskRenderProgress} render_progress
+ */
+SocketIOTaskUpdate.prototype['render_progress'] = undefined;
+
 
 
 
@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import JobRetryPolicy from './JobRetryPolicy';
 import JobStorageInfo from './JobStorageInfo';
 
 /**
@@ -84,6 +85,18 @@ class SubmittedJob {
             if (data.hasOwnProperty('worker_tag')) {
                 obj['worker_tag'] = ApiClient.convertToType(data['worker_tag'], 'String');
             }
+            if (data.hasOwnProperty('depends_on')) {
+                obj['depends_on'] = ApiClient.convertToType(data['depends_on'], ['String']);
+            }
+            if (data.hasOwnProperty('not_before')) {
+                obj['not_before'] = ApiClient.convertToType(data['not_before'], 'Date');
+            }
+            if (data.hasOwnProperty('max_workers')) {
+                obj['max_workers'] = ApiClient.convertToType(data['max_workers'], 'Number');
+            }
+            if (data.hasOwnProperty('retry_policy')) {
+                obj['retry_policy'] = JobRetryPolicy.constructFromObject(data['retry_policy']);
+            }
         }
         return obj;
     }
@@ -141,6 +154,29 @@ SubmittedJob.prototype['storage'] = undefined;
  */
 SubmittedJob.prototype['worker_tag'] = undefined;
 
+/**
+ * UUIDs of jobs that have to be completed before this job can start. When any of those jobs fails or is canceled, this job will fail or be canceled as well. 
+ * @member {Array.<String>} depends_on
+ */
+SubmittedJob.prototype['depends_on'] = undefined;
+
+/**
+ * Timestamp before which the job should not start. Until that time, the job will be in 'waiting' status, after which it is queued automatically. If omitted or in the past, the job is queued immediately. 
+ * @member {Date} not_before
+ */
+SubmittedJob.prototype['not_before'] = undefined;
+
+/**
+ * Maximum number of Workers that can work on this job concurrently. If zero or omitted, there is no limit. 
+ * @member {Number} max_workers
+ */
+SubmittedJob.prototype['max_workers'] = undefined;
+
+/**
+ * @member {module:model/JobRetryPolicy} retry_policy
+ */
+SubmittedJob.prototype['retry_policy'] = undefined;
+
 
 
 
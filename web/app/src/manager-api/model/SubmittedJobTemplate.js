@@ -0,0 +1,107 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import SubmittedJob from './SubmittedJob';
+
+/**
+ * The SubmittedJobTemplate model module.
+ * @module model/SubmittedJobTemplate
+ * @version 0.0.0
+ */
+class SubmittedJobTemplate {
+    /**
+     * Constructs a new <code>SubmittedJobTemplate</code>.
+     * Job that is submitted periodically, according to a cron-style schedule. 
+     * @alias module:model/SubmittedJobTemplate
+     * @param name {String} 
+     * @param cron {String} Cron expression, with the fields \"minute hour day-of-month month day-of-week\", evaluated in the timezone of the Manager. The macros `@yearly`, `@monthly`, `@weekly`, `@daily`, and `@hourly` are supported as well. 
+     * @param isActive {Boolean} Only active templates submit jobs.
+     * @param job {module:model/SubmittedJob} 
+     */
+    constructor(name, cron, isActive, job) { 
+        
+        SubmittedJobTemplate.initialize(this, name, cron, isActive, job);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, name, cron, isActive, job) { 
+        obj['name'] = name;
+        obj['cron'] = cron;
+        obj['is_active'] = isActive;
+        obj['job'] = job;
+    }
+
+    /**
+     * Constructs a <code>SubmittedJobTemplate</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/SubmittedJobTemplate} obj Optional instance to populate.
+     * @return {module:model/SubmittedJobTemplate} The populated <code>SubmittedJobTemplate</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new SubmittedJobTemplate();
+
+            if (data.hasOwnProperty('name')) {
+                obj['name'] = ApiClient.convertToType(data['name'], 'String');
+            }
+            if (data.hasOwnProperty('cron')) {
+                obj['cron'] = ApiClient.convertToType(data['cron'], 'String');
+            }
+            if (data.hasOwnProperty('is_active')) {
+                obj['is_active'] = ApiClient.convertToType(data['is_active'], 'Boolean');
+            }
+            if (data.hasOwnProperty('job')) {
+                obj['job'] = SubmittedJob.constructFromObject(data['job']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} name
+ */
+SubmittedJobTemplate.prototype['name'] = undefined;
+
+/**
+ * Cron expression, with the fields \"minute hour day-of-month month day-of-week\", evaluated in the timezone of the Manager. The macros `@yearly`, `@monthly`, `@weekly`, `@daily`, and `@hourly` are supported as well. 
+ * @member {String} cron
+ */
+SubmittedJobTemplate.prototype['cron'] = undefined;
+
+/**
+ * Only active templates submit jobs.
+ * @member {Boolean} is_active
+ */
+SubmittedJobTemplate.prototype['is_active'] = undefined;
+
+/**
+ * @member {module:model/SubmittedJob} job
+ */
+SubmittedJobTemplate.prototype['job'] = undefined;
+
+
+
+
+
+
+export default SubmittedJobTemplate;
+
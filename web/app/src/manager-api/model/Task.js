@@ -35,11 +35,12 @@ class Task {
      * @param priority {Number} 
      * @param taskType {String} 
      * @param activity {String} 
+     * @param progress {Number} Percentage of the task that has been completed, 0-100.
      * @param commands {Array.<module:model/Command>} 
      */
-    constructor(id, created, updated, jobId, name, status, priority, taskType, activity, commands) { 
+    constructor(id, created, updated, jobId, name, status, priority, taskType, activity, progress, commands) { 
         
-        Task.initialize(this, id, created, updated, jobId, name, status, priority, taskType, activity, commands);
+        Task.initialize(this, id, created, updated, jobId, name, status, priority, taskType, activity, progress, commands);
     }
 
     /**
@@ -47,7 +48,7 @@ class Task {
      * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
      * Only for internal use.
      */
-    static initialize(obj, id, created, updated, jobId, name, status, priority, taskType, activity, commands) { 
+    static initialize(obj, id, created, updated, jobId, name, status, priority, taskType, activity, progress, commands) { 
         obj['id'] = id;
         obj['created'] = created;
         obj['updated'] = updated;
@@ -57,6 +58,7 @@ class Task {
         obj['priority'] = priority;
         obj['task_type'] = taskType;
         obj['activity'] = activity;
+        obj['progress'] = progress;
         obj['commands'] = commands;
     }
 
@@ -98,6 +100,9 @@ class Task {
             if (data.hasOwnProperty('activity')) {
                 obj['activity'] = ApiClient.convertToType(data['activity'], 'String');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
             if (data.hasOwnProperty('commands')) {
                 obj['commands'] = ApiClient.convertToType(data['commands'], [Command]);
             }
@@ -110,6 +115,12 @@ class Task {
             if (data.hasOwnProperty('failed_by_workers')) {
                 obj['failed_by_workers'] = ApiClient.convertToType(data['failed_by_workers'], [TaskWorker]);
             }
+            if (data.hasOwnProperty('min_cpu_count')) {
+                obj['min_cpu_count'] = ApiClient.convertToType(data['min_cpu_count'], 'Number');
+            }
+            if (data.hasOwnProperty('min_memory_gb')) {
+                obj['min_memory_gb'] = ApiClient.convertToType(data['min_memory_gb'], 'Number');
+            }
         }
         return obj;
     }
@@ -164,6 +175,12 @@ Task.prototype['task_type'] = undefined;
  */
 Task.prototype['activity'] = undefined;
 
+/**
+ * Percentage of the task that has been completed, 0-100.
+ * @member {Number} progress
+ */
+Task.prototype['progress'] = undefined;
+
 /**
  * @member {Array.<module:model/Command>} commands
  */
@@ -185,6 +202,18 @@ Task.prototype['last_touched'] = undefined;
  */
 Task.prototype['failed_by_workers'] = undefined;
 
+/**
+ * Minimum number of CPUs a Worker needs to get this task.
+ * @member {Number} min_cpu_count
+ */
+Task.prototype['min_cpu_count'] = undefined;
+
+/**
+ * Minimum amount of RAM, in GB, a Worker needs to get this task.
+ * @member {Number} min_memory_gb
+ */
+Task.prototype['min_memory_gb'] = undefined;
+
 
 
 
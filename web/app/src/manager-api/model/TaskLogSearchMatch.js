@@ -0,0 +1,116 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The TaskLogSearchMatch model module.
+ * @module model/TaskLogSearchMatch
+ * @version 0.0.0
+ */
+class TaskLogSearchMatch {
+    /**
+     * Constructs a new <code>TaskLogSearchMatch</code>.
+     * A task log line that matched the search query.
+     * @alias module:model/TaskLogSearchMatch
+     * @param jobId {String} 
+     * @param taskId {String} 
+     * @param taskName {String} 
+     * @param lineNumber {Number} Line number in the task log, starting at 1.
+     * @param line {String} The log line itself, without line ending.
+     */
+    constructor(jobId, taskId, taskName, lineNumber, line) { 
+        
+        TaskLogSearchMatch.initialize(this, jobId, taskId, taskName, lineNumber, line);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, jobId, taskId, taskName, lineNumber, line) { 
+        obj['job_id'] = jobId;
+        obj['task_id'] = taskId;
+        obj['task_name'] = taskName;
+        obj['line_number'] = lineNumber;
+        obj['line'] = line;
+    }
+
+    /**
+     * Constructs a <code>TaskLogSearchMatch</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/TaskLogSearchMatch} obj Optional instance to populate.
+     * @return {module:model/TaskLogSearchMatch} The populated <code>TaskLogSearchMatch</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new TaskLogSearchMatch();
+
+            if (data.hasOwnProperty('job_id')) {
+                obj['job_id'] = ApiClient.convertToType(data['job_id'], 'String');
+            }
+            if (data.hasOwnProperty('task_id')) {
+                obj['task_id'] = ApiClient.convertToType(data['task_id'], 'String');
+            }
+            if (data.hasOwnProperty('task_name')) {
+                obj['task_name'] = ApiClient.convertToType(data['task_name'], 'String');
+            }
+            if (data.hasOwnProperty('line_number')) {
+                obj['line_number'] = ApiClient.convertToType(data['line_number'], 'Number');
+            }
+            if (data.hasOwnProperty('line')) {
+                obj['line'] = ApiClient.convertToType(data['line'], 'String');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} job_id
+ */
+TaskLogSearchMatch.prototype['job_id'] = undefined;
+
+/**
+ * @member {String} task_id
+ */
+TaskLogSearchMatch.prototype['task_id'] = undefined;
+
+/**
+ * @member {String} task_name
+ */
+TaskLogSearchMatch.prototype['task_name'] = undefined;
+
+/**
+ * Line number in the task log, starting at 1.
+ * @member {Number} line_number
+ */
+TaskLogSearchMatch.prototype['line_number'] = undefined;
+
+/**
+ * The log line itself, without line ending.
+ * @member {String} line
+ */
+TaskLogSearchMatch.prototype['line'] = undefined;
+
+
+
+
+
+
+export default TaskLogSearchMatch;
+
@@ -0,0 +1,129 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The TaskLogSearchQuery model module.
+ * @module model/TaskLogSearchQuery
+ * @version 0.0.0
+ */
+class TaskLogSearchQuery {
+    /**
+     * Constructs a new <code>TaskLogSearchQuery</code>.
+     * Search query for task logs. Either &#x60;job_id&#x60; or &#x60;since&#x60; must be given. When &#x60;job_id&#x60; is given, &#x60;since&#x60; and &#x60;until&#x60; are ignored. 
+     * @alias module:model/TaskLogSearchQuery
+     * @param pattern {String} Text to search for. Log lines that contain this text are returned. 
+     */
+    constructor(pattern) { 
+        
+        TaskLogSearchQuery.initialize(this, pattern);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, pattern) { 
+        obj['pattern'] = pattern;
+    }
+
+    /**
+     * Constructs a <code>TaskLogSearchQuery</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/TaskLogSearchQuery} obj Optional instance to populate.
+     * @return {module:model/TaskLogSearchQuery} The populated <code>TaskLogSearchQuery</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new TaskLogSearchQuery();
+
+            if (data.hasOwnProperty('pattern')) {
+                obj['pattern'] = ApiClient.convertToType(data['pattern'], 'String');
+            }
+            if (data.hasOwnProperty('regexp')) {
+                obj['regexp'] = ApiClient.convertToType(data['regexp'], 'Boolean');
+            }
+            if (data.hasOwnProperty('ignore_case')) {
+                obj['ignore_case'] = ApiClient.convertToType(data['ignore_case'], 'Boolean');
+            }
+            if (data.hasOwnProperty('job_id')) {
+                obj['job_id'] = ApiClient.convertToType(data['job_id'], 'String');
+            }
+            if (data.hasOwnProperty('since')) {
+                obj['since'] = ApiClient.convertToType(data['since'], 'Date');
+            }
+            if (data.hasOwnProperty('until')) {
+                obj['until'] = ApiClient.convertToType(data['until'], 'Date');
+            }
+            if (data.hasOwnProperty('limit')) {
+                obj['limit'] = ApiClient.convertToType(data['limit'], 'Number');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Text to search for. Log lines that contain this text are returned. 
+ * @member {String} pattern
+ */
+TaskLogSearchQuery.prototype['pattern'] = undefined;
+
+/**
+ * Interpret the pattern as regular expression (RE2 syntax) instead of as literal text. 
+ * @member {Boolean} regexp
+ */
+TaskLogSearchQuery.prototype['regexp'] = undefined;
+
+/**
+ * Perform case-insensitive matching.
+ * @member {Boolean} ignore_case
+ */
+TaskLogSearchQuery.prototype['ignore_case'] = undefined;
+
+/**
+ * Only search the task logs of this job.
+ * @member {String} job_id
+ */
+TaskLogSearchQuery.prototype['job_id'] = undefined;
+
+/**
+ * Only search the task logs of jobs that were updated at or after this time. 
+ * @member {Date} since
+ */
+TaskLogSearchQuery.prototype['since'] = undefined;
+
+/**
+ * Only search the task logs of jobs that were created at or before this time. 
+ * @member {Date} until
+ */
+TaskLogSearchQuery.prototype['until'] = undefined;
+
+/**
+ * Maximum number of matches to return. Defaults to 1000. 
+ * @member {Number} limit
+ */
+TaskLogSearchQuery.prototype['limit'] = undefined;
+
+
+
+
+
+
+export default TaskLogSearchQuery;
+
@@ -0,0 +1,136 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The TaskRenderProgress model module.
+ * @module model/TaskRenderProgress
+ * @version 0.0.0
+ */
+class TaskRenderProgress {
+    /**
+     * Constructs a new <code>TaskRenderProgress</code>.
+     * Progress of a render task, as parsed by the Worker from the output of the renderer. All properties are optional, and only sent when known. 
+     * @alias module:model/TaskRenderProgress
+     */
+    constructor() { 
+        
+        TaskRenderProgress.initialize(this);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj) { 
+    }
+
+    /**
+     * Constructs a <code>TaskRenderProgress</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/TaskRenderProgress} obj Optional instance to populate.
+     * @return {module:model/TaskRenderProgress} The populated <code>TaskRenderProgress</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new TaskRenderProgress();
+
+            if (data.hasOwnProperty('frame')) {
+                obj['frame'] = ApiClient.convertToType(data['frame'], 'Number');
+            }
+            if (data.hasOwnProperty('frames_done')) {
+                obj['frames_done'] = ApiClient.convertToType(data['frames_done'], 'Number');
+            }
+            if (data.hasOwnProperty('frames_total')) {
+                obj['frames_total'] = ApiClient.convertToType(data['frames_total'], 'Number');
+            }
+            if (data.hasOwnProperty('samples_done')) {
+                obj['samples_done'] = ApiClient.convertToType(data['samples_done'], 'Number');
+            }
+            if (data.hasOwnProperty('samples_total')) {
+                obj['samples_total'] = ApiClient.convertToType(data['samples_total'], 'Number');
+            }
+            if (data.hasOwnProperty('memory_peak_mb')) {
+                obj['memory_peak_mb'] = ApiClient.convertToType(data['memory_peak_mb'], 'Number');
+            }
+            if (data.hasOwnProperty('last_frame_time')) {
+                obj['last_frame_time'] = ApiClient.convertToType(data['last_frame_time'], 'Number');
+            }
+            if (data.hasOwnProperty('missing_files')) {
+                obj['missing_files'] = ApiClient.convertToType(data['missing_files'], ['String']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * The frame that is currently being rendered.
+ * @member {Number} frame
+ */
+TaskRenderProgress.prototype['frame'] = undefined;
+
+/**
+ * The number of frames this task has saved so far.
+ * @member {Number} frames_done
+ */
+TaskRenderProgress.prototype['frames_done'] = undefined;
+
+/**
+ * The number of frames this task should render.
+ * @member {Number} frames_total
+ */
+TaskRenderProgress.prototype['frames_total'] = undefined;
+
+/**
+ * The number of samples rendered of the current frame.
+ * @member {Number} samples_done
+ */
+TaskRenderProgress.prototype['samples_done'] = undefined;
+
+/**
+ * The total number of samples to render for the current frame.
+ * @member {Number} samples_total
+ */
+TaskRenderProgress.prototype['samples_total'] = undefined;
+
+/**
+ * Peak memory usage reported by the renderer, in megabytes.
+ * @member {Number} memory_peak_mb
+ */
+TaskRenderProgress.prototype['memory_peak_mb'] = undefined;
+
+/**
+ * Render time of the most recently saved frame, in seconds.
+ * @member {Number} last_frame_time
+ */
+TaskRenderProgress.prototype['last_frame_time'] = undefined;
+
+/**
+ * Files the renderer could not open, such as missing textures. 
+ * @member {Array.<String>} missing_files
+ */
+TaskRenderProgress.prototype['missing_files'] = undefined;
+
+
+
+
+
+
+export default TaskRenderProgress;
+
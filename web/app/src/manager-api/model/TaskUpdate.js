@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import TaskRenderProgress from './TaskRenderProgress';
 import TaskStatus from './TaskStatus';
 
 /**
@@ -58,6 +59,12 @@ class TaskUpdate {
             if (data.hasOwnProperty('log')) {
                 obj['log'] = ApiClient.convertToType(data['log'], 'String');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
+            if (data.hasOwnProperty('render_progress')) {
+                obj['render_progress'] = TaskRenderProgress.constructFromObject(data['render_progress']);
+            }
         }
         return obj;
     }
@@ -82,6 +89,17 @@ TaskUpdate.prototype['activity'] = undefined;
  */
 TaskUpdate.prototype['log'] = undefined;
 
+/**
+ * Percentage of the task that has been completed. Overwrites previously sent progress. 
+ * @member {Number} progress
+ */
+TaskUpdate.prototype['progress'] = undefined;
+
+/**
+ * @member {module:model/TaskRenderProgress} render_progress
+ */
+TaskUpdate.prototype['render_progress'] = undefined;
+
 
 
 
@@ -13,6 +13,8 @@
 
 import ApiClient from '../ApiClient';
 import WorkerAllOf from './WorkerAllOf';
+import WorkerResourceSample from './WorkerResourceSample';
+import WorkerResources from './WorkerResources';
 import WorkerStatus from './WorkerStatus';
 import WorkerStatusChangeRequest from './WorkerStatusChangeRequest';
 import WorkerSummary from './WorkerSummary';
@@ -110,6 +112,12 @@ class Worker {
             if (data.hasOwnProperty('tags')) {
                 obj['tags'] = ApiClient.convertToType(data['tags'], [WorkerTag]);
             }
+            if (data.hasOwnProperty('resources')) {
+                obj['resources'] = WorkerResources.constructFromObject(data['resources']);
+            }
+            if (data.hasOwnProperty('resource_usage')) {
+                obj['resource_usage'] = WorkerResourceSample.constructFromObject(data['resource_usage']);
+            }
         }
         return obj;
     }
@@ -183,6 +191,16 @@ Worker.prototype['task'] = undefined;
  */
 Worker.prototype['tags'] = undefined;
 
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+Worker.prototype['resources'] = undefined;
+
+/**
+ * @member {module:model/WorkerResourceSample} resource_usage
+ */
+Worker.prototype['resource_usage'] = undefined;
+
 
 // Implement WorkerSummary interface:
 /**
@@ -240,6 +258,14 @@ WorkerAllOf.prototype['task'] = undefined;
  * @member {Array.<module:model/WorkerTag>} tags
  */
 WorkerAllOf.prototype['tags'] = undefined;
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+WorkerAllOf.prototype['resources'] = undefined;
+/**
+ * @member {module:model/WorkerResourceSample} resource_usage
+ */
+WorkerAllOf.prototype['resource_usage'] = undefined;
 
 
 
@@ -12,6 +12,8 @@
  */
 
 import ApiClient from '../ApiClient';
+import WorkerResourceSample from './WorkerResourceSample';
+import WorkerResources from './WorkerResources';
 import WorkerTag from './WorkerTag';
 import WorkerTask from './WorkerTask';
 
@@ -70,6 +72,12 @@ class WorkerAllOf {
             if (data.hasOwnProperty('tags')) {
                 obj['tags'] = ApiClient.convertToType(data['tags'], [WorkerTag]);
             }
+            if (data.hasOwnProperty('resources')) {
+                obj['resources'] = WorkerResources.constructFromObject(data['resources']);
+            }
+            if (data.hasOwnProperty('resource_usage')) {
+                obj['resource_usage'] = WorkerResourceSample.constructFromObject(data['resource_usage']);
+            }
         }
         return obj;
     }
@@ -105,6 +113,16 @@ WorkerAllOf.prototype['task'] = undefined;
  */
 WorkerAllOf.prototype['tags'] = undefined;
 
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+WorkerAllOf.prototype['resources'] = undefined;
+
+/**
+ * @member {module:model/WorkerResourceSample} resource_usage
+ */
+WorkerAllOf.prototype['resource_usage'] = undefined;
+
 
 
 
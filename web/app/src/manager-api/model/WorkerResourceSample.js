@@ -0,0 +1,130 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import WorkerResourceSampleAllOf from './WorkerResourceSampleAllOf';
+import WorkerResourceUsage from './WorkerResourceUsage';
+
+/**
+ * The WorkerResourceSample model module.
+ * @module model/WorkerResourceSample
+ * @version 0.0.0
+ */
+class WorkerResourceSample {
+    /**
+     * Constructs a new <code>WorkerResourceSample</code>.
+     * Resource usage of a Worker, as last reported in its heartbeat.
+     * @alias module:model/WorkerResourceSample
+     * @implements module:model/WorkerResourceUsage
+     * @implements module:model/WorkerResourceSampleAllOf
+     * @param timestamp {Date} When the Manager received this sample.
+     */
+    constructor(timestamp) { 
+        WorkerResourceUsage.initialize(this);WorkerResourceSampleAllOf.initialize(this, timestamp);
+        WorkerResourceSample.initialize(this, timestamp);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, timestamp) { 
+        obj['timestamp'] = timestamp;
+    }
+
+    /**
+     * Constructs a <code>WorkerResourceSample</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/WorkerResourceSample} obj Optional instance to populate.
+     * @return {module:model/WorkerResourceSample} The populated <code>WorkerResourceSample</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new WorkerResourceSample();
+            WorkerResourceUsage.constructFromObject(data, obj);
+            WorkerResourceSampleAllOf.constructFromObject(data, obj);
+
+            if (data.hasOwnProperty('load_average')) {
+                obj['load_average'] = ApiClient.convertToType(data['load_average'], 'Number');
+            }
+            if (data.hasOwnProperty('memory_used')) {
+                obj['memory_used'] = ApiClient.convertToType(data['memory_used'], 'Number');
+            }
+            if (data.hasOwnProperty('temp_disk_free')) {
+                obj['temp_disk_free'] = ApiClient.convertToType(data['temp_disk_free'], 'Number');
+            }
+            if (data.hasOwnProperty('timestamp')) {
+                obj['timestamp'] = ApiClient.convertToType(data['timestamp'], 'Date');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * System load averaged over the last minute.
+ * @member {Number} load_average
+ */
+WorkerResourceSample.prototype['load_average'] = undefined;
+
+/**
+ * Amount of RAM in use, in bytes.
+ * @member {Number} memory_used
+ */
+WorkerResourceSample.prototype['memory_used'] = undefined;
+
+/**
+ * Free disk space of the Worker's temporary directory, in bytes.
+ * @member {Number} temp_disk_free
+ */
+WorkerResourceSample.prototype['temp_disk_free'] = undefined;
+
+/**
+ * When the Manager received this sample.
+ * @member {Date} timestamp
+ */
+WorkerResourceSample.prototype['timestamp'] = undefined;
+
+
+// Implement WorkerResourceUsage interface:
+/**
+ * System load averaged over the last minute.
+ * @member {Number} load_average
+ */
+WorkerResourceUsage.prototype['load_average'] = undefined;
+/**
+ * Amount of RAM in use, in bytes.
+ * @member {Number} memory_used
+ */
+WorkerResourceUsage.prototype['memory_used'] = undefined;
+/**
+ * Free disk space of the Worker's temporary directory, in bytes.
+ * @member {Number} temp_disk_free
+ */
+WorkerResourceUsage.prototype['temp_disk_free'] = undefined;
+// Implement WorkerResourceSampleAllOf interface:
+/**
+ * When the Manager received this sample.
+ * @member {Date} timestamp
+ */
+WorkerResourceSampleAllOf.prototype['timestamp'] = undefined;
+
+
+
+
+export default WorkerResourceSample;
+
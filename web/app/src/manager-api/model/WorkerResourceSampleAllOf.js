@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The WorkerResourceSampleAllOf model module.
+ * @module model/WorkerResourceSampleAllOf
+ * @version 0.0.0
+ */
+class WorkerResourceSampleAllOf {
+    /**
+     * Constructs a new <code>WorkerResourceSampleAllOf</code>.
+     * @alias module:model/WorkerResourceSampleAllOf
+     * @param timestamp {Date} When the Manager received this sample.
+     */
+    constructor(timestamp) { 
+        
+        WorkerResourceSampleAllOf.initialize(this, timestamp);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, timestamp) { 
+        obj['timestamp'] = timestamp;
+    }
+
+    /**
+     * Constructs a <code>WorkerResourceSampleAllOf</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/WorkerResourceSampleAllOf} obj Optional instance to populate.
+     * @return {module:model/WorkerResourceSampleAllOf} The populated <code>WorkerResourceSampleAllOf</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new WorkerResourceSampleAllOf();
+
+            if (data.hasOwnProperty('timestamp')) {
+                obj['timestamp'] = ApiClient.convertToType(data['timestamp'], 'Date');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * When the Manager received this sample.
+ * @member {Date} timestamp
+ */
+WorkerResourceSampleAllOf.prototype['timestamp'] = undefined;
+
+
+
+
+
+
+export default WorkerResourceSampleAllOf;
+
@@ -0,0 +1,91 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The WorkerResourceUsage model module.
+ * @module model/WorkerResourceUsage
+ * @version 0.0.0
+ */
+class WorkerResourceUsage {
+    /**
+     * Constructs a new <code>WorkerResourceUsage</code>.
+     * Resource usage of a Worker. Values that cannot be determined on the Worker&#39;s platform are left out. 
+     * @alias module:model/WorkerResourceUsage
+     */
+    constructor() { 
+        
+        WorkerResourceUsage.initialize(this);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj) { 
+    }
+
+    /**
+     * Constructs a <code>WorkerResourceUsage</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/WorkerResourceUsage} obj Optional instance to populate.
+     * @return {module:model/WorkerResourceUsage} The populated <code>WorkerResourceUsage</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new WorkerResourceUsage();
+
+            if (data.hasOwnProperty('load_average')) {
+                obj['load_average'] = ApiClient.convertToType(data['load_average'], 'Number');
+            }
+            if (data.hasOwnProperty('memory_used')) {
+                obj['memory_used'] = ApiClient.convertToType(data['memory_used'], 'Number');
+            }
+            if (data.hasOwnProperty('temp_disk_free')) {
+                obj['temp_disk_free'] = ApiClient.convertToType(data['temp_disk_free'], 'Number');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * System load averaged over the last minute.
+ * @member {Number} load_average
+ */
+WorkerResourceUsage.prototype['load_average'] = undefined;
+
+/**
+ * Amount of RAM in use, in bytes.
+ * @member {Number} memory_used
+ */
+WorkerResourceUsage.prototype['memory_used'] = undefined;
+
+/**
+ * Free disk space of the Worker's temporary directory, in bytes.
+ * @member {Number} temp_disk_free
+ */
+WorkerResourceUsage.prototype['temp_disk_free'] = undefined;
+
+
+
+
+
+
+export default WorkerResourceUsage;
+
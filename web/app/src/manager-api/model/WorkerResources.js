@@ -0,0 +1,100 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The WorkerResources model module.
+ * @module model/WorkerResources
+ * @version 0.0.0
+ */
+class WorkerResources {
+    /**
+     * Constructs a new <code>WorkerResources</code>.
+     * Hardware and operating system of a Worker, as reported when it signs on.
+     * @alias module:model/WorkerResources
+     */
+    constructor() { 
+        
+        WorkerResources.initialize(this);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj) { 
+    }
+
+    /**
+     * Constructs a <code>WorkerResources</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/WorkerResources} obj Optional instance to populate.
+     * @return {module:model/WorkerResources} The populated <code>WorkerResources</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new WorkerResources();
+
+            if (data.hasOwnProperty('cpu_count')) {
+                obj['cpu_count'] = ApiClient.convertToType(data['cpu_count'], 'Number');
+            }
+            if (data.hasOwnProperty('memory_total')) {
+                obj['memory_total'] = ApiClient.convertToType(data['memory_total'], 'Number');
+            }
+            if (data.hasOwnProperty('temp_disk_free')) {
+                obj['temp_disk_free'] = ApiClient.convertToType(data['temp_disk_free'], 'Number');
+            }
+            if (data.hasOwnProperty('os_description')) {
+                obj['os_description'] = ApiClient.convertToType(data['os_description'], 'String');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Number of logical CPUs.
+ * @member {Number} cpu_count
+ */
+WorkerResources.prototype['cpu_count'] = undefined;
+
+/**
+ * Total amount of RAM, in bytes.
+ * @member {Number} memory_total
+ */
+WorkerResources.prototype['memory_total'] = undefined;
+
+/**
+ * Free disk space of the Worker's temporary directory, in bytes.
+ * @member {Number} temp_disk_free
+ */
+WorkerResources.prototype['temp_disk_free'] = undefined;
+
+/**
+ * Description of the operating system, more detailed than the platform.
+ * @member {String} os_description
+ */
+WorkerResources.prototype['os_description'] = undefined;
+
+
+
+
+
+
+export default WorkerResources;
+
@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import WorkerResources from './WorkerResources';
 
 /**
  * The WorkerSignOn model module.
@@ -65,6 +66,9 @@ class WorkerSignOn {
             if (data.hasOwnProperty('can_restart')) {
                 obj['can_restart'] = ApiClient.convertToType(data['can_restart'], 'Boolean');
             }
+            if (data.hasOwnProperty('resources')) {
+                obj['resources'] = WorkerResources.constructFromObject(data['resources']);
+            }
         }
         return obj;
     }
@@ -92,6 +96,11 @@ WorkerSignOn.prototype['software_version'] = undefined;
  */
 WorkerSignOn.prototype['can_restart'] = undefined;
 
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+WorkerSignOn.prototype['resources'] = undefined;
+
 
 
 
@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import WorkerSleepWindow from './WorkerSleepWindow';
 
 /**
  * The WorkerSleepSchedule model module.
@@ -68,6 +69,12 @@ class WorkerSleepSchedule {
             if (data.hasOwnProperty('end_time')) {
                 obj['end_time'] = ApiClient.convertToType(data['end_time'], 'String');
             }
+            if (data.hasOwnProperty('extra_windows')) {
+                obj['extra_windows'] = ApiClient.convertToType(data['extra_windows'], [WorkerSleepWindow]);
+            }
+            if (data.hasOwnProperty('exception_dates')) {
+                obj['exception_dates'] = ApiClient.convertToType(data['exception_dates'], ['String']);
+            }
         }
         return obj;
     }
@@ -96,6 +103,18 @@ WorkerSleepSchedule.prototype['start_time'] = undefined;
  */
 WorkerSleepSchedule.prototype['end_time'] = undefined;
 
+/**
+ * Time windows in which the Worker sleeps, in addition to the window from `start_time` to `end_time`. 
+ * @member {Array.<module:model/WorkerSleepWindow>} extra_windows
+ */
+WorkerSleepSchedule.prototype['extra_windows'] = undefined;
+
+/**
+ * Dates, in YYYY-MM-DD notation, on which the schedule does not apply and the Worker stays awake all day. This can be used to keep Workers rendering during holidays. 
+ * @member {Array.<String>} exception_dates
+ */
+WorkerSleepSchedule.prototype['exception_dates'] = undefined;
+
 
 
 
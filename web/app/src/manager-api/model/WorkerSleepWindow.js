@@ -0,0 +1,84 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The WorkerSleepWindow model module.
+ * @module model/WorkerSleepWindow
+ * @version 0.0.0
+ */
+class WorkerSleepWindow {
+    /**
+     * Constructs a new <code>WorkerSleepWindow</code>.
+     * Time window in which a Worker sleeps. An empty start time means \&quot;start of the day\&quot;, and an empty end time means \&quot;end of the day\&quot;. 
+     * @alias module:model/WorkerSleepWindow
+     * @param startTime {String} 
+     * @param endTime {String} 
+     */
+    constructor(startTime, endTime) { 
+        
+        WorkerSleepWindow.initialize(this, startTime, endTime);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, startTime, endTime) { 
+        obj['start_time'] = startTime;
+        obj['end_time'] = endTime;
+    }
+
+    /**
+     * Constructs a <code>WorkerSleepWindow</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/WorkerSleepWindow} obj Optional instance to populate.
+     * @return {module:model/WorkerSleepWindow} The populated <code>WorkerSleepWindow</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new WorkerSleepWindow();
+
+            if (data.hasOwnProperty('start_time')) {
+                obj['start_time'] = ApiClient.convertToType(data['start_time'], 'String');
+            }
+            if (data.hasOwnProperty('end_time')) {
+                obj['end_time'] = ApiClient.convertToType(data['end_time'], 'String');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} start_time
+ */
+WorkerSleepWindow.prototype['start_time'] = undefined;
+
+/**
+ * @member {String} end_time
+ */
+WorkerSleepWindow.prototype['end_time'] = undefined;
+
+
+
+
+
+
+export default WorkerSleepWindow;
+
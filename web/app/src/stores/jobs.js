@@ -25,14 +25,20 @@ export const useJobs = defineStore('jobs', {
   }),
   getters: {
     canDelete() {
-      return this._anyJobWithStatus(['queued', 'paused', 'failed', 'completed', 'canceled']);
+      return this._anyJobWithStatus(['queued', 'waiting', 'paused', 'failed', 'completed', 'canceled']);
     },
     canCancel() {
-      return this._anyJobWithStatus(['queued', 'active', 'failed']);
+      return this._anyJobWithStatus(['queued', 'waiting', 'active', 'failed', 'paused']);
     },
     canRequeue() {
       return this._anyJobWithStatus(['canceled', 'completed', 'failed', 'paused']);
     },
+    canPause() {
+      return this._anyJobWithStatus(['queued', 'active']);
+    },
+    canResume() {
+      return this._anyJobWithStatus(['paused']);
+    },
   },
   actions: {
     setIsJobless(isJobless) {
@@ -77,6 +83,12 @@ export const useJobs = defineStore('jobs', {
     requeueJobs() {
       return this._setJobStatus('requeueing');
     },
+    pauseJobs() {
+      return this._setJobStatus('paused');
+    },
+    resumeJobs() {
+      return this._setJobStatus('queued');
+    },
     deleteJobs() {
       if (!this.activeJobID) {
         console.warn(`deleteJobs() impossible, no active job ID`);